@@ -68,6 +68,62 @@ func deepCopy_api_ApplicationList(in api.ApplicationList, out *api.ApplicationLi
 	return nil
 }
 
+func deepCopy_api_ApplicationRevision(in api.ApplicationRevision, out *api.ApplicationRevision, c *conversion.Cloner) error {
+	out.Revision = in.Revision
+	if newVal, err := c.DeepCopy(in.CreationTimestamp); err != nil {
+		return err
+	} else {
+		out.CreationTimestamp = newVal.(unversioned.Time)
+	}
+	if in.Items != nil {
+		out.Items = make([]api.Item, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_api_Item(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Snapshots != nil {
+		out.Snapshots = make(map[string]string)
+		for key, val := range in.Snapshots {
+			out.Snapshots[key] = val
+		}
+	} else {
+		out.Snapshots = nil
+	}
+	if in.Replicas != nil {
+		out.Replicas = make(map[string]int)
+		for key, val := range in.Replicas {
+			out.Replicas[key] = val
+		}
+	} else {
+		out.Replicas = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ApplicationRollback(in api.ApplicationRollback, out *api.ApplicationRollback, c *conversion.Cloner) error {
+	out.Revision = in.Revision
+	return nil
+}
+
+func deepCopy_api_ApplicationSchedule(in api.ApplicationSchedule, out *api.ApplicationSchedule, c *conversion.Cloner) error {
+	out.StartTime = in.StartTime
+	out.StopTime = in.StopTime
+	if in.Weekdays != nil {
+		out.Weekdays = make([]string, len(in.Weekdays))
+		for i := range in.Weekdays {
+			out.Weekdays[i] = in.Weekdays[i]
+		}
+	} else {
+		out.Weekdays = nil
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
 func deepCopy_api_ApplicationSpec(in api.ApplicationSpec, out *api.ApplicationSpec, c *conversion.Cloner) error {
 	out.Name = in.Name
 	if in.Items != nil {
@@ -81,6 +137,24 @@ func deepCopy_api_ApplicationSpec(in api.ApplicationSpec, out *api.ApplicationSp
 		out.Items = nil
 	}
 	out.Destory = in.Destory
+	out.ForceDestory = in.ForceDestory
+	out.Stopped = in.Stopped
+	if in.Schedule != nil {
+		out.Schedule = new(api.ApplicationSchedule)
+		if err := deepCopy_api_ApplicationSchedule(*in.Schedule, out.Schedule, c); err != nil {
+			return err
+		}
+	} else {
+		out.Schedule = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(api.ApplicationRollback)
+		if err := deepCopy_api_ApplicationRollback(*in.RollbackTo, out.RollbackTo, c); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapi.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -94,6 +168,41 @@ func deepCopy_api_ApplicationSpec(in api.ApplicationSpec, out *api.ApplicationSp
 
 func deepCopy_api_ApplicationStatus(in api.ApplicationStatus, out *api.ApplicationStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.StoppedReplicas != nil {
+		out.StoppedReplicas = make(map[string]int)
+		for key, val := range in.StoppedReplicas {
+			out.StoppedReplicas[key] = val
+		}
+	} else {
+		out.StoppedReplicas = nil
+	}
+	out.ScheduleState = in.ScheduleState
+	if in.Revisions != nil {
+		out.Revisions = make([]api.ApplicationRevision, len(in.Revisions))
+		for i := range in.Revisions {
+			if err := deepCopy_api_ApplicationRevision(in.Revisions[i], &out.Revisions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revisions = nil
+	}
+	if in.HeldReplicas != nil {
+		out.HeldReplicas = make(map[string]int)
+		for key, val := range in.HeldReplicas {
+			out.HeldReplicas[key] = val
+		}
+	} else {
+		out.HeldReplicas = nil
+	}
+	if in.ReleasedItems != nil {
+		out.ReleasedItems = make([]string, len(in.ReleasedItems))
+		for i := range in.ReleasedItems {
+			out.ReleasedItems[i] = in.ReleasedItems[i]
+		}
+	} else {
+		out.ReleasedItems = nil
+	}
 	return nil
 }
 
@@ -101,6 +210,14 @@ func deepCopy_api_Item(in api.Item, out *api.Item, c *conversion.Cloner) error {
 	out.Kind = in.Kind
 	out.Name = in.Name
 	out.Status = in.Status
+	if in.DependsOn != nil {
+		out.DependsOn = make([]string, len(in.DependsOn))
+		for i := range in.DependsOn {
+			out.DependsOn[i] = in.DependsOn[i]
+		}
+	} else {
+		out.DependsOn = nil
+	}
 	return nil
 }
 
@@ -1110,6 +1227,13 @@ func deepCopy_api_Build(in buildapi.Build, out *buildapi.Build, c *conversion.Cl
 	return nil
 }
 
+func deepCopy_api_BuildCacheVolume(in buildapi.BuildCacheVolume, out *buildapi.BuildCacheVolume, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.ClaimName = in.ClaimName
+	out.Path = in.Path
+	return nil
+}
+
 func deepCopy_api_BuildConfig(in buildapi.BuildConfig, out *buildapi.BuildConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1165,6 +1289,27 @@ func deepCopy_api_BuildConfigSpec(in buildapi.BuildConfigSpec, out *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapi.BuildParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := deepCopy_api_BuildParameter(in.Parameters[i], &out.Parameters[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.Matrix != nil {
+		out.Matrix = make([]buildapi.BuildMatrixParameter, len(in.Matrix))
+		for i := range in.Matrix {
+			if err := deepCopy_api_BuildMatrixParameter(in.Matrix[i], &out.Matrix[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Matrix = nil
+	}
 	if err := deepCopy_api_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -1256,6 +1401,55 @@ func deepCopy_api_BuildLogOptions(in buildapi.BuildLogOptions, out *buildapi.Bui
 	return nil
 }
 
+func deepCopy_api_BuildMatrixParameter(in buildapi.BuildMatrixParameter, out *buildapi.BuildMatrixParameter, c *conversion.Cloner) error {
+	out.Name = in.Name
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BuildNotification(in buildapi.BuildNotification, out *buildapi.BuildNotification, c *conversion.Cloner) error {
+	if in.Phases != nil {
+		out.Phases = make([]buildapi.BuildPhase, len(in.Phases))
+		for i := range in.Phases {
+			out.Phases[i] = in.Phases[i]
+		}
+	} else {
+		out.Phases = nil
+	}
+	if in.Webhook != nil {
+		out.Webhook = new(buildapi.WebhookNotification)
+		if err := deepCopy_api_WebhookNotification(*in.Webhook, out.Webhook, c); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	if in.Slack != nil {
+		out.Slack = new(buildapi.SlackNotification)
+		if err := deepCopy_api_SlackNotification(*in.Slack, out.Slack, c); err != nil {
+			return err
+		}
+	} else {
+		out.Slack = nil
+	}
+	if in.Email != nil {
+		out.Email = new(buildapi.EmailNotification)
+		if err := deepCopy_api_EmailNotification(*in.Email, out.Email, c); err != nil {
+			return err
+		}
+	} else {
+		out.Email = nil
+	}
+	return nil
+}
+
 func deepCopy_api_BuildOutput(in buildapi.BuildOutput, out *buildapi.BuildOutput, c *conversion.Cloner) error {
 	if in.To != nil {
 		if newVal, err := c.DeepCopy(in.To); err != nil {
@@ -1278,6 +1472,21 @@ func deepCopy_api_BuildOutput(in buildapi.BuildOutput, out *buildapi.BuildOutput
 	return nil
 }
 
+func deepCopy_api_BuildParameter(in buildapi.BuildParameter, out *buildapi.BuildParameter, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Type = in.Type
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+func deepCopy_api_BuildParameterValue(in buildapi.BuildParameterValue, out *buildapi.BuildParameterValue, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
 func deepCopy_api_BuildPostCommitSpec(in buildapi.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, c *conversion.Cloner) error {
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
@@ -1362,6 +1571,25 @@ func deepCopy_api_BuildRequest(in buildapi.BuildRequest, out *buildapi.BuildRequ
 	} else {
 		out.Env = nil
 	}
+	if in.PullRequest != nil {
+		out.PullRequest = new(buildapi.GitPullRequest)
+		if err := deepCopy_api_GitPullRequest(*in.PullRequest, out.PullRequest, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
+	out.NoCache = in.NoCache
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapi.BuildParameterValue, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := deepCopy_api_BuildParameterValue(in.Parameters[i], &out.Parameters[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
 	return nil
 }
 
@@ -1454,6 +1682,24 @@ func deepCopy_api_BuildSpec(in buildapi.BuildSpec, out *buildapi.BuildSpec, c *c
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Notifications != nil {
+		out.Notifications = make([]buildapi.BuildNotification, len(in.Notifications))
+		for i := range in.Notifications {
+			if err := deepCopy_api_BuildNotification(in.Notifications[i], &out.Notifications[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Notifications = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusReporting)
+		if err := deepCopy_api_CommitStatusReporting(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1491,6 +1737,16 @@ func deepCopy_api_BuildStatus(in buildapi.BuildStatus, out *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_api_PipelineStageStatus(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
 	return nil
 }
 
@@ -1519,6 +1775,14 @@ func deepCopy_api_BuildStrategy(in buildapi.BuildStrategy, out *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapi.PipelineBuildStrategy)
+		if err := deepCopy_api_PipelineBuildStrategy(*in.PipelineStrategy, out.PipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1540,6 +1804,30 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.GogsWebHook != nil {
+		out.GogsWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.GogsWebHook, out.GogsWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GogsWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := deepCopy_api_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1551,6 +1839,18 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	return nil
 }
 
+func deepCopy_api_CommitStatusReporting(in buildapi.CommitStatusReporting, out *buildapi.CommitStatusReporting, c *conversion.Cloner) error {
+	out.Provider = in.Provider
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.TokenSecret); err != nil {
+		return err
+	} else {
+		out.TokenSecret = newVal.(pkgapi.LocalObjectReference)
+	}
+	out.Context = in.Context
+	return nil
+}
+
 func deepCopy_api_CustomBuildStrategy(in buildapi.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1628,6 +1928,28 @@ func deepCopy_api_DockerBuildStrategy(in buildapi.DockerBuildStrategy, out *buil
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapi.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := deepCopy_api_BuildCacheVolume(in.CacheVolumes[i], &out.CacheVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func deepCopy_api_EmailNotification(in buildapi.EmailNotification, out *buildapi.EmailNotification, c *conversion.Cloner) error {
+	if in.To != nil {
+		out.To = make([]string, len(in.To))
+		for i := range in.To {
+			out.To[i] = in.To[i]
+		}
+	} else {
+		out.To = nil
+	}
 	return nil
 }
 
@@ -1649,6 +1971,12 @@ func deepCopy_api_GitBuildSource(in buildapi.GitBuildSource, out *buildapi.GitBu
 	return nil
 }
 
+func deepCopy_api_GitPullRequest(in buildapi.GitPullRequest, out *buildapi.GitPullRequest, c *conversion.Cloner) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
 func deepCopy_api_GitSourceRevision(in buildapi.GitSourceRevision, out *buildapi.GitSourceRevision, c *conversion.Cloner) error {
 	out.Commit = in.Commit
 	if err := deepCopy_api_SourceControlUser(in.Author, &out.Author, c); err != nil {
@@ -1709,6 +2037,112 @@ func deepCopy_api_ImageSourcePath(in buildapi.ImageSourcePath, out *buildapi.Ima
 	return nil
 }
 
+func deepCopy_api_PipelineBuildStage(in buildapi.PipelineBuildStage, out *buildapi.PipelineBuildStage, c *conversion.Cloner) error {
+	out.BuildConfig = in.BuildConfig
+	return nil
+}
+
+func deepCopy_api_PipelineBuildStrategy(in buildapi.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, c *conversion.Cloner) error {
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_api_PipelineStage(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PipelinePromoteStage(in buildapi.PipelinePromoteStage, out *buildapi.PipelinePromoteStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapi.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_api_PipelineStage(in buildapi.PipelineStage, out *buildapi.PipelineStage, c *conversion.Cloner) error {
+	out.Name = in.Name
+	if in.Build != nil {
+		out.Build = new(buildapi.PipelineBuildStage)
+		if err := deepCopy_api_PipelineBuildStage(*in.Build, out.Build, c); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Test != nil {
+		out.Test = new(buildapi.PipelineTestStage)
+		if err := deepCopy_api_PipelineTestStage(*in.Test, out.Test, c); err != nil {
+			return err
+		}
+	} else {
+		out.Test = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(buildapi.PipelinePromoteStage)
+		if err := deepCopy_api_PipelinePromoteStage(*in.Promote, out.Promote, c); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PipelineStageStatus(in buildapi.PipelineStageStatus, out *buildapi.PipelineStageStatus, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Build = in.Build
+	out.Pod = in.Pod
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PipelineTestStage(in buildapi.PipelineTestStage, out *buildapi.PipelineTestStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Image); err != nil {
+		return err
+	} else {
+		out.Image = newVal.(pkgapi.ObjectReference)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
 func deepCopy_api_SecretBuildSource(in buildapi.SecretBuildSource, out *buildapi.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1729,6 +2163,12 @@ func deepCopy_api_SecretSpec(in buildapi.SecretSpec, out *buildapi.SecretSpec, c
 	return nil
 }
 
+func deepCopy_api_SlackNotification(in buildapi.SlackNotification, out *buildapi.SlackNotification, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Channel = in.Channel
+	return nil
+}
+
 func deepCopy_api_SourceBuildStrategy(in buildapi.SourceBuildStrategy, out *buildapi.SourceBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1759,6 +2199,16 @@ func deepCopy_api_SourceBuildStrategy(in buildapi.SourceBuildStrategy, out *buil
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapi.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := deepCopy_api_BuildCacheVolume(in.CacheVolumes[i], &out.CacheVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
 	return nil
 }
 
@@ -1782,6 +2232,124 @@ func deepCopy_api_SourceRevision(in buildapi.SourceRevision, out *buildapi.Sourc
 
 func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	if in.SignatureSecret != nil {
+		if newVal, err := c.DeepCopy(in.SignatureSecret); err != nil {
+			return err
+		} else {
+			out.SignatureSecret = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.SignatureSecret = nil
+	}
+	out.AllowPullRequests = in.AllowPullRequests
+	if in.IncludePaths != nil {
+		out.IncludePaths = make([]string, len(in.IncludePaths))
+		for i := range in.IncludePaths {
+			out.IncludePaths[i] = in.IncludePaths[i]
+		}
+	} else {
+		out.IncludePaths = nil
+	}
+	if in.ExcludePaths != nil {
+		out.ExcludePaths = make([]string, len(in.ExcludePaths))
+		for i := range in.ExcludePaths {
+			out.ExcludePaths[i] = in.ExcludePaths[i]
+		}
+	} else {
+		out.ExcludePaths = nil
+	}
+	return nil
+}
+
+func deepCopy_api_WebhookNotification(in buildapi.WebhookNotification, out *buildapi.WebhookNotification, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_api_BlueGreenDeploymentStrategyParams(in deployapi.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.ServiceName = in.ServiceName
+	if in.HoldSeconds != nil {
+		out.HoldSeconds = new(int64)
+		*out.HoldSeconds = *in.HoldSeconds
+	} else {
+		out.HoldSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryDeploymentStrategyParams(in deployapi.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Steps != nil {
+		out.Steps = make([]int, len(in.Steps))
+		for i := range in.Steps {
+			out.Steps[i] = in.Steps[i]
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.BakeSeconds != nil {
+		out.BakeSeconds = new(int64)
+		*out.BakeSeconds = *in.BakeSeconds
+	} else {
+		out.BakeSeconds = nil
+	}
+	if in.Check != nil {
+		out.Check = new(deployapi.ExecNewPodHook)
+		if err := deepCopy_api_ExecNewPodHook(*in.Check, out.Check, c); err != nil {
+			return err
+		}
+	} else {
+		out.Check = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ContainerImage(in deployapi.ContainerImage, out *deployapi.ContainerImage, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	return nil
 }
 
@@ -1820,6 +2388,7 @@ func deepCopy_api_DeploymentCause(in deployapi.DeploymentCause, out *deployapi.D
 	} else {
 		out.ImageTrigger = nil
 	}
+	out.Message = in.Message
 	return nil
 }
 
@@ -1852,6 +2421,30 @@ func deepCopy_api_DeploymentConfig(in deployapi.DeploymentConfig, out *deployapi
 	return nil
 }
 
+func deepCopy_api_DeploymentConfigHistory(in deployapi.DeploymentConfigHistory, out *deployapi.DeploymentConfigHistory, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if in.Deployments != nil {
+		out.Deployments = make([]deployapi.DeploymentRecord, len(in.Deployments))
+		for i := range in.Deployments {
+			if err := deepCopy_api_DeploymentRecord(in.Deployments[i], &out.Deployments[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Deployments = nil
+	}
+	return nil
+}
+
 func deepCopy_api_DeploymentConfigList(in deployapi.DeploymentConfigList, out *deployapi.DeploymentConfigList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1917,6 +2510,18 @@ func deepCopy_api_DeploymentConfigSpec(in deployapi.DeploymentConfigSpec, out *d
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.Windows != nil {
+		out.Windows = make([]deployapi.DeploymentWindow, len(in.Windows))
+		for i := range in.Windows {
+			if err := deepCopy_api_DeploymentWindow(in.Windows[i], &out.Windows[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Windows = nil
+	}
+	out.ImageChangeDebounceSeconds = in.ImageChangeDebounceSeconds
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1947,6 +2552,16 @@ func deepCopy_api_DeploymentConfigStatus(in deployapi.DeploymentConfigStatus, ou
 	} else {
 		out.Details = nil
 	}
+	if in.LastTriggeredImages != nil {
+		out.LastTriggeredImages = make([]deployapi.ContainerImage, len(in.LastTriggeredImages))
+		for i := range in.LastTriggeredImages {
+			if err := deepCopy_api_ContainerImage(in.LastTriggeredImages[i], &out.LastTriggeredImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LastTriggeredImages = nil
+	}
 	return nil
 }
 
@@ -1969,6 +2584,13 @@ func deepCopy_api_DeploymentDetails(in deployapi.DeploymentDetails, out *deploya
 	return nil
 }
 
+func deepCopy_api_DeploymentHookResult(in deployapi.DeploymentHookResult, out *deployapi.DeploymentHookResult, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_DeploymentLog(in deployapi.DeploymentLog, out *deployapi.DeploymentLog, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2025,6 +2647,64 @@ func deepCopy_api_DeploymentLogOptions(in deployapi.DeploymentLogOptions, out *d
 	return nil
 }
 
+func deepCopy_api_DeploymentRecord(in deployapi.DeploymentRecord, out *deployapi.DeploymentRecord, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Phase = in.Phase
+	if in.Details != nil {
+		out.Details = new(deployapi.DeploymentDetails)
+		if err := deepCopy_api_DeploymentDetails(*in.Details, out.Details, c); err != nil {
+			return err
+		}
+	} else {
+		out.Details = nil
+	}
+	out.Strategy = in.Strategy
+	if in.StartTime != nil {
+		if newVal, err := c.DeepCopy(in.StartTime); err != nil {
+			return err
+		} else {
+			out.StartTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTime = nil
+	}
+	if in.CompletionTime != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTime); err != nil {
+			return err
+		} else {
+			out.CompletionTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTime = nil
+	}
+	if in.DurationSeconds != nil {
+		out.DurationSeconds = new(int64)
+		*out.DurationSeconds = *in.DurationSeconds
+	} else {
+		out.DurationSeconds = nil
+	}
+	if in.PreviousReplicas != nil {
+		out.PreviousReplicas = new(int)
+		*out.PreviousReplicas = *in.PreviousReplicas
+	} else {
+		out.PreviousReplicas = nil
+	}
+	out.Replicas = in.Replicas
+	if in.Hooks != nil {
+		out.Hooks = make([]deployapi.DeploymentHookResult, len(in.Hooks))
+		for i := range in.Hooks {
+			if err := deepCopy_api_DeploymentHookResult(in.Hooks[i], &out.Hooks[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Hooks = nil
+	}
+	out.Reason = in.Reason
+	return nil
+}
+
 func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deployapi.DeploymentStrategy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.CustomParams != nil {
@@ -2051,6 +2731,22 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_api_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := deepCopy_api_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -2072,6 +2768,15 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	} else {
 		out.Annotations = nil
 	}
+	out.AutoRollback = in.AutoRollback
+	if in.Verification != nil {
+		out.Verification = new(deployapi.DeploymentVerification)
+		if err := deepCopy_api_DeploymentVerification(*in.Verification, out.Verification, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verification = nil
+	}
 	return nil
 }
 
@@ -2107,6 +2812,46 @@ func deepCopy_api_DeploymentTriggerPolicy(in deployapi.DeploymentTriggerPolicy,
 	return nil
 }
 
+func deepCopy_api_DeploymentVerification(in deployapi.DeploymentVerification, out *deployapi.DeploymentVerification, c *conversion.Cloner) error {
+	if in.Prometheus != nil {
+		out.Prometheus = new(deployapi.PrometheusQuery)
+		if err := deepCopy_api_PrometheusQuery(*in.Prometheus, out.Prometheus, c); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.HTTPGetMetric)
+		if err := deepCopy_api_HTTPGetMetric(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	out.MinValue = in.MinValue
+	out.MaxValue = in.MaxValue
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_api_DeploymentWindow(in deployapi.DeploymentWindow, out *deployapi.DeploymentWindow, c *conversion.Cloner) error {
+	out.Schedule = in.Schedule
+	out.DurationMinutes = in.DurationMinutes
+	return nil
+}
+
 func deepCopy_api_ExecNewPodHook(in deployapi.ExecNewPodHook, out *deployapi.ExecNewPodHook, c *conversion.Cloner) error {
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
@@ -2140,6 +2885,25 @@ func deepCopy_api_ExecNewPodHook(in deployapi.ExecNewPodHook, out *deployapi.Exe
 	return nil
 }
 
+func deepCopy_api_HTTPGetHook(in deployapi.HTTPGetHook, out *deployapi.HTTPGetHook, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Method = in.Method
+	out.ExpectedStatus = in.ExpectedStatus
+	out.Retries = in.Retries
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_api_HTTPGetMetric(in deployapi.HTTPGetMetric, out *deployapi.HTTPGetMetric, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
 func deepCopy_api_LifecycleHook(in deployapi.LifecycleHook, out *deployapi.LifecycleHook, c *conversion.Cloner) error {
 	out.FailurePolicy = in.FailurePolicy
 	if in.ExecNewPod != nil {
@@ -2160,6 +2924,28 @@ func deepCopy_api_LifecycleHook(in deployapi.LifecycleHook, out *deployapi.Lifec
 	} else {
 		out.TagImages = nil
 	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.HTTPGetHook)
+		if err := deepCopy_api_HTTPGetHook(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	if in.TCPSocket != nil {
+		out.TCPSocket = new(deployapi.TCPSocketHook)
+		if err := deepCopy_api_TCPSocketHook(*in.TCPSocket, out.TCPSocket, c); err != nil {
+			return err
+		}
+	} else {
+		out.TCPSocket = nil
+	}
+	return nil
+}
+
+func deepCopy_api_PrometheusQuery(in deployapi.PrometheusQuery, out *deployapi.PrometheusQuery, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Query = in.Query
 	return nil
 }
 
@@ -2240,6 +3026,14 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	} else {
 		out.Pre = nil
 	}
+	if in.Batch != nil {
+		out.Batch = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Batch, out.Batch, c); err != nil {
+			return err
+		}
+	} else {
+		out.Batch = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -2251,6 +3045,18 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	return nil
 }
 
+func deepCopy_api_TCPSocketHook(in deployapi.TCPSocketHook, out *deployapi.TCPSocketHook, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.Port = in.Port
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
 func deepCopy_api_TagImageHook(in deployapi.TagImageHook, out *deployapi.TagImageHook, c *conversion.Cloner) error {
 	out.ContainerName = in.ContainerName
 	if newVal, err := c.DeepCopy(in.To); err != nil {
@@ -3744,6 +4550,9 @@ func init() {
 	err := pkgapi.Scheme.AddGeneratedDeepCopyFuncs(
 		deepCopy_api_Application,
 		deepCopy_api_ApplicationList,
+		deepCopy_api_ApplicationRevision,
+		deepCopy_api_ApplicationRollback,
+		deepCopy_api_ApplicationSchedule,
 		deepCopy_api_ApplicationSpec,
 		deepCopy_api_ApplicationStatus,
 		deepCopy_api_Item,
@@ -3791,6 +4600,7 @@ func init() {
 		deepCopy_api_BinaryBuildRequestOptions,
 		deepCopy_api_BinaryBuildSource,
 		deepCopy_api_Build,
+		deepCopy_api_BuildCacheVolume,
 		deepCopy_api_BuildConfig,
 		deepCopy_api_BuildConfigList,
 		deepCopy_api_BuildConfigSpec,
@@ -3798,7 +4608,11 @@ func init() {
 		deepCopy_api_BuildList,
 		deepCopy_api_BuildLog,
 		deepCopy_api_BuildLogOptions,
+		deepCopy_api_BuildMatrixParameter,
+		deepCopy_api_BuildNotification,
 		deepCopy_api_BuildOutput,
+		deepCopy_api_BuildParameter,
+		deepCopy_api_BuildParameterValue,
 		deepCopy_api_BuildPostCommitSpec,
 		deepCopy_api_BuildRequest,
 		deepCopy_api_BuildSource,
@@ -3806,38 +4620,61 @@ func init() {
 		deepCopy_api_BuildStatus,
 		deepCopy_api_BuildStrategy,
 		deepCopy_api_BuildTriggerPolicy,
+		deepCopy_api_CommitStatusReporting,
 		deepCopy_api_CustomBuildStrategy,
 		deepCopy_api_DockerBuildStrategy,
+		deepCopy_api_EmailNotification,
 		deepCopy_api_GitBuildSource,
+		deepCopy_api_GitPullRequest,
 		deepCopy_api_GitSourceRevision,
 		deepCopy_api_ImageChangeTrigger,
 		deepCopy_api_ImageSource,
 		deepCopy_api_ImageSourcePath,
+		deepCopy_api_PipelineBuildStage,
+		deepCopy_api_PipelineBuildStrategy,
+		deepCopy_api_PipelinePromoteStage,
+		deepCopy_api_PipelineStage,
+		deepCopy_api_PipelineStageStatus,
+		deepCopy_api_PipelineTestStage,
 		deepCopy_api_SecretBuildSource,
 		deepCopy_api_SecretSpec,
+		deepCopy_api_SlackNotification,
 		deepCopy_api_SourceBuildStrategy,
 		deepCopy_api_SourceControlUser,
 		deepCopy_api_SourceRevision,
 		deepCopy_api_WebHookTrigger,
+		deepCopy_api_WebhookNotification,
+		deepCopy_api_BlueGreenDeploymentStrategyParams,
+		deepCopy_api_CanaryDeploymentStrategyParams,
+		deepCopy_api_ContainerImage,
 		deepCopy_api_CustomDeploymentStrategyParams,
 		deepCopy_api_DeploymentCause,
 		deepCopy_api_DeploymentCauseImageTrigger,
 		deepCopy_api_DeploymentConfig,
+		deepCopy_api_DeploymentConfigHistory,
 		deepCopy_api_DeploymentConfigList,
 		deepCopy_api_DeploymentConfigRollback,
 		deepCopy_api_DeploymentConfigRollbackSpec,
 		deepCopy_api_DeploymentConfigSpec,
 		deepCopy_api_DeploymentConfigStatus,
 		deepCopy_api_DeploymentDetails,
+		deepCopy_api_DeploymentHookResult,
 		deepCopy_api_DeploymentLog,
 		deepCopy_api_DeploymentLogOptions,
+		deepCopy_api_DeploymentRecord,
 		deepCopy_api_DeploymentStrategy,
 		deepCopy_api_DeploymentTriggerImageChangeParams,
 		deepCopy_api_DeploymentTriggerPolicy,
+		deepCopy_api_DeploymentVerification,
+		deepCopy_api_DeploymentWindow,
 		deepCopy_api_ExecNewPodHook,
+		deepCopy_api_HTTPGetHook,
+		deepCopy_api_HTTPGetMetric,
 		deepCopy_api_LifecycleHook,
+		deepCopy_api_PrometheusQuery,
 		deepCopy_api_RecreateDeploymentStrategyParams,
 		deepCopy_api_RollingDeploymentStrategyParams,
+		deepCopy_api_TCPSocketHook,
 		deepCopy_api_TagImageHook,
 		deepCopy_api_DockerConfig,
 		deepCopy_api_DockerImage,
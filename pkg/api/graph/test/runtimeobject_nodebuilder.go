@@ -13,6 +13,10 @@ import (
 	osgraph "github.com/openshift/origin/pkg/api/graph"
 	_ "github.com/openshift/origin/pkg/api/install"
 	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	appgraph "github.com/openshift/origin/pkg/application/graph/nodes"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	bsigraph "github.com/openshift/origin/pkg/backingserviceinstance/graph/nodes"
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildgraph "github.com/openshift/origin/pkg/build/graph/nodes"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -60,6 +64,12 @@ func init() {
 	if err := RegisterEnsureNode(&routeapi.Route{}, routegraph.EnsureRouteNode); err != nil {
 		panic(err)
 	}
+	if err := RegisterEnsureNode(&applicationapi.Application{}, appgraph.EnsureApplicationNode); err != nil {
+		panic(err)
+	}
+	if err := RegisterEnsureNode(&backingserviceinstanceapi.BackingServiceInstance{}, bsigraph.EnsureBackingServiceInstanceNode); err != nil {
+		panic(err)
+	}
 }
 
 func RegisterEnsureNode(containedType, ensureFunction interface{}) error {
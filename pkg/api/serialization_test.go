@@ -249,8 +249,8 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 		},
 		func(j *deploy.DeploymentStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
-			j.RecreateParams, j.RollingParams, j.CustomParams = nil, nil, nil
-			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCustom}
+			j.RecreateParams, j.RollingParams, j.CustomParams, j.BlueGreenParams, j.CanaryParams = nil, nil, nil, nil, nil
+			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeBlueGreen, deploy.DeploymentStrategyTypeCanary, deploy.DeploymentStrategyTypeCustom}
 			j.Type = strategyTypes[c.Rand.Intn(len(strategyTypes))]
 			switch j.Type {
 			case deploy.DeploymentStrategyTypeRecreate:
@@ -305,6 +305,37 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 					params.MaxUnavailable = intstr.FromString(fmt.Sprintf("%d%%", c.RandUint64()))
 				}
 				j.RollingParams = params
+			case deploy.DeploymentStrategyTypeBlueGreen:
+				params := &deploy.BlueGreenDeploymentStrategyParams{}
+				c.Fuzz(params)
+				if params.TimeoutSeconds == nil {
+					s := deploy.DefaultRollingTimeoutSeconds
+					params.TimeoutSeconds = &s
+				}
+				if params.HoldSeconds == nil {
+					s := deploy.DefaultBlueGreenHoldSeconds
+					params.HoldSeconds = &s
+				}
+				defaultLifecycleHook(params.Pre)
+				defaultLifecycleHook(params.Post)
+				j.BlueGreenParams = params
+			case deploy.DeploymentStrategyTypeCanary:
+				params := &deploy.CanaryDeploymentStrategyParams{}
+				c.Fuzz(params)
+				if params.TimeoutSeconds == nil {
+					s := deploy.DefaultRollingTimeoutSeconds
+					params.TimeoutSeconds = &s
+				}
+				if len(params.Steps) == 0 {
+					params.Steps = []int{10, 50}
+				}
+				if params.BakeSeconds == nil {
+					s := deploy.DefaultCanaryBakeSeconds
+					params.BakeSeconds = &s
+				}
+				defaultLifecycleHook(params.Pre)
+				defaultLifecycleHook(params.Post)
+				j.CanaryParams = params
 			}
 		},
 		func(j *deploy.DeploymentCauseImageTrigger, c fuzz.Continue) {
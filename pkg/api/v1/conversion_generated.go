@@ -84,6 +84,81 @@ func Convert_api_ApplicationList_To_v1_ApplicationList(in *applicationapi.Applic
 	return autoConvert_api_ApplicationList_To_v1_ApplicationList(in, out, s)
 }
 
+func autoConvert_api_ApplicationRevision_To_v1_ApplicationRevision(in *applicationapi.ApplicationRevision, out *v1.ApplicationRevision, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*applicationapi.ApplicationRevision))(in)
+	}
+	out.Revision = in.Revision
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.CreationTimestamp, &out.CreationTimestamp, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.Item, len(in.Items))
+		for i := range in.Items {
+			if err := Convert_api_Item_To_v1_Item(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Snapshots != nil {
+		out.Snapshots = make(map[string]string)
+		for key, val := range in.Snapshots {
+			out.Snapshots[key] = val
+		}
+	} else {
+		out.Snapshots = nil
+	}
+	if in.Replicas != nil {
+		out.Replicas = make(map[string]int)
+		for key, val := range in.Replicas {
+			out.Replicas[key] = val
+		}
+	} else {
+		out.Replicas = nil
+	}
+	return nil
+}
+
+func Convert_api_ApplicationRevision_To_v1_ApplicationRevision(in *applicationapi.ApplicationRevision, out *v1.ApplicationRevision, s conversion.Scope) error {
+	return autoConvert_api_ApplicationRevision_To_v1_ApplicationRevision(in, out, s)
+}
+
+func autoConvert_api_ApplicationRollback_To_v1_ApplicationRollback(in *applicationapi.ApplicationRollback, out *v1.ApplicationRollback, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*applicationapi.ApplicationRollback))(in)
+	}
+	out.Revision = in.Revision
+	return nil
+}
+
+func Convert_api_ApplicationRollback_To_v1_ApplicationRollback(in *applicationapi.ApplicationRollback, out *v1.ApplicationRollback, s conversion.Scope) error {
+	return autoConvert_api_ApplicationRollback_To_v1_ApplicationRollback(in, out, s)
+}
+
+func autoConvert_api_ApplicationSchedule_To_v1_ApplicationSchedule(in *applicationapi.ApplicationSchedule, out *v1.ApplicationSchedule, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*applicationapi.ApplicationSchedule))(in)
+	}
+	out.StartTime = in.StartTime
+	out.StopTime = in.StopTime
+	if in.Weekdays != nil {
+		out.Weekdays = make([]string, len(in.Weekdays))
+		for i := range in.Weekdays {
+			out.Weekdays[i] = in.Weekdays[i]
+		}
+	} else {
+		out.Weekdays = nil
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+func Convert_api_ApplicationSchedule_To_v1_ApplicationSchedule(in *applicationapi.ApplicationSchedule, out *v1.ApplicationSchedule, s conversion.Scope) error {
+	return autoConvert_api_ApplicationSchedule_To_v1_ApplicationSchedule(in, out, s)
+}
+
 func autoConvert_api_ApplicationSpec_To_v1_ApplicationSpec(in *applicationapi.ApplicationSpec, out *v1.ApplicationSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*applicationapi.ApplicationSpec))(in)
@@ -100,6 +175,26 @@ func autoConvert_api_ApplicationSpec_To_v1_ApplicationSpec(in *applicationapi.Ap
 		out.Items = nil
 	}
 	out.Destory = in.Destory
+	out.ForceDestory = in.ForceDestory
+	out.Stopped = in.Stopped
+	// unable to generate simple pointer conversion for api.ApplicationSchedule -> v1.ApplicationSchedule
+	if in.Schedule != nil {
+		out.Schedule = new(v1.ApplicationSchedule)
+		if err := Convert_api_ApplicationSchedule_To_v1_ApplicationSchedule(in.Schedule, out.Schedule, s); err != nil {
+			return err
+		}
+	} else {
+		out.Schedule = nil
+	}
+	// unable to generate simple pointer conversion for api.ApplicationRollback -> v1.ApplicationRollback
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(v1.ApplicationRollback)
+		if err := Convert_api_ApplicationRollback_To_v1_ApplicationRollback(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]apiv1.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -120,6 +215,41 @@ func autoConvert_api_ApplicationStatus_To_v1_ApplicationStatus(in *applicationap
 		defaulting.(func(*applicationapi.ApplicationStatus))(in)
 	}
 	out.Phase = v1.ApplicationPhase(in.Phase)
+	if in.StoppedReplicas != nil {
+		out.StoppedReplicas = make(map[string]int)
+		for key, val := range in.StoppedReplicas {
+			out.StoppedReplicas[key] = val
+		}
+	} else {
+		out.StoppedReplicas = nil
+	}
+	out.ScheduleState = v1.ApplicationScheduleState(in.ScheduleState)
+	if in.Revisions != nil {
+		out.Revisions = make([]v1.ApplicationRevision, len(in.Revisions))
+		for i := range in.Revisions {
+			if err := Convert_api_ApplicationRevision_To_v1_ApplicationRevision(&in.Revisions[i], &out.Revisions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revisions = nil
+	}
+	if in.HeldReplicas != nil {
+		out.HeldReplicas = make(map[string]int)
+		for key, val := range in.HeldReplicas {
+			out.HeldReplicas[key] = val
+		}
+	} else {
+		out.HeldReplicas = nil
+	}
+	if in.ReleasedItems != nil {
+		out.ReleasedItems = make([]string, len(in.ReleasedItems))
+		for i := range in.ReleasedItems {
+			out.ReleasedItems[i] = in.ReleasedItems[i]
+		}
+	} else {
+		out.ReleasedItems = nil
+	}
 	return nil
 }
 
@@ -134,6 +264,14 @@ func autoConvert_api_Item_To_v1_Item(in *applicationapi.Item, out *v1.Item, s co
 	out.Kind = in.Kind
 	out.Name = in.Name
 	out.Status = in.Status
+	if in.DependsOn != nil {
+		out.DependsOn = make([]string, len(in.DependsOn))
+		for i := range in.DependsOn {
+			out.DependsOn[i] = in.DependsOn[i]
+		}
+	} else {
+		out.DependsOn = nil
+	}
 	return nil
 }
 
@@ -185,6 +323,81 @@ func Convert_v1_ApplicationList_To_api_ApplicationList(in *v1.ApplicationList, o
 	return autoConvert_v1_ApplicationList_To_api_ApplicationList(in, out, s)
 }
 
+func autoConvert_v1_ApplicationRevision_To_api_ApplicationRevision(in *v1.ApplicationRevision, out *applicationapi.ApplicationRevision, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationRevision))(in)
+	}
+	out.Revision = in.Revision
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.CreationTimestamp, &out.CreationTimestamp, s); err != nil {
+		return err
+	}
+	if in.Items != nil {
+		out.Items = make([]applicationapi.Item, len(in.Items))
+		for i := range in.Items {
+			if err := Convert_v1_Item_To_api_Item(&in.Items[i], &out.Items[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Snapshots != nil {
+		out.Snapshots = make(map[string]string)
+		for key, val := range in.Snapshots {
+			out.Snapshots[key] = val
+		}
+	} else {
+		out.Snapshots = nil
+	}
+	if in.Replicas != nil {
+		out.Replicas = make(map[string]int)
+		for key, val := range in.Replicas {
+			out.Replicas[key] = val
+		}
+	} else {
+		out.Replicas = nil
+	}
+	return nil
+}
+
+func Convert_v1_ApplicationRevision_To_api_ApplicationRevision(in *v1.ApplicationRevision, out *applicationapi.ApplicationRevision, s conversion.Scope) error {
+	return autoConvert_v1_ApplicationRevision_To_api_ApplicationRevision(in, out, s)
+}
+
+func autoConvert_v1_ApplicationRollback_To_api_ApplicationRollback(in *v1.ApplicationRollback, out *applicationapi.ApplicationRollback, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationRollback))(in)
+	}
+	out.Revision = in.Revision
+	return nil
+}
+
+func Convert_v1_ApplicationRollback_To_api_ApplicationRollback(in *v1.ApplicationRollback, out *applicationapi.ApplicationRollback, s conversion.Scope) error {
+	return autoConvert_v1_ApplicationRollback_To_api_ApplicationRollback(in, out, s)
+}
+
+func autoConvert_v1_ApplicationSchedule_To_api_ApplicationSchedule(in *v1.ApplicationSchedule, out *applicationapi.ApplicationSchedule, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.ApplicationSchedule))(in)
+	}
+	out.StartTime = in.StartTime
+	out.StopTime = in.StopTime
+	if in.Weekdays != nil {
+		out.Weekdays = make([]string, len(in.Weekdays))
+		for i := range in.Weekdays {
+			out.Weekdays[i] = in.Weekdays[i]
+		}
+	} else {
+		out.Weekdays = nil
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+func Convert_v1_ApplicationSchedule_To_api_ApplicationSchedule(in *v1.ApplicationSchedule, out *applicationapi.ApplicationSchedule, s conversion.Scope) error {
+	return autoConvert_v1_ApplicationSchedule_To_api_ApplicationSchedule(in, out, s)
+}
+
 func autoConvert_v1_ApplicationSpec_To_api_ApplicationSpec(in *v1.ApplicationSpec, out *applicationapi.ApplicationSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.ApplicationSpec))(in)
@@ -201,6 +414,26 @@ func autoConvert_v1_ApplicationSpec_To_api_ApplicationSpec(in *v1.ApplicationSpe
 		out.Items = nil
 	}
 	out.Destory = in.Destory
+	out.ForceDestory = in.ForceDestory
+	out.Stopped = in.Stopped
+	// unable to generate simple pointer conversion for v1.ApplicationSchedule -> api.ApplicationSchedule
+	if in.Schedule != nil {
+		out.Schedule = new(applicationapi.ApplicationSchedule)
+		if err := Convert_v1_ApplicationSchedule_To_api_ApplicationSchedule(in.Schedule, out.Schedule, s); err != nil {
+			return err
+		}
+	} else {
+		out.Schedule = nil
+	}
+	// unable to generate simple pointer conversion for v1.ApplicationRollback -> api.ApplicationRollback
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(applicationapi.ApplicationRollback)
+		if err := Convert_v1_ApplicationRollback_To_api_ApplicationRollback(in.RollbackTo, out.RollbackTo, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]api.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -221,6 +454,41 @@ func autoConvert_v1_ApplicationStatus_To_api_ApplicationStatus(in *v1.Applicatio
 		defaulting.(func(*v1.ApplicationStatus))(in)
 	}
 	out.Phase = applicationapi.ApplicationPhase(in.Phase)
+	if in.StoppedReplicas != nil {
+		out.StoppedReplicas = make(map[string]int)
+		for key, val := range in.StoppedReplicas {
+			out.StoppedReplicas[key] = val
+		}
+	} else {
+		out.StoppedReplicas = nil
+	}
+	out.ScheduleState = applicationapi.ApplicationScheduleState(in.ScheduleState)
+	if in.Revisions != nil {
+		out.Revisions = make([]applicationapi.ApplicationRevision, len(in.Revisions))
+		for i := range in.Revisions {
+			if err := Convert_v1_ApplicationRevision_To_api_ApplicationRevision(&in.Revisions[i], &out.Revisions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revisions = nil
+	}
+	if in.HeldReplicas != nil {
+		out.HeldReplicas = make(map[string]int)
+		for key, val := range in.HeldReplicas {
+			out.HeldReplicas[key] = val
+		}
+	} else {
+		out.HeldReplicas = nil
+	}
+	if in.ReleasedItems != nil {
+		out.ReleasedItems = make([]string, len(in.ReleasedItems))
+		for i := range in.ReleasedItems {
+			out.ReleasedItems[i] = in.ReleasedItems[i]
+		}
+	} else {
+		out.ReleasedItems = nil
+	}
 	return nil
 }
 
@@ -235,6 +503,14 @@ func autoConvert_v1_Item_To_api_Item(in *v1.Item, out *applicationapi.Item, s co
 	out.Kind = in.Kind
 	out.Name = in.Name
 	out.Status = in.Status
+	if in.DependsOn != nil {
+		out.DependsOn = make([]string, len(in.DependsOn))
+		for i := range in.DependsOn {
+			out.DependsOn[i] = in.DependsOn[i]
+		}
+	} else {
+		out.DependsOn = nil
+	}
 	return nil
 }
 
@@ -1975,6 +2251,20 @@ func Convert_api_Build_To_v1_Build(in *buildapi.Build, out *buildapiv1.Build, s
 	return autoConvert_api_Build_To_v1_Build(in, out, s)
 }
 
+func autoConvert_api_BuildCacheVolume_To_v1_BuildCacheVolume(in *buildapi.BuildCacheVolume, out *buildapiv1.BuildCacheVolume, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildCacheVolume))(in)
+	}
+	out.Name = in.Name
+	out.ClaimName = in.ClaimName
+	out.Path = in.Path
+	return nil
+}
+
+func Convert_api_BuildCacheVolume_To_v1_BuildCacheVolume(in *buildapi.BuildCacheVolume, out *buildapiv1.BuildCacheVolume, s conversion.Scope) error {
+	return autoConvert_api_BuildCacheVolume_To_v1_BuildCacheVolume(in, out, s)
+}
+
 func autoConvert_api_BuildConfig_To_v1_BuildConfig(in *buildapi.BuildConfig, out *buildapiv1.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildConfig))(in)
@@ -2029,6 +2319,27 @@ func autoConvert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *buildapi.BuildCon
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapiv1.BuildRunPolicy(in.RunPolicy)
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapiv1.BuildParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := Convert_api_BuildParameter_To_v1_BuildParameter(&in.Parameters[i], &out.Parameters[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.Matrix != nil {
+		out.Matrix = make([]buildapiv1.BuildMatrixParameter, len(in.Matrix))
+		for i := range in.Matrix {
+			if err := Convert_api_BuildMatrixParameter_To_v1_BuildMatrixParameter(&in.Matrix[i], &out.Matrix[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Matrix = nil
+	}
 	if err := Convert_api_BuildSpec_To_v1_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -2135,6 +2446,72 @@ func Convert_api_BuildLogOptions_To_v1_BuildLogOptions(in *buildapi.BuildLogOpti
 	return autoConvert_api_BuildLogOptions_To_v1_BuildLogOptions(in, out, s)
 }
 
+func autoConvert_api_BuildMatrixParameter_To_v1_BuildMatrixParameter(in *buildapi.BuildMatrixParameter, out *buildapiv1.BuildMatrixParameter, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildMatrixParameter))(in)
+	}
+	out.Name = in.Name
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func Convert_api_BuildMatrixParameter_To_v1_BuildMatrixParameter(in *buildapi.BuildMatrixParameter, out *buildapiv1.BuildMatrixParameter, s conversion.Scope) error {
+	return autoConvert_api_BuildMatrixParameter_To_v1_BuildMatrixParameter(in, out, s)
+}
+
+func autoConvert_api_BuildNotification_To_v1_BuildNotification(in *buildapi.BuildNotification, out *buildapiv1.BuildNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildNotification))(in)
+	}
+	if in.Phases != nil {
+		out.Phases = make([]buildapiv1.BuildPhase, len(in.Phases))
+		for i := range in.Phases {
+			out.Phases[i] = buildapiv1.BuildPhase(in.Phases[i])
+		}
+	} else {
+		out.Phases = nil
+	}
+	// unable to generate simple pointer conversion for api.WebhookNotification -> v1.WebhookNotification
+	if in.Webhook != nil {
+		out.Webhook = new(buildapiv1.WebhookNotification)
+		if err := Convert_api_WebhookNotification_To_v1_WebhookNotification(in.Webhook, out.Webhook, s); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	// unable to generate simple pointer conversion for api.SlackNotification -> v1.SlackNotification
+	if in.Slack != nil {
+		out.Slack = new(buildapiv1.SlackNotification)
+		if err := Convert_api_SlackNotification_To_v1_SlackNotification(in.Slack, out.Slack, s); err != nil {
+			return err
+		}
+	} else {
+		out.Slack = nil
+	}
+	// unable to generate simple pointer conversion for api.EmailNotification -> v1.EmailNotification
+	if in.Email != nil {
+		out.Email = new(buildapiv1.EmailNotification)
+		if err := Convert_api_EmailNotification_To_v1_EmailNotification(in.Email, out.Email, s); err != nil {
+			return err
+		}
+	} else {
+		out.Email = nil
+	}
+	return nil
+}
+
+func Convert_api_BuildNotification_To_v1_BuildNotification(in *buildapi.BuildNotification, out *buildapiv1.BuildNotification, s conversion.Scope) error {
+	return autoConvert_api_BuildNotification_To_v1_BuildNotification(in, out, s)
+}
+
 func autoConvert_api_BuildOutput_To_v1_BuildOutput(in *buildapi.BuildOutput, out *buildapiv1.BuildOutput, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildOutput))(in)
@@ -2160,6 +2537,35 @@ func autoConvert_api_BuildOutput_To_v1_BuildOutput(in *buildapi.BuildOutput, out
 	return nil
 }
 
+func autoConvert_api_BuildParameter_To_v1_BuildParameter(in *buildapi.BuildParameter, out *buildapiv1.BuildParameter, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildParameter))(in)
+	}
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Type = buildapiv1.BuildParameterType(in.Type)
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+func Convert_api_BuildParameter_To_v1_BuildParameter(in *buildapi.BuildParameter, out *buildapiv1.BuildParameter, s conversion.Scope) error {
+	return autoConvert_api_BuildParameter_To_v1_BuildParameter(in, out, s)
+}
+
+func autoConvert_api_BuildParameterValue_To_v1_BuildParameterValue(in *buildapi.BuildParameterValue, out *buildapiv1.BuildParameterValue, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildParameterValue))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+func Convert_api_BuildParameterValue_To_v1_BuildParameterValue(in *buildapi.BuildParameterValue, out *buildapiv1.BuildParameterValue, s conversion.Scope) error {
+	return autoConvert_api_BuildParameterValue_To_v1_BuildParameterValue(in, out, s)
+}
+
 func autoConvert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec(in *buildapi.BuildPostCommitSpec, out *buildapiv1.BuildPostCommitSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildPostCommitSpec))(in)
@@ -2246,6 +2652,26 @@ func autoConvert_api_BuildRequest_To_v1_BuildRequest(in *buildapi.BuildRequest,
 	} else {
 		out.Env = nil
 	}
+	// unable to generate simple pointer conversion for api.GitPullRequest -> v1.GitPullRequest
+	if in.PullRequest != nil {
+		out.PullRequest = new(buildapiv1.GitPullRequest)
+		if err := Convert_api_GitPullRequest_To_v1_GitPullRequest(in.PullRequest, out.PullRequest, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
+	out.NoCache = in.NoCache
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapiv1.BuildParameterValue, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := Convert_api_BuildParameterValue_To_v1_BuildParameterValue(&in.Parameters[i], &out.Parameters[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
 	return nil
 }
 
@@ -2348,6 +2774,25 @@ func autoConvert_api_BuildSpec_To_v1_BuildSpec(in *buildapi.BuildSpec, out *buil
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Notifications != nil {
+		out.Notifications = make([]buildapiv1.BuildNotification, len(in.Notifications))
+		for i := range in.Notifications {
+			if err := Convert_api_BuildNotification_To_v1_BuildNotification(&in.Notifications[i], &out.Notifications[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Notifications = nil
+	}
+	// unable to generate simple pointer conversion for api.CommitStatusReporting -> v1.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapiv1.CommitStatusReporting)
+		if err := Convert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -2392,6 +2837,16 @@ func autoConvert_api_BuildStatus_To_v1_BuildStatus(in *buildapi.BuildStatus, out
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapiv1.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_api_PipelineStageStatus_To_v1_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
 	return nil
 }
 
@@ -2427,6 +2882,15 @@ func autoConvert_api_BuildStrategy_To_v1_BuildStrategy(in *buildapi.BuildStrateg
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for api.PipelineBuildStrategy -> v1.PipelineBuildStrategy
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapiv1.PipelineBuildStrategy)
+		if err := Convert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2453,18 +2917,62 @@ func autoConvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.Bu
 	} else {
 		out.GenericWebHook = nil
 	}
-	// unable to generate simple pointer conversion for api.ImageChangeTrigger -> v1.ImageChangeTrigger
-	if in.ImageChange != nil {
-		out.ImageChange = new(buildapiv1.ImageChangeTrigger)
-		if err := Convert_api_ImageChangeTrigger_To_v1_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapiv1.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
 			return err
 		}
 	} else {
-		out.ImageChange = nil
+		out.GitLabWebHook = nil
 	}
-	return nil
-}
-
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapiv1.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1.WebHookTrigger
+	if in.GogsWebHook != nil {
+		out.GogsWebHook = new(buildapiv1.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.GogsWebHook, out.GogsWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GogsWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.ImageChangeTrigger -> v1.ImageChangeTrigger
+	if in.ImageChange != nil {
+		out.ImageChange = new(buildapiv1.ImageChangeTrigger)
+		if err := Convert_api_ImageChangeTrigger_To_v1_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChange = nil
+	}
+	return nil
+}
+
+func autoConvert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *buildapiv1.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.CommitStatusReporting))(in)
+	}
+	out.Provider = buildapiv1.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.TokenSecret, &out.TokenSecret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *buildapiv1.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy(in *buildapi.CustomBuildStrategy, out *buildapiv1.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.CustomBuildStrategy))(in)
@@ -2542,9 +3050,38 @@ func autoConvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy(in *buildapi.
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapiv1.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_api_BuildCacheVolume_To_v1_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func autoConvert_api_EmailNotification_To_v1_EmailNotification(in *buildapi.EmailNotification, out *buildapiv1.EmailNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.EmailNotification))(in)
+	}
+	if in.To != nil {
+		out.To = make([]string, len(in.To))
+		for i := range in.To {
+			out.To[i] = in.To[i]
+		}
+	} else {
+		out.To = nil
+	}
 	return nil
 }
 
+func Convert_api_EmailNotification_To_v1_EmailNotification(in *buildapi.EmailNotification, out *buildapiv1.EmailNotification, s conversion.Scope) error {
+	return autoConvert_api_EmailNotification_To_v1_EmailNotification(in, out, s)
+}
+
 func autoConvert_api_GitBuildSource_To_v1_GitBuildSource(in *buildapi.GitBuildSource, out *buildapiv1.GitBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitBuildSource))(in)
@@ -2570,6 +3107,19 @@ func Convert_api_GitBuildSource_To_v1_GitBuildSource(in *buildapi.GitBuildSource
 	return autoConvert_api_GitBuildSource_To_v1_GitBuildSource(in, out, s)
 }
 
+func autoConvert_api_GitPullRequest_To_v1_GitPullRequest(in *buildapi.GitPullRequest, out *buildapiv1.GitPullRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GitPullRequest))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
+func Convert_api_GitPullRequest_To_v1_GitPullRequest(in *buildapi.GitPullRequest, out *buildapiv1.GitPullRequest, s conversion.Scope) error {
+	return autoConvert_api_GitPullRequest_To_v1_GitPullRequest(in, out, s)
+}
+
 func autoConvert_api_GitSourceRevision_To_v1_GitSourceRevision(in *buildapi.GitSourceRevision, out *buildapiv1.GitSourceRevision, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitSourceRevision))(in)
@@ -2656,6 +3206,151 @@ func Convert_api_ImageSourcePath_To_v1_ImageSourcePath(in *buildapi.ImageSourceP
 	return autoConvert_api_ImageSourcePath_To_v1_ImageSourcePath(in, out, s)
 }
 
+func autoConvert_api_PipelineBuildStage_To_v1_PipelineBuildStage(in *buildapi.PipelineBuildStage, out *buildapiv1.PipelineBuildStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineBuildStage))(in)
+	}
+	out.BuildConfig = in.BuildConfig
+	return nil
+}
+
+func Convert_api_PipelineBuildStage_To_v1_PipelineBuildStage(in *buildapi.PipelineBuildStage, out *buildapiv1.PipelineBuildStage, s conversion.Scope) error {
+	return autoConvert_api_PipelineBuildStage_To_v1_PipelineBuildStage(in, out, s)
+}
+
+func autoConvert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *buildapiv1.PipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineBuildStrategy))(in)
+	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapiv1.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_api_PipelineStage_To_v1_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *buildapiv1.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy(in, out, s)
+}
+
+func autoConvert_api_PipelinePromoteStage_To_v1_PipelinePromoteStage(in *buildapi.PipelinePromoteStage, out *buildapiv1.PipelinePromoteStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelinePromoteStage))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_PipelinePromoteStage_To_v1_PipelinePromoteStage(in *buildapi.PipelinePromoteStage, out *buildapiv1.PipelinePromoteStage, s conversion.Scope) error {
+	return autoConvert_api_PipelinePromoteStage_To_v1_PipelinePromoteStage(in, out, s)
+}
+
+func autoConvert_api_PipelineStage_To_v1_PipelineStage(in *buildapi.PipelineStage, out *buildapiv1.PipelineStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStage))(in)
+	}
+	out.Name = in.Name
+	// unable to generate simple pointer conversion for api.PipelineBuildStage -> v1.PipelineBuildStage
+	if in.Build != nil {
+		out.Build = new(buildapiv1.PipelineBuildStage)
+		if err := Convert_api_PipelineBuildStage_To_v1_PipelineBuildStage(in.Build, out.Build, s); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	// unable to generate simple pointer conversion for api.PipelineTestStage -> v1.PipelineTestStage
+	if in.Test != nil {
+		out.Test = new(buildapiv1.PipelineTestStage)
+		if err := Convert_api_PipelineTestStage_To_v1_PipelineTestStage(in.Test, out.Test, s); err != nil {
+			return err
+		}
+	} else {
+		out.Test = nil
+	}
+	// unable to generate simple pointer conversion for api.PipelinePromoteStage -> v1.PipelinePromoteStage
+	if in.Promote != nil {
+		out.Promote = new(buildapiv1.PipelinePromoteStage)
+		if err := Convert_api_PipelinePromoteStage_To_v1_PipelinePromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineStage_To_v1_PipelineStage(in *buildapi.PipelineStage, out *buildapiv1.PipelineStage, s conversion.Scope) error {
+	return autoConvert_api_PipelineStage_To_v1_PipelineStage(in, out, s)
+}
+
+func autoConvert_api_PipelineStageStatus_To_v1_PipelineStageStatus(in *buildapi.PipelineStageStatus, out *buildapiv1.PipelineStageStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStageStatus))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapiv1.BuildPhase(in.Phase)
+	out.Build = in.Build
+	out.Pod = in.Pod
+	out.Message = in.Message
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineStageStatus_To_v1_PipelineStageStatus(in *buildapi.PipelineStageStatus, out *buildapiv1.PipelineStageStatus, s conversion.Scope) error {
+	return autoConvert_api_PipelineStageStatus_To_v1_PipelineStageStatus(in, out, s)
+}
+
+func autoConvert_api_PipelineTestStage_To_v1_PipelineTestStage(in *buildapi.PipelineTestStage, out *buildapiv1.PipelineTestStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineTestStage))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.Image, &out.Image, s); err != nil {
+		return err
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineTestStage_To_v1_PipelineTestStage(in *buildapi.PipelineTestStage, out *buildapiv1.PipelineTestStage, s conversion.Scope) error {
+	return autoConvert_api_PipelineTestStage_To_v1_PipelineTestStage(in, out, s)
+}
+
 func autoConvert_api_SecretBuildSource_To_v1_SecretBuildSource(in *buildapi.SecretBuildSource, out *buildapiv1.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretBuildSource))(in)
@@ -2686,6 +3381,19 @@ func Convert_api_SecretSpec_To_v1_SecretSpec(in *buildapi.SecretSpec, out *build
 	return autoConvert_api_SecretSpec_To_v1_SecretSpec(in, out, s)
 }
 
+func autoConvert_api_SlackNotification_To_v1_SlackNotification(in *buildapi.SlackNotification, out *buildapiv1.SlackNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SlackNotification))(in)
+	}
+	out.URL = in.URL
+	out.Channel = in.Channel
+	return nil
+}
+
+func Convert_api_SlackNotification_To_v1_SlackNotification(in *buildapi.SlackNotification, out *buildapiv1.SlackNotification, s conversion.Scope) error {
+	return autoConvert_api_SlackNotification_To_v1_SlackNotification(in, out, s)
+}
+
 func autoConvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy(in *buildapi.SourceBuildStrategy, out *buildapiv1.SourceBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SourceBuildStrategy))(in)
@@ -2715,6 +3423,16 @@ func autoConvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy(in *buildapi.
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapiv1.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_api_BuildCacheVolume_To_v1_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
 	return nil
 }
 
@@ -2752,6 +3470,32 @@ func autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTri
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1.LocalObjectReference
+	if in.SignatureSecret != nil {
+		out.SignatureSecret = new(apiv1.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.SignatureSecret, out.SignatureSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureSecret = nil
+	}
+	out.AllowPullRequests = in.AllowPullRequests
+	if in.IncludePaths != nil {
+		out.IncludePaths = make([]string, len(in.IncludePaths))
+		for i := range in.IncludePaths {
+			out.IncludePaths[i] = in.IncludePaths[i]
+		}
+	} else {
+		out.IncludePaths = nil
+	}
+	if in.ExcludePaths != nil {
+		out.ExcludePaths = make([]string, len(in.ExcludePaths))
+		for i := range in.ExcludePaths {
+			out.ExcludePaths[i] = in.ExcludePaths[i]
+		}
+	} else {
+		out.ExcludePaths = nil
+	}
 	return nil
 }
 
@@ -2759,6 +3503,18 @@ func Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTrigger
 	return autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger(in, out, s)
 }
 
+func autoConvert_api_WebhookNotification_To_v1_WebhookNotification(in *buildapi.WebhookNotification, out *buildapiv1.WebhookNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.WebhookNotification))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_api_WebhookNotification_To_v1_WebhookNotification(in *buildapi.WebhookNotification, out *buildapiv1.WebhookNotification, s conversion.Scope) error {
+	return autoConvert_api_WebhookNotification_To_v1_WebhookNotification(in, out, s)
+}
+
 func autoConvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions(in *buildapiv1.BinaryBuildRequestOptions, out *buildapi.BinaryBuildRequestOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.BinaryBuildRequestOptions))(in)
@@ -2812,6 +3568,20 @@ func Convert_v1_Build_To_api_Build(in *buildapiv1.Build, out *buildapi.Build, s
 	return autoConvert_v1_Build_To_api_Build(in, out, s)
 }
 
+func autoConvert_v1_BuildCacheVolume_To_api_BuildCacheVolume(in *buildapiv1.BuildCacheVolume, out *buildapi.BuildCacheVolume, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.BuildCacheVolume))(in)
+	}
+	out.Name = in.Name
+	out.ClaimName = in.ClaimName
+	out.Path = in.Path
+	return nil
+}
+
+func Convert_v1_BuildCacheVolume_To_api_BuildCacheVolume(in *buildapiv1.BuildCacheVolume, out *buildapi.BuildCacheVolume, s conversion.Scope) error {
+	return autoConvert_v1_BuildCacheVolume_To_api_BuildCacheVolume(in, out, s)
+}
+
 func autoConvert_v1_BuildConfig_To_api_BuildConfig(in *buildapiv1.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.BuildConfig))(in)
@@ -2866,6 +3636,27 @@ func autoConvert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *buildapiv1.BuildC
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapi.BuildParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := Convert_v1_BuildParameter_To_api_BuildParameter(&in.Parameters[i], &out.Parameters[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.Matrix != nil {
+		out.Matrix = make([]buildapi.BuildMatrixParameter, len(in.Matrix))
+		for i := range in.Matrix {
+			if err := Convert_v1_BuildMatrixParameter_To_api_BuildMatrixParameter(&in.Matrix[i], &out.Matrix[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Matrix = nil
+	}
 	if err := Convert_v1_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -2972,6 +3763,72 @@ func Convert_v1_BuildLogOptions_To_api_BuildLogOptions(in *buildapiv1.BuildLogOp
 	return autoConvert_v1_BuildLogOptions_To_api_BuildLogOptions(in, out, s)
 }
 
+func autoConvert_v1_BuildMatrixParameter_To_api_BuildMatrixParameter(in *buildapiv1.BuildMatrixParameter, out *buildapi.BuildMatrixParameter, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.BuildMatrixParameter))(in)
+	}
+	out.Name = in.Name
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func Convert_v1_BuildMatrixParameter_To_api_BuildMatrixParameter(in *buildapiv1.BuildMatrixParameter, out *buildapi.BuildMatrixParameter, s conversion.Scope) error {
+	return autoConvert_v1_BuildMatrixParameter_To_api_BuildMatrixParameter(in, out, s)
+}
+
+func autoConvert_v1_BuildNotification_To_api_BuildNotification(in *buildapiv1.BuildNotification, out *buildapi.BuildNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.BuildNotification))(in)
+	}
+	if in.Phases != nil {
+		out.Phases = make([]buildapi.BuildPhase, len(in.Phases))
+		for i := range in.Phases {
+			out.Phases[i] = buildapi.BuildPhase(in.Phases[i])
+		}
+	} else {
+		out.Phases = nil
+	}
+	// unable to generate simple pointer conversion for v1.WebhookNotification -> api.WebhookNotification
+	if in.Webhook != nil {
+		out.Webhook = new(buildapi.WebhookNotification)
+		if err := Convert_v1_WebhookNotification_To_api_WebhookNotification(in.Webhook, out.Webhook, s); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	// unable to generate simple pointer conversion for v1.SlackNotification -> api.SlackNotification
+	if in.Slack != nil {
+		out.Slack = new(buildapi.SlackNotification)
+		if err := Convert_v1_SlackNotification_To_api_SlackNotification(in.Slack, out.Slack, s); err != nil {
+			return err
+		}
+	} else {
+		out.Slack = nil
+	}
+	// unable to generate simple pointer conversion for v1.EmailNotification -> api.EmailNotification
+	if in.Email != nil {
+		out.Email = new(buildapi.EmailNotification)
+		if err := Convert_v1_EmailNotification_To_api_EmailNotification(in.Email, out.Email, s); err != nil {
+			return err
+		}
+	} else {
+		out.Email = nil
+	}
+	return nil
+}
+
+func Convert_v1_BuildNotification_To_api_BuildNotification(in *buildapiv1.BuildNotification, out *buildapi.BuildNotification, s conversion.Scope) error {
+	return autoConvert_v1_BuildNotification_To_api_BuildNotification(in, out, s)
+}
+
 func autoConvert_v1_BuildOutput_To_api_BuildOutput(in *buildapiv1.BuildOutput, out *buildapi.BuildOutput, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.BuildOutput))(in)
@@ -2997,25 +3854,54 @@ func autoConvert_v1_BuildOutput_To_api_BuildOutput(in *buildapiv1.BuildOutput, o
 	return nil
 }
 
-func autoConvert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *buildapiv1.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, s conversion.Scope) error {
+func autoConvert_v1_BuildParameter_To_api_BuildParameter(in *buildapiv1.BuildParameter, out *buildapi.BuildParameter, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*buildapiv1.BuildPostCommitSpec))(in)
-	}
-	if in.Command != nil {
-		out.Command = make([]string, len(in.Command))
-		for i := range in.Command {
-			out.Command[i] = in.Command[i]
-		}
-	} else {
-		out.Command = nil
+		defaulting.(func(*buildapiv1.BuildParameter))(in)
 	}
-	if in.Args != nil {
-		out.Args = make([]string, len(in.Args))
-		for i := range in.Args {
-			out.Args[i] = in.Args[i]
-		}
-	} else {
-		out.Args = nil
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Type = buildapi.BuildParameterType(in.Type)
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+func Convert_v1_BuildParameter_To_api_BuildParameter(in *buildapiv1.BuildParameter, out *buildapi.BuildParameter, s conversion.Scope) error {
+	return autoConvert_v1_BuildParameter_To_api_BuildParameter(in, out, s)
+}
+
+func autoConvert_v1_BuildParameterValue_To_api_BuildParameterValue(in *buildapiv1.BuildParameterValue, out *buildapi.BuildParameterValue, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.BuildParameterValue))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+func Convert_v1_BuildParameterValue_To_api_BuildParameterValue(in *buildapiv1.BuildParameterValue, out *buildapi.BuildParameterValue, s conversion.Scope) error {
+	return autoConvert_v1_BuildParameterValue_To_api_BuildParameterValue(in, out, s)
+}
+
+func autoConvert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *buildapiv1.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.BuildPostCommitSpec))(in)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	if in.Args != nil {
+		out.Args = make([]string, len(in.Args))
+		for i := range in.Args {
+			out.Args[i] = in.Args[i]
+		}
+	} else {
+		out.Args = nil
 	}
 	out.Script = in.Script
 	return nil
@@ -3083,6 +3969,26 @@ func autoConvert_v1_BuildRequest_To_api_BuildRequest(in *buildapiv1.BuildRequest
 	} else {
 		out.Env = nil
 	}
+	// unable to generate simple pointer conversion for v1.GitPullRequest -> api.GitPullRequest
+	if in.PullRequest != nil {
+		out.PullRequest = new(buildapi.GitPullRequest)
+		if err := Convert_v1_GitPullRequest_To_api_GitPullRequest(in.PullRequest, out.PullRequest, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
+	out.NoCache = in.NoCache
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapi.BuildParameterValue, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := Convert_v1_BuildParameterValue_To_api_BuildParameterValue(&in.Parameters[i], &out.Parameters[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
 	return nil
 }
 
@@ -3186,6 +4092,25 @@ func autoConvert_v1_BuildSpec_To_api_BuildSpec(in *buildapiv1.BuildSpec, out *bu
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Notifications != nil {
+		out.Notifications = make([]buildapi.BuildNotification, len(in.Notifications))
+		for i := range in.Notifications {
+			if err := Convert_v1_BuildNotification_To_api_BuildNotification(&in.Notifications[i], &out.Notifications[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Notifications = nil
+	}
+	// unable to generate simple pointer conversion for v1.CommitStatusReporting -> api.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusReporting)
+		if err := Convert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -3230,6 +4155,16 @@ func autoConvert_v1_BuildStatus_To_api_BuildStatus(in *buildapiv1.BuildStatus, o
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_v1_PipelineStageStatus_To_api_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
 	return nil
 }
 
@@ -3266,6 +4201,15 @@ func autoConvert_v1_BuildStrategy_To_api_BuildStrategy(in *buildapiv1.BuildStrat
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for v1.PipelineBuildStrategy -> api.PipelineBuildStrategy
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapi.PipelineBuildStrategy)
+		if err := Convert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -3292,6 +4236,33 @@ func autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *buildapiv1.
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for v1.WebHookTrigger -> api.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1.WebHookTrigger -> api.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1.WebHookTrigger -> api.WebHookTrigger
+	if in.GogsWebHook != nil {
+		out.GogsWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.GogsWebHook, out.GogsWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GogsWebHook = nil
+	}
 	// unable to generate simple pointer conversion for v1.ImageChangeTrigger -> api.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
@@ -3304,6 +4275,23 @@ func autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *buildapiv1.
 	return nil
 }
 
+func autoConvert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in *buildapiv1.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.CommitStatusReporting))(in)
+	}
+	out.Provider = buildapi.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.TokenSecret, &out.TokenSecret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in *buildapiv1.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy(in *buildapiv1.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.CustomBuildStrategy))(in)
@@ -3381,9 +4369,38 @@ func autoConvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy(in *buildapiv
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapi.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_v1_BuildCacheVolume_To_api_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func autoConvert_v1_EmailNotification_To_api_EmailNotification(in *buildapiv1.EmailNotification, out *buildapi.EmailNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.EmailNotification))(in)
+	}
+	if in.To != nil {
+		out.To = make([]string, len(in.To))
+		for i := range in.To {
+			out.To[i] = in.To[i]
+		}
+	} else {
+		out.To = nil
+	}
 	return nil
 }
 
+func Convert_v1_EmailNotification_To_api_EmailNotification(in *buildapiv1.EmailNotification, out *buildapi.EmailNotification, s conversion.Scope) error {
+	return autoConvert_v1_EmailNotification_To_api_EmailNotification(in, out, s)
+}
+
 func autoConvert_v1_GitBuildSource_To_api_GitBuildSource(in *buildapiv1.GitBuildSource, out *buildapi.GitBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.GitBuildSource))(in)
@@ -3409,6 +4426,19 @@ func Convert_v1_GitBuildSource_To_api_GitBuildSource(in *buildapiv1.GitBuildSour
 	return autoConvert_v1_GitBuildSource_To_api_GitBuildSource(in, out, s)
 }
 
+func autoConvert_v1_GitPullRequest_To_api_GitPullRequest(in *buildapiv1.GitPullRequest, out *buildapi.GitPullRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.GitPullRequest))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
+func Convert_v1_GitPullRequest_To_api_GitPullRequest(in *buildapiv1.GitPullRequest, out *buildapi.GitPullRequest, s conversion.Scope) error {
+	return autoConvert_v1_GitPullRequest_To_api_GitPullRequest(in, out, s)
+}
+
 func autoConvert_v1_GitSourceRevision_To_api_GitSourceRevision(in *buildapiv1.GitSourceRevision, out *buildapi.GitSourceRevision, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.GitSourceRevision))(in)
@@ -3495,6 +4525,151 @@ func Convert_v1_ImageSourcePath_To_api_ImageSourcePath(in *buildapiv1.ImageSourc
 	return autoConvert_v1_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
+func autoConvert_v1_PipelineBuildStage_To_api_PipelineBuildStage(in *buildapiv1.PipelineBuildStage, out *buildapi.PipelineBuildStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.PipelineBuildStage))(in)
+	}
+	out.BuildConfig = in.BuildConfig
+	return nil
+}
+
+func Convert_v1_PipelineBuildStage_To_api_PipelineBuildStage(in *buildapiv1.PipelineBuildStage, out *buildapi.PipelineBuildStage, s conversion.Scope) error {
+	return autoConvert_v1_PipelineBuildStage_To_api_PipelineBuildStage(in, out, s)
+}
+
+func autoConvert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *buildapiv1.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.PipelineBuildStrategy))(in)
+	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_v1_PipelineStage_To_api_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func Convert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *buildapiv1.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in, out, s)
+}
+
+func autoConvert_v1_PipelinePromoteStage_To_api_PipelinePromoteStage(in *buildapiv1.PipelinePromoteStage, out *buildapi.PipelinePromoteStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.PipelinePromoteStage))(in)
+	}
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_v1_PipelinePromoteStage_To_api_PipelinePromoteStage(in *buildapiv1.PipelinePromoteStage, out *buildapi.PipelinePromoteStage, s conversion.Scope) error {
+	return autoConvert_v1_PipelinePromoteStage_To_api_PipelinePromoteStage(in, out, s)
+}
+
+func autoConvert_v1_PipelineStage_To_api_PipelineStage(in *buildapiv1.PipelineStage, out *buildapi.PipelineStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.PipelineStage))(in)
+	}
+	out.Name = in.Name
+	// unable to generate simple pointer conversion for v1.PipelineBuildStage -> api.PipelineBuildStage
+	if in.Build != nil {
+		out.Build = new(buildapi.PipelineBuildStage)
+		if err := Convert_v1_PipelineBuildStage_To_api_PipelineBuildStage(in.Build, out.Build, s); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	// unable to generate simple pointer conversion for v1.PipelineTestStage -> api.PipelineTestStage
+	if in.Test != nil {
+		out.Test = new(buildapi.PipelineTestStage)
+		if err := Convert_v1_PipelineTestStage_To_api_PipelineTestStage(in.Test, out.Test, s); err != nil {
+			return err
+		}
+	} else {
+		out.Test = nil
+	}
+	// unable to generate simple pointer conversion for v1.PipelinePromoteStage -> api.PipelinePromoteStage
+	if in.Promote != nil {
+		out.Promote = new(buildapi.PipelinePromoteStage)
+		if err := Convert_v1_PipelinePromoteStage_To_api_PipelinePromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func Convert_v1_PipelineStage_To_api_PipelineStage(in *buildapiv1.PipelineStage, out *buildapi.PipelineStage, s conversion.Scope) error {
+	return autoConvert_v1_PipelineStage_To_api_PipelineStage(in, out, s)
+}
+
+func autoConvert_v1_PipelineStageStatus_To_api_PipelineStageStatus(in *buildapiv1.PipelineStageStatus, out *buildapi.PipelineStageStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.PipelineStageStatus))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	out.Build = in.Build
+	out.Pod = in.Pod
+	out.Message = in.Message
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func Convert_v1_PipelineStageStatus_To_api_PipelineStageStatus(in *buildapiv1.PipelineStageStatus, out *buildapi.PipelineStageStatus, s conversion.Scope) error {
+	return autoConvert_v1_PipelineStageStatus_To_api_PipelineStageStatus(in, out, s)
+}
+
+func autoConvert_v1_PipelineTestStage_To_api_PipelineTestStage(in *buildapiv1.PipelineTestStage, out *buildapi.PipelineTestStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.PipelineTestStage))(in)
+	}
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.Image, &out.Image, s); err != nil {
+		return err
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
+func Convert_v1_PipelineTestStage_To_api_PipelineTestStage(in *buildapiv1.PipelineTestStage, out *buildapi.PipelineTestStage, s conversion.Scope) error {
+	return autoConvert_v1_PipelineTestStage_To_api_PipelineTestStage(in, out, s)
+}
+
 func autoConvert_v1_SecretBuildSource_To_api_SecretBuildSource(in *buildapiv1.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.SecretBuildSource))(in)
@@ -3525,6 +4700,19 @@ func Convert_v1_SecretSpec_To_api_SecretSpec(in *buildapiv1.SecretSpec, out *bui
 	return autoConvert_v1_SecretSpec_To_api_SecretSpec(in, out, s)
 }
 
+func autoConvert_v1_SlackNotification_To_api_SlackNotification(in *buildapiv1.SlackNotification, out *buildapi.SlackNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.SlackNotification))(in)
+	}
+	out.URL = in.URL
+	out.Channel = in.Channel
+	return nil
+}
+
+func Convert_v1_SlackNotification_To_api_SlackNotification(in *buildapiv1.SlackNotification, out *buildapi.SlackNotification, s conversion.Scope) error {
+	return autoConvert_v1_SlackNotification_To_api_SlackNotification(in, out, s)
+}
+
 func autoConvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy(in *buildapiv1.SourceBuildStrategy, out *buildapi.SourceBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapiv1.SourceBuildStrategy))(in)
@@ -3554,6 +4742,16 @@ func autoConvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy(in *buildapiv
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapi.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_v1_BuildCacheVolume_To_api_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
 	return nil
 }
 
@@ -3592,42 +4790,193 @@ func autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in *buildapiv1.WebHookT
 		defaulting.(func(*buildapiv1.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
-	return nil
-}
-
-func Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *buildapiv1.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
-	return autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
-}
-
-func autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
+	// unable to generate simple pointer conversion for v1.LocalObjectReference -> api.LocalObjectReference
+	if in.SignatureSecret != nil {
+		out.SignatureSecret = new(api.LocalObjectReference)
+		if err := Convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.SignatureSecret, out.SignatureSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureSecret = nil
 	}
-	out.Image = in.Image
-	if in.Environment != nil {
-		out.Environment = make([]apiv1.EnvVar, len(in.Environment))
-		for i := range in.Environment {
-			if err := Convert_api_EnvVar_To_v1_EnvVar(&in.Environment[i], &out.Environment[i], s); err != nil {
-				return err
-			}
+	out.AllowPullRequests = in.AllowPullRequests
+	if in.IncludePaths != nil {
+		out.IncludePaths = make([]string, len(in.IncludePaths))
+		for i := range in.IncludePaths {
+			out.IncludePaths[i] = in.IncludePaths[i]
 		}
 	} else {
-		out.Environment = nil
+		out.IncludePaths = nil
 	}
-	if in.Command != nil {
-		out.Command = make([]string, len(in.Command))
-		for i := range in.Command {
-			out.Command[i] = in.Command[i]
+	if in.ExcludePaths != nil {
+		out.ExcludePaths = make([]string, len(in.ExcludePaths))
+		for i := range in.ExcludePaths {
+			out.ExcludePaths[i] = in.ExcludePaths[i]
 		}
 	} else {
-		out.Command = nil
+		out.ExcludePaths = nil
 	}
 	return nil
 }
 
-func Convert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
-	return autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in, out, s)
-}
+func Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *buildapiv1.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
+	return autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
+}
+
+func autoConvert_v1_WebhookNotification_To_api_WebhookNotification(in *buildapiv1.WebhookNotification, out *buildapi.WebhookNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapiv1.WebhookNotification))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_v1_WebhookNotification_To_api_WebhookNotification(in *buildapiv1.WebhookNotification, out *buildapi.WebhookNotification, s conversion.Scope) error {
+	return autoConvert_v1_WebhookNotification_To_api_WebhookNotification(in, out, s)
+}
+
+func autoConvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.BlueGreenDeploymentStrategyParams))(in)
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.ServiceName = in.ServiceName
+	if in.HoldSeconds != nil {
+		out.HoldSeconds = new(int64)
+		*out.HoldSeconds = *in.HoldSeconds
+	} else {
+		out.HoldSeconds = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
+func autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Steps != nil {
+		out.Steps = make([]int, len(in.Steps))
+		for i := range in.Steps {
+			out.Steps[i] = in.Steps[i]
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.BakeSeconds != nil {
+		out.BakeSeconds = new(int64)
+		*out.BakeSeconds = *in.BakeSeconds
+	} else {
+		out.BakeSeconds = nil
+	}
+	// unable to generate simple pointer conversion for api.ExecNewPodHook -> v1.ExecNewPodHook
+	if in.Check != nil {
+		out.Check = new(deployapiv1.ExecNewPodHook)
+		if err := Convert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in.Check, out.Check, s); err != nil {
+			return err
+		}
+	} else {
+		out.Check = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoConvert_api_ContainerImage_To_v1_ContainerImage(in *deployapi.ContainerImage, out *deployapiv1.ContainerImage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.ContainerImage))(in)
+	}
+	out.ContainerName = in.ContainerName
+	out.Image = in.Image
+	return nil
+}
+
+func Convert_api_ContainerImage_To_v1_ContainerImage(in *deployapi.ContainerImage, out *deployapiv1.ContainerImage, s conversion.Scope) error {
+	return autoConvert_api_ContainerImage_To_v1_ContainerImage(in, out, s)
+}
+
+func autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
+	}
+	out.Image = in.Image
+	if in.Environment != nil {
+		out.Environment = make([]apiv1.EnvVar, len(in.Environment))
+		for i := range in.Environment {
+			if err := Convert_api_EnvVar_To_v1_EnvVar(&in.Environment[i], &out.Environment[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Environment = nil
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
+func Convert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in, out, s)
+}
 
 func autoConvert_api_DeploymentCause_To_v1_DeploymentCause(in *deployapi.DeploymentCause, out *deployapiv1.DeploymentCause, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
@@ -3643,6 +4992,7 @@ func autoConvert_api_DeploymentCause_To_v1_DeploymentCause(in *deployapi.Deploym
 	} else {
 		out.ImageTrigger = nil
 	}
+	out.Message = in.Message
 	return nil
 }
 
@@ -3684,6 +5034,30 @@ func Convert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.Deploymen
 	return autoConvert_api_DeploymentConfig_To_v1_DeploymentConfig(in, out, s)
 }
 
+func autoConvert_api_DeploymentConfigHistory_To_v1_DeploymentConfigHistory(in *deployapi.DeploymentConfigHistory, out *deployapiv1.DeploymentConfigHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigHistory))(in)
+	}
+	if err := Convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Deployments != nil {
+		out.Deployments = make([]deployapiv1.DeploymentRecord, len(in.Deployments))
+		for i := range in.Deployments {
+			if err := Convert_api_DeploymentRecord_To_v1_DeploymentRecord(&in.Deployments[i], &out.Deployments[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Deployments = nil
+	}
+	return nil
+}
+
+func Convert_api_DeploymentConfigHistory_To_v1_DeploymentConfigHistory(in *deployapi.DeploymentConfigHistory, out *deployapiv1.DeploymentConfigHistory, s conversion.Scope) error {
+	return autoConvert_api_DeploymentConfigHistory_To_v1_DeploymentConfigHistory(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfigList_To_v1_DeploymentConfigList(in *deployapi.DeploymentConfigList, out *deployapiv1.DeploymentConfigList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfigList))(in)
@@ -3759,6 +5133,18 @@ func autoConvert_api_DeploymentConfigSpec_To_v1_DeploymentConfigSpec(in *deploya
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.Windows != nil {
+		out.Windows = make([]deployapiv1.DeploymentWindow, len(in.Windows))
+		for i := range in.Windows {
+			if err := Convert_api_DeploymentWindow_To_v1_DeploymentWindow(&in.Windows[i], &out.Windows[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Windows = nil
+	}
+	out.ImageChangeDebounceSeconds = in.ImageChangeDebounceSeconds
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -3797,6 +5183,16 @@ func autoConvert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	if in.LastTriggeredImages != nil {
+		out.LastTriggeredImages = make([]deployapiv1.ContainerImage, len(in.LastTriggeredImages))
+		for i := range in.LastTriggeredImages {
+			if err := Convert_api_ContainerImage_To_v1_ContainerImage(&in.LastTriggeredImages[i], &out.LastTriggeredImages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LastTriggeredImages = nil
+	}
 	return nil
 }
 
@@ -3826,6 +5222,20 @@ func Convert_api_DeploymentDetails_To_v1_DeploymentDetails(in *deployapi.Deploym
 	return autoConvert_api_DeploymentDetails_To_v1_DeploymentDetails(in, out, s)
 }
 
+func autoConvert_api_DeploymentHookResult_To_v1_DeploymentHookResult(in *deployapi.DeploymentHookResult, out *deployapiv1.DeploymentHookResult, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentHookResult))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1.PodPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_api_DeploymentHookResult_To_v1_DeploymentHookResult(in *deployapi.DeploymentHookResult, out *deployapiv1.DeploymentHookResult, s conversion.Scope) error {
+	return autoConvert_api_DeploymentHookResult_To_v1_DeploymentHookResult(in, out, s)
+}
+
 func autoConvert_api_DeploymentLog_To_v1_DeploymentLog(in *deployapi.DeploymentLog, out *deployapiv1.DeploymentLog, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentLog))(in)
@@ -3886,6 +5296,74 @@ func Convert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions(in *deployapi.D
 	return autoConvert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_api_DeploymentRecord_To_v1_DeploymentRecord(in *deployapi.DeploymentRecord, out *deployapiv1.DeploymentRecord, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentRecord))(in)
+	}
+	out.Name = in.Name
+	out.Version = in.Version
+	if err := s.Convert(&in.Phase, &out.Phase, 0); err != nil {
+		return err
+	}
+	// unable to generate simple pointer conversion for api.DeploymentDetails -> v1.DeploymentDetails
+	if in.Details != nil {
+		out.Details = new(deployapiv1.DeploymentDetails)
+		if err := Convert_api_DeploymentDetails_To_v1_DeploymentDetails(in.Details, out.Details, s); err != nil {
+			return err
+		}
+	} else {
+		out.Details = nil
+	}
+	out.Strategy = deployapiv1.DeploymentStrategyType(in.Strategy)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTime != nil {
+		out.StartTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTime, out.StartTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTime = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTime != nil {
+		out.CompletionTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTime, out.CompletionTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTime = nil
+	}
+	if in.DurationSeconds != nil {
+		out.DurationSeconds = new(int64)
+		*out.DurationSeconds = *in.DurationSeconds
+	} else {
+		out.DurationSeconds = nil
+	}
+	if in.PreviousReplicas != nil {
+		out.PreviousReplicas = new(int)
+		*out.PreviousReplicas = *in.PreviousReplicas
+	} else {
+		out.PreviousReplicas = nil
+	}
+	out.Replicas = in.Replicas
+	if in.Hooks != nil {
+		out.Hooks = make([]deployapiv1.DeploymentHookResult, len(in.Hooks))
+		for i := range in.Hooks {
+			if err := Convert_api_DeploymentHookResult_To_v1_DeploymentHookResult(&in.Hooks[i], &out.Hooks[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Hooks = nil
+	}
+	out.Reason = in.Reason
+	return nil
+}
+
+func Convert_api_DeploymentRecord_To_v1_DeploymentRecord(in *deployapi.DeploymentRecord, out *deployapiv1.DeploymentRecord, s conversion.Scope) error {
+	return autoConvert_api_DeploymentRecord_To_v1_DeploymentRecord(in, out, s)
+}
+
 func autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.DeploymentStrategy, out *deployapiv1.DeploymentStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentStrategy))(in)
@@ -3917,6 +5395,24 @@ func autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.D
 	} else {
 		out.RollingParams = nil
 	}
+	// unable to generate simple pointer conversion for api.BlueGreenDeploymentStrategyParams -> v1.BlueGreenDeploymentStrategyParams
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1.BlueGreenDeploymentStrategyParams)
+		if err := Convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	// unable to generate simple pointer conversion for api.CanaryDeploymentStrategyParams -> v1.CanaryDeploymentStrategyParams
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := Convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if err := Convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3936,6 +5432,16 @@ func autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.D
 	} else {
 		out.Annotations = nil
 	}
+	out.AutoRollback = in.AutoRollback
+	// unable to generate simple pointer conversion for api.DeploymentVerification -> v1.DeploymentVerification
+	if in.Verification != nil {
+		out.Verification = new(deployapiv1.DeploymentVerification)
+		if err := Convert_api_DeploymentVerification_To_v1_DeploymentVerification(in.Verification, out.Verification, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verification = nil
+	}
 	return nil
 }
 
@@ -3983,6 +5489,62 @@ func Convert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in *deplo
 	return autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoConvert_api_DeploymentVerification_To_v1_DeploymentVerification(in *deployapi.DeploymentVerification, out *deployapiv1.DeploymentVerification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentVerification))(in)
+	}
+	// unable to generate simple pointer conversion for api.PrometheusQuery -> v1.PrometheusQuery
+	if in.Prometheus != nil {
+		out.Prometheus = new(deployapiv1.PrometheusQuery)
+		if err := Convert_api_PrometheusQuery_To_v1_PrometheusQuery(in.Prometheus, out.Prometheus, s); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
+	// unable to generate simple pointer conversion for api.HTTPGetMetric -> v1.HTTPGetMetric
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1.HTTPGetMetric)
+		if err := Convert_api_HTTPGetMetric_To_v1_HTTPGetMetric(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	out.MinValue = in.MinValue
+	out.MaxValue = in.MaxValue
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_DeploymentVerification_To_v1_DeploymentVerification(in *deployapi.DeploymentVerification, out *deployapiv1.DeploymentVerification, s conversion.Scope) error {
+	return autoConvert_api_DeploymentVerification_To_v1_DeploymentVerification(in, out, s)
+}
+
+func autoConvert_api_DeploymentWindow_To_v1_DeploymentWindow(in *deployapi.DeploymentWindow, out *deployapiv1.DeploymentWindow, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentWindow))(in)
+	}
+	out.Schedule = in.Schedule
+	out.DurationMinutes = in.DurationMinutes
+	return nil
+}
+
+func Convert_api_DeploymentWindow_To_v1_DeploymentWindow(in *deployapi.DeploymentWindow, out *deployapiv1.DeploymentWindow, s conversion.Scope) error {
+	return autoConvert_api_DeploymentWindow_To_v1_DeploymentWindow(in, out, s)
+}
+
 func autoConvert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in *deployapi.ExecNewPodHook, out *deployapiv1.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.ExecNewPodHook))(in)
@@ -4021,6 +5583,39 @@ func Convert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in *deployapi.ExecNewPodHoo
 	return autoConvert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in, out, s)
 }
 
+func autoConvert_api_HTTPGetHook_To_v1_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.HTTPGetHook))(in)
+	}
+	out.URL = in.URL
+	out.Method = in.Method
+	out.ExpectedStatus = in.ExpectedStatus
+	out.Retries = in.Retries
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_HTTPGetHook_To_v1_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_api_HTTPGetHook_To_v1_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_api_HTTPGetMetric_To_v1_HTTPGetMetric(in *deployapi.HTTPGetMetric, out *deployapiv1.HTTPGetMetric, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.HTTPGetMetric))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_api_HTTPGetMetric_To_v1_HTTPGetMetric(in *deployapi.HTTPGetMetric, out *deployapiv1.HTTPGetMetric, s conversion.Scope) error {
+	return autoConvert_api_HTTPGetMetric_To_v1_HTTPGetMetric(in, out, s)
+}
+
 func autoConvert_api_LifecycleHook_To_v1_LifecycleHook(in *deployapi.LifecycleHook, out *deployapiv1.LifecycleHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.LifecycleHook))(in)
@@ -4045,6 +5640,24 @@ func autoConvert_api_LifecycleHook_To_v1_LifecycleHook(in *deployapi.LifecycleHo
 	} else {
 		out.TagImages = nil
 	}
+	// unable to generate simple pointer conversion for api.HTTPGetHook -> v1.HTTPGetHook
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1.HTTPGetHook)
+		if err := Convert_api_HTTPGetHook_To_v1_HTTPGetHook(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	// unable to generate simple pointer conversion for api.TCPSocketHook -> v1.TCPSocketHook
+	if in.TCPSocket != nil {
+		out.TCPSocket = new(deployapiv1.TCPSocketHook)
+		if err := Convert_api_TCPSocketHook_To_v1_TCPSocketHook(in.TCPSocket, out.TCPSocket, s); err != nil {
+			return err
+		}
+	} else {
+		out.TCPSocket = nil
+	}
 	return nil
 }
 
@@ -4052,6 +5665,19 @@ func Convert_api_LifecycleHook_To_v1_LifecycleHook(in *deployapi.LifecycleHook,
 	return autoConvert_api_LifecycleHook_To_v1_LifecycleHook(in, out, s)
 }
 
+func autoConvert_api_PrometheusQuery_To_v1_PrometheusQuery(in *deployapi.PrometheusQuery, out *deployapiv1.PrometheusQuery, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.PrometheusQuery))(in)
+	}
+	out.URL = in.URL
+	out.Query = in.Query
+	return nil
+}
+
+func Convert_api_PrometheusQuery_To_v1_PrometheusQuery(in *deployapi.PrometheusQuery, out *deployapiv1.PrometheusQuery, s conversion.Scope) error {
+	return autoConvert_api_PrometheusQuery_To_v1_PrometheusQuery(in, out, s)
+}
+
 func autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams(in *deployapi.RecreateDeploymentStrategyParams, out *deployapiv1.RecreateDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.RecreateDeploymentStrategyParams))(in)
@@ -4062,28 +5688,168 @@ func autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentSt
 	} else {
 		out.TimeoutSeconds = nil
 	}
-	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
-	if in.Pre != nil {
-		out.Pre = new(deployapiv1.LifecycleHook)
-		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
-			return err
-		}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams(in *deployapi.RecreateDeploymentStrategyParams, out *deployapiv1.RecreateDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams(in, out, s)
+}
+
+func autoConvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategyParams(in *deployapi.RollingDeploymentStrategyParams, out *deployapiv1.RollingDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.RollingDeploymentStrategyParams))(in)
+	}
+	if in.UpdatePeriodSeconds != nil {
+		out.UpdatePeriodSeconds = new(int64)
+		*out.UpdatePeriodSeconds = *in.UpdatePeriodSeconds
+	} else {
+		out.UpdatePeriodSeconds = nil
+	}
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if err := s.Convert(&in.MaxUnavailable, &out.MaxUnavailable, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.MaxSurge, &out.MaxSurge, 0); err != nil {
+		return err
+	}
+	if in.UpdatePercent != nil {
+		out.UpdatePercent = new(int)
+		*out.UpdatePercent = *in.UpdatePercent
+	} else {
+		out.UpdatePercent = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Batch != nil {
+		out.Batch = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Batch, out.Batch, s); err != nil {
+			return err
+		}
+	} else {
+		out.Batch = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func autoConvert_api_TCPSocketHook_To_v1_TCPSocketHook(in *deployapi.TCPSocketHook, out *deployapiv1.TCPSocketHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.TCPSocketHook))(in)
+	}
+	out.Host = in.Host
+	out.Port = in.Port
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_TCPSocketHook_To_v1_TCPSocketHook(in *deployapi.TCPSocketHook, out *deployapiv1.TCPSocketHook, s conversion.Scope) error {
+	return autoConvert_api_TCPSocketHook_To_v1_TCPSocketHook(in, out, s)
+}
+
+func autoConvert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.TagImageHook))(in)
+	}
+	out.ContainerName = in.ContainerName
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+	return autoConvert_api_TagImageHook_To_v1_TagImageHook(in, out, s)
+}
+
+func autoConvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.BlueGreenDeploymentStrategyParams))(in)
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.ServiceName = in.ServiceName
+	if in.HoldSeconds != nil {
+		out.HoldSeconds = new(int64)
+		*out.HoldSeconds = *in.HoldSeconds
 	} else {
-		out.Pre = nil
+		out.HoldSeconds = nil
 	}
-	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
-	if in.Mid != nil {
-		out.Mid = new(deployapiv1.LifecycleHook)
-		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
 			return err
 		}
 	} else {
-		out.Mid = nil
+		out.Pre = nil
 	}
-	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
 	if in.Post != nil {
-		out.Post = new(deployapiv1.LifecycleHook)
-		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
 			return err
 		}
 	} else {
@@ -4092,25 +5858,13 @@ func autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentSt
 	return nil
 }
 
-func Convert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams(in *deployapi.RecreateDeploymentStrategyParams, out *deployapiv1.RecreateDeploymentStrategyParams, s conversion.Scope) error {
-	return autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams(in, out, s)
+func Convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in, out, s)
 }
 
-func autoConvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategyParams(in *deployapi.RollingDeploymentStrategyParams, out *deployapiv1.RollingDeploymentStrategyParams, s conversion.Scope) error {
+func autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.RollingDeploymentStrategyParams))(in)
-	}
-	if in.UpdatePeriodSeconds != nil {
-		out.UpdatePeriodSeconds = new(int64)
-		*out.UpdatePeriodSeconds = *in.UpdatePeriodSeconds
-	} else {
-		out.UpdatePeriodSeconds = nil
-	}
-	if in.IntervalSeconds != nil {
-		out.IntervalSeconds = new(int64)
-		*out.IntervalSeconds = *in.IntervalSeconds
-	} else {
-		out.IntervalSeconds = nil
+		defaulting.(func(*deployapiv1.CanaryDeploymentStrategyParams))(in)
 	}
 	if in.TimeoutSeconds != nil {
 		out.TimeoutSeconds = new(int64)
@@ -4118,31 +5872,42 @@ func autoConvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	} else {
 		out.TimeoutSeconds = nil
 	}
-	if err := s.Convert(&in.MaxUnavailable, &out.MaxUnavailable, 0); err != nil {
-		return err
+	if in.Steps != nil {
+		out.Steps = make([]int, len(in.Steps))
+		for i := range in.Steps {
+			out.Steps[i] = in.Steps[i]
+		}
+	} else {
+		out.Steps = nil
 	}
-	if err := s.Convert(&in.MaxSurge, &out.MaxSurge, 0); err != nil {
-		return err
+	if in.BakeSeconds != nil {
+		out.BakeSeconds = new(int64)
+		*out.BakeSeconds = *in.BakeSeconds
+	} else {
+		out.BakeSeconds = nil
 	}
-	if in.UpdatePercent != nil {
-		out.UpdatePercent = new(int)
-		*out.UpdatePercent = *in.UpdatePercent
+	// unable to generate simple pointer conversion for v1.ExecNewPodHook -> api.ExecNewPodHook
+	if in.Check != nil {
+		out.Check = new(deployapi.ExecNewPodHook)
+		if err := Convert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in.Check, out.Check, s); err != nil {
+			return err
+		}
 	} else {
-		out.UpdatePercent = nil
+		out.Check = nil
 	}
-	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
 	if in.Pre != nil {
-		out.Pre = new(deployapiv1.LifecycleHook)
-		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
 			return err
 		}
 	} else {
 		out.Pre = nil
 	}
-	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
 	if in.Post != nil {
-		out.Post = new(deployapiv1.LifecycleHook)
-		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
 			return err
 		}
 	} else {
@@ -4151,19 +5916,21 @@ func autoConvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	return nil
 }
 
-func autoConvert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+func Convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoConvert_v1_ContainerImage_To_api_ContainerImage(in *deployapiv1.ContainerImage, out *deployapi.ContainerImage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.TagImageHook))(in)
+		defaulting.(func(*deployapiv1.ContainerImage))(in)
 	}
 	out.ContainerName = in.ContainerName
-	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
-		return err
-	}
+	out.Image = in.Image
 	return nil
 }
 
-func Convert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
-	return autoConvert_api_TagImageHook_To_v1_TagImageHook(in, out, s)
+func Convert_v1_ContainerImage_To_api_ContainerImage(in *deployapiv1.ContainerImage, out *deployapi.ContainerImage, s conversion.Scope) error {
+	return autoConvert_v1_ContainerImage_To_api_ContainerImage(in, out, s)
 }
 
 func autoConvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *deployapiv1.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, s conversion.Scope) error {
@@ -4210,6 +5977,7 @@ func autoConvert_v1_DeploymentCause_To_api_DeploymentCause(in *deployapiv1.Deplo
 	} else {
 		out.ImageTrigger = nil
 	}
+	out.Message = in.Message
 	return nil
 }
 
@@ -4251,6 +6019,30 @@ func Convert_v1_DeploymentConfig_To_api_DeploymentConfig(in *deployapiv1.Deploym
 	return autoConvert_v1_DeploymentConfig_To_api_DeploymentConfig(in, out, s)
 }
 
+func autoConvert_v1_DeploymentConfigHistory_To_api_DeploymentConfigHistory(in *deployapiv1.DeploymentConfigHistory, out *deployapi.DeploymentConfigHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentConfigHistory))(in)
+	}
+	if err := Convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Deployments != nil {
+		out.Deployments = make([]deployapi.DeploymentRecord, len(in.Deployments))
+		for i := range in.Deployments {
+			if err := Convert_v1_DeploymentRecord_To_api_DeploymentRecord(&in.Deployments[i], &out.Deployments[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Deployments = nil
+	}
+	return nil
+}
+
+func Convert_v1_DeploymentConfigHistory_To_api_DeploymentConfigHistory(in *deployapiv1.DeploymentConfigHistory, out *deployapi.DeploymentConfigHistory, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentConfigHistory_To_api_DeploymentConfigHistory(in, out, s)
+}
+
 func autoConvert_v1_DeploymentConfigList_To_api_DeploymentConfigList(in *deployapiv1.DeploymentConfigList, out *deployapi.DeploymentConfigList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentConfigList))(in)
@@ -4326,6 +6118,18 @@ func autoConvert_v1_DeploymentConfigSpec_To_api_DeploymentConfigSpec(in *deploya
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.Windows != nil {
+		out.Windows = make([]deployapi.DeploymentWindow, len(in.Windows))
+		for i := range in.Windows {
+			if err := Convert_v1_DeploymentWindow_To_api_DeploymentWindow(&in.Windows[i], &out.Windows[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Windows = nil
+	}
+	out.ImageChangeDebounceSeconds = in.ImageChangeDebounceSeconds
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -4364,6 +6168,16 @@ func autoConvert_v1_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	if in.LastTriggeredImages != nil {
+		out.LastTriggeredImages = make([]deployapi.ContainerImage, len(in.LastTriggeredImages))
+		for i := range in.LastTriggeredImages {
+			if err := Convert_v1_ContainerImage_To_api_ContainerImage(&in.LastTriggeredImages[i], &out.LastTriggeredImages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LastTriggeredImages = nil
+	}
 	return nil
 }
 
@@ -4393,6 +6207,20 @@ func Convert_v1_DeploymentDetails_To_api_DeploymentDetails(in *deployapiv1.Deplo
 	return autoConvert_v1_DeploymentDetails_To_api_DeploymentDetails(in, out, s)
 }
 
+func autoConvert_v1_DeploymentHookResult_To_api_DeploymentHookResult(in *deployapiv1.DeploymentHookResult, out *deployapi.DeploymentHookResult, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentHookResult))(in)
+	}
+	out.Name = in.Name
+	out.Phase = api.PodPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_v1_DeploymentHookResult_To_api_DeploymentHookResult(in *deployapiv1.DeploymentHookResult, out *deployapi.DeploymentHookResult, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentHookResult_To_api_DeploymentHookResult(in, out, s)
+}
+
 func autoConvert_v1_DeploymentLog_To_api_DeploymentLog(in *deployapiv1.DeploymentLog, out *deployapi.DeploymentLog, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentLog))(in)
@@ -4453,6 +6281,74 @@ func Convert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deployapiv1
 	return autoConvert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_v1_DeploymentRecord_To_api_DeploymentRecord(in *deployapiv1.DeploymentRecord, out *deployapi.DeploymentRecord, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentRecord))(in)
+	}
+	out.Name = in.Name
+	out.Version = in.Version
+	if err := s.Convert(&in.Phase, &out.Phase, 0); err != nil {
+		return err
+	}
+	// unable to generate simple pointer conversion for v1.DeploymentDetails -> api.DeploymentDetails
+	if in.Details != nil {
+		out.Details = new(deployapi.DeploymentDetails)
+		if err := Convert_v1_DeploymentDetails_To_api_DeploymentDetails(in.Details, out.Details, s); err != nil {
+			return err
+		}
+	} else {
+		out.Details = nil
+	}
+	out.Strategy = deployapi.DeploymentStrategyType(in.Strategy)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTime != nil {
+		out.StartTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTime, out.StartTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTime = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTime != nil {
+		out.CompletionTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTime, out.CompletionTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTime = nil
+	}
+	if in.DurationSeconds != nil {
+		out.DurationSeconds = new(int64)
+		*out.DurationSeconds = *in.DurationSeconds
+	} else {
+		out.DurationSeconds = nil
+	}
+	if in.PreviousReplicas != nil {
+		out.PreviousReplicas = new(int)
+		*out.PreviousReplicas = *in.PreviousReplicas
+	} else {
+		out.PreviousReplicas = nil
+	}
+	out.Replicas = in.Replicas
+	if in.Hooks != nil {
+		out.Hooks = make([]deployapi.DeploymentHookResult, len(in.Hooks))
+		for i := range in.Hooks {
+			if err := Convert_v1_DeploymentHookResult_To_api_DeploymentHookResult(&in.Hooks[i], &out.Hooks[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Hooks = nil
+	}
+	out.Reason = in.Reason
+	return nil
+}
+
+func Convert_v1_DeploymentRecord_To_api_DeploymentRecord(in *deployapiv1.DeploymentRecord, out *deployapi.DeploymentRecord, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentRecord_To_api_DeploymentRecord(in, out, s)
+}
+
 func autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1.DeploymentStrategy, out *deployapi.DeploymentStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentStrategy))(in)
@@ -4484,6 +6380,24 @@ func autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1
 	} else {
 		out.RollingParams = nil
 	}
+	// unable to generate simple pointer conversion for v1.BlueGreenDeploymentStrategyParams -> api.BlueGreenDeploymentStrategyParams
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := Convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	// unable to generate simple pointer conversion for v1.CanaryDeploymentStrategyParams -> api.CanaryDeploymentStrategyParams
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := Convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if err := Convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -4503,6 +6417,16 @@ func autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1
 	} else {
 		out.Annotations = nil
 	}
+	out.AutoRollback = in.AutoRollback
+	// unable to generate simple pointer conversion for v1.DeploymentVerification -> api.DeploymentVerification
+	if in.Verification != nil {
+		out.Verification = new(deployapi.DeploymentVerification)
+		if err := Convert_v1_DeploymentVerification_To_api_DeploymentVerification(in.Verification, out.Verification, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verification = nil
+	}
 	return nil
 }
 
@@ -4550,6 +6474,62 @@ func Convert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *deplo
 	return autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoConvert_v1_DeploymentVerification_To_api_DeploymentVerification(in *deployapiv1.DeploymentVerification, out *deployapi.DeploymentVerification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentVerification))(in)
+	}
+	// unable to generate simple pointer conversion for v1.PrometheusQuery -> api.PrometheusQuery
+	if in.Prometheus != nil {
+		out.Prometheus = new(deployapi.PrometheusQuery)
+		if err := Convert_v1_PrometheusQuery_To_api_PrometheusQuery(in.Prometheus, out.Prometheus, s); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
+	// unable to generate simple pointer conversion for v1.HTTPGetMetric -> api.HTTPGetMetric
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.HTTPGetMetric)
+		if err := Convert_v1_HTTPGetMetric_To_api_HTTPGetMetric(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	out.MinValue = in.MinValue
+	out.MaxValue = in.MaxValue
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1_DeploymentVerification_To_api_DeploymentVerification(in *deployapiv1.DeploymentVerification, out *deployapi.DeploymentVerification, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentVerification_To_api_DeploymentVerification(in, out, s)
+}
+
+func autoConvert_v1_DeploymentWindow_To_api_DeploymentWindow(in *deployapiv1.DeploymentWindow, out *deployapi.DeploymentWindow, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentWindow))(in)
+	}
+	out.Schedule = in.Schedule
+	out.DurationMinutes = in.DurationMinutes
+	return nil
+}
+
+func Convert_v1_DeploymentWindow_To_api_DeploymentWindow(in *deployapiv1.DeploymentWindow, out *deployapi.DeploymentWindow, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentWindow_To_api_DeploymentWindow(in, out, s)
+}
+
 func autoConvert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1.ExecNewPodHook, out *deployapi.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.ExecNewPodHook))(in)
@@ -4588,6 +6568,39 @@ func Convert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1.ExecNewPodH
 	return autoConvert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in, out, s)
 }
 
+func autoConvert_v1_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.HTTPGetHook))(in)
+	}
+	out.URL = in.URL
+	out.Method = in.Method
+	out.ExpectedStatus = in.ExpectedStatus
+	out.Retries = in.Retries
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_v1_HTTPGetHook_To_api_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_v1_HTTPGetMetric_To_api_HTTPGetMetric(in *deployapiv1.HTTPGetMetric, out *deployapi.HTTPGetMetric, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.HTTPGetMetric))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_v1_HTTPGetMetric_To_api_HTTPGetMetric(in *deployapiv1.HTTPGetMetric, out *deployapi.HTTPGetMetric, s conversion.Scope) error {
+	return autoConvert_v1_HTTPGetMetric_To_api_HTTPGetMetric(in, out, s)
+}
+
 func autoConvert_v1_LifecycleHook_To_api_LifecycleHook(in *deployapiv1.LifecycleHook, out *deployapi.LifecycleHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.LifecycleHook))(in)
@@ -4612,6 +6625,24 @@ func autoConvert_v1_LifecycleHook_To_api_LifecycleHook(in *deployapiv1.Lifecycle
 	} else {
 		out.TagImages = nil
 	}
+	// unable to generate simple pointer conversion for v1.HTTPGetHook -> api.HTTPGetHook
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.HTTPGetHook)
+		if err := Convert_v1_HTTPGetHook_To_api_HTTPGetHook(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	// unable to generate simple pointer conversion for v1.TCPSocketHook -> api.TCPSocketHook
+	if in.TCPSocket != nil {
+		out.TCPSocket = new(deployapi.TCPSocketHook)
+		if err := Convert_v1_TCPSocketHook_To_api_TCPSocketHook(in.TCPSocket, out.TCPSocket, s); err != nil {
+			return err
+		}
+	} else {
+		out.TCPSocket = nil
+	}
 	return nil
 }
 
@@ -4619,6 +6650,19 @@ func Convert_v1_LifecycleHook_To_api_LifecycleHook(in *deployapiv1.LifecycleHook
 	return autoConvert_v1_LifecycleHook_To_api_LifecycleHook(in, out, s)
 }
 
+func autoConvert_v1_PrometheusQuery_To_api_PrometheusQuery(in *deployapiv1.PrometheusQuery, out *deployapi.PrometheusQuery, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.PrometheusQuery))(in)
+	}
+	out.URL = in.URL
+	out.Query = in.Query
+	return nil
+}
+
+func Convert_v1_PrometheusQuery_To_api_PrometheusQuery(in *deployapiv1.PrometheusQuery, out *deployapi.PrometheusQuery, s conversion.Scope) error {
+	return autoConvert_v1_PrometheusQuery_To_api_PrometheusQuery(in, out, s)
+}
+
 func autoConvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams(in *deployapiv1.RecreateDeploymentStrategyParams, out *deployapi.RecreateDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.RecreateDeploymentStrategyParams))(in)
@@ -4703,6 +6747,15 @@ func autoConvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStra
 		out.Pre = nil
 	}
 	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
+	if in.Batch != nil {
+		out.Batch = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Batch, out.Batch, s); err != nil {
+			return err
+		}
+	} else {
+		out.Batch = nil
+	}
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -4714,6 +6767,25 @@ func autoConvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStra
 	return nil
 }
 
+func autoConvert_v1_TCPSocketHook_To_api_TCPSocketHook(in *deployapiv1.TCPSocketHook, out *deployapi.TCPSocketHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.TCPSocketHook))(in)
+	}
+	out.Host = in.Host
+	out.Port = in.Port
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1_TCPSocketHook_To_api_TCPSocketHook(in *deployapiv1.TCPSocketHook, out *deployapi.TCPSocketHook, s conversion.Scope) error {
+	return autoConvert_v1_TCPSocketHook_To_api_TCPSocketHook(in, out, s)
+}
+
 func autoConvert_v1_TagImageHook_To_api_TagImageHook(in *deployapiv1.TagImageHook, out *deployapi.TagImageHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.TagImageHook))(in)
@@ -10023,6 +12095,9 @@ func init() {
 	err := api.Scheme.AddGeneratedConversionFuncs(
 		autoConvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource,
 		autoConvert_api_ApplicationList_To_v1_ApplicationList,
+		autoConvert_api_ApplicationRevision_To_v1_ApplicationRevision,
+		autoConvert_api_ApplicationRollback_To_v1_ApplicationRollback,
+		autoConvert_api_ApplicationSchedule_To_v1_ApplicationSchedule,
 		autoConvert_api_ApplicationSpec_To_v1_ApplicationSpec,
 		autoConvert_api_ApplicationStatus_To_v1_ApplicationStatus,
 		autoConvert_api_Application_To_v1_Application,
@@ -10038,6 +12113,8 @@ func init() {
 		autoConvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions,
 		autoConvert_api_BinaryBuildSource_To_v1_BinaryBuildSource,
 		autoConvert_api_BindingRequestOptions_To_v1_BindingRequestOptions,
+		autoConvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams,
+		autoConvert_api_BuildCacheVolume_To_v1_BuildCacheVolume,
 		autoConvert_api_BuildConfigList_To_v1_BuildConfigList,
 		autoConvert_api_BuildConfigSpec_To_v1_BuildConfigSpec,
 		autoConvert_api_BuildConfigStatus_To_v1_BuildConfigStatus,
@@ -10045,7 +12122,11 @@ func init() {
 		autoConvert_api_BuildList_To_v1_BuildList,
 		autoConvert_api_BuildLogOptions_To_v1_BuildLogOptions,
 		autoConvert_api_BuildLog_To_v1_BuildLog,
+		autoConvert_api_BuildMatrixParameter_To_v1_BuildMatrixParameter,
+		autoConvert_api_BuildNotification_To_v1_BuildNotification,
 		autoConvert_api_BuildOutput_To_v1_BuildOutput,
+		autoConvert_api_BuildParameterValue_To_v1_BuildParameterValue,
+		autoConvert_api_BuildParameter_To_v1_BuildParameter,
 		autoConvert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec,
 		autoConvert_api_BuildRequest_To_v1_BuildRequest,
 		autoConvert_api_BuildSource_To_v1_BuildSource,
@@ -10054,6 +12135,7 @@ func init() {
 		autoConvert_api_BuildStrategy_To_v1_BuildStrategy,
 		autoConvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy,
 		autoConvert_api_Build_To_v1_Build,
+		autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams,
 		autoConvert_api_Capabilities_To_v1_Capabilities,
 		autoConvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
 		autoConvert_api_CinderVolumeSource_To_v1_CinderVolumeSource,
@@ -10067,14 +12149,17 @@ func init() {
 		autoConvert_api_ClusterRoleBinding_To_v1_ClusterRoleBinding,
 		autoConvert_api_ClusterRoleList_To_v1_ClusterRoleList,
 		autoConvert_api_ClusterRole_To_v1_ClusterRole,
+		autoConvert_api_CommitStatusReporting_To_v1_CommitStatusReporting,
 		autoConvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector,
 		autoConvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource,
+		autoConvert_api_ContainerImage_To_v1_ContainerImage,
 		autoConvert_api_ContainerPort_To_v1_ContainerPort,
 		autoConvert_api_Container_To_v1_Container,
 		autoConvert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy,
 		autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams,
 		autoConvert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger,
 		autoConvert_api_DeploymentCause_To_v1_DeploymentCause,
+		autoConvert_api_DeploymentConfigHistory_To_v1_DeploymentConfigHistory,
 		autoConvert_api_DeploymentConfigList_To_v1_DeploymentConfigList,
 		autoConvert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollbackSpec,
 		autoConvert_api_DeploymentConfigRollback_To_v1_DeploymentConfigRollback,
@@ -10082,14 +12167,19 @@ func init() {
 		autoConvert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus,
 		autoConvert_api_DeploymentConfig_To_v1_DeploymentConfig,
 		autoConvert_api_DeploymentDetails_To_v1_DeploymentDetails,
+		autoConvert_api_DeploymentHookResult_To_v1_DeploymentHookResult,
 		autoConvert_api_DeploymentLogOptions_To_v1_DeploymentLogOptions,
 		autoConvert_api_DeploymentLog_To_v1_DeploymentLog,
+		autoConvert_api_DeploymentRecord_To_v1_DeploymentRecord,
 		autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy,
 		autoConvert_api_DeploymentTriggerImageChangeParams_To_v1_DeploymentTriggerImageChangeParams,
 		autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy,
+		autoConvert_api_DeploymentVerification_To_v1_DeploymentVerification,
+		autoConvert_api_DeploymentWindow_To_v1_DeploymentWindow,
 		autoConvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy,
 		autoConvert_api_DownwardAPIVolumeFile_To_v1_DownwardAPIVolumeFile,
 		autoConvert_api_DownwardAPIVolumeSource_To_v1_DownwardAPIVolumeSource,
+		autoConvert_api_EmailNotification_To_v1_EmailNotification,
 		autoConvert_api_EmptyDirVolumeSource_To_v1_EmptyDirVolumeSource,
 		autoConvert_api_EnvVarSource_To_v1_EnvVarSource,
 		autoConvert_api_EnvVar_To_v1_EnvVar,
@@ -10100,12 +12190,15 @@ func init() {
 		autoConvert_api_FlockerVolumeSource_To_v1_FlockerVolumeSource,
 		autoConvert_api_GCEPersistentDiskVolumeSource_To_v1_GCEPersistentDiskVolumeSource,
 		autoConvert_api_GitBuildSource_To_v1_GitBuildSource,
+		autoConvert_api_GitPullRequest_To_v1_GitPullRequest,
 		autoConvert_api_GitRepoVolumeSource_To_v1_GitRepoVolumeSource,
 		autoConvert_api_GitSourceRevision_To_v1_GitSourceRevision,
 		autoConvert_api_GlusterfsVolumeSource_To_v1_GlusterfsVolumeSource,
 		autoConvert_api_GroupList_To_v1_GroupList,
 		autoConvert_api_Group_To_v1_Group,
 		autoConvert_api_HTTPGetAction_To_v1_HTTPGetAction,
+		autoConvert_api_HTTPGetHook_To_v1_HTTPGetHook,
+		autoConvert_api_HTTPGetMetric_To_v1_HTTPGetMetric,
 		autoConvert_api_HTTPHeader_To_v1_HTTPHeader,
 		autoConvert_api_Handler_To_v1_Handler,
 		autoConvert_api_HostPathVolumeSource_To_v1_HostPathVolumeSource,
@@ -10159,6 +12252,12 @@ func init() {
 		autoConvert_api_ObjectReference_To_v1_ObjectReference,
 		autoConvert_api_Parameter_To_v1_Parameter,
 		autoConvert_api_PersistentVolumeClaimVolumeSource_To_v1_PersistentVolumeClaimVolumeSource,
+		autoConvert_api_PipelineBuildStage_To_v1_PipelineBuildStage,
+		autoConvert_api_PipelineBuildStrategy_To_v1_PipelineBuildStrategy,
+		autoConvert_api_PipelinePromoteStage_To_v1_PipelinePromoteStage,
+		autoConvert_api_PipelineStageStatus_To_v1_PipelineStageStatus,
+		autoConvert_api_PipelineStage_To_v1_PipelineStage,
+		autoConvert_api_PipelineTestStage_To_v1_PipelineTestStage,
 		autoConvert_api_PodSpec_To_v1_PodSpec,
 		autoConvert_api_PodTemplateSpec_To_v1_PodTemplateSpec,
 		autoConvert_api_PolicyBindingList_To_v1_PolicyBindingList,
@@ -10172,6 +12271,7 @@ func init() {
 		autoConvert_api_ProjectSpec_To_v1_ProjectSpec,
 		autoConvert_api_ProjectStatus_To_v1_ProjectStatus,
 		autoConvert_api_Project_To_v1_Project,
+		autoConvert_api_PrometheusQuery_To_v1_PrometheusQuery,
 		autoConvert_api_RBDVolumeSource_To_v1_RBDVolumeSource,
 		autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams,
 		autoConvert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec,
@@ -10204,12 +12304,14 @@ func init() {
 		autoConvert_api_ServicePlanCost_To_v1_ServicePlanCost,
 		autoConvert_api_ServicePlanMetadata_To_v1_ServicePlanMetadata,
 		autoConvert_api_ServicePlan_To_v1_ServicePlan,
+		autoConvert_api_SlackNotification_To_v1_SlackNotification,
 		autoConvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy,
 		autoConvert_api_SourceControlUser_To_v1_SourceControlUser,
 		autoConvert_api_SourceRevision_To_v1_SourceRevision,
 		autoConvert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse,
 		autoConvert_api_SubjectAccessReview_To_v1_SubjectAccessReview,
 		autoConvert_api_TCPSocketAction_To_v1_TCPSocketAction,
+		autoConvert_api_TCPSocketHook_To_v1_TCPSocketHook,
 		autoConvert_api_TLSConfig_To_v1_TLSConfig,
 		autoConvert_api_TagEventCondition_To_v1_TagEventCondition,
 		autoConvert_api_TagImageHook_To_v1_TagImageHook,
@@ -10225,8 +12327,12 @@ func init() {
 		autoConvert_api_VolumeSource_To_v1_VolumeSource,
 		autoConvert_api_Volume_To_v1_Volume,
 		autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger,
+		autoConvert_api_WebhookNotification_To_v1_WebhookNotification,
 		autoConvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoConvert_v1_ApplicationList_To_api_ApplicationList,
+		autoConvert_v1_ApplicationRevision_To_api_ApplicationRevision,
+		autoConvert_v1_ApplicationRollback_To_api_ApplicationRollback,
+		autoConvert_v1_ApplicationSchedule_To_api_ApplicationSchedule,
 		autoConvert_v1_ApplicationSpec_To_api_ApplicationSpec,
 		autoConvert_v1_ApplicationStatus_To_api_ApplicationStatus,
 		autoConvert_v1_Application_To_api_Application,
@@ -10242,6 +12348,8 @@ func init() {
 		autoConvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoConvert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
 		autoConvert_v1_BindingRequestOptions_To_api_BindingRequestOptions,
+		autoConvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams,
+		autoConvert_v1_BuildCacheVolume_To_api_BuildCacheVolume,
 		autoConvert_v1_BuildConfigList_To_api_BuildConfigList,
 		autoConvert_v1_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoConvert_v1_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -10249,7 +12357,11 @@ func init() {
 		autoConvert_v1_BuildList_To_api_BuildList,
 		autoConvert_v1_BuildLogOptions_To_api_BuildLogOptions,
 		autoConvert_v1_BuildLog_To_api_BuildLog,
+		autoConvert_v1_BuildMatrixParameter_To_api_BuildMatrixParameter,
+		autoConvert_v1_BuildNotification_To_api_BuildNotification,
 		autoConvert_v1_BuildOutput_To_api_BuildOutput,
+		autoConvert_v1_BuildParameterValue_To_api_BuildParameterValue,
+		autoConvert_v1_BuildParameter_To_api_BuildParameter,
 		autoConvert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec,
 		autoConvert_v1_BuildRequest_To_api_BuildRequest,
 		autoConvert_v1_BuildSource_To_api_BuildSource,
@@ -10258,6 +12370,7 @@ func init() {
 		autoConvert_v1_BuildStrategy_To_api_BuildStrategy,
 		autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
 		autoConvert_v1_Build_To_api_Build,
+		autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams,
 		autoConvert_v1_Capabilities_To_api_Capabilities,
 		autoConvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoConvert_v1_CinderVolumeSource_To_api_CinderVolumeSource,
@@ -10271,14 +12384,17 @@ func init() {
 		autoConvert_v1_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoConvert_v1_ClusterRoleList_To_api_ClusterRoleList,
 		autoConvert_v1_ClusterRole_To_api_ClusterRole,
+		autoConvert_v1_CommitStatusReporting_To_api_CommitStatusReporting,
 		autoConvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector,
 		autoConvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource,
+		autoConvert_v1_ContainerImage_To_api_ContainerImage,
 		autoConvert_v1_ContainerPort_To_api_ContainerPort,
 		autoConvert_v1_Container_To_api_Container,
 		autoConvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		autoConvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams,
 		autoConvert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
 		autoConvert_v1_DeploymentCause_To_api_DeploymentCause,
+		autoConvert_v1_DeploymentConfigHistory_To_api_DeploymentConfigHistory,
 		autoConvert_v1_DeploymentConfigList_To_api_DeploymentConfigList,
 		autoConvert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoConvert_v1_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
@@ -10286,14 +12402,19 @@ func init() {
 		autoConvert_v1_DeploymentConfigStatus_To_api_DeploymentConfigStatus,
 		autoConvert_v1_DeploymentConfig_To_api_DeploymentConfig,
 		autoConvert_v1_DeploymentDetails_To_api_DeploymentDetails,
+		autoConvert_v1_DeploymentHookResult_To_api_DeploymentHookResult,
 		autoConvert_v1_DeploymentLogOptions_To_api_DeploymentLogOptions,
 		autoConvert_v1_DeploymentLog_To_api_DeploymentLog,
+		autoConvert_v1_DeploymentRecord_To_api_DeploymentRecord,
 		autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy,
 		autoConvert_v1_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
 		autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
+		autoConvert_v1_DeploymentVerification_To_api_DeploymentVerification,
+		autoConvert_v1_DeploymentWindow_To_api_DeploymentWindow,
 		autoConvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy,
 		autoConvert_v1_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
 		autoConvert_v1_DownwardAPIVolumeSource_To_api_DownwardAPIVolumeSource,
+		autoConvert_v1_EmailNotification_To_api_EmailNotification,
 		autoConvert_v1_EmptyDirVolumeSource_To_api_EmptyDirVolumeSource,
 		autoConvert_v1_EnvVarSource_To_api_EnvVarSource,
 		autoConvert_v1_EnvVar_To_api_EnvVar,
@@ -10304,12 +12425,15 @@ func init() {
 		autoConvert_v1_FlockerVolumeSource_To_api_FlockerVolumeSource,
 		autoConvert_v1_GCEPersistentDiskVolumeSource_To_api_GCEPersistentDiskVolumeSource,
 		autoConvert_v1_GitBuildSource_To_api_GitBuildSource,
+		autoConvert_v1_GitPullRequest_To_api_GitPullRequest,
 		autoConvert_v1_GitRepoVolumeSource_To_api_GitRepoVolumeSource,
 		autoConvert_v1_GitSourceRevision_To_api_GitSourceRevision,
 		autoConvert_v1_GlusterfsVolumeSource_To_api_GlusterfsVolumeSource,
 		autoConvert_v1_GroupList_To_api_GroupList,
 		autoConvert_v1_Group_To_api_Group,
 		autoConvert_v1_HTTPGetAction_To_api_HTTPGetAction,
+		autoConvert_v1_HTTPGetHook_To_api_HTTPGetHook,
+		autoConvert_v1_HTTPGetMetric_To_api_HTTPGetMetric,
 		autoConvert_v1_HTTPHeader_To_api_HTTPHeader,
 		autoConvert_v1_Handler_To_api_Handler,
 		autoConvert_v1_HostPathVolumeSource_To_api_HostPathVolumeSource,
@@ -10363,6 +12487,12 @@ func init() {
 		autoConvert_v1_ObjectReference_To_api_ObjectReference,
 		autoConvert_v1_Parameter_To_api_Parameter,
 		autoConvert_v1_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
+		autoConvert_v1_PipelineBuildStage_To_api_PipelineBuildStage,
+		autoConvert_v1_PipelineBuildStrategy_To_api_PipelineBuildStrategy,
+		autoConvert_v1_PipelinePromoteStage_To_api_PipelinePromoteStage,
+		autoConvert_v1_PipelineStageStatus_To_api_PipelineStageStatus,
+		autoConvert_v1_PipelineStage_To_api_PipelineStage,
+		autoConvert_v1_PipelineTestStage_To_api_PipelineTestStage,
 		autoConvert_v1_PodSpec_To_api_PodSpec,
 		autoConvert_v1_PodTemplateSpec_To_api_PodTemplateSpec,
 		autoConvert_v1_PolicyBindingList_To_api_PolicyBindingList,
@@ -10376,6 +12506,7 @@ func init() {
 		autoConvert_v1_ProjectSpec_To_api_ProjectSpec,
 		autoConvert_v1_ProjectStatus_To_api_ProjectStatus,
 		autoConvert_v1_Project_To_api_Project,
+		autoConvert_v1_PrometheusQuery_To_api_PrometheusQuery,
 		autoConvert_v1_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoConvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams,
 		autoConvert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec,
@@ -10408,12 +12539,14 @@ func init() {
 		autoConvert_v1_ServicePlanCost_To_api_ServicePlanCost,
 		autoConvert_v1_ServicePlanMetadata_To_api_ServicePlanMetadata,
 		autoConvert_v1_ServicePlan_To_api_ServicePlan,
+		autoConvert_v1_SlackNotification_To_api_SlackNotification,
 		autoConvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoConvert_v1_SourceControlUser_To_api_SourceControlUser,
 		autoConvert_v1_SourceRevision_To_api_SourceRevision,
 		autoConvert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoConvert_v1_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoConvert_v1_TCPSocketAction_To_api_TCPSocketAction,
+		autoConvert_v1_TCPSocketHook_To_api_TCPSocketHook,
 		autoConvert_v1_TLSConfig_To_api_TLSConfig,
 		autoConvert_v1_TagEventCondition_To_api_TagEventCondition,
 		autoConvert_v1_TagImageHook_To_api_TagImageHook,
@@ -10429,6 +12562,7 @@ func init() {
 		autoConvert_v1_VolumeSource_To_api_VolumeSource,
 		autoConvert_v1_Volume_To_api_Volume,
 		autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger,
+		autoConvert_v1_WebhookNotification_To_api_WebhookNotification,
 	)
 	if err != nil {
 		// If one of the conversion functions is malformed, detect it immediately.
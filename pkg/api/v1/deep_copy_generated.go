@@ -68,6 +68,62 @@ func deepCopy_v1_ApplicationList(in v1.ApplicationList, out *v1.ApplicationList,
 	return nil
 }
 
+func deepCopy_v1_ApplicationRevision(in v1.ApplicationRevision, out *v1.ApplicationRevision, c *conversion.Cloner) error {
+	out.Revision = in.Revision
+	if newVal, err := c.DeepCopy(in.CreationTimestamp); err != nil {
+		return err
+	} else {
+		out.CreationTimestamp = newVal.(unversioned.Time)
+	}
+	if in.Items != nil {
+		out.Items = make([]v1.Item, len(in.Items))
+		for i := range in.Items {
+			if err := deepCopy_v1_Item(in.Items[i], &out.Items[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	if in.Snapshots != nil {
+		out.Snapshots = make(map[string]string)
+		for key, val := range in.Snapshots {
+			out.Snapshots[key] = val
+		}
+	} else {
+		out.Snapshots = nil
+	}
+	if in.Replicas != nil {
+		out.Replicas = make(map[string]int)
+		for key, val := range in.Replicas {
+			out.Replicas[key] = val
+		}
+	} else {
+		out.Replicas = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ApplicationRollback(in v1.ApplicationRollback, out *v1.ApplicationRollback, c *conversion.Cloner) error {
+	out.Revision = in.Revision
+	return nil
+}
+
+func deepCopy_v1_ApplicationSchedule(in v1.ApplicationSchedule, out *v1.ApplicationSchedule, c *conversion.Cloner) error {
+	out.StartTime = in.StartTime
+	out.StopTime = in.StopTime
+	if in.Weekdays != nil {
+		out.Weekdays = make([]string, len(in.Weekdays))
+		for i := range in.Weekdays {
+			out.Weekdays[i] = in.Weekdays[i]
+		}
+	} else {
+		out.Weekdays = nil
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
 func deepCopy_v1_ApplicationSpec(in v1.ApplicationSpec, out *v1.ApplicationSpec, c *conversion.Cloner) error {
 	out.Name = in.Name
 	if in.Items != nil {
@@ -81,6 +137,24 @@ func deepCopy_v1_ApplicationSpec(in v1.ApplicationSpec, out *v1.ApplicationSpec,
 		out.Items = nil
 	}
 	out.Destory = in.Destory
+	out.ForceDestory = in.ForceDestory
+	out.Stopped = in.Stopped
+	if in.Schedule != nil {
+		out.Schedule = new(v1.ApplicationSchedule)
+		if err := deepCopy_v1_ApplicationSchedule(*in.Schedule, out.Schedule, c); err != nil {
+			return err
+		}
+	} else {
+		out.Schedule = nil
+	}
+	if in.RollbackTo != nil {
+		out.RollbackTo = new(v1.ApplicationRollback)
+		if err := deepCopy_v1_ApplicationRollback(*in.RollbackTo, out.RollbackTo, c); err != nil {
+			return err
+		}
+	} else {
+		out.RollbackTo = nil
+	}
 	if in.Finalizers != nil {
 		out.Finalizers = make([]pkgapiv1.FinalizerName, len(in.Finalizers))
 		for i := range in.Finalizers {
@@ -94,6 +168,41 @@ func deepCopy_v1_ApplicationSpec(in v1.ApplicationSpec, out *v1.ApplicationSpec,
 
 func deepCopy_v1_ApplicationStatus(in v1.ApplicationStatus, out *v1.ApplicationStatus, c *conversion.Cloner) error {
 	out.Phase = in.Phase
+	if in.StoppedReplicas != nil {
+		out.StoppedReplicas = make(map[string]int)
+		for key, val := range in.StoppedReplicas {
+			out.StoppedReplicas[key] = val
+		}
+	} else {
+		out.StoppedReplicas = nil
+	}
+	out.ScheduleState = in.ScheduleState
+	if in.Revisions != nil {
+		out.Revisions = make([]v1.ApplicationRevision, len(in.Revisions))
+		for i := range in.Revisions {
+			if err := deepCopy_v1_ApplicationRevision(in.Revisions[i], &out.Revisions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Revisions = nil
+	}
+	if in.HeldReplicas != nil {
+		out.HeldReplicas = make(map[string]int)
+		for key, val := range in.HeldReplicas {
+			out.HeldReplicas[key] = val
+		}
+	} else {
+		out.HeldReplicas = nil
+	}
+	if in.ReleasedItems != nil {
+		out.ReleasedItems = make([]string, len(in.ReleasedItems))
+		for i := range in.ReleasedItems {
+			out.ReleasedItems[i] = in.ReleasedItems[i]
+		}
+	} else {
+		out.ReleasedItems = nil
+	}
 	return nil
 }
 
@@ -101,6 +210,14 @@ func deepCopy_v1_Item(in v1.Item, out *v1.Item, c *conversion.Cloner) error {
 	out.Kind = in.Kind
 	out.Name = in.Name
 	out.Status = in.Status
+	if in.DependsOn != nil {
+		out.DependsOn = make([]string, len(in.DependsOn))
+		for i := range in.DependsOn {
+			out.DependsOn[i] = in.DependsOn[i]
+		}
+	} else {
+		out.DependsOn = nil
+	}
 	return nil
 }
 
@@ -1130,6 +1247,13 @@ func deepCopy_v1_Build(in buildapiv1.Build, out *buildapiv1.Build, c *conversion
 	return nil
 }
 
+func deepCopy_v1_BuildCacheVolume(in buildapiv1.BuildCacheVolume, out *buildapiv1.BuildCacheVolume, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.ClaimName = in.ClaimName
+	out.Path = in.Path
+	return nil
+}
+
 func deepCopy_v1_BuildConfig(in buildapiv1.BuildConfig, out *buildapiv1.BuildConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1185,6 +1309,27 @@ func deepCopy_v1_BuildConfigSpec(in buildapiv1.BuildConfigSpec, out *buildapiv1.
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapiv1.BuildParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := deepCopy_v1_BuildParameter(in.Parameters[i], &out.Parameters[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.Matrix != nil {
+		out.Matrix = make([]buildapiv1.BuildMatrixParameter, len(in.Matrix))
+		for i := range in.Matrix {
+			if err := deepCopy_v1_BuildMatrixParameter(in.Matrix[i], &out.Matrix[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Matrix = nil
+	}
 	if err := deepCopy_v1_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -1276,6 +1421,55 @@ func deepCopy_v1_BuildLogOptions(in buildapiv1.BuildLogOptions, out *buildapiv1.
 	return nil
 }
 
+func deepCopy_v1_BuildMatrixParameter(in buildapiv1.BuildMatrixParameter, out *buildapiv1.BuildMatrixParameter, c *conversion.Cloner) error {
+	out.Name = in.Name
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BuildNotification(in buildapiv1.BuildNotification, out *buildapiv1.BuildNotification, c *conversion.Cloner) error {
+	if in.Phases != nil {
+		out.Phases = make([]buildapiv1.BuildPhase, len(in.Phases))
+		for i := range in.Phases {
+			out.Phases[i] = in.Phases[i]
+		}
+	} else {
+		out.Phases = nil
+	}
+	if in.Webhook != nil {
+		out.Webhook = new(buildapiv1.WebhookNotification)
+		if err := deepCopy_v1_WebhookNotification(*in.Webhook, out.Webhook, c); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	if in.Slack != nil {
+		out.Slack = new(buildapiv1.SlackNotification)
+		if err := deepCopy_v1_SlackNotification(*in.Slack, out.Slack, c); err != nil {
+			return err
+		}
+	} else {
+		out.Slack = nil
+	}
+	if in.Email != nil {
+		out.Email = new(buildapiv1.EmailNotification)
+		if err := deepCopy_v1_EmailNotification(*in.Email, out.Email, c); err != nil {
+			return err
+		}
+	} else {
+		out.Email = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_BuildOutput(in buildapiv1.BuildOutput, out *buildapiv1.BuildOutput, c *conversion.Cloner) error {
 	if in.To != nil {
 		if newVal, err := c.DeepCopy(in.To); err != nil {
@@ -1298,6 +1492,21 @@ func deepCopy_v1_BuildOutput(in buildapiv1.BuildOutput, out *buildapiv1.BuildOut
 	return nil
 }
 
+func deepCopy_v1_BuildParameter(in buildapiv1.BuildParameter, out *buildapiv1.BuildParameter, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Type = in.Type
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+func deepCopy_v1_BuildParameterValue(in buildapiv1.BuildParameterValue, out *buildapiv1.BuildParameterValue, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
 func deepCopy_v1_BuildPostCommitSpec(in buildapiv1.BuildPostCommitSpec, out *buildapiv1.BuildPostCommitSpec, c *conversion.Cloner) error {
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
@@ -1382,6 +1591,25 @@ func deepCopy_v1_BuildRequest(in buildapiv1.BuildRequest, out *buildapiv1.BuildR
 	} else {
 		out.Env = nil
 	}
+	if in.PullRequest != nil {
+		out.PullRequest = new(buildapiv1.GitPullRequest)
+		if err := deepCopy_v1_GitPullRequest(*in.PullRequest, out.PullRequest, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
+	out.NoCache = in.NoCache
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapiv1.BuildParameterValue, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := deepCopy_v1_BuildParameterValue(in.Parameters[i], &out.Parameters[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
 	return nil
 }
 
@@ -1475,6 +1703,24 @@ func deepCopy_v1_BuildSpec(in buildapiv1.BuildSpec, out *buildapiv1.BuildSpec, c
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Notifications != nil {
+		out.Notifications = make([]buildapiv1.BuildNotification, len(in.Notifications))
+		for i := range in.Notifications {
+			if err := deepCopy_v1_BuildNotification(in.Notifications[i], &out.Notifications[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Notifications = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapiv1.CommitStatusReporting)
+		if err := deepCopy_v1_CommitStatusReporting(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1512,6 +1758,16 @@ func deepCopy_v1_BuildStatus(in buildapiv1.BuildStatus, out *buildapiv1.BuildSta
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapiv1.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1_PipelineStageStatus(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
 	return nil
 }
 
@@ -1541,6 +1797,14 @@ func deepCopy_v1_BuildStrategy(in buildapiv1.BuildStrategy, out *buildapiv1.Buil
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapiv1.PipelineBuildStrategy)
+		if err := deepCopy_v1_PipelineBuildStrategy(*in.PipelineStrategy, out.PipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1562,6 +1826,30 @@ func deepCopy_v1_BuildTriggerPolicy(in buildapiv1.BuildTriggerPolicy, out *build
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.GogsWebHook != nil {
+		out.GogsWebHook = new(buildapiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.GogsWebHook, out.GogsWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GogsWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapiv1.ImageChangeTrigger)
 		if err := deepCopy_v1_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1573,6 +1861,18 @@ func deepCopy_v1_BuildTriggerPolicy(in buildapiv1.BuildTriggerPolicy, out *build
 	return nil
 }
 
+func deepCopy_v1_CommitStatusReporting(in buildapiv1.CommitStatusReporting, out *buildapiv1.CommitStatusReporting, c *conversion.Cloner) error {
+	out.Provider = in.Provider
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.TokenSecret); err != nil {
+		return err
+	} else {
+		out.TokenSecret = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	out.Context = in.Context
+	return nil
+}
+
 func deepCopy_v1_CustomBuildStrategy(in buildapiv1.CustomBuildStrategy, out *buildapiv1.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1650,6 +1950,28 @@ func deepCopy_v1_DockerBuildStrategy(in buildapiv1.DockerBuildStrategy, out *bui
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapiv1.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := deepCopy_v1_BuildCacheVolume(in.CacheVolumes[i], &out.CacheVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_EmailNotification(in buildapiv1.EmailNotification, out *buildapiv1.EmailNotification, c *conversion.Cloner) error {
+	if in.To != nil {
+		out.To = make([]string, len(in.To))
+		for i := range in.To {
+			out.To[i] = in.To[i]
+		}
+	} else {
+		out.To = nil
+	}
 	return nil
 }
 
@@ -1671,6 +1993,12 @@ func deepCopy_v1_GitBuildSource(in buildapiv1.GitBuildSource, out *buildapiv1.Gi
 	return nil
 }
 
+func deepCopy_v1_GitPullRequest(in buildapiv1.GitPullRequest, out *buildapiv1.GitPullRequest, c *conversion.Cloner) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
 func deepCopy_v1_GitSourceRevision(in buildapiv1.GitSourceRevision, out *buildapiv1.GitSourceRevision, c *conversion.Cloner) error {
 	out.Commit = in.Commit
 	if err := deepCopy_v1_SourceControlUser(in.Author, &out.Author, c); err != nil {
@@ -1731,6 +2059,112 @@ func deepCopy_v1_ImageSourcePath(in buildapiv1.ImageSourcePath, out *buildapiv1.
 	return nil
 }
 
+func deepCopy_v1_PipelineBuildStage(in buildapiv1.PipelineBuildStage, out *buildapiv1.PipelineBuildStage, c *conversion.Cloner) error {
+	out.BuildConfig = in.BuildConfig
+	return nil
+}
+
+func deepCopy_v1_PipelineBuildStrategy(in buildapiv1.PipelineBuildStrategy, out *buildapiv1.PipelineBuildStrategy, c *conversion.Cloner) error {
+	if in.Stages != nil {
+		out.Stages = make([]buildapiv1.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1_PipelineStage(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PipelinePromoteStage(in buildapiv1.PipelinePromoteStage, out *buildapiv1.PipelinePromoteStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1_PipelineStage(in buildapiv1.PipelineStage, out *buildapiv1.PipelineStage, c *conversion.Cloner) error {
+	out.Name = in.Name
+	if in.Build != nil {
+		out.Build = new(buildapiv1.PipelineBuildStage)
+		if err := deepCopy_v1_PipelineBuildStage(*in.Build, out.Build, c); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Test != nil {
+		out.Test = new(buildapiv1.PipelineTestStage)
+		if err := deepCopy_v1_PipelineTestStage(*in.Test, out.Test, c); err != nil {
+			return err
+		}
+	} else {
+		out.Test = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(buildapiv1.PipelinePromoteStage)
+		if err := deepCopy_v1_PipelinePromoteStage(*in.Promote, out.Promote, c); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PipelineStageStatus(in buildapiv1.PipelineStageStatus, out *buildapiv1.PipelineStageStatus, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Build = in.Build
+	out.Pod = in.Pod
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PipelineTestStage(in buildapiv1.PipelineTestStage, out *buildapiv1.PipelineTestStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Image); err != nil {
+		return err
+	} else {
+		out.Image = newVal.(pkgapiv1.ObjectReference)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_SecretBuildSource(in buildapiv1.SecretBuildSource, out *buildapiv1.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1751,6 +2185,12 @@ func deepCopy_v1_SecretSpec(in buildapiv1.SecretSpec, out *buildapiv1.SecretSpec
 	return nil
 }
 
+func deepCopy_v1_SlackNotification(in buildapiv1.SlackNotification, out *buildapiv1.SlackNotification, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Channel = in.Channel
+	return nil
+}
+
 func deepCopy_v1_SourceBuildStrategy(in buildapiv1.SourceBuildStrategy, out *buildapiv1.SourceBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1781,6 +2221,16 @@ func deepCopy_v1_SourceBuildStrategy(in buildapiv1.SourceBuildStrategy, out *bui
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapiv1.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := deepCopy_v1_BuildCacheVolume(in.CacheVolumes[i], &out.CacheVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
 	return nil
 }
 
@@ -1805,6 +2255,124 @@ func deepCopy_v1_SourceRevision(in buildapiv1.SourceRevision, out *buildapiv1.So
 
 func deepCopy_v1_WebHookTrigger(in buildapiv1.WebHookTrigger, out *buildapiv1.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	if in.SignatureSecret != nil {
+		if newVal, err := c.DeepCopy(in.SignatureSecret); err != nil {
+			return err
+		} else {
+			out.SignatureSecret = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.SignatureSecret = nil
+	}
+	out.AllowPullRequests = in.AllowPullRequests
+	if in.IncludePaths != nil {
+		out.IncludePaths = make([]string, len(in.IncludePaths))
+		for i := range in.IncludePaths {
+			out.IncludePaths[i] = in.IncludePaths[i]
+		}
+	} else {
+		out.IncludePaths = nil
+	}
+	if in.ExcludePaths != nil {
+		out.ExcludePaths = make([]string, len(in.ExcludePaths))
+		for i := range in.ExcludePaths {
+			out.ExcludePaths[i] = in.ExcludePaths[i]
+		}
+	} else {
+		out.ExcludePaths = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_WebhookNotification(in buildapiv1.WebhookNotification, out *buildapiv1.WebhookNotification, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_v1_BlueGreenDeploymentStrategyParams(in deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.ServiceName = in.ServiceName
+	if in.HoldSeconds != nil {
+		out.HoldSeconds = new(int64)
+		*out.HoldSeconds = *in.HoldSeconds
+	} else {
+		out.HoldSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryDeploymentStrategyParams(in deployapiv1.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Steps != nil {
+		out.Steps = make([]int, len(in.Steps))
+		for i := range in.Steps {
+			out.Steps[i] = in.Steps[i]
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.BakeSeconds != nil {
+		out.BakeSeconds = new(int64)
+		*out.BakeSeconds = *in.BakeSeconds
+	} else {
+		out.BakeSeconds = nil
+	}
+	if in.Check != nil {
+		out.Check = new(deployapiv1.ExecNewPodHook)
+		if err := deepCopy_v1_ExecNewPodHook(*in.Check, out.Check, c); err != nil {
+			return err
+		}
+	} else {
+		out.Check = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ContainerImage(in deployapiv1.ContainerImage, out *deployapiv1.ContainerImage, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	return nil
 }
 
@@ -1843,6 +2411,7 @@ func deepCopy_v1_DeploymentCause(in deployapiv1.DeploymentCause, out *deployapiv
 	} else {
 		out.ImageTrigger = nil
 	}
+	out.Message = in.Message
 	return nil
 }
 
@@ -1875,6 +2444,30 @@ func deepCopy_v1_DeploymentConfig(in deployapiv1.DeploymentConfig, out *deployap
 	return nil
 }
 
+func deepCopy_v1_DeploymentConfigHistory(in deployapiv1.DeploymentConfigHistory, out *deployapiv1.DeploymentConfigHistory, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if in.Deployments != nil {
+		out.Deployments = make([]deployapiv1.DeploymentRecord, len(in.Deployments))
+		for i := range in.Deployments {
+			if err := deepCopy_v1_DeploymentRecord(in.Deployments[i], &out.Deployments[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Deployments = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_DeploymentConfigList(in deployapiv1.DeploymentConfigList, out *deployapiv1.DeploymentConfigList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1940,6 +2533,18 @@ func deepCopy_v1_DeploymentConfigSpec(in deployapiv1.DeploymentConfigSpec, out *
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.Windows != nil {
+		out.Windows = make([]deployapiv1.DeploymentWindow, len(in.Windows))
+		for i := range in.Windows {
+			if err := deepCopy_v1_DeploymentWindow(in.Windows[i], &out.Windows[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Windows = nil
+	}
+	out.ImageChangeDebounceSeconds = in.ImageChangeDebounceSeconds
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1970,6 +2575,16 @@ func deepCopy_v1_DeploymentConfigStatus(in deployapiv1.DeploymentConfigStatus, o
 	} else {
 		out.Details = nil
 	}
+	if in.LastTriggeredImages != nil {
+		out.LastTriggeredImages = make([]deployapiv1.ContainerImage, len(in.LastTriggeredImages))
+		for i := range in.LastTriggeredImages {
+			if err := deepCopy_v1_ContainerImage(in.LastTriggeredImages[i], &out.LastTriggeredImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LastTriggeredImages = nil
+	}
 	return nil
 }
 
@@ -1992,6 +2607,13 @@ func deepCopy_v1_DeploymentDetails(in deployapiv1.DeploymentDetails, out *deploy
 	return nil
 }
 
+func deepCopy_v1_DeploymentHookResult(in deployapiv1.DeploymentHookResult, out *deployapiv1.DeploymentHookResult, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1_DeploymentLog(in deployapiv1.DeploymentLog, out *deployapiv1.DeploymentLog, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2048,6 +2670,64 @@ func deepCopy_v1_DeploymentLogOptions(in deployapiv1.DeploymentLogOptions, out *
 	return nil
 }
 
+func deepCopy_v1_DeploymentRecord(in deployapiv1.DeploymentRecord, out *deployapiv1.DeploymentRecord, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Phase = in.Phase
+	if in.Details != nil {
+		out.Details = new(deployapiv1.DeploymentDetails)
+		if err := deepCopy_v1_DeploymentDetails(*in.Details, out.Details, c); err != nil {
+			return err
+		}
+	} else {
+		out.Details = nil
+	}
+	out.Strategy = in.Strategy
+	if in.StartTime != nil {
+		if newVal, err := c.DeepCopy(in.StartTime); err != nil {
+			return err
+		} else {
+			out.StartTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTime = nil
+	}
+	if in.CompletionTime != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTime); err != nil {
+			return err
+		} else {
+			out.CompletionTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTime = nil
+	}
+	if in.DurationSeconds != nil {
+		out.DurationSeconds = new(int64)
+		*out.DurationSeconds = *in.DurationSeconds
+	} else {
+		out.DurationSeconds = nil
+	}
+	if in.PreviousReplicas != nil {
+		out.PreviousReplicas = new(int)
+		*out.PreviousReplicas = *in.PreviousReplicas
+	} else {
+		out.PreviousReplicas = nil
+	}
+	out.Replicas = in.Replicas
+	if in.Hooks != nil {
+		out.Hooks = make([]deployapiv1.DeploymentHookResult, len(in.Hooks))
+		for i := range in.Hooks {
+			if err := deepCopy_v1_DeploymentHookResult(in.Hooks[i], &out.Hooks[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Hooks = nil
+	}
+	out.Reason = in.Reason
+	return nil
+}
+
 func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *deployapiv1.DeploymentStrategy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.CustomParams != nil {
@@ -2074,6 +2754,22 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -2095,6 +2791,15 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	} else {
 		out.Annotations = nil
 	}
+	out.AutoRollback = in.AutoRollback
+	if in.Verification != nil {
+		out.Verification = new(deployapiv1.DeploymentVerification)
+		if err := deepCopy_v1_DeploymentVerification(*in.Verification, out.Verification, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verification = nil
+	}
 	return nil
 }
 
@@ -2130,6 +2835,46 @@ func deepCopy_v1_DeploymentTriggerPolicy(in deployapiv1.DeploymentTriggerPolicy,
 	return nil
 }
 
+func deepCopy_v1_DeploymentVerification(in deployapiv1.DeploymentVerification, out *deployapiv1.DeploymentVerification, c *conversion.Cloner) error {
+	if in.Prometheus != nil {
+		out.Prometheus = new(deployapiv1.PrometheusQuery)
+		if err := deepCopy_v1_PrometheusQuery(*in.Prometheus, out.Prometheus, c); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1.HTTPGetMetric)
+		if err := deepCopy_v1_HTTPGetMetric(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	out.MinValue = in.MinValue
+	out.MaxValue = in.MaxValue
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_DeploymentWindow(in deployapiv1.DeploymentWindow, out *deployapiv1.DeploymentWindow, c *conversion.Cloner) error {
+	out.Schedule = in.Schedule
+	out.DurationMinutes = in.DurationMinutes
+	return nil
+}
+
 func deepCopy_v1_ExecNewPodHook(in deployapiv1.ExecNewPodHook, out *deployapiv1.ExecNewPodHook, c *conversion.Cloner) error {
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
@@ -2163,6 +2908,25 @@ func deepCopy_v1_ExecNewPodHook(in deployapiv1.ExecNewPodHook, out *deployapiv1.
 	return nil
 }
 
+func deepCopy_v1_HTTPGetHook(in deployapiv1.HTTPGetHook, out *deployapiv1.HTTPGetHook, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Method = in.Method
+	out.ExpectedStatus = in.ExpectedStatus
+	out.Retries = in.Retries
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_HTTPGetMetric(in deployapiv1.HTTPGetMetric, out *deployapiv1.HTTPGetMetric, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
 func deepCopy_v1_LifecycleHook(in deployapiv1.LifecycleHook, out *deployapiv1.LifecycleHook, c *conversion.Cloner) error {
 	out.FailurePolicy = in.FailurePolicy
 	if in.ExecNewPod != nil {
@@ -2183,6 +2947,28 @@ func deepCopy_v1_LifecycleHook(in deployapiv1.LifecycleHook, out *deployapiv1.Li
 	} else {
 		out.TagImages = nil
 	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1.HTTPGetHook)
+		if err := deepCopy_v1_HTTPGetHook(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	if in.TCPSocket != nil {
+		out.TCPSocket = new(deployapiv1.TCPSocketHook)
+		if err := deepCopy_v1_TCPSocketHook(*in.TCPSocket, out.TCPSocket, c); err != nil {
+			return err
+		}
+	} else {
+		out.TCPSocket = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_PrometheusQuery(in deployapiv1.PrometheusQuery, out *deployapiv1.PrometheusQuery, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Query = in.Query
 	return nil
 }
 
@@ -2271,6 +3057,14 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	} else {
 		out.Pre = nil
 	}
+	if in.Batch != nil {
+		out.Batch = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Batch, out.Batch, c); err != nil {
+			return err
+		}
+	} else {
+		out.Batch = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -2282,6 +3076,18 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	return nil
 }
 
+func deepCopy_v1_TCPSocketHook(in deployapiv1.TCPSocketHook, out *deployapiv1.TCPSocketHook, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.Port = in.Port
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_TagImageHook(in deployapiv1.TagImageHook, out *deployapiv1.TagImageHook, c *conversion.Cloner) error {
 	out.ContainerName = in.ContainerName
 	if newVal, err := c.DeepCopy(in.To); err != nil {
@@ -3629,6 +4435,9 @@ func init() {
 	err := api.Scheme.AddGeneratedDeepCopyFuncs(
 		deepCopy_v1_Application,
 		deepCopy_v1_ApplicationList,
+		deepCopy_v1_ApplicationRevision,
+		deepCopy_v1_ApplicationRollback,
+		deepCopy_v1_ApplicationSchedule,
 		deepCopy_v1_ApplicationSpec,
 		deepCopy_v1_ApplicationStatus,
 		deepCopy_v1_Item,
@@ -3680,6 +4489,7 @@ func init() {
 		deepCopy_v1_BinaryBuildRequestOptions,
 		deepCopy_v1_BinaryBuildSource,
 		deepCopy_v1_Build,
+		deepCopy_v1_BuildCacheVolume,
 		deepCopy_v1_BuildConfig,
 		deepCopy_v1_BuildConfigList,
 		deepCopy_v1_BuildConfigSpec,
@@ -3687,7 +4497,11 @@ func init() {
 		deepCopy_v1_BuildList,
 		deepCopy_v1_BuildLog,
 		deepCopy_v1_BuildLogOptions,
+		deepCopy_v1_BuildMatrixParameter,
+		deepCopy_v1_BuildNotification,
 		deepCopy_v1_BuildOutput,
+		deepCopy_v1_BuildParameter,
+		deepCopy_v1_BuildParameterValue,
 		deepCopy_v1_BuildPostCommitSpec,
 		deepCopy_v1_BuildRequest,
 		deepCopy_v1_BuildSource,
@@ -3695,38 +4509,61 @@ func init() {
 		deepCopy_v1_BuildStatus,
 		deepCopy_v1_BuildStrategy,
 		deepCopy_v1_BuildTriggerPolicy,
+		deepCopy_v1_CommitStatusReporting,
 		deepCopy_v1_CustomBuildStrategy,
 		deepCopy_v1_DockerBuildStrategy,
+		deepCopy_v1_EmailNotification,
 		deepCopy_v1_GitBuildSource,
+		deepCopy_v1_GitPullRequest,
 		deepCopy_v1_GitSourceRevision,
 		deepCopy_v1_ImageChangeTrigger,
 		deepCopy_v1_ImageSource,
 		deepCopy_v1_ImageSourcePath,
+		deepCopy_v1_PipelineBuildStage,
+		deepCopy_v1_PipelineBuildStrategy,
+		deepCopy_v1_PipelinePromoteStage,
+		deepCopy_v1_PipelineStage,
+		deepCopy_v1_PipelineStageStatus,
+		deepCopy_v1_PipelineTestStage,
 		deepCopy_v1_SecretBuildSource,
 		deepCopy_v1_SecretSpec,
+		deepCopy_v1_SlackNotification,
 		deepCopy_v1_SourceBuildStrategy,
 		deepCopy_v1_SourceControlUser,
 		deepCopy_v1_SourceRevision,
 		deepCopy_v1_WebHookTrigger,
+		deepCopy_v1_WebhookNotification,
+		deepCopy_v1_BlueGreenDeploymentStrategyParams,
+		deepCopy_v1_CanaryDeploymentStrategyParams,
+		deepCopy_v1_ContainerImage,
 		deepCopy_v1_CustomDeploymentStrategyParams,
 		deepCopy_v1_DeploymentCause,
 		deepCopy_v1_DeploymentCauseImageTrigger,
 		deepCopy_v1_DeploymentConfig,
+		deepCopy_v1_DeploymentConfigHistory,
 		deepCopy_v1_DeploymentConfigList,
 		deepCopy_v1_DeploymentConfigRollback,
 		deepCopy_v1_DeploymentConfigRollbackSpec,
 		deepCopy_v1_DeploymentConfigSpec,
 		deepCopy_v1_DeploymentConfigStatus,
 		deepCopy_v1_DeploymentDetails,
+		deepCopy_v1_DeploymentHookResult,
 		deepCopy_v1_DeploymentLog,
 		deepCopy_v1_DeploymentLogOptions,
+		deepCopy_v1_DeploymentRecord,
 		deepCopy_v1_DeploymentStrategy,
 		deepCopy_v1_DeploymentTriggerImageChangeParams,
 		deepCopy_v1_DeploymentTriggerPolicy,
+		deepCopy_v1_DeploymentVerification,
+		deepCopy_v1_DeploymentWindow,
 		deepCopy_v1_ExecNewPodHook,
+		deepCopy_v1_HTTPGetHook,
+		deepCopy_v1_HTTPGetMetric,
 		deepCopy_v1_LifecycleHook,
+		deepCopy_v1_PrometheusQuery,
 		deepCopy_v1_RecreateDeploymentStrategyParams,
 		deepCopy_v1_RollingDeploymentStrategyParams,
+		deepCopy_v1_TCPSocketHook,
 		deepCopy_v1_TagImageHook,
 		deepCopy_v1_Image,
 		deepCopy_v1_ImageImportSpec,
@@ -995,6 +995,20 @@ func Convert_api_Build_To_v1beta3_Build(in *buildapi.Build, out *v1beta3.Build,
 	return autoConvert_api_Build_To_v1beta3_Build(in, out, s)
 }
 
+func autoConvert_api_BuildCacheVolume_To_v1beta3_BuildCacheVolume(in *buildapi.BuildCacheVolume, out *v1beta3.BuildCacheVolume, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildCacheVolume))(in)
+	}
+	out.Name = in.Name
+	out.ClaimName = in.ClaimName
+	out.Path = in.Path
+	return nil
+}
+
+func Convert_api_BuildCacheVolume_To_v1beta3_BuildCacheVolume(in *buildapi.BuildCacheVolume, out *v1beta3.BuildCacheVolume, s conversion.Scope) error {
+	return autoConvert_api_BuildCacheVolume_To_v1beta3_BuildCacheVolume(in, out, s)
+}
+
 func autoConvert_api_BuildConfig_To_v1beta3_BuildConfig(in *buildapi.BuildConfig, out *v1beta3.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildConfig))(in)
@@ -1049,6 +1063,27 @@ func autoConvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec(in *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = v1beta3.BuildRunPolicy(in.RunPolicy)
+	if in.Parameters != nil {
+		out.Parameters = make([]v1beta3.BuildParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := Convert_api_BuildParameter_To_v1beta3_BuildParameter(&in.Parameters[i], &out.Parameters[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.Matrix != nil {
+		out.Matrix = make([]v1beta3.BuildMatrixParameter, len(in.Matrix))
+		for i := range in.Matrix {
+			if err := Convert_api_BuildMatrixParameter_To_v1beta3_BuildMatrixParameter(&in.Matrix[i], &out.Matrix[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Matrix = nil
+	}
 	if err := Convert_api_BuildSpec_To_v1beta3_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -1155,6 +1190,72 @@ func Convert_api_BuildLogOptions_To_v1beta3_BuildLogOptions(in *buildapi.BuildLo
 	return autoConvert_api_BuildLogOptions_To_v1beta3_BuildLogOptions(in, out, s)
 }
 
+func autoConvert_api_BuildMatrixParameter_To_v1beta3_BuildMatrixParameter(in *buildapi.BuildMatrixParameter, out *v1beta3.BuildMatrixParameter, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildMatrixParameter))(in)
+	}
+	out.Name = in.Name
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func Convert_api_BuildMatrixParameter_To_v1beta3_BuildMatrixParameter(in *buildapi.BuildMatrixParameter, out *v1beta3.BuildMatrixParameter, s conversion.Scope) error {
+	return autoConvert_api_BuildMatrixParameter_To_v1beta3_BuildMatrixParameter(in, out, s)
+}
+
+func autoConvert_api_BuildNotification_To_v1beta3_BuildNotification(in *buildapi.BuildNotification, out *v1beta3.BuildNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildNotification))(in)
+	}
+	if in.Phases != nil {
+		out.Phases = make([]v1beta3.BuildPhase, len(in.Phases))
+		for i := range in.Phases {
+			out.Phases[i] = v1beta3.BuildPhase(in.Phases[i])
+		}
+	} else {
+		out.Phases = nil
+	}
+	// unable to generate simple pointer conversion for api.WebhookNotification -> v1beta3.WebhookNotification
+	if in.Webhook != nil {
+		out.Webhook = new(v1beta3.WebhookNotification)
+		if err := Convert_api_WebhookNotification_To_v1beta3_WebhookNotification(in.Webhook, out.Webhook, s); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	// unable to generate simple pointer conversion for api.SlackNotification -> v1beta3.SlackNotification
+	if in.Slack != nil {
+		out.Slack = new(v1beta3.SlackNotification)
+		if err := Convert_api_SlackNotification_To_v1beta3_SlackNotification(in.Slack, out.Slack, s); err != nil {
+			return err
+		}
+	} else {
+		out.Slack = nil
+	}
+	// unable to generate simple pointer conversion for api.EmailNotification -> v1beta3.EmailNotification
+	if in.Email != nil {
+		out.Email = new(v1beta3.EmailNotification)
+		if err := Convert_api_EmailNotification_To_v1beta3_EmailNotification(in.Email, out.Email, s); err != nil {
+			return err
+		}
+	} else {
+		out.Email = nil
+	}
+	return nil
+}
+
+func Convert_api_BuildNotification_To_v1beta3_BuildNotification(in *buildapi.BuildNotification, out *v1beta3.BuildNotification, s conversion.Scope) error {
+	return autoConvert_api_BuildNotification_To_v1beta3_BuildNotification(in, out, s)
+}
+
 func autoConvert_api_BuildOutput_To_v1beta3_BuildOutput(in *buildapi.BuildOutput, out *v1beta3.BuildOutput, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildOutput))(in)
@@ -1180,6 +1281,35 @@ func autoConvert_api_BuildOutput_To_v1beta3_BuildOutput(in *buildapi.BuildOutput
 	return nil
 }
 
+func autoConvert_api_BuildParameter_To_v1beta3_BuildParameter(in *buildapi.BuildParameter, out *v1beta3.BuildParameter, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildParameter))(in)
+	}
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Type = v1beta3.BuildParameterType(in.Type)
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+func Convert_api_BuildParameter_To_v1beta3_BuildParameter(in *buildapi.BuildParameter, out *v1beta3.BuildParameter, s conversion.Scope) error {
+	return autoConvert_api_BuildParameter_To_v1beta3_BuildParameter(in, out, s)
+}
+
+func autoConvert_api_BuildParameterValue_To_v1beta3_BuildParameterValue(in *buildapi.BuildParameterValue, out *v1beta3.BuildParameterValue, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildParameterValue))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+func Convert_api_BuildParameterValue_To_v1beta3_BuildParameterValue(in *buildapi.BuildParameterValue, out *v1beta3.BuildParameterValue, s conversion.Scope) error {
+	return autoConvert_api_BuildParameterValue_To_v1beta3_BuildParameterValue(in, out, s)
+}
+
 func autoConvert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec(in *buildapi.BuildPostCommitSpec, out *v1beta3.BuildPostCommitSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildPostCommitSpec))(in)
@@ -1303,6 +1433,25 @@ func autoConvert_api_BuildSpec_To_v1beta3_BuildSpec(in *buildapi.BuildSpec, out
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Notifications != nil {
+		out.Notifications = make([]v1beta3.BuildNotification, len(in.Notifications))
+		for i := range in.Notifications {
+			if err := Convert_api_BuildNotification_To_v1beta3_BuildNotification(&in.Notifications[i], &out.Notifications[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Notifications = nil
+	}
+	// unable to generate simple pointer conversion for api.CommitStatusReporting -> v1beta3.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(v1beta3.CommitStatusReporting)
+		if err := Convert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1347,6 +1496,16 @@ func autoConvert_api_BuildStatus_To_v1beta3_BuildStatus(in *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]v1beta3.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
 	return nil
 }
 
@@ -1382,6 +1541,15 @@ func autoConvert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for api.PipelineBuildStrategy -> v1beta3.PipelineBuildStrategy
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(v1beta3.PipelineBuildStrategy)
+		if err := Convert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1408,6 +1576,33 @@ func autoConvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1beta3.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(v1beta3.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1beta3.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(v1beta3.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1beta3.WebHookTrigger
+	if in.GogsWebHook != nil {
+		out.GogsWebHook = new(v1beta3.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.GogsWebHook, out.GogsWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GogsWebHook = nil
+	}
 	// unable to generate simple pointer conversion for api.ImageChangeTrigger -> v1beta3.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(v1beta3.ImageChangeTrigger)
@@ -1420,6 +1615,23 @@ func autoConvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	return nil
 }
 
+func autoConvert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *v1beta3.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.CommitStatusReporting))(in)
+	}
+	out.Provider = v1beta3.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.TokenSecret, &out.TokenSecret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *v1beta3.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy(in *buildapi.CustomBuildStrategy, out *v1beta3.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.CustomBuildStrategy))(in)
@@ -1497,9 +1709,38 @@ func autoConvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy(in *buil
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]v1beta3.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_api_BuildCacheVolume_To_v1beta3_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func autoConvert_api_EmailNotification_To_v1beta3_EmailNotification(in *buildapi.EmailNotification, out *v1beta3.EmailNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.EmailNotification))(in)
+	}
+	if in.To != nil {
+		out.To = make([]string, len(in.To))
+		for i := range in.To {
+			out.To[i] = in.To[i]
+		}
+	} else {
+		out.To = nil
+	}
 	return nil
 }
 
+func Convert_api_EmailNotification_To_v1beta3_EmailNotification(in *buildapi.EmailNotification, out *v1beta3.EmailNotification, s conversion.Scope) error {
+	return autoConvert_api_EmailNotification_To_v1beta3_EmailNotification(in, out, s)
+}
+
 func autoConvert_api_GitBuildSource_To_v1beta3_GitBuildSource(in *buildapi.GitBuildSource, out *v1beta3.GitBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitBuildSource))(in)
@@ -1525,6 +1766,19 @@ func Convert_api_GitBuildSource_To_v1beta3_GitBuildSource(in *buildapi.GitBuildS
 	return autoConvert_api_GitBuildSource_To_v1beta3_GitBuildSource(in, out, s)
 }
 
+func autoConvert_api_GitPullRequest_To_v1beta3_GitPullRequest(in *buildapi.GitPullRequest, out *v1beta3.GitPullRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.GitPullRequest))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
+func Convert_api_GitPullRequest_To_v1beta3_GitPullRequest(in *buildapi.GitPullRequest, out *v1beta3.GitPullRequest, s conversion.Scope) error {
+	return autoConvert_api_GitPullRequest_To_v1beta3_GitPullRequest(in, out, s)
+}
+
 func autoConvert_api_GitSourceRevision_To_v1beta3_GitSourceRevision(in *buildapi.GitSourceRevision, out *v1beta3.GitSourceRevision, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.GitSourceRevision))(in)
@@ -1611,6 +1865,151 @@ func Convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in *buildapi.ImageSo
 	return autoConvert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in, out, s)
 }
 
+func autoConvert_api_PipelineBuildStage_To_v1beta3_PipelineBuildStage(in *buildapi.PipelineBuildStage, out *v1beta3.PipelineBuildStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineBuildStage))(in)
+	}
+	out.BuildConfig = in.BuildConfig
+	return nil
+}
+
+func Convert_api_PipelineBuildStage_To_v1beta3_PipelineBuildStage(in *buildapi.PipelineBuildStage, out *v1beta3.PipelineBuildStage, s conversion.Scope) error {
+	return autoConvert_api_PipelineBuildStage_To_v1beta3_PipelineBuildStage(in, out, s)
+}
+
+func autoConvert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *v1beta3.PipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineBuildStrategy))(in)
+	}
+	if in.Stages != nil {
+		out.Stages = make([]v1beta3.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_api_PipelineStage_To_v1beta3_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in *buildapi.PipelineBuildStrategy, out *v1beta3.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy(in, out, s)
+}
+
+func autoConvert_api_PipelinePromoteStage_To_v1beta3_PipelinePromoteStage(in *buildapi.PipelinePromoteStage, out *v1beta3.PipelinePromoteStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelinePromoteStage))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_PipelinePromoteStage_To_v1beta3_PipelinePromoteStage(in *buildapi.PipelinePromoteStage, out *v1beta3.PipelinePromoteStage, s conversion.Scope) error {
+	return autoConvert_api_PipelinePromoteStage_To_v1beta3_PipelinePromoteStage(in, out, s)
+}
+
+func autoConvert_api_PipelineStage_To_v1beta3_PipelineStage(in *buildapi.PipelineStage, out *v1beta3.PipelineStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStage))(in)
+	}
+	out.Name = in.Name
+	// unable to generate simple pointer conversion for api.PipelineBuildStage -> v1beta3.PipelineBuildStage
+	if in.Build != nil {
+		out.Build = new(v1beta3.PipelineBuildStage)
+		if err := Convert_api_PipelineBuildStage_To_v1beta3_PipelineBuildStage(in.Build, out.Build, s); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	// unable to generate simple pointer conversion for api.PipelineTestStage -> v1beta3.PipelineTestStage
+	if in.Test != nil {
+		out.Test = new(v1beta3.PipelineTestStage)
+		if err := Convert_api_PipelineTestStage_To_v1beta3_PipelineTestStage(in.Test, out.Test, s); err != nil {
+			return err
+		}
+	} else {
+		out.Test = nil
+	}
+	// unable to generate simple pointer conversion for api.PipelinePromoteStage -> v1beta3.PipelinePromoteStage
+	if in.Promote != nil {
+		out.Promote = new(v1beta3.PipelinePromoteStage)
+		if err := Convert_api_PipelinePromoteStage_To_v1beta3_PipelinePromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineStage_To_v1beta3_PipelineStage(in *buildapi.PipelineStage, out *v1beta3.PipelineStage, s conversion.Scope) error {
+	return autoConvert_api_PipelineStage_To_v1beta3_PipelineStage(in, out, s)
+}
+
+func autoConvert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus(in *buildapi.PipelineStageStatus, out *v1beta3.PipelineStageStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineStageStatus))(in)
+	}
+	out.Name = in.Name
+	out.Phase = v1beta3.BuildPhase(in.Phase)
+	out.Build = in.Build
+	out.Pod = in.Pod
+	out.Message = in.Message
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus(in *buildapi.PipelineStageStatus, out *v1beta3.PipelineStageStatus, s conversion.Scope) error {
+	return autoConvert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus(in, out, s)
+}
+
+func autoConvert_api_PipelineTestStage_To_v1beta3_PipelineTestStage(in *buildapi.PipelineTestStage, out *v1beta3.PipelineTestStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PipelineTestStage))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.Image, &out.Image, s); err != nil {
+		return err
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
+func Convert_api_PipelineTestStage_To_v1beta3_PipelineTestStage(in *buildapi.PipelineTestStage, out *v1beta3.PipelineTestStage, s conversion.Scope) error {
+	return autoConvert_api_PipelineTestStage_To_v1beta3_PipelineTestStage(in, out, s)
+}
+
 func autoConvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(in *buildapi.SecretBuildSource, out *v1beta3.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretBuildSource))(in)
@@ -1641,6 +2040,19 @@ func Convert_api_SecretSpec_To_v1beta3_SecretSpec(in *buildapi.SecretSpec, out *
 	return autoConvert_api_SecretSpec_To_v1beta3_SecretSpec(in, out, s)
 }
 
+func autoConvert_api_SlackNotification_To_v1beta3_SlackNotification(in *buildapi.SlackNotification, out *v1beta3.SlackNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SlackNotification))(in)
+	}
+	out.URL = in.URL
+	out.Channel = in.Channel
+	return nil
+}
+
+func Convert_api_SlackNotification_To_v1beta3_SlackNotification(in *buildapi.SlackNotification, out *v1beta3.SlackNotification, s conversion.Scope) error {
+	return autoConvert_api_SlackNotification_To_v1beta3_SlackNotification(in, out, s)
+}
+
 func autoConvert_api_SourceBuildStrategy_To_v1beta3_SourceBuildStrategy(in *buildapi.SourceBuildStrategy, out *v1beta3.SourceBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SourceBuildStrategy))(in)
@@ -1670,6 +2082,16 @@ func autoConvert_api_SourceBuildStrategy_To_v1beta3_SourceBuildStrategy(in *buil
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]v1beta3.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_api_BuildCacheVolume_To_v1beta3_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
 	return nil
 }
 
@@ -1707,13 +2129,51 @@ func autoConvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHo
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
-	return nil
-}
-
-func Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *v1beta3.WebHookTrigger, s conversion.Scope) error {
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1beta3.LocalObjectReference
+	if in.SignatureSecret != nil {
+		out.SignatureSecret = new(apiv1beta3.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.SignatureSecret, out.SignatureSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureSecret = nil
+	}
+	out.AllowPullRequests = in.AllowPullRequests
+	if in.IncludePaths != nil {
+		out.IncludePaths = make([]string, len(in.IncludePaths))
+		for i := range in.IncludePaths {
+			out.IncludePaths[i] = in.IncludePaths[i]
+		}
+	} else {
+		out.IncludePaths = nil
+	}
+	if in.ExcludePaths != nil {
+		out.ExcludePaths = make([]string, len(in.ExcludePaths))
+		for i := range in.ExcludePaths {
+			out.ExcludePaths[i] = in.ExcludePaths[i]
+		}
+	} else {
+		out.ExcludePaths = nil
+	}
+	return nil
+}
+
+func Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *v1beta3.WebHookTrigger, s conversion.Scope) error {
 	return autoConvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in, out, s)
 }
 
+func autoConvert_api_WebhookNotification_To_v1beta3_WebhookNotification(in *buildapi.WebhookNotification, out *v1beta3.WebhookNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.WebhookNotification))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_api_WebhookNotification_To_v1beta3_WebhookNotification(in *buildapi.WebhookNotification, out *v1beta3.WebhookNotification, s conversion.Scope) error {
+	return autoConvert_api_WebhookNotification_To_v1beta3_WebhookNotification(in, out, s)
+}
+
 func autoConvert_v1beta3_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions(in *v1beta3.BinaryBuildRequestOptions, out *buildapi.BinaryBuildRequestOptions, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.BinaryBuildRequestOptions))(in)
@@ -1767,6 +2227,20 @@ func Convert_v1beta3_Build_To_api_Build(in *v1beta3.Build, out *buildapi.Build,
 	return autoConvert_v1beta3_Build_To_api_Build(in, out, s)
 }
 
+func autoConvert_v1beta3_BuildCacheVolume_To_api_BuildCacheVolume(in *v1beta3.BuildCacheVolume, out *buildapi.BuildCacheVolume, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildCacheVolume))(in)
+	}
+	out.Name = in.Name
+	out.ClaimName = in.ClaimName
+	out.Path = in.Path
+	return nil
+}
+
+func Convert_v1beta3_BuildCacheVolume_To_api_BuildCacheVolume(in *v1beta3.BuildCacheVolume, out *buildapi.BuildCacheVolume, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildCacheVolume_To_api_BuildCacheVolume(in, out, s)
+}
+
 func autoConvert_v1beta3_BuildConfig_To_api_BuildConfig(in *v1beta3.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.BuildConfig))(in)
@@ -1821,6 +2295,27 @@ func autoConvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(in *v1beta3.Buil
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	if in.Parameters != nil {
+		out.Parameters = make([]buildapi.BuildParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := Convert_v1beta3_BuildParameter_To_api_BuildParameter(&in.Parameters[i], &out.Parameters[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.Matrix != nil {
+		out.Matrix = make([]buildapi.BuildMatrixParameter, len(in.Matrix))
+		for i := range in.Matrix {
+			if err := Convert_v1beta3_BuildMatrixParameter_To_api_BuildMatrixParameter(&in.Matrix[i], &out.Matrix[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Matrix = nil
+	}
 	if err := Convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -1927,6 +2422,72 @@ func Convert_v1beta3_BuildLogOptions_To_api_BuildLogOptions(in *v1beta3.BuildLog
 	return autoConvert_v1beta3_BuildLogOptions_To_api_BuildLogOptions(in, out, s)
 }
 
+func autoConvert_v1beta3_BuildMatrixParameter_To_api_BuildMatrixParameter(in *v1beta3.BuildMatrixParameter, out *buildapi.BuildMatrixParameter, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildMatrixParameter))(in)
+	}
+	out.Name = in.Name
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_BuildMatrixParameter_To_api_BuildMatrixParameter(in *v1beta3.BuildMatrixParameter, out *buildapi.BuildMatrixParameter, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildMatrixParameter_To_api_BuildMatrixParameter(in, out, s)
+}
+
+func autoConvert_v1beta3_BuildNotification_To_api_BuildNotification(in *v1beta3.BuildNotification, out *buildapi.BuildNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildNotification))(in)
+	}
+	if in.Phases != nil {
+		out.Phases = make([]buildapi.BuildPhase, len(in.Phases))
+		for i := range in.Phases {
+			out.Phases[i] = buildapi.BuildPhase(in.Phases[i])
+		}
+	} else {
+		out.Phases = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.WebhookNotification -> api.WebhookNotification
+	if in.Webhook != nil {
+		out.Webhook = new(buildapi.WebhookNotification)
+		if err := Convert_v1beta3_WebhookNotification_To_api_WebhookNotification(in.Webhook, out.Webhook, s); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.SlackNotification -> api.SlackNotification
+	if in.Slack != nil {
+		out.Slack = new(buildapi.SlackNotification)
+		if err := Convert_v1beta3_SlackNotification_To_api_SlackNotification(in.Slack, out.Slack, s); err != nil {
+			return err
+		}
+	} else {
+		out.Slack = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.EmailNotification -> api.EmailNotification
+	if in.Email != nil {
+		out.Email = new(buildapi.EmailNotification)
+		if err := Convert_v1beta3_EmailNotification_To_api_EmailNotification(in.Email, out.Email, s); err != nil {
+			return err
+		}
+	} else {
+		out.Email = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_BuildNotification_To_api_BuildNotification(in *v1beta3.BuildNotification, out *buildapi.BuildNotification, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildNotification_To_api_BuildNotification(in, out, s)
+}
+
 func autoConvert_v1beta3_BuildOutput_To_api_BuildOutput(in *v1beta3.BuildOutput, out *buildapi.BuildOutput, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.BuildOutput))(in)
@@ -1952,6 +2513,35 @@ func autoConvert_v1beta3_BuildOutput_To_api_BuildOutput(in *v1beta3.BuildOutput,
 	return nil
 }
 
+func autoConvert_v1beta3_BuildParameter_To_api_BuildParameter(in *v1beta3.BuildParameter, out *buildapi.BuildParameter, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildParameter))(in)
+	}
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Type = buildapi.BuildParameterType(in.Type)
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+func Convert_v1beta3_BuildParameter_To_api_BuildParameter(in *v1beta3.BuildParameter, out *buildapi.BuildParameter, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildParameter_To_api_BuildParameter(in, out, s)
+}
+
+func autoConvert_v1beta3_BuildParameterValue_To_api_BuildParameterValue(in *v1beta3.BuildParameterValue, out *buildapi.BuildParameterValue, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildParameterValue))(in)
+	}
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+func Convert_v1beta3_BuildParameterValue_To_api_BuildParameterValue(in *v1beta3.BuildParameterValue, out *buildapi.BuildParameterValue, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildParameterValue_To_api_BuildParameterValue(in, out, s)
+}
+
 func autoConvert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *v1beta3.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.BuildPostCommitSpec))(in)
@@ -2076,6 +2666,25 @@ func autoConvert_v1beta3_BuildSpec_To_api_BuildSpec(in *v1beta3.BuildSpec, out *
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Notifications != nil {
+		out.Notifications = make([]buildapi.BuildNotification, len(in.Notifications))
+		for i := range in.Notifications {
+			if err := Convert_v1beta3_BuildNotification_To_api_BuildNotification(&in.Notifications[i], &out.Notifications[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Notifications = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.CommitStatusReporting -> api.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusReporting)
+		if err := Convert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -2120,6 +2729,16 @@ func autoConvert_v1beta3_BuildStatus_To_api_BuildStatus(in *v1beta3.BuildStatus,
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
 	return nil
 }
 
@@ -2156,6 +2775,15 @@ func autoConvert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *v1beta3.BuildStr
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.PipelineBuildStrategy -> api.PipelineBuildStrategy
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(buildapi.PipelineBuildStrategy)
+		if err := Convert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in.PipelineStrategy, out.PipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2182,6 +2810,33 @@ func autoConvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1beta
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.WebHookTrigger -> api.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.WebHookTrigger -> api.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.WebHookTrigger -> api.WebHookTrigger
+	if in.GogsWebHook != nil {
+		out.GogsWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.GogsWebHook, out.GogsWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GogsWebHook = nil
+	}
 	// unable to generate simple pointer conversion for v1beta3.ImageChangeTrigger -> api.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
@@ -2194,6 +2849,23 @@ func autoConvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1beta
 	return nil
 }
 
+func autoConvert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in *v1beta3.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.CommitStatusReporting))(in)
+	}
+	out.Provider = buildapi.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.TokenSecret, &out.TokenSecret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in *v1beta3.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy(in *v1beta3.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.CustomBuildStrategy))(in)
@@ -2271,9 +2943,38 @@ func autoConvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy(in *v1be
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapi.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_v1beta3_BuildCacheVolume_To_api_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func autoConvert_v1beta3_EmailNotification_To_api_EmailNotification(in *v1beta3.EmailNotification, out *buildapi.EmailNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.EmailNotification))(in)
+	}
+	if in.To != nil {
+		out.To = make([]string, len(in.To))
+		for i := range in.To {
+			out.To[i] = in.To[i]
+		}
+	} else {
+		out.To = nil
+	}
 	return nil
 }
 
+func Convert_v1beta3_EmailNotification_To_api_EmailNotification(in *v1beta3.EmailNotification, out *buildapi.EmailNotification, s conversion.Scope) error {
+	return autoConvert_v1beta3_EmailNotification_To_api_EmailNotification(in, out, s)
+}
+
 func autoConvert_v1beta3_GitBuildSource_To_api_GitBuildSource(in *v1beta3.GitBuildSource, out *buildapi.GitBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.GitBuildSource))(in)
@@ -2299,6 +3000,19 @@ func Convert_v1beta3_GitBuildSource_To_api_GitBuildSource(in *v1beta3.GitBuildSo
 	return autoConvert_v1beta3_GitBuildSource_To_api_GitBuildSource(in, out, s)
 }
 
+func autoConvert_v1beta3_GitPullRequest_To_api_GitPullRequest(in *v1beta3.GitPullRequest, out *buildapi.GitPullRequest, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.GitPullRequest))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
+func Convert_v1beta3_GitPullRequest_To_api_GitPullRequest(in *v1beta3.GitPullRequest, out *buildapi.GitPullRequest, s conversion.Scope) error {
+	return autoConvert_v1beta3_GitPullRequest_To_api_GitPullRequest(in, out, s)
+}
+
 func autoConvert_v1beta3_GitSourceRevision_To_api_GitSourceRevision(in *v1beta3.GitSourceRevision, out *buildapi.GitSourceRevision, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.GitSourceRevision))(in)
@@ -2385,103 +3099,297 @@ func Convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in *v1beta3.ImageSou
 	return autoConvert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
-func autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *v1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
+func autoConvert_v1beta3_PipelineBuildStage_To_api_PipelineBuildStage(in *v1beta3.PipelineBuildStage, out *buildapi.PipelineBuildStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*v1beta3.SecretBuildSource))(in)
-	}
-	if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
-		return err
+		defaulting.(func(*v1beta3.PipelineBuildStage))(in)
 	}
-	out.DestinationDir = in.DestinationDir
+	out.BuildConfig = in.BuildConfig
 	return nil
 }
 
-func Convert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *v1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
-	return autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in, out, s)
+func Convert_v1beta3_PipelineBuildStage_To_api_PipelineBuildStage(in *v1beta3.PipelineBuildStage, out *buildapi.PipelineBuildStage, s conversion.Scope) error {
+	return autoConvert_v1beta3_PipelineBuildStage_To_api_PipelineBuildStage(in, out, s)
 }
 
-func autoConvert_v1beta3_SecretSpec_To_api_SecretSpec(in *v1beta3.SecretSpec, out *buildapi.SecretSpec, s conversion.Scope) error {
+func autoConvert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *v1beta3.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*v1beta3.SecretSpec))(in)
+		defaulting.(func(*v1beta3.PipelineBuildStrategy))(in)
 	}
-	if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.SecretSource, &out.SecretSource, s); err != nil {
-		return err
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_v1beta3_PipelineStage_To_api_PipelineStage(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
 	}
-	out.MountPath = in.MountPath
 	return nil
 }
 
-func Convert_v1beta3_SecretSpec_To_api_SecretSpec(in *v1beta3.SecretSpec, out *buildapi.SecretSpec, s conversion.Scope) error {
-	return autoConvert_v1beta3_SecretSpec_To_api_SecretSpec(in, out, s)
+func Convert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in *v1beta3.PipelineBuildStrategy, out *buildapi.PipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy(in, out, s)
 }
 
-func autoConvert_v1beta3_SourceBuildStrategy_To_api_SourceBuildStrategy(in *v1beta3.SourceBuildStrategy, out *buildapi.SourceBuildStrategy, s conversion.Scope) error {
+func autoConvert_v1beta3_PipelinePromoteStage_To_api_PipelinePromoteStage(in *v1beta3.PipelinePromoteStage, out *buildapi.PipelinePromoteStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*v1beta3.SourceBuildStrategy))(in)
+		defaulting.(func(*v1beta3.PipelinePromoteStage))(in)
 	}
 	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
 		return err
 	}
-	// unable to generate simple pointer conversion for v1beta3.LocalObjectReference -> api.LocalObjectReference
-	if in.PullSecret != nil {
-		out.PullSecret = new(api.LocalObjectReference)
-		if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.PullSecret, out.PullSecret, s); err != nil {
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_v1beta3_PipelinePromoteStage_To_api_PipelinePromoteStage(in *v1beta3.PipelinePromoteStage, out *buildapi.PipelinePromoteStage, s conversion.Scope) error {
+	return autoConvert_v1beta3_PipelinePromoteStage_To_api_PipelinePromoteStage(in, out, s)
+}
+
+func autoConvert_v1beta3_PipelineStage_To_api_PipelineStage(in *v1beta3.PipelineStage, out *buildapi.PipelineStage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.PipelineStage))(in)
+	}
+	out.Name = in.Name
+	// unable to generate simple pointer conversion for v1beta3.PipelineBuildStage -> api.PipelineBuildStage
+	if in.Build != nil {
+		out.Build = new(buildapi.PipelineBuildStage)
+		if err := Convert_v1beta3_PipelineBuildStage_To_api_PipelineBuildStage(in.Build, out.Build, s); err != nil {
 			return err
 		}
 	} else {
-		out.PullSecret = nil
+		out.Build = nil
 	}
-	if in.Env != nil {
-		out.Env = make([]api.EnvVar, len(in.Env))
-		for i := range in.Env {
-			if err := s.Convert(&in.Env[i], &out.Env[i], 0); err != nil {
-				return err
-			}
+	// unable to generate simple pointer conversion for v1beta3.PipelineTestStage -> api.PipelineTestStage
+	if in.Test != nil {
+		out.Test = new(buildapi.PipelineTestStage)
+		if err := Convert_v1beta3_PipelineTestStage_To_api_PipelineTestStage(in.Test, out.Test, s); err != nil {
+			return err
 		}
 	} else {
-		out.Env = nil
+		out.Test = nil
 	}
-	out.Scripts = in.Scripts
-	out.Incremental = in.Incremental
-	out.ForcePull = in.ForcePull
-	return nil
-}
-
-func autoConvert_v1beta3_SourceControlUser_To_api_SourceControlUser(in *v1beta3.SourceControlUser, out *buildapi.SourceControlUser, s conversion.Scope) error {
-	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*v1beta3.SourceControlUser))(in)
+	// unable to generate simple pointer conversion for v1beta3.PipelinePromoteStage -> api.PipelinePromoteStage
+	if in.Promote != nil {
+		out.Promote = new(buildapi.PipelinePromoteStage)
+		if err := Convert_v1beta3_PipelinePromoteStage_To_api_PipelinePromoteStage(in.Promote, out.Promote, s); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
 	}
-	out.Name = in.Name
-	out.Email = in.Email
 	return nil
 }
 
-func Convert_v1beta3_SourceControlUser_To_api_SourceControlUser(in *v1beta3.SourceControlUser, out *buildapi.SourceControlUser, s conversion.Scope) error {
-	return autoConvert_v1beta3_SourceControlUser_To_api_SourceControlUser(in, out, s)
+func Convert_v1beta3_PipelineStage_To_api_PipelineStage(in *v1beta3.PipelineStage, out *buildapi.PipelineStage, s conversion.Scope) error {
+	return autoConvert_v1beta3_PipelineStage_To_api_PipelineStage(in, out, s)
 }
 
-func autoConvert_v1beta3_SourceRevision_To_api_SourceRevision(in *v1beta3.SourceRevision, out *buildapi.SourceRevision, s conversion.Scope) error {
+func autoConvert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus(in *v1beta3.PipelineStageStatus, out *buildapi.PipelineStageStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*v1beta3.SourceRevision))(in)
+		defaulting.(func(*v1beta3.PipelineStageStatus))(in)
 	}
-	// in.Type has no peer in out
-	// unable to generate simple pointer conversion for v1beta3.GitSourceRevision -> api.GitSourceRevision
-	if in.Git != nil {
-		out.Git = new(buildapi.GitSourceRevision)
-		if err := Convert_v1beta3_GitSourceRevision_To_api_GitSourceRevision(in.Git, out.Git, s); err != nil {
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	out.Build = in.Build
+	out.Pod = in.Pod
+	out.Message = in.Message
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
 			return err
 		}
 	} else {
-		out.Git = nil
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
 	}
 	return nil
 }
 
-func autoConvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *v1beta3.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
+func Convert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus(in *v1beta3.PipelineStageStatus, out *buildapi.PipelineStageStatus, s conversion.Scope) error {
+	return autoConvert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus(in, out, s)
+}
+
+func autoConvert_v1beta3_PipelineTestStage_To_api_PipelineTestStage(in *v1beta3.PipelineTestStage, out *buildapi.PipelineTestStage, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*v1beta3.WebHookTrigger))(in)
+		defaulting.(func(*v1beta3.PipelineTestStage))(in)
+	}
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.Image, &out.Image, s); err != nil {
+		return err
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_PipelineTestStage_To_api_PipelineTestStage(in *v1beta3.PipelineTestStage, out *buildapi.PipelineTestStage, s conversion.Scope) error {
+	return autoConvert_v1beta3_PipelineTestStage_To_api_PipelineTestStage(in, out, s)
+}
+
+func autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *v1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SecretBuildSource))(in)
+	}
+	if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func Convert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *v1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
+	return autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in, out, s)
+}
+
+func autoConvert_v1beta3_SecretSpec_To_api_SecretSpec(in *v1beta3.SecretSpec, out *buildapi.SecretSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SecretSpec))(in)
+	}
+	if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.SecretSource, &out.SecretSource, s); err != nil {
+		return err
+	}
+	out.MountPath = in.MountPath
+	return nil
+}
+
+func Convert_v1beta3_SecretSpec_To_api_SecretSpec(in *v1beta3.SecretSpec, out *buildapi.SecretSpec, s conversion.Scope) error {
+	return autoConvert_v1beta3_SecretSpec_To_api_SecretSpec(in, out, s)
+}
+
+func autoConvert_v1beta3_SlackNotification_To_api_SlackNotification(in *v1beta3.SlackNotification, out *buildapi.SlackNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SlackNotification))(in)
+	}
+	out.URL = in.URL
+	out.Channel = in.Channel
+	return nil
+}
+
+func Convert_v1beta3_SlackNotification_To_api_SlackNotification(in *v1beta3.SlackNotification, out *buildapi.SlackNotification, s conversion.Scope) error {
+	return autoConvert_v1beta3_SlackNotification_To_api_SlackNotification(in, out, s)
+}
+
+func autoConvert_v1beta3_SourceBuildStrategy_To_api_SourceBuildStrategy(in *v1beta3.SourceBuildStrategy, out *buildapi.SourceBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SourceBuildStrategy))(in)
+	}
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	// unable to generate simple pointer conversion for v1beta3.LocalObjectReference -> api.LocalObjectReference
+	if in.PullSecret != nil {
+		out.PullSecret = new(api.LocalObjectReference)
+		if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.PullSecret, out.PullSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullSecret = nil
+	}
+	if in.Env != nil {
+		out.Env = make([]api.EnvVar, len(in.Env))
+		for i := range in.Env {
+			if err := s.Convert(&in.Env[i], &out.Env[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
+	}
+	out.Scripts = in.Scripts
+	out.Incremental = in.Incremental
+	out.ForcePull = in.ForcePull
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]buildapi.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := Convert_v1beta3_BuildCacheVolume_To_api_BuildCacheVolume(&in.CacheVolumes[i], &out.CacheVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func autoConvert_v1beta3_SourceControlUser_To_api_SourceControlUser(in *v1beta3.SourceControlUser, out *buildapi.SourceControlUser, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SourceControlUser))(in)
+	}
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+func Convert_v1beta3_SourceControlUser_To_api_SourceControlUser(in *v1beta3.SourceControlUser, out *buildapi.SourceControlUser, s conversion.Scope) error {
+	return autoConvert_v1beta3_SourceControlUser_To_api_SourceControlUser(in, out, s)
+}
+
+func autoConvert_v1beta3_SourceRevision_To_api_SourceRevision(in *v1beta3.SourceRevision, out *buildapi.SourceRevision, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.SourceRevision))(in)
+	}
+	// in.Type has no peer in out
+	// unable to generate simple pointer conversion for v1beta3.GitSourceRevision -> api.GitSourceRevision
+	if in.Git != nil {
+		out.Git = new(buildapi.GitSourceRevision)
+		if err := Convert_v1beta3_GitSourceRevision_To_api_GitSourceRevision(in.Git, out.Git, s); err != nil {
+			return err
+		}
+	} else {
+		out.Git = nil
+	}
+	return nil
+}
+
+func autoConvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *v1beta3.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.WebHookTrigger))(in)
+	}
+	out.Secret = in.Secret
+	// unable to generate simple pointer conversion for v1beta3.LocalObjectReference -> api.LocalObjectReference
+	if in.SignatureSecret != nil {
+		out.SignatureSecret = new(api.LocalObjectReference)
+		if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.SignatureSecret, out.SignatureSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureSecret = nil
+	}
+	out.AllowPullRequests = in.AllowPullRequests
+	if in.IncludePaths != nil {
+		out.IncludePaths = make([]string, len(in.IncludePaths))
+		for i := range in.IncludePaths {
+			out.IncludePaths[i] = in.IncludePaths[i]
+		}
+	} else {
+		out.IncludePaths = nil
+	}
+	if in.ExcludePaths != nil {
+		out.ExcludePaths = make([]string, len(in.ExcludePaths))
+		for i := range in.ExcludePaths {
+			out.ExcludePaths[i] = in.ExcludePaths[i]
+		}
+	} else {
+		out.ExcludePaths = nil
 	}
-	out.Secret = in.Secret
 	return nil
 }
 
@@ -2489,6 +3397,31 @@ func Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *v1beta3.WebHookTri
 	return autoConvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoConvert_v1beta3_WebhookNotification_To_api_WebhookNotification(in *v1beta3.WebhookNotification, out *buildapi.WebhookNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.WebhookNotification))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_v1beta3_WebhookNotification_To_api_WebhookNotification(in *v1beta3.WebhookNotification, out *buildapi.WebhookNotification, s conversion.Scope) error {
+	return autoConvert_v1beta3_WebhookNotification_To_api_WebhookNotification(in, out, s)
+}
+
+func autoConvert_api_ContainerImage_To_v1beta3_ContainerImage(in *deployapi.ContainerImage, out *deployapiv1beta3.ContainerImage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.ContainerImage))(in)
+	}
+	out.ContainerName = in.ContainerName
+	out.Image = in.Image
+	return nil
+}
+
+func Convert_api_ContainerImage_To_v1beta3_ContainerImage(in *deployapi.ContainerImage, out *deployapiv1beta3.ContainerImage, s conversion.Scope) error {
+	return autoConvert_api_ContainerImage_To_v1beta3_ContainerImage(in, out, s)
+}
+
 func autoConvert_api_DeploymentCause_To_v1beta3_DeploymentCause(in *deployapi.DeploymentCause, out *deployapiv1beta3.DeploymentCause, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentCause))(in)
@@ -2503,6 +3436,7 @@ func autoConvert_api_DeploymentCause_To_v1beta3_DeploymentCause(in *deployapi.De
 	} else {
 		out.ImageTrigger = nil
 	}
+	out.Message = in.Message
 	return nil
 }
 
@@ -2524,6 +3458,30 @@ func Convert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrig
 	return autoConvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_api_DeploymentConfigHistory_To_v1beta3_DeploymentConfigHistory(in *deployapi.DeploymentConfigHistory, out *deployapiv1beta3.DeploymentConfigHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigHistory))(in)
+	}
+	if err := Convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Deployments != nil {
+		out.Deployments = make([]deployapiv1beta3.DeploymentRecord, len(in.Deployments))
+		for i := range in.Deployments {
+			if err := Convert_api_DeploymentRecord_To_v1beta3_DeploymentRecord(&in.Deployments[i], &out.Deployments[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Deployments = nil
+	}
+	return nil
+}
+
+func Convert_api_DeploymentConfigHistory_To_v1beta3_DeploymentConfigHistory(in *deployapi.DeploymentConfigHistory, out *deployapiv1beta3.DeploymentConfigHistory, s conversion.Scope) error {
+	return autoConvert_api_DeploymentConfigHistory_To_v1beta3_DeploymentConfigHistory(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback(in *deployapi.DeploymentConfigRollback, out *deployapiv1beta3.DeploymentConfigRollback, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfigRollback))(in)
@@ -2578,6 +3536,20 @@ func Convert_api_DeploymentDetails_To_v1beta3_DeploymentDetails(in *deployapi.De
 	return autoConvert_api_DeploymentDetails_To_v1beta3_DeploymentDetails(in, out, s)
 }
 
+func autoConvert_api_DeploymentHookResult_To_v1beta3_DeploymentHookResult(in *deployapi.DeploymentHookResult, out *deployapiv1beta3.DeploymentHookResult, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentHookResult))(in)
+	}
+	out.Name = in.Name
+	out.Phase = apiv1beta3.PodPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_api_DeploymentHookResult_To_v1beta3_DeploymentHookResult(in *deployapi.DeploymentHookResult, out *deployapiv1beta3.DeploymentHookResult, s conversion.Scope) error {
+	return autoConvert_api_DeploymentHookResult_To_v1beta3_DeploymentHookResult(in, out, s)
+}
+
 func autoConvert_api_DeploymentLog_To_v1beta3_DeploymentLog(in *deployapi.DeploymentLog, out *deployapiv1beta3.DeploymentLog, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentLog))(in)
@@ -2638,6 +3610,72 @@ func Convert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *deploy
 	return autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_api_DeploymentRecord_To_v1beta3_DeploymentRecord(in *deployapi.DeploymentRecord, out *deployapiv1beta3.DeploymentRecord, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentRecord))(in)
+	}
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Phase = deployapiv1beta3.DeploymentPhase(in.Phase)
+	// unable to generate simple pointer conversion for api.DeploymentDetails -> v1beta3.DeploymentDetails
+	if in.Details != nil {
+		out.Details = new(deployapiv1beta3.DeploymentDetails)
+		if err := Convert_api_DeploymentDetails_To_v1beta3_DeploymentDetails(in.Details, out.Details, s); err != nil {
+			return err
+		}
+	} else {
+		out.Details = nil
+	}
+	out.Strategy = deployapiv1beta3.DeploymentStrategyType(in.Strategy)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTime != nil {
+		out.StartTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTime, out.StartTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTime = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTime != nil {
+		out.CompletionTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTime, out.CompletionTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTime = nil
+	}
+	if in.DurationSeconds != nil {
+		out.DurationSeconds = new(int64)
+		*out.DurationSeconds = *in.DurationSeconds
+	} else {
+		out.DurationSeconds = nil
+	}
+	if in.PreviousReplicas != nil {
+		out.PreviousReplicas = new(int)
+		*out.PreviousReplicas = *in.PreviousReplicas
+	} else {
+		out.PreviousReplicas = nil
+	}
+	out.Replicas = in.Replicas
+	if in.Hooks != nil {
+		out.Hooks = make([]deployapiv1beta3.DeploymentHookResult, len(in.Hooks))
+		for i := range in.Hooks {
+			if err := Convert_api_DeploymentHookResult_To_v1beta3_DeploymentHookResult(&in.Hooks[i], &out.Hooks[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Hooks = nil
+	}
+	out.Reason = in.Reason
+	return nil
+}
+
+func Convert_api_DeploymentRecord_To_v1beta3_DeploymentRecord(in *deployapi.DeploymentRecord, out *deployapiv1beta3.DeploymentRecord, s conversion.Scope) error {
+	return autoConvert_api_DeploymentRecord_To_v1beta3_DeploymentRecord(in, out, s)
+}
+
 func autoConvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams(in *deployapi.DeploymentTriggerImageChangeParams, out *deployapiv1beta3.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentTriggerImageChangeParams))(in)
@@ -2678,6 +3716,108 @@ func Convert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in *
 	return autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoConvert_api_DeploymentVerification_To_v1beta3_DeploymentVerification(in *deployapi.DeploymentVerification, out *deployapiv1beta3.DeploymentVerification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentVerification))(in)
+	}
+	// unable to generate simple pointer conversion for api.PrometheusQuery -> v1beta3.PrometheusQuery
+	if in.Prometheus != nil {
+		out.Prometheus = new(deployapiv1beta3.PrometheusQuery)
+		if err := Convert_api_PrometheusQuery_To_v1beta3_PrometheusQuery(in.Prometheus, out.Prometheus, s); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
+	// unable to generate simple pointer conversion for api.HTTPGetMetric -> v1beta3.HTTPGetMetric
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1beta3.HTTPGetMetric)
+		if err := Convert_api_HTTPGetMetric_To_v1beta3_HTTPGetMetric(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	out.MinValue = in.MinValue
+	out.MaxValue = in.MaxValue
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_DeploymentVerification_To_v1beta3_DeploymentVerification(in *deployapi.DeploymentVerification, out *deployapiv1beta3.DeploymentVerification, s conversion.Scope) error {
+	return autoConvert_api_DeploymentVerification_To_v1beta3_DeploymentVerification(in, out, s)
+}
+
+func autoConvert_api_DeploymentWindow_To_v1beta3_DeploymentWindow(in *deployapi.DeploymentWindow, out *deployapiv1beta3.DeploymentWindow, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentWindow))(in)
+	}
+	out.Schedule = in.Schedule
+	out.DurationMinutes = in.DurationMinutes
+	return nil
+}
+
+func Convert_api_DeploymentWindow_To_v1beta3_DeploymentWindow(in *deployapi.DeploymentWindow, out *deployapiv1beta3.DeploymentWindow, s conversion.Scope) error {
+	return autoConvert_api_DeploymentWindow_To_v1beta3_DeploymentWindow(in, out, s)
+}
+
+func autoConvert_api_HTTPGetHook_To_v1beta3_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1beta3.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.HTTPGetHook))(in)
+	}
+	out.URL = in.URL
+	out.Method = in.Method
+	out.ExpectedStatus = in.ExpectedStatus
+	out.Retries = in.Retries
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_HTTPGetHook_To_v1beta3_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1beta3.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_api_HTTPGetHook_To_v1beta3_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_api_HTTPGetMetric_To_v1beta3_HTTPGetMetric(in *deployapi.HTTPGetMetric, out *deployapiv1beta3.HTTPGetMetric, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.HTTPGetMetric))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_api_HTTPGetMetric_To_v1beta3_HTTPGetMetric(in *deployapi.HTTPGetMetric, out *deployapiv1beta3.HTTPGetMetric, s conversion.Scope) error {
+	return autoConvert_api_HTTPGetMetric_To_v1beta3_HTTPGetMetric(in, out, s)
+}
+
+func autoConvert_api_PrometheusQuery_To_v1beta3_PrometheusQuery(in *deployapi.PrometheusQuery, out *deployapiv1beta3.PrometheusQuery, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.PrometheusQuery))(in)
+	}
+	out.URL = in.URL
+	out.Query = in.Query
+	return nil
+}
+
+func Convert_api_PrometheusQuery_To_v1beta3_PrometheusQuery(in *deployapi.PrometheusQuery, out *deployapiv1beta3.PrometheusQuery, s conversion.Scope) error {
+	return autoConvert_api_PrometheusQuery_To_v1beta3_PrometheusQuery(in, out, s)
+}
+
 func autoConvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStrategyParams(in *deployapi.RollingDeploymentStrategyParams, out *deployapiv1beta3.RollingDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.RollingDeploymentStrategyParams))(in)
@@ -2721,6 +3861,14 @@ func autoConvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymen
 		out.Pre = nil
 	}
 	// unable to generate simple pointer conversion for api.LifecycleHook -> v1beta3.LifecycleHook
+	if in.Batch != nil {
+		if err := s.Convert(&in.Batch, &out.Batch, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Batch = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1beta3.LifecycleHook
 	if in.Post != nil {
 		if err := s.Convert(&in.Post, &out.Post, 0); err != nil {
 			return err
@@ -2731,6 +3879,25 @@ func autoConvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymen
 	return nil
 }
 
+func autoConvert_api_TCPSocketHook_To_v1beta3_TCPSocketHook(in *deployapi.TCPSocketHook, out *deployapiv1beta3.TCPSocketHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.TCPSocketHook))(in)
+	}
+	out.Host = in.Host
+	out.Port = in.Port
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_TCPSocketHook_To_v1beta3_TCPSocketHook(in *deployapi.TCPSocketHook, out *deployapiv1beta3.TCPSocketHook, s conversion.Scope) error {
+	return autoConvert_api_TCPSocketHook_To_v1beta3_TCPSocketHook(in, out, s)
+}
+
 func autoConvert_api_TagImageHook_To_v1beta3_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1beta3.TagImageHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.TagImageHook))(in)
@@ -2746,6 +3913,19 @@ func Convert_api_TagImageHook_To_v1beta3_TagImageHook(in *deployapi.TagImageHook
 	return autoConvert_api_TagImageHook_To_v1beta3_TagImageHook(in, out, s)
 }
 
+func autoConvert_v1beta3_ContainerImage_To_api_ContainerImage(in *deployapiv1beta3.ContainerImage, out *deployapi.ContainerImage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.ContainerImage))(in)
+	}
+	out.ContainerName = in.ContainerName
+	out.Image = in.Image
+	return nil
+}
+
+func Convert_v1beta3_ContainerImage_To_api_ContainerImage(in *deployapiv1beta3.ContainerImage, out *deployapi.ContainerImage, s conversion.Scope) error {
+	return autoConvert_v1beta3_ContainerImage_To_api_ContainerImage(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentCause_To_api_DeploymentCause(in *deployapiv1beta3.DeploymentCause, out *deployapi.DeploymentCause, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentCause))(in)
@@ -2760,6 +3940,7 @@ func autoConvert_v1beta3_DeploymentCause_To_api_DeploymentCause(in *deployapiv1b
 	} else {
 		out.ImageTrigger = nil
 	}
+	out.Message = in.Message
 	return nil
 }
 
@@ -2781,6 +3962,30 @@ func Convert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrig
 	return autoConvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentConfigHistory_To_api_DeploymentConfigHistory(in *deployapiv1beta3.DeploymentConfigHistory, out *deployapi.DeploymentConfigHistory, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentConfigHistory))(in)
+	}
+	if err := Convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Deployments != nil {
+		out.Deployments = make([]deployapi.DeploymentRecord, len(in.Deployments))
+		for i := range in.Deployments {
+			if err := Convert_v1beta3_DeploymentRecord_To_api_DeploymentRecord(&in.Deployments[i], &out.Deployments[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Deployments = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_DeploymentConfigHistory_To_api_DeploymentConfigHistory(in *deployapiv1beta3.DeploymentConfigHistory, out *deployapi.DeploymentConfigHistory, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentConfigHistory_To_api_DeploymentConfigHistory(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback(in *deployapiv1beta3.DeploymentConfigRollback, out *deployapi.DeploymentConfigRollback, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentConfigRollback))(in)
@@ -2827,6 +4032,16 @@ func autoConvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in
 	} else {
 		out.Details = nil
 	}
+	if in.LastTriggeredImages != nil {
+		out.LastTriggeredImages = make([]deployapi.ContainerImage, len(in.LastTriggeredImages))
+		for i := range in.LastTriggeredImages {
+			if err := Convert_v1beta3_ContainerImage_To_api_ContainerImage(&in.LastTriggeredImages[i], &out.LastTriggeredImages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LastTriggeredImages = nil
+	}
 	return nil
 }
 
@@ -2856,6 +4071,20 @@ func Convert_v1beta3_DeploymentDetails_To_api_DeploymentDetails(in *deployapiv1b
 	return autoConvert_v1beta3_DeploymentDetails_To_api_DeploymentDetails(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentHookResult_To_api_DeploymentHookResult(in *deployapiv1beta3.DeploymentHookResult, out *deployapi.DeploymentHookResult, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentHookResult))(in)
+	}
+	out.Name = in.Name
+	out.Phase = api.PodPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_v1beta3_DeploymentHookResult_To_api_DeploymentHookResult(in *deployapiv1beta3.DeploymentHookResult, out *deployapi.DeploymentHookResult, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentHookResult_To_api_DeploymentHookResult(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentLog_To_api_DeploymentLog(in *deployapiv1beta3.DeploymentLog, out *deployapi.DeploymentLog, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentLog))(in)
@@ -2916,6 +4145,72 @@ func Convert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions(in *deploy
 	return autoConvert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentRecord_To_api_DeploymentRecord(in *deployapiv1beta3.DeploymentRecord, out *deployapi.DeploymentRecord, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentRecord))(in)
+	}
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Phase = deployapi.DeploymentStatus(in.Phase)
+	// unable to generate simple pointer conversion for v1beta3.DeploymentDetails -> api.DeploymentDetails
+	if in.Details != nil {
+		out.Details = new(deployapi.DeploymentDetails)
+		if err := Convert_v1beta3_DeploymentDetails_To_api_DeploymentDetails(in.Details, out.Details, s); err != nil {
+			return err
+		}
+	} else {
+		out.Details = nil
+	}
+	out.Strategy = deployapi.DeploymentStrategyType(in.Strategy)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTime != nil {
+		out.StartTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTime, out.StartTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTime = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTime != nil {
+		out.CompletionTime = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTime, out.CompletionTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTime = nil
+	}
+	if in.DurationSeconds != nil {
+		out.DurationSeconds = new(int64)
+		*out.DurationSeconds = *in.DurationSeconds
+	} else {
+		out.DurationSeconds = nil
+	}
+	if in.PreviousReplicas != nil {
+		out.PreviousReplicas = new(int)
+		*out.PreviousReplicas = *in.PreviousReplicas
+	} else {
+		out.PreviousReplicas = nil
+	}
+	out.Replicas = in.Replicas
+	if in.Hooks != nil {
+		out.Hooks = make([]deployapi.DeploymentHookResult, len(in.Hooks))
+		for i := range in.Hooks {
+			if err := Convert_v1beta3_DeploymentHookResult_To_api_DeploymentHookResult(&in.Hooks[i], &out.Hooks[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Hooks = nil
+	}
+	out.Reason = in.Reason
+	return nil
+}
+
+func Convert_v1beta3_DeploymentRecord_To_api_DeploymentRecord(in *deployapiv1beta3.DeploymentRecord, out *deployapi.DeploymentRecord, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentRecord_To_api_DeploymentRecord(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams(in *deployapiv1beta3.DeploymentTriggerImageChangeParams, out *deployapi.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentTriggerImageChangeParams))(in)
@@ -2956,6 +4251,108 @@ func Convert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *
 	return autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentVerification_To_api_DeploymentVerification(in *deployapiv1beta3.DeploymentVerification, out *deployapi.DeploymentVerification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentVerification))(in)
+	}
+	// unable to generate simple pointer conversion for v1beta3.PrometheusQuery -> api.PrometheusQuery
+	if in.Prometheus != nil {
+		out.Prometheus = new(deployapi.PrometheusQuery)
+		if err := Convert_v1beta3_PrometheusQuery_To_api_PrometheusQuery(in.Prometheus, out.Prometheus, s); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.HTTPGetMetric -> api.HTTPGetMetric
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.HTTPGetMetric)
+		if err := Convert_v1beta3_HTTPGetMetric_To_api_HTTPGetMetric(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	out.MinValue = in.MinValue
+	out.MaxValue = in.MaxValue
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_DeploymentVerification_To_api_DeploymentVerification(in *deployapiv1beta3.DeploymentVerification, out *deployapi.DeploymentVerification, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentVerification_To_api_DeploymentVerification(in, out, s)
+}
+
+func autoConvert_v1beta3_DeploymentWindow_To_api_DeploymentWindow(in *deployapiv1beta3.DeploymentWindow, out *deployapi.DeploymentWindow, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentWindow))(in)
+	}
+	out.Schedule = in.Schedule
+	out.DurationMinutes = in.DurationMinutes
+	return nil
+}
+
+func Convert_v1beta3_DeploymentWindow_To_api_DeploymentWindow(in *deployapiv1beta3.DeploymentWindow, out *deployapi.DeploymentWindow, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentWindow_To_api_DeploymentWindow(in, out, s)
+}
+
+func autoConvert_v1beta3_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1beta3.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.HTTPGetHook))(in)
+	}
+	out.URL = in.URL
+	out.Method = in.Method
+	out.ExpectedStatus = in.ExpectedStatus
+	out.Retries = in.Retries
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1beta3.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_v1beta3_HTTPGetHook_To_api_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_v1beta3_HTTPGetMetric_To_api_HTTPGetMetric(in *deployapiv1beta3.HTTPGetMetric, out *deployapi.HTTPGetMetric, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.HTTPGetMetric))(in)
+	}
+	out.URL = in.URL
+	return nil
+}
+
+func Convert_v1beta3_HTTPGetMetric_To_api_HTTPGetMetric(in *deployapiv1beta3.HTTPGetMetric, out *deployapi.HTTPGetMetric, s conversion.Scope) error {
+	return autoConvert_v1beta3_HTTPGetMetric_To_api_HTTPGetMetric(in, out, s)
+}
+
+func autoConvert_v1beta3_PrometheusQuery_To_api_PrometheusQuery(in *deployapiv1beta3.PrometheusQuery, out *deployapi.PrometheusQuery, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.PrometheusQuery))(in)
+	}
+	out.URL = in.URL
+	out.Query = in.Query
+	return nil
+}
+
+func Convert_v1beta3_PrometheusQuery_To_api_PrometheusQuery(in *deployapiv1beta3.PrometheusQuery, out *deployapi.PrometheusQuery, s conversion.Scope) error {
+	return autoConvert_v1beta3_PrometheusQuery_To_api_PrometheusQuery(in, out, s)
+}
+
 func autoConvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams(in *deployapiv1beta3.RollingDeploymentStrategyParams, out *deployapi.RollingDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.RollingDeploymentStrategyParams))(in)
@@ -2995,6 +4392,14 @@ func autoConvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymen
 		out.Pre = nil
 	}
 	// unable to generate simple pointer conversion for v1beta3.LifecycleHook -> api.LifecycleHook
+	if in.Batch != nil {
+		if err := s.Convert(&in.Batch, &out.Batch, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Batch = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.LifecycleHook -> api.LifecycleHook
 	if in.Post != nil {
 		if err := s.Convert(&in.Post, &out.Post, 0); err != nil {
 			return err
@@ -3005,6 +4410,25 @@ func autoConvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymen
 	return nil
 }
 
+func autoConvert_v1beta3_TCPSocketHook_To_api_TCPSocketHook(in *deployapiv1beta3.TCPSocketHook, out *deployapi.TCPSocketHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.TCPSocketHook))(in)
+	}
+	out.Host = in.Host
+	out.Port = in.Port
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_TCPSocketHook_To_api_TCPSocketHook(in *deployapiv1beta3.TCPSocketHook, out *deployapi.TCPSocketHook, s conversion.Scope) error {
+	return autoConvert_v1beta3_TCPSocketHook_To_api_TCPSocketHook(in, out, s)
+}
+
 func autoConvert_v1beta3_TagImageHook_To_api_TagImageHook(in *deployapiv1beta3.TagImageHook, out *deployapi.TagImageHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.TagImageHook))(in)
@@ -6603,6 +8027,7 @@ func init() {
 		autoConvert_api_AWSElasticBlockStoreVolumeSource_To_v1beta3_AWSElasticBlockStoreVolumeSource,
 		autoConvert_api_BinaryBuildRequestOptions_To_v1beta3_BinaryBuildRequestOptions,
 		autoConvert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource,
+		autoConvert_api_BuildCacheVolume_To_v1beta3_BuildCacheVolume,
 		autoConvert_api_BuildConfigList_To_v1beta3_BuildConfigList,
 		autoConvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec,
 		autoConvert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus,
@@ -6610,7 +8035,11 @@ func init() {
 		autoConvert_api_BuildList_To_v1beta3_BuildList,
 		autoConvert_api_BuildLogOptions_To_v1beta3_BuildLogOptions,
 		autoConvert_api_BuildLog_To_v1beta3_BuildLog,
+		autoConvert_api_BuildMatrixParameter_To_v1beta3_BuildMatrixParameter,
+		autoConvert_api_BuildNotification_To_v1beta3_BuildNotification,
 		autoConvert_api_BuildOutput_To_v1beta3_BuildOutput,
+		autoConvert_api_BuildParameterValue_To_v1beta3_BuildParameterValue,
+		autoConvert_api_BuildParameter_To_v1beta3_BuildParameter,
 		autoConvert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec,
 		autoConvert_api_BuildSource_To_v1beta3_BuildSource,
 		autoConvert_api_BuildSpec_To_v1beta3_BuildSpec,
@@ -6629,31 +8058,42 @@ func init() {
 		autoConvert_api_ClusterRoleBinding_To_v1beta3_ClusterRoleBinding,
 		autoConvert_api_ClusterRoleList_To_v1beta3_ClusterRoleList,
 		autoConvert_api_ClusterRole_To_v1beta3_ClusterRole,
+		autoConvert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting,
+		autoConvert_api_ContainerImage_To_v1beta3_ContainerImage,
 		autoConvert_api_ContainerPort_To_v1beta3_ContainerPort,
 		autoConvert_api_Container_To_v1beta3_Container,
 		autoConvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy,
 		autoConvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger,
 		autoConvert_api_DeploymentCause_To_v1beta3_DeploymentCause,
+		autoConvert_api_DeploymentConfigHistory_To_v1beta3_DeploymentConfigHistory,
 		autoConvert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRollbackSpec,
 		autoConvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback,
 		autoConvert_api_DeploymentDetails_To_v1beta3_DeploymentDetails,
+		autoConvert_api_DeploymentHookResult_To_v1beta3_DeploymentHookResult,
 		autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions,
 		autoConvert_api_DeploymentLog_To_v1beta3_DeploymentLog,
+		autoConvert_api_DeploymentRecord_To_v1beta3_DeploymentRecord,
 		autoConvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams,
 		autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy,
+		autoConvert_api_DeploymentVerification_To_v1beta3_DeploymentVerification,
+		autoConvert_api_DeploymentWindow_To_v1beta3_DeploymentWindow,
 		autoConvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy,
 		autoConvert_api_DownwardAPIVolumeFile_To_v1beta3_DownwardAPIVolumeFile,
 		autoConvert_api_DownwardAPIVolumeSource_To_v1beta3_DownwardAPIVolumeSource,
+		autoConvert_api_EmailNotification_To_v1beta3_EmailNotification,
 		autoConvert_api_EmptyDirVolumeSource_To_v1beta3_EmptyDirVolumeSource,
 		autoConvert_api_ExecAction_To_v1beta3_ExecAction,
 		autoConvert_api_FCVolumeSource_To_v1beta3_FCVolumeSource,
 		autoConvert_api_FlockerVolumeSource_To_v1beta3_FlockerVolumeSource,
 		autoConvert_api_GCEPersistentDiskVolumeSource_To_v1beta3_GCEPersistentDiskVolumeSource,
 		autoConvert_api_GitBuildSource_To_v1beta3_GitBuildSource,
+		autoConvert_api_GitPullRequest_To_v1beta3_GitPullRequest,
 		autoConvert_api_GitSourceRevision_To_v1beta3_GitSourceRevision,
 		autoConvert_api_GlusterfsVolumeSource_To_v1beta3_GlusterfsVolumeSource,
 		autoConvert_api_GroupList_To_v1beta3_GroupList,
 		autoConvert_api_Group_To_v1beta3_Group,
+		autoConvert_api_HTTPGetHook_To_v1beta3_HTTPGetHook,
+		autoConvert_api_HTTPGetMetric_To_v1beta3_HTTPGetMetric,
 		autoConvert_api_HostPathVolumeSource_To_v1beta3_HostPathVolumeSource,
 		autoConvert_api_HostSubnetList_To_v1beta3_HostSubnetList,
 		autoConvert_api_HostSubnet_To_v1beta3_HostSubnet,
@@ -6692,6 +8132,12 @@ func init() {
 		autoConvert_api_ObjectReference_To_v1beta3_ObjectReference,
 		autoConvert_api_Parameter_To_v1beta3_Parameter,
 		autoConvert_api_PersistentVolumeClaimVolumeSource_To_v1beta3_PersistentVolumeClaimVolumeSource,
+		autoConvert_api_PipelineBuildStage_To_v1beta3_PipelineBuildStage,
+		autoConvert_api_PipelineBuildStrategy_To_v1beta3_PipelineBuildStrategy,
+		autoConvert_api_PipelinePromoteStage_To_v1beta3_PipelinePromoteStage,
+		autoConvert_api_PipelineStageStatus_To_v1beta3_PipelineStageStatus,
+		autoConvert_api_PipelineStage_To_v1beta3_PipelineStage,
+		autoConvert_api_PipelineTestStage_To_v1beta3_PipelineTestStage,
 		autoConvert_api_PodSpec_To_v1beta3_PodSpec,
 		autoConvert_api_PodTemplateSpec_To_v1beta3_PodTemplateSpec,
 		autoConvert_api_PolicyBindingList_To_v1beta3_PolicyBindingList,
@@ -6704,6 +8150,7 @@ func init() {
 		autoConvert_api_ProjectSpec_To_v1beta3_ProjectSpec,
 		autoConvert_api_ProjectStatus_To_v1beta3_ProjectStatus,
 		autoConvert_api_Project_To_v1beta3_Project,
+		autoConvert_api_PrometheusQuery_To_v1beta3_PrometheusQuery,
 		autoConvert_api_RBDVolumeSource_To_v1beta3_RBDVolumeSource,
 		autoConvert_api_ResourceAccessReviewResponse_To_v1beta3_ResourceAccessReviewResponse,
 		autoConvert_api_ResourceAccessReview_To_v1beta3_ResourceAccessReview,
@@ -6723,12 +8170,14 @@ func init() {
 		autoConvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource,
 		autoConvert_api_SecretSpec_To_v1beta3_SecretSpec,
 		autoConvert_api_SecretVolumeSource_To_v1beta3_SecretVolumeSource,
+		autoConvert_api_SlackNotification_To_v1beta3_SlackNotification,
 		autoConvert_api_SourceBuildStrategy_To_v1beta3_SourceBuildStrategy,
 		autoConvert_api_SourceControlUser_To_v1beta3_SourceControlUser,
 		autoConvert_api_SourceRevision_To_v1beta3_SourceRevision,
 		autoConvert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewResponse,
 		autoConvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview,
 		autoConvert_api_TCPSocketAction_To_v1beta3_TCPSocketAction,
+		autoConvert_api_TCPSocketHook_To_v1beta3_TCPSocketHook,
 		autoConvert_api_TLSConfig_To_v1beta3_TLSConfig,
 		autoConvert_api_TagImageHook_To_v1beta3_TagImageHook,
 		autoConvert_api_TemplateList_To_v1beta3_TemplateList,
@@ -6740,9 +8189,11 @@ func init() {
 		autoConvert_api_VolumeSource_To_v1beta3_VolumeSource,
 		autoConvert_api_Volume_To_v1beta3_Volume,
 		autoConvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger,
+		autoConvert_api_WebhookNotification_To_v1beta3_WebhookNotification,
 		autoConvert_v1beta3_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoConvert_v1beta3_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoConvert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource,
+		autoConvert_v1beta3_BuildCacheVolume_To_api_BuildCacheVolume,
 		autoConvert_v1beta3_BuildConfigList_To_api_BuildConfigList,
 		autoConvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoConvert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -6750,7 +8201,11 @@ func init() {
 		autoConvert_v1beta3_BuildList_To_api_BuildList,
 		autoConvert_v1beta3_BuildLogOptions_To_api_BuildLogOptions,
 		autoConvert_v1beta3_BuildLog_To_api_BuildLog,
+		autoConvert_v1beta3_BuildMatrixParameter_To_api_BuildMatrixParameter,
+		autoConvert_v1beta3_BuildNotification_To_api_BuildNotification,
 		autoConvert_v1beta3_BuildOutput_To_api_BuildOutput,
+		autoConvert_v1beta3_BuildParameterValue_To_api_BuildParameterValue,
+		autoConvert_v1beta3_BuildParameter_To_api_BuildParameter,
 		autoConvert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec,
 		autoConvert_v1beta3_BuildSource_To_api_BuildSource,
 		autoConvert_v1beta3_BuildSpec_To_api_BuildSpec,
@@ -6769,32 +8224,43 @@ func init() {
 		autoConvert_v1beta3_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoConvert_v1beta3_ClusterRoleList_To_api_ClusterRoleList,
 		autoConvert_v1beta3_ClusterRole_To_api_ClusterRole,
+		autoConvert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting,
+		autoConvert_v1beta3_ContainerImage_To_api_ContainerImage,
 		autoConvert_v1beta3_ContainerPort_To_api_ContainerPort,
 		autoConvert_v1beta3_Container_To_api_Container,
 		autoConvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		autoConvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
 		autoConvert_v1beta3_DeploymentCause_To_api_DeploymentCause,
+		autoConvert_v1beta3_DeploymentConfigHistory_To_api_DeploymentConfigHistory,
 		autoConvert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoConvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
 		autoConvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus,
 		autoConvert_v1beta3_DeploymentDetails_To_api_DeploymentDetails,
+		autoConvert_v1beta3_DeploymentHookResult_To_api_DeploymentHookResult,
 		autoConvert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions,
 		autoConvert_v1beta3_DeploymentLog_To_api_DeploymentLog,
+		autoConvert_v1beta3_DeploymentRecord_To_api_DeploymentRecord,
 		autoConvert_v1beta3_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
 		autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
+		autoConvert_v1beta3_DeploymentVerification_To_api_DeploymentVerification,
+		autoConvert_v1beta3_DeploymentWindow_To_api_DeploymentWindow,
 		autoConvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy,
 		autoConvert_v1beta3_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
 		autoConvert_v1beta3_DownwardAPIVolumeSource_To_api_DownwardAPIVolumeSource,
+		autoConvert_v1beta3_EmailNotification_To_api_EmailNotification,
 		autoConvert_v1beta3_EmptyDirVolumeSource_To_api_EmptyDirVolumeSource,
 		autoConvert_v1beta3_ExecAction_To_api_ExecAction,
 		autoConvert_v1beta3_FCVolumeSource_To_api_FCVolumeSource,
 		autoConvert_v1beta3_FlockerVolumeSource_To_api_FlockerVolumeSource,
 		autoConvert_v1beta3_GCEPersistentDiskVolumeSource_To_api_GCEPersistentDiskVolumeSource,
 		autoConvert_v1beta3_GitBuildSource_To_api_GitBuildSource,
+		autoConvert_v1beta3_GitPullRequest_To_api_GitPullRequest,
 		autoConvert_v1beta3_GitSourceRevision_To_api_GitSourceRevision,
 		autoConvert_v1beta3_GlusterfsVolumeSource_To_api_GlusterfsVolumeSource,
 		autoConvert_v1beta3_GroupList_To_api_GroupList,
 		autoConvert_v1beta3_Group_To_api_Group,
+		autoConvert_v1beta3_HTTPGetHook_To_api_HTTPGetHook,
+		autoConvert_v1beta3_HTTPGetMetric_To_api_HTTPGetMetric,
 		autoConvert_v1beta3_HostPathVolumeSource_To_api_HostPathVolumeSource,
 		autoConvert_v1beta3_HostSubnetList_To_api_HostSubnetList,
 		autoConvert_v1beta3_HostSubnet_To_api_HostSubnet,
@@ -6833,6 +8299,12 @@ func init() {
 		autoConvert_v1beta3_ObjectReference_To_api_ObjectReference,
 		autoConvert_v1beta3_Parameter_To_api_Parameter,
 		autoConvert_v1beta3_PersistentVolumeClaimVolumeSource_To_api_PersistentVolumeClaimVolumeSource,
+		autoConvert_v1beta3_PipelineBuildStage_To_api_PipelineBuildStage,
+		autoConvert_v1beta3_PipelineBuildStrategy_To_api_PipelineBuildStrategy,
+		autoConvert_v1beta3_PipelinePromoteStage_To_api_PipelinePromoteStage,
+		autoConvert_v1beta3_PipelineStageStatus_To_api_PipelineStageStatus,
+		autoConvert_v1beta3_PipelineStage_To_api_PipelineStage,
+		autoConvert_v1beta3_PipelineTestStage_To_api_PipelineTestStage,
 		autoConvert_v1beta3_PodSpec_To_api_PodSpec,
 		autoConvert_v1beta3_PodTemplateSpec_To_api_PodTemplateSpec,
 		autoConvert_v1beta3_PolicyBindingList_To_api_PolicyBindingList,
@@ -6845,6 +8317,7 @@ func init() {
 		autoConvert_v1beta3_ProjectSpec_To_api_ProjectSpec,
 		autoConvert_v1beta3_ProjectStatus_To_api_ProjectStatus,
 		autoConvert_v1beta3_Project_To_api_Project,
+		autoConvert_v1beta3_PrometheusQuery_To_api_PrometheusQuery,
 		autoConvert_v1beta3_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoConvert_v1beta3_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResponse,
 		autoConvert_v1beta3_ResourceAccessReview_To_api_ResourceAccessReview,
@@ -6864,12 +8337,14 @@ func init() {
 		autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource,
 		autoConvert_v1beta3_SecretSpec_To_api_SecretSpec,
 		autoConvert_v1beta3_SecretVolumeSource_To_api_SecretVolumeSource,
+		autoConvert_v1beta3_SlackNotification_To_api_SlackNotification,
 		autoConvert_v1beta3_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoConvert_v1beta3_SourceControlUser_To_api_SourceControlUser,
 		autoConvert_v1beta3_SourceRevision_To_api_SourceRevision,
 		autoConvert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoConvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoConvert_v1beta3_TCPSocketAction_To_api_TCPSocketAction,
+		autoConvert_v1beta3_TCPSocketHook_To_api_TCPSocketHook,
 		autoConvert_v1beta3_TLSConfig_To_api_TLSConfig,
 		autoConvert_v1beta3_TagImageHook_To_api_TagImageHook,
 		autoConvert_v1beta3_TemplateList_To_api_TemplateList,
@@ -6881,6 +8356,7 @@ func init() {
 		autoConvert_v1beta3_VolumeSource_To_api_VolumeSource,
 		autoConvert_v1beta3_Volume_To_api_Volume,
 		autoConvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger,
+		autoConvert_v1beta3_WebhookNotification_To_api_WebhookNotification,
 	)
 	if err != nil {
 		// If one of the conversion functions is malformed, detect it immediately.
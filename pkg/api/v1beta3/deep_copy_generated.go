@@ -743,6 +743,13 @@ func deepCopy_v1beta3_Build(in apiv1beta3.Build, out *apiv1beta3.Build, c *conve
 	return nil
 }
 
+func deepCopy_v1beta3_BuildCacheVolume(in apiv1beta3.BuildCacheVolume, out *apiv1beta3.BuildCacheVolume, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.ClaimName = in.ClaimName
+	out.Path = in.Path
+	return nil
+}
+
 func deepCopy_v1beta3_BuildConfig(in apiv1beta3.BuildConfig, out *apiv1beta3.BuildConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -798,6 +805,27 @@ func deepCopy_v1beta3_BuildConfigSpec(in apiv1beta3.BuildConfigSpec, out *apiv1b
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.Parameters != nil {
+		out.Parameters = make([]apiv1beta3.BuildParameter, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := deepCopy_v1beta3_BuildParameter(in.Parameters[i], &out.Parameters[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
+	if in.Matrix != nil {
+		out.Matrix = make([]apiv1beta3.BuildMatrixParameter, len(in.Matrix))
+		for i := range in.Matrix {
+			if err := deepCopy_v1beta3_BuildMatrixParameter(in.Matrix[i], &out.Matrix[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Matrix = nil
+	}
 	if err := deepCopy_v1beta3_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -889,6 +917,55 @@ func deepCopy_v1beta3_BuildLogOptions(in apiv1beta3.BuildLogOptions, out *apiv1b
 	return nil
 }
 
+func deepCopy_v1beta3_BuildMatrixParameter(in apiv1beta3.BuildMatrixParameter, out *apiv1beta3.BuildMatrixParameter, c *conversion.Cloner) error {
+	out.Name = in.Name
+	if in.Values != nil {
+		out.Values = make([]string, len(in.Values))
+		for i := range in.Values {
+			out.Values[i] = in.Values[i]
+		}
+	} else {
+		out.Values = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_BuildNotification(in apiv1beta3.BuildNotification, out *apiv1beta3.BuildNotification, c *conversion.Cloner) error {
+	if in.Phases != nil {
+		out.Phases = make([]apiv1beta3.BuildPhase, len(in.Phases))
+		for i := range in.Phases {
+			out.Phases[i] = in.Phases[i]
+		}
+	} else {
+		out.Phases = nil
+	}
+	if in.Webhook != nil {
+		out.Webhook = new(apiv1beta3.WebhookNotification)
+		if err := deepCopy_v1beta3_WebhookNotification(*in.Webhook, out.Webhook, c); err != nil {
+			return err
+		}
+	} else {
+		out.Webhook = nil
+	}
+	if in.Slack != nil {
+		out.Slack = new(apiv1beta3.SlackNotification)
+		if err := deepCopy_v1beta3_SlackNotification(*in.Slack, out.Slack, c); err != nil {
+			return err
+		}
+	} else {
+		out.Slack = nil
+	}
+	if in.Email != nil {
+		out.Email = new(apiv1beta3.EmailNotification)
+		if err := deepCopy_v1beta3_EmailNotification(*in.Email, out.Email, c); err != nil {
+			return err
+		}
+	} else {
+		out.Email = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_BuildOutput(in apiv1beta3.BuildOutput, out *apiv1beta3.BuildOutput, c *conversion.Cloner) error {
 	if in.To != nil {
 		if newVal, err := c.DeepCopy(in.To); err != nil {
@@ -911,6 +988,21 @@ func deepCopy_v1beta3_BuildOutput(in apiv1beta3.BuildOutput, out *apiv1beta3.Bui
 	return nil
 }
 
+func deepCopy_v1beta3_BuildParameter(in apiv1beta3.BuildParameter, out *apiv1beta3.BuildParameter, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Type = in.Type
+	out.Value = in.Value
+	out.Required = in.Required
+	return nil
+}
+
+func deepCopy_v1beta3_BuildParameterValue(in apiv1beta3.BuildParameterValue, out *apiv1beta3.BuildParameterValue, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
 func deepCopy_v1beta3_BuildPostCommitSpec(in apiv1beta3.BuildPostCommitSpec, out *apiv1beta3.BuildPostCommitSpec, c *conversion.Cloner) error {
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
@@ -995,6 +1087,25 @@ func deepCopy_v1beta3_BuildRequest(in apiv1beta3.BuildRequest, out *apiv1beta3.B
 	} else {
 		out.Env = nil
 	}
+	if in.PullRequest != nil {
+		out.PullRequest = new(apiv1beta3.GitPullRequest)
+		if err := deepCopy_v1beta3_GitPullRequest(*in.PullRequest, out.PullRequest, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
+	out.NoCache = in.NoCache
+	if in.Parameters != nil {
+		out.Parameters = make([]apiv1beta3.BuildParameterValue, len(in.Parameters))
+		for i := range in.Parameters {
+			if err := deepCopy_v1beta3_BuildParameterValue(in.Parameters[i], &out.Parameters[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Parameters = nil
+	}
 	return nil
 }
 
@@ -1088,6 +1199,24 @@ func deepCopy_v1beta3_BuildSpec(in apiv1beta3.BuildSpec, out *apiv1beta3.BuildSp
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Notifications != nil {
+		out.Notifications = make([]apiv1beta3.BuildNotification, len(in.Notifications))
+		for i := range in.Notifications {
+			if err := deepCopy_v1beta3_BuildNotification(in.Notifications[i], &out.Notifications[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Notifications = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(apiv1beta3.CommitStatusReporting)
+		if err := deepCopy_v1beta3_CommitStatusReporting(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1125,6 +1254,16 @@ func deepCopy_v1beta3_BuildStatus(in apiv1beta3.BuildStatus, out *apiv1beta3.Bui
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.PipelineStageStatus, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1beta3_PipelineStageStatus(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
 	return nil
 }
 
@@ -1154,6 +1293,14 @@ func deepCopy_v1beta3_BuildStrategy(in apiv1beta3.BuildStrategy, out *apiv1beta3
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.PipelineStrategy != nil {
+		out.PipelineStrategy = new(apiv1beta3.PipelineBuildStrategy)
+		if err := deepCopy_v1beta3_PipelineBuildStrategy(*in.PipelineStrategy, out.PipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.PipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1175,6 +1322,30 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
+	if in.GogsWebHook != nil {
+		out.GogsWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.GogsWebHook, out.GogsWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GogsWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := deepCopy_v1beta3_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1186,6 +1357,18 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	return nil
 }
 
+func deepCopy_v1beta3_CommitStatusReporting(in apiv1beta3.CommitStatusReporting, out *apiv1beta3.CommitStatusReporting, c *conversion.Cloner) error {
+	out.Provider = in.Provider
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.TokenSecret); err != nil {
+		return err
+	} else {
+		out.TokenSecret = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	out.Context = in.Context
+	return nil
+}
+
 func deepCopy_v1beta3_CustomBuildStrategy(in apiv1beta3.CustomBuildStrategy, out *apiv1beta3.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1263,6 +1446,28 @@ func deepCopy_v1beta3_DockerBuildStrategy(in apiv1beta3.DockerBuildStrategy, out
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]apiv1beta3.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := deepCopy_v1beta3_BuildCacheVolume(in.CacheVolumes[i], &out.CacheVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_EmailNotification(in apiv1beta3.EmailNotification, out *apiv1beta3.EmailNotification, c *conversion.Cloner) error {
+	if in.To != nil {
+		out.To = make([]string, len(in.To))
+		for i := range in.To {
+			out.To[i] = in.To[i]
+		}
+	} else {
+		out.To = nil
+	}
 	return nil
 }
 
@@ -1284,6 +1489,12 @@ func deepCopy_v1beta3_GitBuildSource(in apiv1beta3.GitBuildSource, out *apiv1bet
 	return nil
 }
 
+func deepCopy_v1beta3_GitPullRequest(in apiv1beta3.GitPullRequest, out *apiv1beta3.GitPullRequest, c *conversion.Cloner) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
 func deepCopy_v1beta3_GitSourceRevision(in apiv1beta3.GitSourceRevision, out *apiv1beta3.GitSourceRevision, c *conversion.Cloner) error {
 	out.Commit = in.Commit
 	if err := deepCopy_v1beta3_SourceControlUser(in.Author, &out.Author, c); err != nil {
@@ -1344,6 +1555,112 @@ func deepCopy_v1beta3_ImageSourcePath(in apiv1beta3.ImageSourcePath, out *apiv1b
 	return nil
 }
 
+func deepCopy_v1beta3_PipelineBuildStage(in apiv1beta3.PipelineBuildStage, out *apiv1beta3.PipelineBuildStage, c *conversion.Cloner) error {
+	out.BuildConfig = in.BuildConfig
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineBuildStrategy(in apiv1beta3.PipelineBuildStrategy, out *apiv1beta3.PipelineBuildStrategy, c *conversion.Cloner) error {
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.PipelineStage, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1beta3_PipelineStage(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PipelinePromoteStage(in apiv1beta3.PipelinePromoteStage, out *apiv1beta3.PipelinePromoteStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineStage(in apiv1beta3.PipelineStage, out *apiv1beta3.PipelineStage, c *conversion.Cloner) error {
+	out.Name = in.Name
+	if in.Build != nil {
+		out.Build = new(apiv1beta3.PipelineBuildStage)
+		if err := deepCopy_v1beta3_PipelineBuildStage(*in.Build, out.Build, c); err != nil {
+			return err
+		}
+	} else {
+		out.Build = nil
+	}
+	if in.Test != nil {
+		out.Test = new(apiv1beta3.PipelineTestStage)
+		if err := deepCopy_v1beta3_PipelineTestStage(*in.Test, out.Test, c); err != nil {
+			return err
+		}
+	} else {
+		out.Test = nil
+	}
+	if in.Promote != nil {
+		out.Promote = new(apiv1beta3.PipelinePromoteStage)
+		if err := deepCopy_v1beta3_PipelinePromoteStage(*in.Promote, out.Promote, c); err != nil {
+			return err
+		}
+	} else {
+		out.Promote = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineStageStatus(in apiv1beta3.PipelineStageStatus, out *apiv1beta3.PipelineStageStatus, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Build = in.Build
+	out.Pod = in.Pod
+	out.Message = in.Message
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PipelineTestStage(in apiv1beta3.PipelineTestStage, out *apiv1beta3.PipelineTestStage, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Image); err != nil {
+		return err
+	} else {
+		out.Image = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if in.Command != nil {
+		out.Command = make([]string, len(in.Command))
+		for i := range in.Command {
+			out.Command[i] = in.Command[i]
+		}
+	} else {
+		out.Command = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_SecretBuildSource(in apiv1beta3.SecretBuildSource, out *apiv1beta3.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1364,6 +1681,12 @@ func deepCopy_v1beta3_SecretSpec(in apiv1beta3.SecretSpec, out *apiv1beta3.Secre
 	return nil
 }
 
+func deepCopy_v1beta3_SlackNotification(in apiv1beta3.SlackNotification, out *apiv1beta3.SlackNotification, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Channel = in.Channel
+	return nil
+}
+
 func deepCopy_v1beta3_SourceBuildStrategy(in apiv1beta3.SourceBuildStrategy, out *apiv1beta3.SourceBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1394,6 +1717,16 @@ func deepCopy_v1beta3_SourceBuildStrategy(in apiv1beta3.SourceBuildStrategy, out
 	out.Scripts = in.Scripts
 	out.Incremental = in.Incremental
 	out.ForcePull = in.ForcePull
+	if in.CacheVolumes != nil {
+		out.CacheVolumes = make([]apiv1beta3.BuildCacheVolume, len(in.CacheVolumes))
+		for i := range in.CacheVolumes {
+			if err := deepCopy_v1beta3_BuildCacheVolume(in.CacheVolumes[i], &out.CacheVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.CacheVolumes = nil
+	}
 	return nil
 }
 
@@ -1418,6 +1751,124 @@ func deepCopy_v1beta3_SourceRevision(in apiv1beta3.SourceRevision, out *apiv1bet
 
 func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1beta3.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	if in.SignatureSecret != nil {
+		if newVal, err := c.DeepCopy(in.SignatureSecret); err != nil {
+			return err
+		} else {
+			out.SignatureSecret = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.SignatureSecret = nil
+	}
+	out.AllowPullRequests = in.AllowPullRequests
+	if in.IncludePaths != nil {
+		out.IncludePaths = make([]string, len(in.IncludePaths))
+		for i := range in.IncludePaths {
+			out.IncludePaths[i] = in.IncludePaths[i]
+		}
+	} else {
+		out.IncludePaths = nil
+	}
+	if in.ExcludePaths != nil {
+		out.ExcludePaths = make([]string, len(in.ExcludePaths))
+		for i := range in.ExcludePaths {
+			out.ExcludePaths[i] = in.ExcludePaths[i]
+		}
+	} else {
+		out.ExcludePaths = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_WebhookNotification(in apiv1beta3.WebhookNotification, out *apiv1beta3.WebhookNotification, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
+func deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(in deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.ServiceName = in.ServiceName
+	if in.HoldSeconds != nil {
+		out.HoldSeconds = new(int64)
+		*out.HoldSeconds = *in.HoldSeconds
+	} else {
+		out.HoldSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryDeploymentStrategyParams(in deployapiv1beta3.CanaryDeploymentStrategyParams, out *deployapiv1beta3.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Steps != nil {
+		out.Steps = make([]int, len(in.Steps))
+		for i := range in.Steps {
+			out.Steps[i] = in.Steps[i]
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.BakeSeconds != nil {
+		out.BakeSeconds = new(int64)
+		*out.BakeSeconds = *in.BakeSeconds
+	} else {
+		out.BakeSeconds = nil
+	}
+	if in.Check != nil {
+		out.Check = new(deployapiv1beta3.ExecNewPodHook)
+		if err := deepCopy_v1beta3_ExecNewPodHook(*in.Check, out.Check, c); err != nil {
+			return err
+		}
+	} else {
+		out.Check = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ContainerImage(in deployapiv1beta3.ContainerImage, out *deployapiv1beta3.ContainerImage, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	out.Image = in.Image
 	return nil
 }
 
@@ -1456,6 +1907,7 @@ func deepCopy_v1beta3_DeploymentCause(in deployapiv1beta3.DeploymentCause, out *
 	} else {
 		out.ImageTrigger = nil
 	}
+	out.Message = in.Message
 	return nil
 }
 
@@ -1488,6 +1940,30 @@ func deepCopy_v1beta3_DeploymentConfig(in deployapiv1beta3.DeploymentConfig, out
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentConfigHistory(in deployapiv1beta3.DeploymentConfigHistory, out *deployapiv1beta3.DeploymentConfigHistory, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1beta3.ObjectMeta)
+	}
+	if in.Deployments != nil {
+		out.Deployments = make([]deployapiv1beta3.DeploymentRecord, len(in.Deployments))
+		for i := range in.Deployments {
+			if err := deepCopy_v1beta3_DeploymentRecord(in.Deployments[i], &out.Deployments[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Deployments = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentConfigList(in deployapiv1beta3.DeploymentConfigList, out *deployapiv1beta3.DeploymentConfigList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1553,6 +2029,18 @@ func deepCopy_v1beta3_DeploymentConfigSpec(in deployapiv1beta3.DeploymentConfigS
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.Windows != nil {
+		out.Windows = make([]deployapiv1beta3.DeploymentWindow, len(in.Windows))
+		for i := range in.Windows {
+			if err := deepCopy_v1beta3_DeploymentWindow(in.Windows[i], &out.Windows[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Windows = nil
+	}
+	out.ImageChangeDebounceSeconds = in.ImageChangeDebounceSeconds
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1583,6 +2071,16 @@ func deepCopy_v1beta3_DeploymentConfigStatus(in deployapiv1beta3.DeploymentConfi
 	} else {
 		out.Details = nil
 	}
+	if in.LastTriggeredImages != nil {
+		out.LastTriggeredImages = make([]deployapiv1beta3.ContainerImage, len(in.LastTriggeredImages))
+		for i := range in.LastTriggeredImages {
+			if err := deepCopy_v1beta3_ContainerImage(in.LastTriggeredImages[i], &out.LastTriggeredImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LastTriggeredImages = nil
+	}
 	return nil
 }
 
@@ -1605,6 +2103,13 @@ func deepCopy_v1beta3_DeploymentDetails(in deployapiv1beta3.DeploymentDetails, o
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentHookResult(in deployapiv1beta3.DeploymentHookResult, out *deployapiv1beta3.DeploymentHookResult, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentLog(in deployapiv1beta3.DeploymentLog, out *deployapiv1beta3.DeploymentLog, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1661,6 +2166,64 @@ func deepCopy_v1beta3_DeploymentLogOptions(in deployapiv1beta3.DeploymentLogOpti
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentRecord(in deployapiv1beta3.DeploymentRecord, out *deployapiv1beta3.DeploymentRecord, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Phase = in.Phase
+	if in.Details != nil {
+		out.Details = new(deployapiv1beta3.DeploymentDetails)
+		if err := deepCopy_v1beta3_DeploymentDetails(*in.Details, out.Details, c); err != nil {
+			return err
+		}
+	} else {
+		out.Details = nil
+	}
+	out.Strategy = in.Strategy
+	if in.StartTime != nil {
+		if newVal, err := c.DeepCopy(in.StartTime); err != nil {
+			return err
+		} else {
+			out.StartTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTime = nil
+	}
+	if in.CompletionTime != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTime); err != nil {
+			return err
+		} else {
+			out.CompletionTime = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTime = nil
+	}
+	if in.DurationSeconds != nil {
+		out.DurationSeconds = new(int64)
+		*out.DurationSeconds = *in.DurationSeconds
+	} else {
+		out.DurationSeconds = nil
+	}
+	if in.PreviousReplicas != nil {
+		out.PreviousReplicas = new(int)
+		*out.PreviousReplicas = *in.PreviousReplicas
+	} else {
+		out.PreviousReplicas = nil
+	}
+	out.Replicas = in.Replicas
+	if in.Hooks != nil {
+		out.Hooks = make([]deployapiv1beta3.DeploymentHookResult, len(in.Hooks))
+		for i := range in.Hooks {
+			if err := deepCopy_v1beta3_DeploymentHookResult(in.Hooks[i], &out.Hooks[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Hooks = nil
+	}
+	out.Reason = in.Reason
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy, out *deployapiv1beta3.DeploymentStrategy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.CustomParams != nil {
@@ -1687,6 +2250,22 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	} else {
 		out.RollingParams = nil
 	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1beta3.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1beta3.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1708,6 +2287,15 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	} else {
 		out.Annotations = nil
 	}
+	out.AutoRollback = in.AutoRollback
+	if in.Verification != nil {
+		out.Verification = new(deployapiv1beta3.DeploymentVerification)
+		if err := deepCopy_v1beta3_DeploymentVerification(*in.Verification, out.Verification, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verification = nil
+	}
 	return nil
 }
 
@@ -1743,6 +2331,46 @@ func deepCopy_v1beta3_DeploymentTriggerPolicy(in deployapiv1beta3.DeploymentTrig
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentVerification(in deployapiv1beta3.DeploymentVerification, out *deployapiv1beta3.DeploymentVerification, c *conversion.Cloner) error {
+	if in.Prometheus != nil {
+		out.Prometheus = new(deployapiv1beta3.PrometheusQuery)
+		if err := deepCopy_v1beta3_PrometheusQuery(*in.Prometheus, out.Prometheus, c); err != nil {
+			return err
+		}
+	} else {
+		out.Prometheus = nil
+	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1beta3.HTTPGetMetric)
+		if err := deepCopy_v1beta3_HTTPGetMetric(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	out.MinValue = in.MinValue
+	out.MaxValue = in.MaxValue
+	if in.IntervalSeconds != nil {
+		out.IntervalSeconds = new(int64)
+		*out.IntervalSeconds = *in.IntervalSeconds
+	} else {
+		out.IntervalSeconds = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_DeploymentWindow(in deployapiv1beta3.DeploymentWindow, out *deployapiv1beta3.DeploymentWindow, c *conversion.Cloner) error {
+	out.Schedule = in.Schedule
+	out.DurationMinutes = in.DurationMinutes
+	return nil
+}
+
 func deepCopy_v1beta3_ExecNewPodHook(in deployapiv1beta3.ExecNewPodHook, out *deployapiv1beta3.ExecNewPodHook, c *conversion.Cloner) error {
 	if in.Command != nil {
 		out.Command = make([]string, len(in.Command))
@@ -1776,6 +2404,25 @@ func deepCopy_v1beta3_ExecNewPodHook(in deployapiv1beta3.ExecNewPodHook, out *de
 	return nil
 }
 
+func deepCopy_v1beta3_HTTPGetHook(in deployapiv1beta3.HTTPGetHook, out *deployapiv1beta3.HTTPGetHook, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Method = in.Method
+	out.ExpectedStatus = in.ExpectedStatus
+	out.Retries = in.Retries
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_HTTPGetMetric(in deployapiv1beta3.HTTPGetMetric, out *deployapiv1beta3.HTTPGetMetric, c *conversion.Cloner) error {
+	out.URL = in.URL
+	return nil
+}
+
 func deepCopy_v1beta3_LifecycleHook(in deployapiv1beta3.LifecycleHook, out *deployapiv1beta3.LifecycleHook, c *conversion.Cloner) error {
 	out.FailurePolicy = in.FailurePolicy
 	if in.ExecNewPod != nil {
@@ -1796,6 +2443,28 @@ func deepCopy_v1beta3_LifecycleHook(in deployapiv1beta3.LifecycleHook, out *depl
 	} else {
 		out.TagImages = nil
 	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1beta3.HTTPGetHook)
+		if err := deepCopy_v1beta3_HTTPGetHook(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	if in.TCPSocket != nil {
+		out.TCPSocket = new(deployapiv1beta3.TCPSocketHook)
+		if err := deepCopy_v1beta3_TCPSocketHook(*in.TCPSocket, out.TCPSocket, c); err != nil {
+			return err
+		}
+	} else {
+		out.TCPSocket = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_PrometheusQuery(in deployapiv1beta3.PrometheusQuery, out *deployapiv1beta3.PrometheusQuery, c *conversion.Cloner) error {
+	out.URL = in.URL
+	out.Query = in.Query
 	return nil
 }
 
@@ -1884,6 +2553,14 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	} else {
 		out.Pre = nil
 	}
+	if in.Batch != nil {
+		out.Batch = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Batch, out.Batch, c); err != nil {
+			return err
+		}
+	} else {
+		out.Batch = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1895,6 +2572,18 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	return nil
 }
 
+func deepCopy_v1beta3_TCPSocketHook(in deployapiv1beta3.TCPSocketHook, out *deployapiv1beta3.TCPSocketHook, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.Port = in.Port
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_TagImageHook(in deployapiv1beta3.TagImageHook, out *deployapiv1beta3.TagImageHook, c *conversion.Cloner) error {
 	out.ContainerName = in.ContainerName
 	if newVal, err := c.DeepCopy(in.To); err != nil {
@@ -3017,6 +3706,7 @@ func init() {
 		deepCopy_v1beta3_BinaryBuildRequestOptions,
 		deepCopy_v1beta3_BinaryBuildSource,
 		deepCopy_v1beta3_Build,
+		deepCopy_v1beta3_BuildCacheVolume,
 		deepCopy_v1beta3_BuildConfig,
 		deepCopy_v1beta3_BuildConfigList,
 		deepCopy_v1beta3_BuildConfigSpec,
@@ -3024,7 +3714,11 @@ func init() {
 		deepCopy_v1beta3_BuildList,
 		deepCopy_v1beta3_BuildLog,
 		deepCopy_v1beta3_BuildLogOptions,
+		deepCopy_v1beta3_BuildMatrixParameter,
+		deepCopy_v1beta3_BuildNotification,
 		deepCopy_v1beta3_BuildOutput,
+		deepCopy_v1beta3_BuildParameter,
+		deepCopy_v1beta3_BuildParameterValue,
 		deepCopy_v1beta3_BuildPostCommitSpec,
 		deepCopy_v1beta3_BuildRequest,
 		deepCopy_v1beta3_BuildSource,
@@ -3032,38 +3726,61 @@ func init() {
 		deepCopy_v1beta3_BuildStatus,
 		deepCopy_v1beta3_BuildStrategy,
 		deepCopy_v1beta3_BuildTriggerPolicy,
+		deepCopy_v1beta3_CommitStatusReporting,
 		deepCopy_v1beta3_CustomBuildStrategy,
 		deepCopy_v1beta3_DockerBuildStrategy,
+		deepCopy_v1beta3_EmailNotification,
 		deepCopy_v1beta3_GitBuildSource,
+		deepCopy_v1beta3_GitPullRequest,
 		deepCopy_v1beta3_GitSourceRevision,
 		deepCopy_v1beta3_ImageChangeTrigger,
 		deepCopy_v1beta3_ImageSource,
 		deepCopy_v1beta3_ImageSourcePath,
+		deepCopy_v1beta3_PipelineBuildStage,
+		deepCopy_v1beta3_PipelineBuildStrategy,
+		deepCopy_v1beta3_PipelinePromoteStage,
+		deepCopy_v1beta3_PipelineStage,
+		deepCopy_v1beta3_PipelineStageStatus,
+		deepCopy_v1beta3_PipelineTestStage,
 		deepCopy_v1beta3_SecretBuildSource,
 		deepCopy_v1beta3_SecretSpec,
+		deepCopy_v1beta3_SlackNotification,
 		deepCopy_v1beta3_SourceBuildStrategy,
 		deepCopy_v1beta3_SourceControlUser,
 		deepCopy_v1beta3_SourceRevision,
 		deepCopy_v1beta3_WebHookTrigger,
+		deepCopy_v1beta3_WebhookNotification,
+		deepCopy_v1beta3_BlueGreenDeploymentStrategyParams,
+		deepCopy_v1beta3_CanaryDeploymentStrategyParams,
+		deepCopy_v1beta3_ContainerImage,
 		deepCopy_v1beta3_CustomDeploymentStrategyParams,
 		deepCopy_v1beta3_DeploymentCause,
 		deepCopy_v1beta3_DeploymentCauseImageTrigger,
 		deepCopy_v1beta3_DeploymentConfig,
+		deepCopy_v1beta3_DeploymentConfigHistory,
 		deepCopy_v1beta3_DeploymentConfigList,
 		deepCopy_v1beta3_DeploymentConfigRollback,
 		deepCopy_v1beta3_DeploymentConfigRollbackSpec,
 		deepCopy_v1beta3_DeploymentConfigSpec,
 		deepCopy_v1beta3_DeploymentConfigStatus,
 		deepCopy_v1beta3_DeploymentDetails,
+		deepCopy_v1beta3_DeploymentHookResult,
 		deepCopy_v1beta3_DeploymentLog,
 		deepCopy_v1beta3_DeploymentLogOptions,
+		deepCopy_v1beta3_DeploymentRecord,
 		deepCopy_v1beta3_DeploymentStrategy,
 		deepCopy_v1beta3_DeploymentTriggerImageChangeParams,
 		deepCopy_v1beta3_DeploymentTriggerPolicy,
+		deepCopy_v1beta3_DeploymentVerification,
+		deepCopy_v1beta3_DeploymentWindow,
 		deepCopy_v1beta3_ExecNewPodHook,
+		deepCopy_v1beta3_HTTPGetHook,
+		deepCopy_v1beta3_HTTPGetMetric,
 		deepCopy_v1beta3_LifecycleHook,
+		deepCopy_v1beta3_PrometheusQuery,
 		deepCopy_v1beta3_RecreateDeploymentStrategyParams,
 		deepCopy_v1beta3_RollingDeploymentStrategyParams,
+		deepCopy_v1beta3_TCPSocketHook,
 		deepCopy_v1beta3_TagImageHook,
 		deepCopy_v1beta3_Image,
 		deepCopy_v1beta3_ImageLayer,
@@ -33,7 +33,13 @@ const (
 var ApplicationItemSupportKinds = []string{
 	"Build", "BuildConfig", "DeploymentConfig", "ImageStream", "ImageStreamTag", "ImageStreamImage", //openshift kind
 	"Event", "Node", "Job", "Pod", "ReplicationController", "Service", "PersistentVolume", "PersistentVolumeClaim", //k8s kind
-	"ServiceBroker", "BackingServiceInstance",
+	"ServiceBroker", "BackingServiceInstance", "Route",
+}
+
+// ApplicationClusterScopedKinds are item kinds that are shared by the whole cluster,
+// they are only deleted with an application when ForceDestory is set.
+var ApplicationClusterScopedKinds = []string{
+	"Node", "ServiceBroker", "PersistentVolume",
 }
 
 type ApplicationPhase string
@@ -61,20 +67,92 @@ type ApplicationSpec struct {
 	Items ItemList
 
 	Destory bool
+	// ForceDestory allows cluster-scoped items to be deleted when the application is destoryed
+	ForceDestory bool
+
+	// Stopped indicates that the DeploymentConfig and ReplicationController items are scaled to zero
+	Stopped bool
+	// Schedule defines when the application is started and stopped automatically
+	Schedule *ApplicationSchedule
+	// RollbackTo requests the controller to restore the items of the application to a recorded revision
+	RollbackTo *ApplicationRollback
 
 	Finalizers []kapi.FinalizerName
 }
 
+// ApplicationSchedule is a daily window in which the application is running, the
+// application is stopped outside of the window.
+type ApplicationSchedule struct {
+	// StartTime is the time of day, formatted as HH:MM, when the application is started
+	StartTime string
+	// StopTime is the time of day, formatted as HH:MM, when the application is stopped
+	StopTime string
+	// Weekdays are the days, e.g. Mon, Tue, on which the application is started, every day when empty
+	Weekdays []string
+	// TimeZone is the location name of StartTime and StopTime, UTC when empty
+	TimeZone string
+}
+
 type ApplicationStatus struct {
 	Phase ApplicationPhase
+
+	// StoppedReplicas records the replica counts of the items before the application was stopped, keyed by Kind=Name
+	StoppedReplicas map[string]int
+	// ScheduleState is the state the schedule last put the application in
+	ScheduleState ApplicationScheduleState
+	// Revisions are the most recent snapshots of the application, oldest first
+	Revisions []ApplicationRevision
+	// HeldReplicas records the replica counts of the items which are kept at zero replicas until
+	// their dependencies are ready, keyed by Kind=Name
+	HeldReplicas map[string]int
+	// ReleasedItems are the keys, formatted as Kind=Name, of the items whose dependencies have been
+	// ready, they are not held again when a dependency becomes unready later
+	ReleasedItems []string
 }
 
+// ApplicationRevisionHistoryLimit is the number of revisions kept in the status of an application.
+const ApplicationRevisionHistoryLimit = 10
+
+// ApplicationRevision is a snapshot of the items of an application and of the specs of its
+// DeploymentConfig, BuildConfig and Route items.
+type ApplicationRevision struct {
+	// Revision is the number of the snapshot, starting at 1
+	Revision int64
+	// CreationTimestamp is the time the snapshot was recorded
+	CreationTimestamp unversioned.Time
+	// Items are the items of the application at this revision
+	Items ItemList
+	// Snapshots are the encoded DeploymentConfig, BuildConfig and Route items, keyed by Kind=Name
+	Snapshots map[string]string
+	// Replicas are the replica counts of the DeploymentConfig items when the revision was recorded,
+	// keyed by Kind=Name, they are left out of the snapshots
+	Replicas map[string]int
+}
+
+// ApplicationRollback identifies the revision an application is rolled back to.
+type ApplicationRollback struct {
+	// Revision is the revision to restore, 0 means the revision before the latest one
+	Revision int64
+}
+
+type ApplicationScheduleState string
+
+const (
+	// ApplicationScheduleRunning means the schedule last started the application.
+	ApplicationScheduleRunning ApplicationScheduleState = "Running"
+	// ApplicationScheduleStopped means the schedule last stopped the application.
+	ApplicationScheduleStopped ApplicationScheduleState = "Stopped"
+)
+
 type ItemList []Item
 
 type Item struct {
 	Kind   string
 	Name   string
 	Status string
+	// DependsOn are the keys, formatted as Kind=Name, of the items which must be ready before
+	// a DeploymentConfig or ReplicationController item is scaled up
+	DependsOn []string
 }
 
 const (
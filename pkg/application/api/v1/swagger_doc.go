@@ -26,11 +26,49 @@ func (ApplicationList) SwaggerDoc() map[string]string {
 	return map_ApplicationList
 }
 
+var map_ApplicationRevision = map[string]string{
+	"":                  "ApplicationRevision is a snapshot of the items of an application and of the specs of its DeploymentConfig, BuildConfig and Route items.",
+	"revision":          "revision is the number of the snapshot, starting at 1",
+	"creationTimestamp": "creationTimestamp is the time the snapshot was recorded",
+	"items":             "items are the items of the application at this revision",
+	"snapshots":         "snapshots are the encoded DeploymentConfig, BuildConfig and Route items, keyed by Kind=Name",
+	"replicas":          "replicas are the replica counts of the DeploymentConfig items when the revision was recorded, keyed by Kind=Name, they are left out of the snapshots",
+}
+
+func (ApplicationRevision) SwaggerDoc() map[string]string {
+	return map_ApplicationRevision
+}
+
+var map_ApplicationRollback = map[string]string{
+	"":         "ApplicationRollback identifies the revision an application is rolled back to.",
+	"revision": "revision is the revision to restore, 0 means the revision before the latest one",
+}
+
+func (ApplicationRollback) SwaggerDoc() map[string]string {
+	return map_ApplicationRollback
+}
+
+var map_ApplicationSchedule = map[string]string{
+	"":          "ApplicationSchedule is a daily window in which the application is running, the application is stopped outside of the window.",
+	"startTime": "startTime is the time of day, formatted as HH:MM, when the application is started",
+	"stopTime":  "stopTime is the time of day, formatted as HH:MM, when the application is stopped",
+	"weekdays":  "weekdays are the days, e.g. Mon, Tue, on which the application is started, every day when empty",
+	"timeZone":  "timeZone is the location name of startTime and stopTime, UTC when empty",
+}
+
+func (ApplicationSchedule) SwaggerDoc() map[string]string {
+	return map_ApplicationSchedule
+}
+
 var map_ApplicationSpec = map[string]string{
 	"":              "ApplicationSpec describes the attributes on a Application",
 	"name":          "name defines the name of a Application",
 	"items":         "items defines the resources to be labeled in a Application",
 	"destoryOption": "destory defines the resources to be removed in a Application",
+	"forceDestory":  "forceDestory allows cluster-scoped items to be deleted when the application is destoryed",
+	"stopped":       "stopped indicates that the DeploymentConfig and ReplicationController items are scaled to zero",
+	"schedule":      "schedule defines when the application is started and stopped automatically",
+	"rollbackTo":    "rollbackTo requests the controller to restore the items of the application to a recorded revision",
 	"finalizers":    "Finalizers is an opaque list of values that must be empty to permanently remove object from storage",
 }
 
@@ -39,8 +77,13 @@ func (ApplicationSpec) SwaggerDoc() map[string]string {
 }
 
 var map_ApplicationStatus = map[string]string{
-	"":      "ApplicationStatus is information about the current status of a Application",
-	"phase": "phase is the current lifecycle phase of the Application",
+	"":                "ApplicationStatus is information about the current status of a Application",
+	"phase":           "phase is the current lifecycle phase of the Application",
+	"stoppedReplicas": "stoppedReplicas records the replica counts of the items before the application was stopped, keyed by Kind=Name",
+	"scheduleState":   "scheduleState is the state the schedule last put the application in",
+	"revisions":       "revisions are the most recent snapshots of the application, oldest first",
+	"heldReplicas":    "heldReplicas records the replica counts of the items which are kept at zero replicas until their dependencies are ready, keyed by Kind=Name",
+	"releasedItems":   "releasedItems are the keys, formatted as Kind=Name, of the items whose dependencies have been ready, they are not held again when a dependency becomes unready later",
 }
 
 func (ApplicationStatus) SwaggerDoc() map[string]string {
@@ -48,10 +91,11 @@ func (ApplicationStatus) SwaggerDoc() map[string]string {
 }
 
 var map_Item = map[string]string{
-	"":       "Item  describe an application item",
-	"kind":   "kind defines the item kind of a item in Application",
-	"name":   "name defines the item name of a item in Application",
-	"status": "status defines a operate to the item label",
+	"":          "Item  describe an application item",
+	"kind":      "kind defines the item kind of a item in Application",
+	"name":      "name defines the item name of a item in Application",
+	"status":    "status defines a operate to the item label",
+	"dependsOn": "dependsOn are the keys, formatted as Kind=Name, of the items which must be ready before a DeploymentConfig or ReplicationController item is scaled up",
 }
 
 func (Item) SwaggerDoc() map[string]string {
@@ -33,7 +33,13 @@ const (
 var ApplicationItemSupportKinds = []string{
 	"Build", "BuildConfig", "DeploymentConfig", "ImageStream", "ImageStreamTag", "ImageStreamImage", //openshift kind
 	"Event", "Node", "Job", "Pod", "ReplicationController", "Service", "PersistentVolume", "PersistentVolumeClaim", //k8s kind
-	"ServiceBroker", "BackingServiceInstance",
+	"ServiceBroker", "BackingServiceInstance", "Route",
+}
+
+// ApplicationClusterScopedKinds are item kinds that are shared by the whole cluster,
+// they are only deleted with an application when ForceDestory is set.
+var ApplicationClusterScopedKinds = []string{
+	"Node", "ServiceBroker", "PersistentVolume",
 }
 
 type ApplicationPhase string
@@ -69,16 +75,77 @@ type ApplicationSpec struct {
 	Items ItemList `json:"items" description:"items defines the resources to be labeled in a Application"`
 	//destory defines the resources to be removed in a Application
 	Destory bool `json:"destoryOption" description:"destory defines the resources to be removed in a Application"`
+	// forceDestory allows cluster-scoped items to be deleted when the application is destoryed
+	ForceDestory bool `json:"forceDestory,omitempty" description:"forceDestory allows cluster-scoped items to be deleted when the application is destoryed"`
+	// stopped indicates that the DeploymentConfig and ReplicationController items are scaled to zero
+	Stopped bool `json:"stopped,omitempty" description:"stopped indicates that the DeploymentConfig and ReplicationController items are scaled to zero"`
+	// schedule defines when the application is started and stopped automatically
+	Schedule *ApplicationSchedule `json:"schedule,omitempty" description:"schedule defines when the application is started and stopped automatically"`
+	// rollbackTo requests the controller to restore the items of the application to a recorded revision
+	RollbackTo *ApplicationRollback `json:"rollbackTo,omitempty" description:"rollbackTo requests the controller to restore the items of the application to a recorded revision"`
 	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage
 	Finalizers []kapi.FinalizerName `json:"finalizers,omitempty" description:"an opaque list of values that must be empty to permanently remove object from storage"`
 }
 
+// ApplicationSchedule is a daily window in which the application is running, the
+// application is stopped outside of the window.
+type ApplicationSchedule struct {
+	// startTime is the time of day, formatted as HH:MM, when the application is started
+	StartTime string `json:"startTime" description:"startTime is the time of day, formatted as HH:MM, when the application is started"`
+	// stopTime is the time of day, formatted as HH:MM, when the application is stopped
+	StopTime string `json:"stopTime" description:"stopTime is the time of day, formatted as HH:MM, when the application is stopped"`
+	// weekdays are the days, e.g. Mon, Tue, on which the application is started, every day when empty
+	Weekdays []string `json:"weekdays,omitempty" description:"weekdays are the days, e.g. Mon, Tue, on which the application is started, every day when empty"`
+	// timeZone is the location name of startTime and stopTime, UTC when empty
+	TimeZone string `json:"timeZone,omitempty" description:"timeZone is the location name of startTime and stopTime, UTC when empty"`
+}
+
 // ApplicationStatus is information about the current status of a Application
 type ApplicationStatus struct {
 	// phase is the current lifecycle phase of the Application
 	Phase ApplicationPhase `json:"phase,omitempty" description:"phase is the current lifecycle phase of the Application"`
+	// stoppedReplicas records the replica counts of the items before the application was stopped, keyed by Kind=Name
+	StoppedReplicas map[string]int `json:"stoppedReplicas,omitempty" description:"stoppedReplicas records the replica counts of the items before the application was stopped, keyed by Kind=Name"`
+	// scheduleState is the state the schedule last put the application in
+	ScheduleState ApplicationScheduleState `json:"scheduleState,omitempty" description:"scheduleState is the state the schedule last put the application in"`
+	// revisions are the most recent snapshots of the application, oldest first
+	Revisions []ApplicationRevision `json:"revisions,omitempty" description:"revisions are the most recent snapshots of the application, oldest first"`
+	// heldReplicas records the replica counts of the items which are kept at zero replicas until their dependencies are ready, keyed by Kind=Name
+	HeldReplicas map[string]int `json:"heldReplicas,omitempty" description:"heldReplicas records the replica counts of the items which are kept at zero replicas until their dependencies are ready, keyed by Kind=Name"`
+	// releasedItems are the keys, formatted as Kind=Name, of the items whose dependencies have been ready, they are not held again when a dependency becomes unready later
+	ReleasedItems []string `json:"releasedItems,omitempty" description:"releasedItems are the keys, formatted as Kind=Name, of the items whose dependencies have been ready, they are not held again when a dependency becomes unready later"`
+}
+
+// ApplicationRevision is a snapshot of the items of an application and of the specs of its
+// DeploymentConfig, BuildConfig and Route items.
+type ApplicationRevision struct {
+	// revision is the number of the snapshot, starting at 1
+	Revision int64 `json:"revision" description:"revision is the number of the snapshot, starting at 1"`
+	// creationTimestamp is the time the snapshot was recorded
+	CreationTimestamp unversioned.Time `json:"creationTimestamp" description:"creationTimestamp is the time the snapshot was recorded"`
+	// items are the items of the application at this revision
+	Items ItemList `json:"items" description:"items are the items of the application at this revision"`
+	// snapshots are the encoded DeploymentConfig, BuildConfig and Route items, keyed by Kind=Name
+	Snapshots map[string]string `json:"snapshots,omitempty" description:"snapshots are the encoded DeploymentConfig, BuildConfig and Route items, keyed by Kind=Name"`
+	// replicas are the replica counts of the DeploymentConfig items when the revision was recorded, keyed by Kind=Name, they are left out of the snapshots
+	Replicas map[string]int `json:"replicas,omitempty" description:"replicas are the replica counts of the DeploymentConfig items when the revision was recorded, keyed by Kind=Name, they are left out of the snapshots"`
 }
 
+// ApplicationRollback identifies the revision an application is rolled back to.
+type ApplicationRollback struct {
+	// revision is the revision to restore, 0 means the revision before the latest one
+	Revision int64 `json:"revision" description:"revision is the revision to restore, 0 means the revision before the latest one"`
+}
+
+type ApplicationScheduleState string
+
+const (
+	// ApplicationScheduleRunning means the schedule last started the application.
+	ApplicationScheduleRunning ApplicationScheduleState = "Running"
+	// ApplicationScheduleStopped means the schedule last stopped the application.
+	ApplicationScheduleStopped ApplicationScheduleState = "Stopped"
+)
+
 type ItemList []Item
 
 // Item  describe an application item
@@ -89,6 +156,8 @@ type Item struct {
 	Name string `json:"name" description:"name defines the item name of a item in Application"`
 	// status defines a operate to the item label
 	Status string `json:"status,omitempty" description:"status defines a operate to the item label"`
+	// dependsOn are the keys, formatted as Kind=Name, of the items which must be ready before a DeploymentConfig or ReplicationController item is scaled up
+	DependsOn []string `json:"dependsOn,omitempty" description:"dependsOn are the keys, formatted as Kind=Name, of the items which must be ready before a DeploymentConfig or ReplicationController item is scaled up"`
 }
 
 const (
@@ -6,6 +6,9 @@ import (
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	"fmt"
+	"strings"
+	"time"
+
 	oapi "github.com/openshift/origin/pkg/api"
 	applicationapi "github.com/openshift/origin/pkg/application/api"
 	applicationutil "github.com/openshift/origin/pkg/application/util"
@@ -114,6 +117,13 @@ func ValidationApplicationItemName(namespace string, items applicationapi.ItemLi
 					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
 				}
 			}
+
+		case "Route":
+			if _, err := oClient.Routes(namespace).Get(item.Name); err != nil {
+				if kerrors.IsNotFound(err) {
+					return false, fmt.Sprintf("resource %s=%s no found.", item.Kind, item.Name)
+				}
+			}
 		}
 	}
 	return true, ""
@@ -133,9 +143,83 @@ func ValidateApplication(application *applicationapi.Application, oClient *oclie
 		result = append(result, field.Invalid(field.NewPath("items"), application.Spec.Items, err))
 	}
 
+	if application.Spec.Schedule != nil {
+		result = append(result, ValidateApplicationSchedule(application.Spec.Schedule, field.NewPath("spec", "schedule"))...)
+	}
+
+	result = append(result, ValidateItemDependencies(application.Spec.Items, field.NewPath("spec", "items"))...)
+
 	return result
 }
 
+// ValidateItemDependencies tests that the dependencies of the items refer to other items of the
+// application and do not form a cycle.
+func ValidateItemDependencies(items applicationapi.ItemList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	keys := map[string]bool{}
+	for _, item := range items {
+		keys[applicationutil.ItemKey(item)] = true
+	}
+
+	for i, item := range items {
+		for j, dep := range item.DependsOn {
+			depPath := fldPath.Index(i).Child("dependsOn").Index(j)
+			kind, name, err := applicationutil.ParseItemKey(dep)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(depPath, dep, err.Error()))
+				continue
+			}
+			key := kind + "=" + name
+			switch {
+			case key == applicationutil.ItemKey(item):
+				allErrs = append(allErrs, field.Invalid(depPath, dep, "an item can not depend on itself"))
+			case !keys[key]:
+				allErrs = append(allErrs, field.Invalid(depPath, dep, "must refer to an item of the application"))
+			}
+		}
+	}
+
+	if len(allErrs) == 0 {
+		if cycle := applicationutil.DependencyCycle(items); cycle != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, strings.Join(cycle, " -> "), "dependencies must not form a cycle"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateApplicationSchedule tests the times, weekdays and time zone of a schedule.
+func ValidateApplicationSchedule(schedule *applicationapi.ApplicationSchedule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	start, err := applicationutil.ParseTimeOfDay(schedule.StartTime)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("startTime"), schedule.StartTime, err.Error()))
+	}
+	stop, err := applicationutil.ParseTimeOfDay(schedule.StopTime)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("stopTime"), schedule.StopTime, err.Error()))
+	}
+	if len(allErrs) == 0 && start == stop {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("stopTime"), schedule.StopTime, "must be different from startTime"))
+	}
+
+	for i, day := range schedule.Weekdays {
+		if !applicationutil.IsScheduleWeekday(day) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("weekdays").Index(i), day, "must be one of Mon, Tue, Wed, Thu, Fri, Sat, Sun"))
+		}
+	}
+
+	if len(schedule.TimeZone) > 0 {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), schedule.TimeZone, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 
 
 // ValidateApplication tests required fields for a Application.
@@ -151,6 +235,16 @@ func ValidateApplicationUpdate(newApplication *applicationapi.Application, oldAp
 	allErrs := validation.ValidateObjectMetaUpdate(&newApplication.ObjectMeta, &oldApplication.ObjectMeta,field.NewPath("metadata"))
 	allErrs = append(allErrs, ValidateApplicationProxy(newApplication)...)
 
+	if newApplication.Spec.Schedule != nil {
+		allErrs = append(allErrs, ValidateApplicationSchedule(newApplication.Spec.Schedule, field.NewPath("spec", "schedule"))...)
+	}
+
+	allErrs = append(allErrs, ValidateItemDependencies(newApplication.Spec.Items, field.NewPath("spec", "items"))...)
+
+	if newApplication.Spec.RollbackTo != nil && newApplication.Spec.RollbackTo.Revision < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rollbackTo", "revision"), newApplication.Spec.RollbackTo.Revision, "must be greater than or equal to 0"))
+	}
+
 
 
 	return allErrs
@@ -6,6 +6,7 @@ import (
 	"github.com/openshift/origin/pkg/application/api"
 	osclient "github.com/openshift/origin/pkg/client"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
 	errutil "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/client/record"
 )
@@ -19,6 +20,9 @@ type ApplicationController struct {
 	KubeClient kclient.Interface
 
 	Recorder record.EventRecorder
+
+	// Codec is used to encode the item snapshots of application revisions.
+	Codec runtime.Codec
 }
 
 type fatalError string
@@ -42,6 +46,18 @@ func (c *ApplicationController) Handle(application *api.Application) (err error)
 		c.Recorder.Event(application, kapi.EventTypeNormal, "DeleteApplicationEvent", "delete application success")
 
 	case api.ApplicationActive:
+		if application.Spec.RollbackTo != nil {
+			return c.handleRollback(application)
+		}
+		if updated, err := c.handleStartStop(application); updated || err != nil {
+			return err
+		}
+		if updated, err := c.recordRevision(application); updated || err != nil {
+			return err
+		}
+		if updated, err := c.handleDependencies(application); updated || err != nil {
+			return err
+		}
 		c.healthCheck(application)
 		return nil
 
@@ -104,6 +120,10 @@ func (c *ApplicationController) healthCheck(application *api.Application) {
 			resource, err := c.KubeClient.Nodes().Get(application.Spec.Items[i].Name)
 			errHandle(err, application, i, resource.Labels, c.Recorder.Eventf)
 
+		case "PersistentVolume":
+			resource, err := c.KubeClient.PersistentVolumes().Get(application.Spec.Items[i].Name)
+			errHandle(err, application, i, resource.Labels, c.Recorder.Eventf)
+
 		case "Pod":
 			resource, err := c.KubeClient.Pods(application.Namespace).Get(application.Spec.Items[i].Name)
 			errHandle(err, application, i, resource.Labels, c.Recorder.Eventf)
@@ -111,6 +131,10 @@ func (c *ApplicationController) healthCheck(application *api.Application) {
 		case "Service":
 			resource, err := c.KubeClient.Services(application.Namespace).Get(application.Spec.Items[i].Name)
 			errHandle(err, application, i, resource.Labels, c.Recorder.Eventf)
+
+		case "Route":
+			resource, err := c.Client.Routes(application.Namespace).Get(application.Spec.Items[i].Name)
+			errHandle(err, application, i, resource.Labels, c.Recorder.Eventf)
 		}
 
 	}
@@ -163,6 +187,10 @@ func (c *ApplicationController) preHandleAllLabel(application *api.Application)
 		errs = append(errs, err)
 	}
 
+	if err := unloadPersistentVolumeLabel(c.KubeClient, application, selector); err != nil {
+		errs = append(errs, err)
+	}
+
 	if err := unloadPodLabel(c.KubeClient, application, selector); err != nil {
 		errs = append(errs, err)
 	}
@@ -171,6 +199,10 @@ func (c *ApplicationController) preHandleAllLabel(application *api.Application)
 		errs = append(errs, err)
 	}
 
+	if err := unloadRouteLabel(c.Client, application, selector); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errutil.NewAggregate(errs)
 }
 
@@ -219,6 +251,10 @@ func (c *ApplicationController) handleAllLabel(app *api.Application) error {
 			if err := c.handleNodeLabel(app, i); err != nil {
 				errs = append(errs, err)
 			}
+		case "PersistentVolume":
+			if err := c.handlePersistentVolumeLabel(app, i); err != nil {
+				errs = append(errs, err)
+			}
 		case "Pod":
 			if err := c.handlePodLabel(app, i); err != nil {
 				errs = append(errs, err)
@@ -227,6 +263,10 @@ func (c *ApplicationController) handleAllLabel(app *api.Application) error {
 			if err := c.handleServiceLabel(app, i); err != nil {
 				errs = append(errs, err)
 			}
+		case "Route":
+			if err := c.handleRouteLabel(app, i); err != nil {
+				errs = append(errs, err)
+			}
 		default:
 			errs = append(errs, errors.New("unknown resource "+item.Kind+"="+item.Name))
 		}
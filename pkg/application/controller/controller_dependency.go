@@ -0,0 +1,289 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/labels"
+	errutil "k8s.io/kubernetes/pkg/util/errors"
+
+	"github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// handleDependencies keeps DeploymentConfig and ReplicationController items at zero replicas
+// while any of their dependencies is not ready and restores their replica counts once all of
+// them are. An item is held only until its dependencies have been ready once. It returns true
+// when the application has been updated.
+func (c *ApplicationController) handleDependencies(app *api.Application) (bool, error) {
+	if app.Spec.Stopped {
+		return false, nil
+	}
+
+	changed := false
+	errs := []error{}
+	checker := &readinessChecker{controller: c, namespace: app.Namespace, cache: map[string]string{}}
+	for _, item := range app.Spec.Items {
+		if item.Kind != "DeploymentConfig" && item.Kind != "ReplicationController" {
+			continue
+		}
+		key := applicationutil.ItemKey(item)
+		if applicationutil.Contains(app.Status.ReleasedItems, key) {
+			continue
+		}
+
+		waiting := []string{}
+		for _, dep := range applicationutil.DependencyKeys(item) {
+			reason, err := checker.waitingReason(dep)
+			if err != nil {
+				errs = append(errs, err)
+				reason = err.Error()
+			}
+			if len(reason) > 0 {
+				waiting = append(waiting, fmt.Sprintf("%s (%s)", dep, reason))
+			}
+		}
+
+		replicas, held := app.Status.HeldReplicas[key]
+		if len(waiting) > 0 {
+			if held {
+				continue
+			}
+			current, err := c.itemReplicas(app.Namespace, item)
+			if err != nil {
+				if !kerrors.IsNotFound(err) {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			if current != 0 {
+				if err := c.scaleItem(app.Namespace, item, 0); err != nil {
+					c.Recorder.Eventf(app, kapi.EventTypeWarning, "ItemWaiting", "scale %s to 0 has error: %s", key, err.Error())
+					errs = append(errs, err)
+					continue
+				}
+			}
+			if app.Status.HeldReplicas == nil {
+				app.Status.HeldReplicas = make(map[string]int)
+			}
+			app.Status.HeldReplicas[key] = current
+			changed = true
+			c.Recorder.Eventf(app, kapi.EventTypeNormal, "ItemWaiting", "%s is held at 0 replicas, waiting for %s", key, strings.Join(waiting, ", "))
+			continue
+		}
+
+		if held {
+			if err := c.scaleItem(app.Namespace, item, replicas); err != nil && !kerrors.IsNotFound(err) {
+				c.Recorder.Eventf(app, kapi.EventTypeWarning, "ItemReady", "scale %s to %d has error: %s", key, replicas, err.Error())
+				errs = append(errs, err)
+				continue
+			}
+			delete(app.Status.HeldReplicas, key)
+			c.Recorder.Eventf(app, kapi.EventTypeNormal, "ItemReady", "dependencies of %s are ready, scaled to %d", key, replicas)
+		}
+		if len(item.DependsOn) > 0 {
+			app.Status.ReleasedItems = append(app.Status.ReleasedItems, key)
+			changed = true
+		}
+	}
+
+	// forget the items which have been removed from the application
+	for key := range app.Status.HeldReplicas {
+		if !hasItemKey(app.Spec.Items, key) {
+			delete(app.Status.HeldReplicas, key)
+			changed = true
+		}
+	}
+	released := []string{}
+	for _, key := range app.Status.ReleasedItems {
+		if hasItemKey(app.Spec.Items, key) {
+			released = append(released, key)
+		}
+	}
+	if len(released) != len(app.Status.ReleasedItems) {
+		app.Status.ReleasedItems = released
+		changed = true
+	}
+	if len(app.Status.HeldReplicas) == 0 {
+		app.Status.HeldReplicas = nil
+	}
+	if len(app.Status.ReleasedItems) == 0 {
+		app.Status.ReleasedItems = nil
+	}
+
+	if changed {
+		if _, err := c.Client.Applications(app.Namespace).Update(app); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return changed, errutil.NewAggregate(errs)
+}
+
+// readinessChecker reports why the dependencies of items are not ready, the result for each
+// dependency is cached for one pass over the items of an application.
+type readinessChecker struct {
+	controller *ApplicationController
+	namespace  string
+	cache      map[string]string
+}
+
+// waitingReason returns why the item with the Kind=Name key is not ready, or an empty string
+// when it is ready.
+func (r *readinessChecker) waitingReason(key string) (string, error) {
+	if reason, ok := r.cache[key]; ok {
+		return reason, nil
+	}
+
+	kind, name, err := applicationutil.ParseItemKey(key)
+	if err != nil {
+		return "", err
+	}
+	reason, err := r.controller.itemWaitingReason(r.namespace, kind, name)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return "", err
+		}
+		reason = "not found"
+	}
+	r.cache[key] = reason
+	return reason, nil
+}
+
+// itemWaitingReason returns why a resource is not ready, or an empty string when it is ready.
+// Kinds without a notion of readiness are ready as soon as they exist.
+func (c *ApplicationController) itemWaitingReason(namespace, kind, name string) (string, error) {
+	switch kind {
+	case "BackingServiceInstance":
+		bsi, err := c.Client.BackingServiceInstances(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if bsi.Status.Phase != backingserviceinstanceapi.BackingServiceInstancePhaseBound {
+			return fmt.Sprintf("phase is %q", bsi.Status.Phase), nil
+		}
+		return "", nil
+
+	case "DeploymentConfig":
+		dc, err := c.Client.DeploymentConfigs(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if dc.Status.LatestVersion == 0 {
+			return "not deployed yet", nil
+		}
+		rc, err := c.KubeClient.ReplicationControllers(namespace).Get(deployutil.LatestDeploymentNameForConfig(dc))
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return fmt.Sprintf("deployment #%d not created yet", dc.Status.LatestVersion), nil
+			}
+			return "", err
+		}
+		if status := deployutil.DeploymentStatusFor(rc); status != deployapi.DeploymentStatusComplete {
+			return fmt.Sprintf("deployment #%d is %s", dc.Status.LatestVersion, strings.ToLower(string(status))), nil
+		}
+		return c.podsWaitingReason(namespace, rc.Spec.Selector, rc.Spec.Replicas)
+
+	case "ReplicationController":
+		rc, err := c.KubeClient.ReplicationControllers(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		return c.podsWaitingReason(namespace, rc.Spec.Selector, rc.Spec.Replicas)
+
+	case "Pod":
+		pod, err := c.KubeClient.Pods(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if !kapi.IsPodReady(pod) {
+			return "pod is not ready", nil
+		}
+		return "", nil
+
+	case "Service":
+		endpoints, err := c.KubeClient.Endpoints(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		for _, subset := range endpoints.Subsets {
+			if len(subset.Addresses) > 0 {
+				return "", nil
+			}
+		}
+		return "no ready endpoints", nil
+
+	case "Build":
+		build, err := c.Client.Builds(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if build.Status.Phase != buildapi.BuildPhaseComplete {
+			return fmt.Sprintf("build is %s", strings.ToLower(string(build.Status.Phase))), nil
+		}
+		return "", nil
+
+	case "BuildConfig":
+		bc, err := c.Client.BuildConfigs(namespace).Get(name)
+		if err != nil {
+			return "", err
+		}
+		if bc.Status.LastVersion == 0 {
+			return "not built yet", nil
+		}
+		return c.itemWaitingReason(namespace, "Build", buildutil.BuildNameForConfigVersion(bc.Name, bc.Status.LastVersion))
+	}
+
+	_, err := applicationItemExists(c, namespace, kind, name)
+	return "", err
+}
+
+// podsWaitingReason reports whether at least replicas pods matching selector are ready, zero
+// replicas are never ready.
+func (c *ApplicationController) podsWaitingReason(namespace string, selector map[string]string, replicas int) (string, error) {
+	if replicas == 0 {
+		return "scaled to 0 replicas", nil
+	}
+
+	pods, err := c.KubeClient.Pods(namespace).List(kapi.ListOptions{LabelSelector: labels.SelectorFromSet(selector)})
+	if err != nil {
+		return "", err
+	}
+	ready := 0
+	for i := range pods.Items {
+		if kapi.IsPodReady(&pods.Items[i]) {
+			ready++
+		}
+	}
+	if ready < replicas {
+		return fmt.Sprintf("%d of %d pods ready", ready, replicas), nil
+	}
+	return "", nil
+}
+
+// applicationItemExists gets the resource of an item kind without a notion of readiness.
+func applicationItemExists(c *ApplicationController, namespace, kind, name string) (bool, error) {
+	var err error
+	switch kind {
+	case "ServiceBroker":
+		_, err = c.Client.ServiceBrokers().Get(name)
+	case "ImageStream":
+		_, err = c.Client.ImageStreams(namespace).Get(name)
+	case "Route":
+		_, err = c.Client.Routes(namespace).Get(name)
+	case "Node":
+		_, err = c.KubeClient.Nodes().Get(name)
+	case "PersistentVolume":
+		_, err = c.KubeClient.PersistentVolumes().Get(name)
+	default:
+		return true, nil
+	}
+	return err == nil, err
+}
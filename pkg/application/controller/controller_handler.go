@@ -3,6 +3,7 @@ package controller
 import (
 	"fmt"
 	"github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
@@ -46,7 +47,7 @@ func (c *ApplicationController) handleServiceBrokerLabel(app *api.Application, i
 		c.Recorder.Event(app, kapi.EventTypeNormal, "Application", addItemEvent(app.Spec.Items[itemIndex]) + "success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete servicebroker has error: %s", err.Error())
 				return err
@@ -121,7 +122,7 @@ func (c *ApplicationController) handleBackingServiceInstanceLabel(app *api.Appli
 		c.Recorder.Event(app,kapi.EventTypeWarning,  "Application", addItemEvent(app.Spec.Items[itemIndex])+"success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete backingservice has error: %s", err.Error())
 				return err
@@ -195,7 +196,7 @@ func (c *ApplicationController) handleBuildLabel(app *api.Application, itemIndex
 		c.Recorder.Event(app,kapi.EventTypeNormal, "Application", addItemEvent(app.Spec.Items[itemIndex])+"success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app,kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete build has error: %s", err.Error())
 				return err
@@ -269,7 +270,7 @@ func (c *ApplicationController) handleBuildConfigLabel(app *api.Application, ite
 		c.Recorder.Event(app, kapi.EventTypeWarning,"Application", addItemEvent(app.Spec.Items[itemIndex])+"success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning,getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete buildconfig has error: %s", err.Error())
 				return err
@@ -350,7 +351,7 @@ func (c *ApplicationController) handleDeploymentConfigLabel(app *api.Application
 		c.Recorder.Event(app,kapi.EventTypeWarning, "Application", addItemEvent(app.Spec.Items[itemIndex])+ "success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete deploymentconfig has error: %s", err.Error())
@@ -451,7 +452,7 @@ func (c *ApplicationController) handleImageStreamLabel(app *api.Application, ite
 		c.Recorder.Event(app, kapi.EventTypeWarning, "Application", addItemEvent(app.Spec.Items[itemIndex])+ "success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete imagestream has error: %s", err.Error())
 				return err
@@ -525,7 +526,7 @@ func (c *ApplicationController) handleReplicationControllerLabel(app *api.Applic
 		c.Recorder.Event(app, kapi.EventTypeWarning, "Application", addItemEvent(app.Spec.Items[itemIndex])+ "success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete replicationcontroller has error: %s", err.Error())
 				return err
@@ -599,7 +600,7 @@ func (c *ApplicationController) handleNodeLabel(app *api.Application, itemIndex
 		c.Recorder.Event(app, kapi.EventTypeWarning, "Application", addItemEvent(app.Spec.Items[itemIndex])+ "success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete node has error: %s", err.Error())
 				return err
@@ -638,6 +639,80 @@ func (c *ApplicationController) handleNodeLabel(app *api.Application, itemIndex
 	return nil
 }
 
+func (c *ApplicationController) handlePersistentVolumeLabel(app *api.Application, itemIndex int) error {
+	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
+
+	client := c.KubeClient.PersistentVolumes()
+
+	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "get persistentvolume has error: %s", err.Error())
+			c.deleteApplicationItem(app, itemIndex)
+			return nil
+		}
+		return err
+	}
+
+	switch app.Status.Phase {
+	case api.ApplicationActiveUpdate:
+		if _, exists := resource.Labels[labelSelectorStr]; exists {
+			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
+			return nil
+		}
+		fallthrough
+	case api.ApplicationNew:
+		if resource.Labels == nil {
+			resource.Labels = make(map[string]string)
+		}
+
+		resource.Labels[labelSelectorStr] = app.Name
+		if _, err := client.Update(resource); err != nil {
+			c.Recorder.Eventf(app, kapi.EventTypeWarning, addItemEvent(app.Spec.Items[itemIndex]), "error: %s", err.Error())
+			return err
+		}
+		c.Recorder.Event(app, kapi.EventTypeWarning, "Application", addItemEvent(app.Spec.Items[itemIndex])+ "success")
+
+	case api.ApplicationTerminating:
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
+			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
+				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete persistentvolume has error: %s", err.Error())
+				return err
+			}
+		} else {
+			delete(resource.Labels, labelSelectorStr)
+			if _, err := client.Update(resource); err != nil {
+				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "update persistentvolume has error: %s", err.Error())
+				return err
+			}
+		}
+
+		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
+
+		if len(app.Spec.Items) == 0 {
+			if err := c.Client.Applications(app.Namespace).Delete(app.Name); err != nil {
+				c.Recorder.Eventf(app, kapi.EventTypeWarning, "Clean Application", "delete application has error: %s", err.Error())
+			}
+		}
+
+	case api.ApplicationTerminatingLabel:
+		delete(resource.Labels, labelSelectorStr)
+		if _, err := client.Update(resource); err != nil {
+			return err
+		}
+
+		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
+
+		if len(app.Spec.Items) == 0 {
+			if err := c.Client.Applications(app.Namespace).Delete(app.Name); err != nil {
+				c.Recorder.Eventf(app, kapi.EventTypeWarning, "Clean Application", "delete application has error: %s", err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *ApplicationController) handlePodLabel(app *api.Application, itemIndex int) error {
 	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
 
@@ -673,7 +748,7 @@ func (c *ApplicationController) handlePodLabel(app *api.Application, itemIndex i
 		c.Recorder.Event(app, "Application", addItemEvent(app.Spec.Items[itemIndex]), "success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name, nil); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete pod has error: %s", err.Error())
 				return err
@@ -747,7 +822,7 @@ func (c *ApplicationController) handleServiceLabel(app *api.Application, itemInd
 		c.Recorder.Event(app, kapi.EventTypeWarning, "Application", addItemEvent(app.Spec.Items[itemIndex])+ "success")
 
 	case api.ApplicationTerminating:
-		if !labelExistsOtherApplicationKey(resource.Labels, labelSelectorStr) {
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
 			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
 				c.Recorder.Eventf(app, kapi.EventTypeWarning, getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete service has error: %s", err.Error())
 				return err
@@ -786,7 +861,93 @@ func (c *ApplicationController) handleServiceLabel(app *api.Application, itemInd
 	return nil
 }
 
+func (c *ApplicationController) handleRouteLabel(app *api.Application, itemIndex int) error {
+	labelSelectorStr := fmt.Sprintf("%s.application.%s", app.Namespace, app.Name)
+
+	client := c.Client.Routes(app.Namespace)
+
+	resource, err := client.Get(app.Spec.Items[itemIndex].Name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			c.Recorder.Eventf(app, kapi.EventTypeWarning,getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "get route has error: %s", err.Error())
+			c.deleteApplicationItem(app, itemIndex)
+			return nil
+		}
+		return err
+	}
+
+	switch app.Status.Phase {
+	case api.ApplicationActiveUpdate:
+		if _, exists := resource.Labels[labelSelectorStr]; exists {
+			//Active正常状态,当有新的更新时,如果这个label不存在,则新建
+			return nil
+		}
+		fallthrough
+	case api.ApplicationNew:
+		if resource.Labels == nil {
+			resource.Labels = make(map[string]string)
+		}
+
+		resource.Labels[labelSelectorStr] = app.Name
+		if _, err := client.Update(resource); err != nil {
+			c.Recorder.Eventf(app,kapi.EventTypeWarning, addItemEvent(app.Spec.Items[itemIndex]), "error: %s", err.Error())
+			return err
+		}
+		c.Recorder.Event(app, kapi.EventTypeWarning,"Application", addItemEvent(app.Spec.Items[itemIndex])+"success")
+
+	case api.ApplicationTerminating:
+		if c.shouldDeleteItem(app, itemIndex, resource.Labels) {
+			if err := client.Delete(app.Spec.Items[itemIndex].Name); err != nil {
+				c.Recorder.Eventf(app, kapi.EventTypeWarning,getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "delete route has error: %s", err.Error())
+				return err
+			}
+		} else {
+			delete(resource.Labels, labelSelectorStr)
+			if _, err := client.Update(resource); err != nil {
+				c.Recorder.Eventf(app, kapi.EventTypeWarning,getItemErrEventReason(app.Status, app.Spec.Items[itemIndex]), "update route has error: %s", err.Error())
+				return err
+			}
+		}
+
+		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
+
+		if len(app.Spec.Items) == 0 {
+			if err := c.Client.Applications(app.Namespace).Delete(app.Name); err != nil {
+				c.Recorder.Eventf(app,kapi.EventTypeWarning, "Clean Application", "delete application has error: %s", err.Error())
+			}
+		}
+
+	case api.ApplicationTerminatingLabel:
+		delete(resource.Labels, labelSelectorStr)
+		if _, err := client.Update(resource); err != nil {
+			return err
+		}
+
+		app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
+
+		if len(app.Spec.Items) == 0 {
+			if err := c.Client.Applications(app.Namespace).Delete(app.Name); err != nil {
+				c.Recorder.Eventf(app,kapi.EventTypeWarning, "Clean Application", "delete application has error: %s", err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *ApplicationController) deleteApplicationItem(app *api.Application, itemIndex int) {
 	app.Spec.Items = append(app.Spec.Items[:itemIndex], app.Spec.Items[itemIndex + 1:]...)
 	c.Client.Applications(app.Namespace).Delete(app.Name)
-}
\ No newline at end of file
+}
+
+// shouldDeleteItem reports whether the item is deleted while the application is destoryed,
+// items which are protected are only unlabelled.
+func (c *ApplicationController) shouldDeleteItem(app *api.Application, itemIndex int, labels map[string]string) bool {
+	item := app.Spec.Items[itemIndex]
+	action, reason := applicationutil.DestoryAction(app, item.Kind, labels)
+	if action != applicationutil.ItemActionDelete {
+		c.Recorder.Eventf(app, kapi.EventTypeNormal, "ProtectedItem", "%s=%s is not deleted: %s", item.Kind, item.Name, reason)
+		return false
+	}
+	return true
+}
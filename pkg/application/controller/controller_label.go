@@ -137,6 +137,22 @@ func unloadNodeLabel(client kclient.Interface, application *api.Application, lab
 	return nil
 }
 
+func unloadPersistentVolumeLabel(client kclient.Interface, application *api.Application, labelSelector labels.Selector) error {
+
+	resourceList, _ := client.PersistentVolumes().List(kapi.ListOptions{LabelSelector:labelSelector,FieldSelector: fields.Everything()})
+	errs := []error{}
+	for _, resource := range resourceList.Items {
+		if !hasItem(application.Spec.Items, api.Item{Kind: "PersistentVolume", Name: resource.Name}) {
+			delete(resource.Labels, fmt.Sprintf("%s.application.%s", application.Namespace, application.Name))
+			if _, err := client.PersistentVolumes().Update(&resource); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func unloadPodLabel(client kclient.Interface, application *api.Application, labelSelector labels.Selector) error {
 
 	resourceList, _ := client.Pods(application.Namespace).List(kapi.ListOptions{LabelSelector:labelSelector,FieldSelector: fields.Everything()})
@@ -168,3 +184,19 @@ func unloadServiceLabel(client kclient.Interface, application *api.Application,
 
 	return nil
 }
+
+func unloadRouteLabel(client osclient.Interface, application *api.Application, labelSelector labels.Selector) error {
+
+	resourceList, _ := client.Routes(application.Namespace).List(kapi.ListOptions{LabelSelector:labelSelector,FieldSelector: fields.Everything()})
+	errs := []error{}
+	for _, resource := range resourceList.Items {
+		if !hasItem(application.Spec.Items, api.Item{Kind: "Route", Name: resource.Name}) {
+			delete(resource.Labels, fmt.Sprintf("%s.application.%s", application.Namespace, application.Name))
+			if _, err := client.Routes(application.Namespace).Update(&resource); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return nil
+}
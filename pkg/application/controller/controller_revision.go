@@ -0,0 +1,289 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	errutil "k8s.io/kubernetes/pkg/util/errors"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// recordRevision records a new revision when the items of the application or the specs of its
+// DeploymentConfig, BuildConfig and Route items differ from the latest revision. It returns true
+// when the application has been updated.
+func (c *ApplicationController) recordRevision(app *api.Application) (bool, error) {
+	items := revisionItems(app.Spec.Items)
+	snapshots, replicas, err := c.snapshotItems(app.Namespace, items)
+	if err != nil {
+		return false, err
+	}
+
+	number := int64(1)
+	if len(app.Status.Revisions) > 0 {
+		latest := app.Status.Revisions[len(app.Status.Revisions)-1]
+		if sameItems(latest.Items, items) && sameSnapshots(latest.Snapshots, snapshots) {
+			return false, nil
+		}
+		number = latest.Revision + 1
+	}
+
+	app.Status.Revisions = append(app.Status.Revisions, api.ApplicationRevision{
+		Revision:          number,
+		CreationTimestamp: unversioned.Now(),
+		Items:             items,
+		Snapshots:         snapshots,
+		Replicas:          replicas,
+	})
+	if extra := len(app.Status.Revisions) - api.ApplicationRevisionHistoryLimit; extra > 0 {
+		app.Status.Revisions = app.Status.Revisions[extra:]
+	}
+
+	if _, err := c.Client.Applications(app.Namespace).Update(app); err != nil {
+		return false, err
+	}
+	c.Recorder.Eventf(app, kapi.EventTypeNormal, "ApplicationRevision", "recorded revision %d", number)
+	return true, nil
+}
+
+// handleRollback restores the items of the application and the specs of its DeploymentConfig,
+// BuildConfig and Route items to the revision requested by Spec.RollbackTo.
+func (c *ApplicationController) handleRollback(app *api.Application) error {
+	target := app.Spec.RollbackTo.Revision
+	revision := applicationutil.FindRevision(app, target)
+	if revision == nil {
+		c.Recorder.Eventf(app, kapi.EventTypeWarning, "ApplicationRollback", "revision %d not found", target)
+		app.Spec.RollbackTo = nil
+		_, err := c.Client.Applications(app.Namespace).Update(app)
+		return err
+	}
+
+	errs := []error{}
+	for key, encoded := range revision.Snapshots {
+		if err := c.restoreSnapshot(app, key, encoded); err != nil {
+			c.Recorder.Eventf(app, kapi.EventTypeWarning, "ApplicationRollback", "restore %s has error: %s", key, err.Error())
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errutil.NewAggregate(errs)
+	}
+
+	app.Spec.Items = revisionItems(revision.Items)
+	app.Spec.RollbackTo = nil
+	if _, err := c.Client.Applications(app.Namespace).Update(app); err != nil {
+		return err
+	}
+	c.Recorder.Eventf(app, kapi.EventTypeNormal, "ApplicationRollback", "rolled back to revision %d", revision.Revision)
+	return nil
+}
+
+// snapshotItems encodes the DeploymentConfig, BuildConfig and Route items, keyed by Kind=Name.
+// Only the name, the labels, the annotations and the spec are kept. Replica counts of
+// DeploymentConfigs are left out of the snapshots so that scaling does not create revisions,
+// they are returned apart, keyed by Kind=Name.
+func (c *ApplicationController) snapshotItems(namespace string, items api.ItemList) (map[string]string, map[string]int, error) {
+	snapshots := map[string]string{}
+	replicas := map[string]int{}
+	for _, item := range items {
+		var snapshot runtime.Object
+
+		switch item.Kind {
+		case "DeploymentConfig":
+			dc, err := c.Client.DeploymentConfigs(namespace).Get(item.Name)
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					continue
+				}
+				return nil, nil, err
+			}
+			spec := dc.Spec
+			spec.Replicas = 0
+			snapshot = &deployapi.DeploymentConfig{ObjectMeta: snapshotMeta(dc.ObjectMeta), Spec: spec}
+			replicas[applicationutil.ItemKey(item)] = dc.Spec.Replicas
+
+		case "BuildConfig":
+			bc, err := c.Client.BuildConfigs(namespace).Get(item.Name)
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					continue
+				}
+				return nil, nil, err
+			}
+			snapshot = &buildapi.BuildConfig{ObjectMeta: snapshotMeta(bc.ObjectMeta), Spec: bc.Spec}
+
+		case "Route":
+			route, err := c.Client.Routes(namespace).Get(item.Name)
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					continue
+				}
+				return nil, nil, err
+			}
+			snapshot = &routeapi.Route{ObjectMeta: snapshotMeta(route.ObjectMeta), Spec: route.Spec}
+
+		default:
+			continue
+		}
+
+		encoded, err := runtime.Encode(c.Codec, snapshot)
+		if err != nil {
+			return nil, nil, err
+		}
+		snapshots[applicationutil.ItemKey(item)] = string(encoded)
+	}
+
+	if len(snapshots) == 0 {
+		return nil, nil, nil
+	}
+	if len(replicas) == 0 {
+		replicas = nil
+	}
+	return snapshots, replicas, nil
+}
+
+// transientAnnotations are set by controllers for the time of a single change, they are left
+// out of the snapshots.
+var transientAnnotations = sets.NewString(
+	deployapi.DeploymentImageChangePendingAnnotation,
+	deployapi.DeploymentWindowOverrideAnnotation,
+)
+
+// snapshotMeta returns the name, namespace, labels and annotations of meta.
+func snapshotMeta(meta kapi.ObjectMeta) kapi.ObjectMeta {
+	var annotations map[string]string
+	for key, value := range meta.Annotations {
+		if transientAnnotations.Has(key) {
+			continue
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = value
+	}
+	return kapi.ObjectMeta{
+		Namespace:   meta.Namespace,
+		Name:        meta.Name,
+		Labels:      meta.Labels,
+		Annotations: annotations,
+	}
+}
+
+// restoreSnapshot sets the spec of the resource of the application to the encoded snapshot,
+// the resource is created again when it has been deleted. A DeploymentConfig that is created
+// again gets the replica count last recorded for it, it is held at zero replicas while the
+// application is stopped.
+func (c *ApplicationController) restoreSnapshot(app *api.Application, key, encoded string) error {
+	namespace := app.Namespace
+	obj, err := runtime.Decode(c.Codec, []byte(encoded))
+	if err != nil {
+		return err
+	}
+
+	switch snapshot := obj.(type) {
+	case *deployapi.DeploymentConfig:
+		dc, err := c.Client.DeploymentConfigs(namespace).Get(snapshot.Name)
+		if kerrors.IsNotFound(err) {
+			replicas := recordedReplicas(app, key)
+			if app.Spec.Stopped {
+				if app.Status.StoppedReplicas == nil {
+					app.Status.StoppedReplicas = make(map[string]int)
+				}
+				app.Status.StoppedReplicas[key] = replicas
+				replicas = 0
+			}
+			snapshot.Spec.Replicas = replicas
+			_, err = c.Client.DeploymentConfigs(namespace).Create(snapshot)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		replicas := dc.Spec.Replicas
+		dc.Spec = snapshot.Spec
+		dc.Spec.Replicas = replicas
+		_, err = c.Client.DeploymentConfigs(namespace).Update(dc)
+		return err
+
+	case *buildapi.BuildConfig:
+		bc, err := c.Client.BuildConfigs(namespace).Get(snapshot.Name)
+		if kerrors.IsNotFound(err) {
+			_, err = c.Client.BuildConfigs(namespace).Create(snapshot)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		bc.Spec = snapshot.Spec
+		_, err = c.Client.BuildConfigs(namespace).Update(bc)
+		return err
+
+	case *routeapi.Route:
+		route, err := c.Client.Routes(namespace).Get(snapshot.Name)
+		if kerrors.IsNotFound(err) {
+			_, err = c.Client.Routes(namespace).Create(snapshot)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		route.Spec = snapshot.Spec
+		_, err = c.Client.Routes(namespace).Update(route)
+		return err
+	}
+
+	return fmt.Errorf("unsupported snapshot %T", obj)
+}
+
+// recordedReplicas returns the replica count of the item key recorded by the most recent
+// revision of app, 1 when no revision recorded it.
+func recordedReplicas(app *api.Application, key string) int {
+	for i := len(app.Status.Revisions) - 1; i >= 0; i-- {
+		if replicas, ok := app.Status.Revisions[i].Replicas[key]; ok {
+			return replicas
+		}
+	}
+	return 1
+}
+
+// revisionItems returns a copy of items without their label status.
+func revisionItems(items api.ItemList) api.ItemList {
+	copied := make(api.ItemList, 0, len(items))
+	for _, item := range items {
+		copied = append(copied, api.Item{Kind: item.Kind, Name: item.Name, DependsOn: item.DependsOn})
+	}
+	return copied
+}
+
+func sameItems(a, b api.ItemList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Kind != b[i].Kind || a[i].Name != b[i].Name || strings.Join(a[i].DependsOn, ",") != strings.Join(b[i].DependsOn, ",") {
+			return false
+		}
+	}
+	return true
+}
+
+func sameSnapshots(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
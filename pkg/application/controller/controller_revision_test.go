@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	"github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	_ "github.com/openshift/origin/pkg/deploy/api/install"
+)
+
+func TestRollbackDeletedDeploymentConfig(t *testing.T) {
+	labelKey := applicationutil.LabelKey("test", "app")
+	tests := []struct {
+		name             string
+		stopped          bool
+		expectedReplicas int
+	}{
+		{name: "running", expectedReplicas: 3},
+		{name: "stopped", stopped: true, expectedReplicas: 0},
+	}
+
+	for _, test := range tests {
+		existing := &deployapi.DeploymentConfig{
+			ObjectMeta: kapi.ObjectMeta{
+				Namespace:   "test",
+				Name:        "frontend",
+				Labels:      map[string]string{labelKey: "app"},
+				Annotations: map[string]string{"description": "frontend", deployapi.DeploymentImageChangePendingAnnotation: "2016-01-01T00:00:00Z"},
+			},
+			Spec: deployapi.DeploymentConfigSpec{Replicas: 3},
+		}
+		var created *deployapi.DeploymentConfig
+
+		client := &testclient.Fake{}
+		client.AddReactor("get", "deploymentconfigs", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			if existing == nil {
+				return true, nil, kerrors.NewNotFound(deployapi.Resource("deploymentconfigs"), "frontend")
+			}
+			return true, existing, nil
+		})
+		client.AddReactor("create", "deploymentconfigs", func(action ktestclient.Action) (bool, runtime.Object, error) {
+			created = action.(ktestclient.CreateAction).GetObject().(*deployapi.DeploymentConfig)
+			return true, created, nil
+		})
+		c := &ApplicationController{
+			Client:   client,
+			Recorder: &record.FakeRecorder{},
+			Codec:    kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion),
+		}
+
+		app := &api.Application{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "app"},
+			Spec: api.ApplicationSpec{
+				Items: api.ItemList{{Kind: "DeploymentConfig", Name: "frontend"}},
+			},
+			Status: api.ApplicationStatus{Phase: api.ApplicationActive},
+		}
+		if _, err := c.recordRevision(app); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		existing = nil
+		app.Spec.Stopped = test.stopped
+		app.Spec.RollbackTo = &api.ApplicationRollback{Revision: 1}
+		if err := c.handleRollback(app); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if created == nil {
+			t.Fatalf("%s: expected the DeploymentConfig to be created", test.name)
+		}
+		if created.Spec.Replicas != test.expectedReplicas {
+			t.Errorf("%s: expected %d replicas, got %d", test.name, test.expectedReplicas, created.Spec.Replicas)
+		}
+		if created.Labels[labelKey] != "app" {
+			t.Errorf("%s: expected the application label, got labels %v", test.name, created.Labels)
+		}
+		if created.Annotations["description"] != "frontend" {
+			t.Errorf("%s: expected the annotations to be restored, got %v", test.name, created.Annotations)
+		}
+		if _, ok := created.Annotations[deployapi.DeploymentImageChangePendingAnnotation]; ok {
+			t.Errorf("%s: expected the image change pending annotation to be left out, got %v", test.name, created.Annotations)
+		}
+		if test.stopped && app.Status.StoppedReplicas["DeploymentConfig=frontend"] != 3 {
+			t.Errorf("%s: expected 3 stopped replicas to be recorded, got %v", test.name, app.Status.StoppedReplicas)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/kubectl"
+	errutil "k8s.io/kubernetes/pkg/util/errors"
+
+	"github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	deployscaler "github.com/openshift/origin/pkg/deploy/scaler"
+)
+
+// handleStartStop applies the schedule of the application and scales its DeploymentConfig and
+// ReplicationController items to zero or back to their recorded replica counts according to
+// Spec.Stopped. It returns true when the application has been updated.
+func (c *ApplicationController) handleStartStop(app *api.Application) (bool, error) {
+	changed := c.applySchedule(app, time.Now())
+
+	errs := []error{}
+	for _, item := range app.Spec.Items {
+		if item.Kind != "DeploymentConfig" && item.Kind != "ReplicationController" {
+			continue
+		}
+
+		key := applicationutil.ItemKey(item)
+		if app.Spec.Stopped {
+			if _, recorded := app.Status.StoppedReplicas[key]; recorded {
+				continue
+			}
+			replicas, err := c.itemReplicas(app.Namespace, item)
+			if err != nil {
+				if !kerrors.IsNotFound(err) {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			if replicas != 0 {
+				if err := c.scaleItem(app.Namespace, item, 0); err != nil {
+					c.Recorder.Eventf(app, kapi.EventTypeWarning, "StopApplication", "scale %s to 0 has error: %s", key, err.Error())
+					errs = append(errs, err)
+					continue
+				}
+			}
+			if app.Status.StoppedReplicas == nil {
+				app.Status.StoppedReplicas = make(map[string]int)
+			}
+			app.Status.StoppedReplicas[key] = replicas
+			changed = true
+			c.Recorder.Eventf(app, kapi.EventTypeNormal, "StopApplication", "scaled %s from %d to 0", key, replicas)
+			continue
+		}
+
+		replicas, recorded := app.Status.StoppedReplicas[key]
+		if !recorded {
+			continue
+		}
+		if err := c.scaleItem(app.Namespace, item, replicas); err != nil && !kerrors.IsNotFound(err) {
+			c.Recorder.Eventf(app, kapi.EventTypeWarning, "StartApplication", "scale %s to %d has error: %s", key, replicas, err.Error())
+			errs = append(errs, err)
+			continue
+		}
+		delete(app.Status.StoppedReplicas, key)
+		changed = true
+		c.Recorder.Eventf(app, kapi.EventTypeNormal, "StartApplication", "scaled %s to %d", key, replicas)
+	}
+
+	if !app.Spec.Stopped {
+		// forget the replicas of items which have been removed from the application
+		for key := range app.Status.StoppedReplicas {
+			if !hasItemKey(app.Spec.Items, key) {
+				delete(app.Status.StoppedReplicas, key)
+				changed = true
+			}
+		}
+	}
+	if len(app.Status.StoppedReplicas) == 0 {
+		app.Status.StoppedReplicas = nil
+	}
+
+	if changed {
+		if _, err := c.Client.Applications(app.Namespace).Update(app); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return changed, errutil.NewAggregate(errs)
+}
+
+// applySchedule sets Spec.Stopped when the schedule of the application enters a new state,
+// so a manual start or stop is kept until the next start or stop time of the schedule.
+func (c *ApplicationController) applySchedule(app *api.Application, now time.Time) bool {
+	if app.Spec.Schedule == nil {
+		if len(app.Status.ScheduleState) == 0 {
+			return false
+		}
+		app.Status.ScheduleState = ""
+		return true
+	}
+
+	running, err := applicationutil.ScheduleRunning(app.Spec.Schedule, now)
+	if err != nil {
+		c.Recorder.Eventf(app, kapi.EventTypeWarning, "ApplicationSchedule", "invalid schedule: %s", err.Error())
+		return false
+	}
+
+	state, action := api.ApplicationScheduleStopped, "stopped"
+	if running {
+		state, action = api.ApplicationScheduleRunning, "started"
+	}
+	if app.Status.ScheduleState == state {
+		return false
+	}
+
+	app.Status.ScheduleState = state
+	app.Spec.Stopped = !running
+	c.Recorder.Eventf(app, kapi.EventTypeNormal, "ApplicationSchedule", "application is %s by schedule", action)
+	return true
+}
+
+// itemReplicas returns the replica count of a DeploymentConfig or ReplicationController item.
+func (c *ApplicationController) itemReplicas(namespace string, item api.Item) (int, error) {
+	if item.Kind == "DeploymentConfig" {
+		dc, err := c.Client.DeploymentConfigs(namespace).Get(item.Name)
+		if err != nil {
+			return 0, err
+		}
+		return dc.Spec.Replicas, nil
+	}
+
+	rc, err := c.KubeClient.ReplicationControllers(namespace).Get(item.Name)
+	if err != nil {
+		return 0, err
+	}
+	return rc.Spec.Replicas, nil
+}
+
+// scaleItem scales a DeploymentConfig or ReplicationController item to replicas.
+func (c *ApplicationController) scaleItem(namespace string, item api.Item, replicas int) error {
+	var scaler kubectl.Scaler
+	if item.Kind == "DeploymentConfig" {
+		scaler = deployscaler.NewDeploymentConfigScaler(c.Client, c.KubeClient)
+	} else {
+		var err error
+		if scaler, err = kubectl.ScalerFor(kapi.Kind("ReplicationController"), c.KubeClient); err != nil {
+			return err
+		}
+	}
+	return scaler.Scale(namespace, item.Name, uint(replicas), nil, nil, nil)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	api "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
 	"k8s.io/kubernetes/pkg/labels"
 )
 
@@ -13,18 +14,6 @@ func getLabelSelectorByApplication(application *api.Application) (labels.Selecto
 	return labels.Parse(fmt.Sprintf("%s.application.%s=%s", application.Namespace, application.Name, application.Name))
 }
 
-func labelExistsOtherApplicationKey(label map[string]string, labelString string) bool {
-	m := filterMapByKey(label, ".application.", strings.Contains)
-
-	if len(m) > 1 {
-		if _, exist := m[labelString]; exist {
-			return exist
-		}
-	}
-
-	return false
-}
-
 func labelExistsApplicationKey(label map[string]string, keyString string) bool {
 
 	m := filterMapByKey(label, ".application.", strings.Contains)
@@ -60,6 +49,16 @@ func hasItem(items api.ItemList, item api.Item) bool {
 	return false
 }
 
+func hasItemKey(items api.ItemList, key string) bool {
+	for i := range items {
+		if applicationutil.ItemKey(items[i]) == key {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getItemErrEventReason(appStatus api.ApplicationStatus, item api.Item) string {
 	return fmt.Sprintf("Application[%s] Handle Resource %s=%s", appStatus.Phase, item.Kind, item.Name)
 }
@@ -21,6 +21,8 @@ type ApplicationControllerFactory struct {
 	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
+	// Codec is used to encode the item snapshots of application revisions.
+	Codec runtime.Codec
 }
 
 // Create creates a ApplicationControllerFactory.
@@ -45,6 +47,7 @@ func (factory *ApplicationControllerFactory) Create() controller.RunnableControl
 		Client:     factory.Client,
 		KubeClient: factory.KubeClient,
 		Recorder:   eventBroadcaster.NewRecorder(kapi.EventSource{Component: "application"}),
+		Codec:      factory.Codec,
 	}
 
 	return &controller.RetryController{
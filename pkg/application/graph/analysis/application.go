@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"fmt"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	appedges "github.com/openshift/origin/pkg/application/graph"
+	appgraph "github.com/openshift/origin/pkg/application/graph/nodes"
+)
+
+const (
+	// MissingApplicationItemWarning is returned when an item of an application does not exist.
+	MissingApplicationItemWarning = "MissingApplicationItem"
+)
+
+// FindMissingApplicationItems checks all applications and reports the items which are not found.
+// Only items of kinds which are represented in the graph are checked.
+func FindMissingApplicationItems(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
+	markers := []osgraph.Marker{}
+
+	for _, uncastAppNode := range g.NodesByKind(appgraph.ApplicationNodeKind) {
+		appNode := uncastAppNode.(*appgraph.ApplicationNode)
+
+		for _, item := range appNode.Spec.Items {
+			name, ok := appedges.ItemNodeName(appNode.Application, item)
+			if !ok {
+				continue
+			}
+			itemNode := g.Find(name)
+			if itemNode != nil {
+				if checker, ok := itemNode.(osgraph.ExistenceChecker); !ok || checker.Found() {
+					continue
+				}
+			}
+
+			markers = append(markers, osgraph.Marker{
+				Node: appNode,
+
+				Severity: osgraph.WarningSeverity,
+				Key:      MissingApplicationItemWarning,
+				Message: fmt.Sprintf("%s contains %s %s but it doesn't exist.",
+					f.ResourceName(appNode), item.Kind, item.Name),
+			})
+		}
+	}
+
+	return markers
+}
@@ -0,0 +1,34 @@
+package analysis
+
+import (
+	"testing"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	osgraphtest "github.com/openshift/origin/pkg/api/graph/test"
+	appedges "github.com/openshift/origin/pkg/application/graph"
+	appgraph "github.com/openshift/origin/pkg/application/graph/nodes"
+)
+
+func TestMissingApplicationItems(t *testing.T) {
+	g, _, err := osgraphtest.BuildGraph("../../../api/graph/test/application-bsi.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	appedges.AddAllApplicationItemEdges(g)
+
+	appNodes := g.NodesByKind(appgraph.ApplicationNodeKind)
+	if len(appNodes) != 1 {
+		t.Fatalf("expected one application, got %d", len(appNodes))
+	}
+	if expected, got := 3, len(g.SuccessorNodesByEdgeKind(appNodes[0], appedges.ApplicationItemEdgeKind)); expected != got {
+		t.Fatalf("expected %d application items, got %d", expected, got)
+	}
+
+	markers := FindMissingApplicationItems(g, osgraph.DefaultNamer)
+	if expected, got := 1, len(markers); expected != got {
+		t.Fatalf("expected %d markers, got %d: %v", expected, got, markers)
+	}
+	if expected, got := MissingApplicationItemWarning, markers[0].Key; expected != got {
+		t.Fatalf("expected %s marker key, got %s", expected, got)
+	}
+}
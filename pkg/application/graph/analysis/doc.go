@@ -0,0 +1,3 @@
+// Package analysis provides functions that analyse applications and setup markers
+// that will be reported by oc status
+package analysis
@@ -0,0 +1,2 @@
+// Package graph contains graph utilities for applications
+package graph
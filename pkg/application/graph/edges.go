@@ -0,0 +1,68 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/gonum/graph"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	appgraph "github.com/openshift/origin/pkg/application/graph/nodes"
+	bsigraph "github.com/openshift/origin/pkg/backingserviceinstance/graph/nodes"
+	buildgraph "github.com/openshift/origin/pkg/build/graph/nodes"
+	deploygraph "github.com/openshift/origin/pkg/deploy/graph/nodes"
+	imagegraph "github.com/openshift/origin/pkg/image/graph/nodes"
+	routegraph "github.com/openshift/origin/pkg/route/graph/nodes"
+)
+
+const (
+	// ApplicationItemEdgeKind goes from an Application to the resources listed in its items
+	ApplicationItemEdgeKind = "ApplicationItem"
+)
+
+// itemNodeKinds are the application item kinds which are represented in the graph
+var itemNodeKinds = map[string]string{
+	"BackingServiceInstance": bsigraph.BackingServiceInstanceNodeKind,
+	"Build":                  buildgraph.BuildNodeKind,
+	"BuildConfig":            buildgraph.BuildConfigNodeKind,
+	"DeploymentConfig":       deploygraph.DeploymentConfigNodeKind,
+	"ImageStream":            imagegraph.ImageStreamNodeKind,
+	"Pod":                    kubegraph.PodNodeKind,
+	"ReplicationController":  kubegraph.ReplicationControllerNodeKind,
+	"Route":                  routegraph.RouteNodeKind,
+	"Service":                kubegraph.ServiceNodeKind,
+}
+
+// ItemNodeName returns the unique name of the graph node for an item of the application, false
+// is returned if items of that kind are not represented in the graph.
+func ItemNodeName(app *applicationapi.Application, item applicationapi.Item) (osgraph.UniqueName, bool) {
+	nodeKind, ok := itemNodeKinds[item.Kind]
+	if !ok {
+		return "", false
+	}
+	return osgraph.UniqueName(fmt.Sprintf("%s|%s/%s", nodeKind, app.Namespace, item.Name)), true
+}
+
+// AddApplicationItemEdges adds an edge from the application to each of its items that exists in the graph.
+func AddApplicationItemEdges(g osgraph.MutableUniqueGraph, node *appgraph.ApplicationNode) *appgraph.ApplicationNode {
+	for _, item := range node.Spec.Items {
+		name, ok := ItemNodeName(node.Application, item)
+		if !ok {
+			continue
+		}
+		if itemNode := g.Find(name); itemNode != nil {
+			g.AddEdge(node, itemNode, ApplicationItemEdgeKind)
+		}
+	}
+
+	return node
+}
+
+func AddAllApplicationItemEdges(g osgraph.MutableUniqueGraph) {
+	for _, node := range g.(graph.Graph).Nodes() {
+		if appNode, ok := node.(*appgraph.ApplicationNode); ok {
+			AddApplicationItemEdges(g, appNode)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package nodes
+
+import (
+	"github.com/gonum/graph"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+// EnsureApplicationNode adds a graph node for the specific application if it does not exist
+func EnsureApplicationNode(g osgraph.MutableUniqueGraph, app *applicationapi.Application) *ApplicationNode {
+	return osgraph.EnsureUnique(
+		g,
+		ApplicationNodeName(app),
+		func(node osgraph.Node) graph.Node {
+			return &ApplicationNode{
+				Node:        node,
+				Application: app,
+			}
+		},
+	).(*ApplicationNode)
+}
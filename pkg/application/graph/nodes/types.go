@@ -0,0 +1,33 @@
+package nodes
+
+import (
+	"reflect"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+var (
+	ApplicationNodeKind = reflect.TypeOf(applicationapi.Application{}).Name()
+)
+
+func ApplicationNodeName(o *applicationapi.Application) osgraph.UniqueName {
+	return osgraph.GetUniqueRuntimeObjectNodeName(ApplicationNodeKind, o)
+}
+
+type ApplicationNode struct {
+	osgraph.Node
+	*applicationapi.Application
+}
+
+func (n ApplicationNode) Object() interface{} {
+	return n.Application
+}
+
+func (n ApplicationNode) String() string {
+	return string(ApplicationNodeName(n.Application))
+}
+
+func (*ApplicationNode) Kind() string {
+	return ApplicationNodeKind
+}
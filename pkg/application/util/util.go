@@ -2,8 +2,18 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	applicationapi "github.com/openshift/origin/pkg/application/api"
+	"sort"
 	"strings"
+	"time"
+)
+
+const (
+	// ItemActionDelete means the item is deleted when the application is destoryed.
+	ItemActionDelete = "delete"
+	// ItemActionUnlabel means only the application label is removed from the item.
+	ItemActionUnlabel = "unlabel"
 )
 
 func Contains(arr []string, str string) bool {
@@ -16,6 +26,128 @@ func Contains(arr []string, str string) bool {
 	return false
 }
 
+// LabelKey returns the label key which marks a resource as an item of the application.
+func LabelKey(namespace, name string) string {
+	return fmt.Sprintf("%s.application.%s", namespace, name)
+}
+
+// OtherApplications returns the sorted "namespace/name" of the applications other than
+// namespace/name which have marked a resource with labels as their item.
+func OtherApplications(labels map[string]string, namespace, name string) []string {
+	own := LabelKey(namespace, name)
+	apps := []string{}
+	for key := range labels {
+		if key == own {
+			continue
+		}
+		if parts := strings.SplitN(key, ".application.", 2); len(parts) == 2 {
+			apps = append(apps, parts[0]+"/"+parts[1])
+		}
+	}
+	sort.Strings(apps)
+	return apps
+}
+
+// IsClusterScopedKind returns true if kind is shared by the whole cluster.
+func IsClusterScopedKind(kind string) bool {
+	return Contains(applicationapi.ApplicationClusterScopedKinds, kind)
+}
+
+// DestoryAction decides what destorying app does to an item of the given kind with the
+// given labels, and the reason when the item is only unlabelled. Items which belong to
+// other applications and cluster-scoped items without ForceDestory are never deleted.
+func DestoryAction(app *applicationapi.Application, kind string, labels map[string]string) (string, string) {
+	if !app.Spec.Destory {
+		return ItemActionUnlabel, "only label is deleted"
+	}
+	if others := OtherApplications(labels, app.Namespace, app.Name); len(others) > 0 {
+		return ItemActionUnlabel, fmt.Sprintf("shared with %s", strings.Join(others, ","))
+	}
+	if IsClusterScopedKind(kind) && !app.Spec.ForceDestory {
+		return ItemActionUnlabel, "cluster-scoped resource, use --force to delete it"
+	}
+	return ItemActionDelete, ""
+}
+
+// ItemKey returns the Kind=Name key of an application item.
+func ItemKey(item applicationapi.Item) string {
+	return item.Kind + "=" + item.Name
+}
+
+var scheduleWeekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// IsScheduleWeekday returns true if day is a valid weekday of an ApplicationSchedule.
+func IsScheduleWeekday(day string) bool {
+	_, ok := scheduleWeekdays[day]
+	return ok
+}
+
+// ParseTimeOfDay parses a HH:MM time of day and returns the minutes since midnight.
+func ParseTimeOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, must be HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ScheduleRunning returns true if the application should be running at now according to
+// the schedule. A window whose StopTime is before its StartTime ends on the next day and
+// belongs to the weekday it starts on.
+func ScheduleRunning(schedule *applicationapi.ApplicationSchedule, now time.Time) (bool, error) {
+	location := time.UTC
+	if len(schedule.TimeZone) > 0 {
+		loc, err := time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return false, err
+		}
+		location = loc
+	}
+	start, err := ParseTimeOfDay(schedule.StartTime)
+	if err != nil {
+		return false, err
+	}
+	stop, err := ParseTimeOfDay(schedule.StopTime)
+	if err != nil {
+		return false, err
+	}
+
+	now = now.In(location)
+	minute := now.Hour()*60 + now.Minute()
+	day := now.Weekday()
+
+	running := false
+	switch {
+	case start < stop:
+		running = minute >= start && minute < stop
+	case start > stop:
+		if minute >= start {
+			running = true
+		} else if minute < stop {
+			running = true
+			day = (day + 6) % 7
+		}
+	}
+	if !running || len(schedule.Weekdays) == 0 {
+		return running, nil
+	}
+
+	for _, weekday := range schedule.Weekdays {
+		if d, ok := scheduleWeekdays[weekday]; ok && d == day {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func Parse(items string) (applicationapi.ItemList, error) {
 	list := applicationapi.ItemList{}
 	arr := strings.Split(items, ",")
@@ -58,4 +190,123 @@ func expandKindShortcut(kind string) string {
 		return expanded
 	}
 	return kind
-}
\ No newline at end of file
+}
+// FindRevision returns the recorded revision of the application with the given number, the
+// revision before the latest one when revision is 0, or nil when there is no such revision.
+func FindRevision(app *applicationapi.Application, revision int64) *applicationapi.ApplicationRevision {
+	revisions := app.Status.Revisions
+	if revision == 0 {
+		if len(revisions) < 2 {
+			return nil
+		}
+		return &revisions[len(revisions)-2]
+	}
+
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			return &revisions[i]
+		}
+	}
+	return nil
+}
+
+// ParseItemKey splits a Kind=Name item key, kind shortcuts such as dc or bsi are expanded.
+func ParseItemKey(key string) (string, string, error) {
+	parts := strings.Split(strings.TrimSpace(key), "=")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", fmt.Errorf("item %q must be formatted as KIND=NAME", key)
+	}
+	return expandKindShortcut(parts[0]), parts[1], nil
+}
+
+// ParseDependencies adds the dependencies, each formatted as KIND=NAME:KIND=NAME meaning that
+// the first item depends on the second one, to the items they refer to.
+func ParseDependencies(items applicationapi.ItemList, dependencies []string) error {
+	for _, dependency := range dependencies {
+		parts := strings.Split(dependency, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("dependency %q must be formatted as KIND=NAME:KIND=NAME", dependency)
+		}
+		kind, name, err := ParseItemKey(parts[0])
+		if err != nil {
+			return err
+		}
+		depKind, depName, err := ParseItemKey(parts[1])
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for i := range items {
+			if items[i].Kind == kind && items[i].Name == name {
+				items[i].DependsOn = append(items[i].DependsOn, depKind+"="+depName)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("dependency %q refers to %s=%s which is not an item", dependency, kind, name)
+		}
+	}
+	return nil
+}
+
+// DependencyKeys returns the Kind=Name keys of the items the item depends on with kind shortcuts
+// expanded, malformed dependencies are skipped.
+func DependencyKeys(item applicationapi.Item) []string {
+	keys := []string{}
+	for _, dep := range item.DependsOn {
+		if kind, name, err := ParseItemKey(dep); err == nil {
+			keys = append(keys, kind+"="+name)
+		}
+	}
+	return keys
+}
+
+// DependencyCycle returns the keys of the items forming a dependency cycle, or nil when the
+// dependencies of the items are acyclic.
+func DependencyCycle(items applicationapi.ItemList) []string {
+	dependsOn := map[string][]string{}
+	for _, item := range items {
+		dependsOn[ItemKey(item)] = DependencyKeys(item)
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	path := []string{}
+
+	var visit func(key string) []string
+	visit = func(key string) []string {
+		switch state[key] {
+		case visiting:
+			for i := range path {
+				if path[i] == key {
+					return append(append([]string{}, path[i:]...), key)
+				}
+			}
+		case visited:
+			return nil
+		}
+
+		state[key] = visiting
+		path = append(path, key)
+		for _, dep := range dependsOn[key] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[key] = visited
+		return nil
+	}
+
+	for _, item := range items {
+		if cycle := visit(ItemKey(item)); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
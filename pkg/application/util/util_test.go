@@ -0,0 +1,199 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+func TestOtherApplications(t *testing.T) {
+	labels := map[string]string{
+		"test.application.app":   "app",
+		"other.application.foo":  "foo",
+		"test.application.bar":   "bar",
+		"deploymentconfig":       "web",
+		"test.application.extra": "extra",
+	}
+
+	others := OtherApplications(labels, "test", "app")
+	expected := []string{"other/foo", "test/bar", "test/extra"}
+	if !reflect.DeepEqual(others, expected) {
+		t.Errorf("expected %v, got %v", expected, others)
+	}
+}
+
+func TestDestoryAction(t *testing.T) {
+	ownLabels := map[string]string{LabelKey("test", "app"): "app"}
+	sharedLabels := map[string]string{LabelKey("test", "app"): "app", LabelKey("test", "other"): "other"}
+
+	tests := []struct {
+		name    string
+		destory bool
+		force   bool
+		kind    string
+		labels  map[string]string
+		action  string
+	}{
+		{name: "only label", destory: false, kind: "DeploymentConfig", labels: ownLabels, action: ItemActionUnlabel},
+		{name: "owned item", destory: true, kind: "DeploymentConfig", labels: ownLabels, action: ItemActionDelete},
+		{name: "shared item", destory: true, kind: "DeploymentConfig", labels: sharedLabels, action: ItemActionUnlabel},
+		{name: "shared item with force", destory: true, force: true, kind: "Service", labels: sharedLabels, action: ItemActionUnlabel},
+		{name: "cluster-scoped item", destory: true, kind: "Node", labels: ownLabels, action: ItemActionUnlabel},
+		{name: "cluster-scoped item with force", destory: true, force: true, kind: "ServiceBroker", labels: ownLabels, action: ItemActionDelete},
+		{name: "shared cluster-scoped item with force", destory: true, force: true, kind: "PersistentVolume", labels: sharedLabels, action: ItemActionUnlabel},
+	}
+
+	for _, test := range tests {
+		app := &applicationapi.Application{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "app"},
+			Spec:       applicationapi.ApplicationSpec{Destory: test.destory, ForceDestory: test.force},
+		}
+		action, reason := DestoryAction(app, test.kind, test.labels)
+		if action != test.action {
+			t.Errorf("%s: expected action %s, got %s", test.name, test.action, action)
+		}
+		if action == ItemActionUnlabel && len(reason) == 0 {
+			t.Errorf("%s: expected a reason for unlabelling", test.name)
+		}
+	}
+}
+
+func TestScheduleRunning(t *testing.T) {
+	// 2016-05-02 is a Monday
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2016, 5, 2, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		schedule applicationapi.ApplicationSchedule
+		now      time.Time
+		running  bool
+	}{
+		{
+			name:     "inside window",
+			schedule: applicationapi.ApplicationSchedule{StartTime: "08:00", StopTime: "18:00"},
+			now:      monday(9, 30),
+			running:  true,
+		},
+		{
+			name:     "at stop time",
+			schedule: applicationapi.ApplicationSchedule{StartTime: "08:00", StopTime: "18:00"},
+			now:      monday(18, 0),
+			running:  false,
+		},
+		{
+			name:     "weekday not listed",
+			schedule: applicationapi.ApplicationSchedule{StartTime: "08:00", StopTime: "18:00", Weekdays: []string{"Tue", "Wed"}},
+			now:      monday(9, 30),
+			running:  false,
+		},
+		{
+			name:     "overnight window started the day before",
+			schedule: applicationapi.ApplicationSchedule{StartTime: "22:00", StopTime: "06:00", Weekdays: []string{"Sun"}},
+			now:      monday(5, 0),
+			running:  true,
+		},
+		{
+			name:     "overnight window not started the day before",
+			schedule: applicationapi.ApplicationSchedule{StartTime: "22:00", StopTime: "06:00", Weekdays: []string{"Mon"}},
+			now:      monday(5, 0),
+			running:  false,
+		},
+		{
+			name:     "time zone",
+			schedule: applicationapi.ApplicationSchedule{StartTime: "08:00", StopTime: "18:00", TimeZone: "Asia/Shanghai"},
+			now:      monday(1, 0),
+			running:  true,
+		},
+	}
+
+	for _, test := range tests {
+		running, err := ScheduleRunning(&test.schedule, test.now)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if running != test.running {
+			t.Errorf("%s: expected running %t, got %t", test.name, test.running, running)
+		}
+	}
+}
+
+func TestScheduleRunningInvalid(t *testing.T) {
+	if _, err := ScheduleRunning(&applicationapi.ApplicationSchedule{StartTime: "8am", StopTime: "18:00"}, time.Now()); err == nil {
+		t.Errorf("expected an error for an invalid start time")
+	}
+}
+
+func TestFindRevision(t *testing.T) {
+	app := &applicationapi.Application{}
+	if FindRevision(app, 0) != nil {
+		t.Errorf("expected no previous revision of an application without revisions")
+	}
+
+	app.Status.Revisions = []applicationapi.ApplicationRevision{{Revision: 3}, {Revision: 4}, {Revision: 5}}
+	tests := map[int64]int64{
+		0: 4,
+		3: 3,
+		5: 5,
+		6: -1,
+		1: -1,
+	}
+	for revision, expected := range tests {
+		found := FindRevision(app, revision)
+		if expected < 0 {
+			if found != nil {
+				t.Errorf("revision %d: expected none, got %d", revision, found.Revision)
+			}
+			continue
+		}
+		if found == nil || found.Revision != expected {
+			t.Errorf("revision %d: expected %d, got %v", revision, expected, found)
+		}
+	}
+}
+
+func TestParseDependencies(t *testing.T) {
+	items := applicationapi.ItemList{
+		{Kind: "DeploymentConfig", Name: "web"},
+		{Kind: "DeploymentConfig", Name: "api"},
+		{Kind: "BackingServiceInstance", Name: "db"},
+	}
+	if err := ParseDependencies(items, []string{"dc=web:bsi=db", "dc=web:dc=api", "DeploymentConfig=api:bsi=db"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"BackingServiceInstance=db", "DeploymentConfig=api"}; !reflect.DeepEqual(items[0].DependsOn, expected) {
+		t.Errorf("expected %v, got %v", expected, items[0].DependsOn)
+	}
+	if expected := []string{"BackingServiceInstance=db"}; !reflect.DeepEqual(items[1].DependsOn, expected) {
+		t.Errorf("expected %v, got %v", expected, items[1].DependsOn)
+	}
+
+	for _, invalid := range []string{"dc=web", "dc=web:", "dc=missing:bsi=db", "dc=web:bsi"} {
+		if err := ParseDependencies(items, []string{invalid}); err == nil {
+			t.Errorf("%s: expected an error", invalid)
+		}
+	}
+}
+
+func TestDependencyCycle(t *testing.T) {
+	items := applicationapi.ItemList{
+		{Kind: "DeploymentConfig", Name: "web", DependsOn: []string{"DeploymentConfig=api", "BackingServiceInstance=db"}},
+		{Kind: "DeploymentConfig", Name: "api", DependsOn: []string{"BackingServiceInstance=db"}},
+		{Kind: "BackingServiceInstance", Name: "db"},
+	}
+	if cycle := DependencyCycle(items); cycle != nil {
+		t.Errorf("unexpected cycle %v", cycle)
+	}
+
+	items[2].DependsOn = []string{"DeploymentConfig=web"}
+	expected := []string{"DeploymentConfig=web", "DeploymentConfig=api", "BackingServiceInstance=db", "DeploymentConfig=web"}
+	if cycle := DependencyCycle(items); !reflect.DeepEqual(cycle, expected) {
+		t.Errorf("expected cycle %v, got %v", expected, cycle)
+	}
+}
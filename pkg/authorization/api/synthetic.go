@@ -2,9 +2,10 @@ package api
 
 // Synthetic authorization endpoints
 const (
-	DockerBuildResource = "builds/docker"
-	SourceBuildResource = "builds/source"
-	CustomBuildResource = "builds/custom"
+	DockerBuildResource   = "builds/docker"
+	SourceBuildResource   = "builds/source"
+	CustomBuildResource   = "builds/custom"
+	PipelineBuildResource = "builds/pipeline"
 
 	NodeMetricsResource = "nodes/metrics"
 	NodeStatsResource   = "nodes/stats"
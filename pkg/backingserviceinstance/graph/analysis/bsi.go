@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	"fmt"
+
+	"github.com/gonum/graph"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	bsiedges "github.com/openshift/origin/pkg/backingserviceinstance/graph"
+	bsigraph "github.com/openshift/origin/pkg/backingserviceinstance/graph/nodes"
+)
+
+const (
+	// UnboundInstanceWarning is returned when a deployment config is bound to a backing service
+	// instance that is not in the Bound phase.
+	UnboundInstanceWarning = "UnboundBackingServiceInstance"
+)
+
+// FindUnboundInstances checks all deployment configs bound to a backing service instance and
+// reports those whose instance is not in the Bound phase.
+func FindUnboundInstances(g osgraph.Graph, f osgraph.Namer) []osgraph.Marker {
+	markers := []osgraph.Marker{}
+
+	for _, uncastBSINode := range g.NodesByKind(bsigraph.BackingServiceInstanceNodeKind) {
+		bsiNode := uncastBSINode.(*bsigraph.BackingServiceInstanceNode)
+		if bsiNode.Status.Phase == backingserviceinstanceapi.BackingServiceInstancePhaseBound {
+			continue
+		}
+
+		phase := string(bsiNode.Status.Phase)
+		if len(phase) == 0 {
+			phase = "unknown"
+		}
+		for _, dcNode := range g.SuccessorNodesByEdgeKind(bsiNode, bsiedges.BoundDeploymentConfigEdgeKind) {
+			markers = append(markers, osgraph.Marker{
+				Node:         dcNode,
+				RelatedNodes: []graph.Node{bsiNode},
+
+				Severity: osgraph.WarningSeverity,
+				Key:      UnboundInstanceWarning,
+				Message: fmt.Sprintf("%s is bound to %s but the instance is in phase %s.",
+					f.ResourceName(dcNode), f.ResourceName(bsiNode), phase),
+				Suggestion: osgraph.Suggestion(fmt.Sprintf("oc describe %s", f.ResourceName(bsiNode))),
+			})
+		}
+	}
+
+	return markers
+}
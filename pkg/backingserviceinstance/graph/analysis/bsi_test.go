@@ -0,0 +1,25 @@
+package analysis
+
+import (
+	"testing"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	osgraphtest "github.com/openshift/origin/pkg/api/graph/test"
+	bsiedges "github.com/openshift/origin/pkg/backingserviceinstance/graph"
+)
+
+func TestUnboundInstances(t *testing.T) {
+	g, _, err := osgraphtest.BuildGraph("../../../api/graph/test/application-bsi.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bsiedges.AddAllBindingEdges(g)
+
+	markers := FindUnboundInstances(g, osgraph.DefaultNamer)
+	if expected, got := 1, len(markers); expected != got {
+		t.Fatalf("expected %d markers, got %d: %v", expected, got, markers)
+	}
+	if expected, got := UnboundInstanceWarning, markers[0].Key; expected != got {
+		t.Fatalf("expected %s marker key, got %s", expected, got)
+	}
+}
@@ -0,0 +1,42 @@
+package graph
+
+import (
+	"github.com/gonum/graph"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	bsigraph "github.com/openshift/origin/pkg/backingserviceinstance/graph/nodes"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploygraph "github.com/openshift/origin/pkg/deploy/graph/nodes"
+)
+
+const (
+	// BoundDeploymentConfigEdgeKind goes from a BackingServiceInstance to the DeploymentConfigs it is bound to
+	BoundDeploymentConfigEdgeKind = "BoundDeploymentConfig"
+)
+
+// AddBindingEdges adds an edge from the backing service instance to each existing deployment config
+// named in its bindings.
+func AddBindingEdges(g osgraph.MutableUniqueGraph, node *bsigraph.BackingServiceInstanceNode) *bsigraph.BackingServiceInstanceNode {
+	for _, binding := range node.Spec.Binding {
+		if len(binding.BindDeploymentConfig) == 0 {
+			continue
+		}
+		dc := &deployapi.DeploymentConfig{ObjectMeta: kapi.ObjectMeta{Namespace: node.Namespace, Name: binding.BindDeploymentConfig}}
+		dcNode, ok := g.Find(deploygraph.DeploymentConfigNodeName(dc)).(*deploygraph.DeploymentConfigNode)
+		if !ok {
+			continue
+		}
+		g.AddEdge(node, dcNode, BoundDeploymentConfigEdgeKind)
+	}
+
+	return node
+}
+
+func AddAllBindingEdges(g osgraph.MutableUniqueGraph) {
+	for _, node := range g.(graph.Graph).Nodes() {
+		if bsiNode, ok := node.(*bsigraph.BackingServiceInstanceNode); ok {
+			AddBindingEdges(g, bsiNode)
+		}
+	}
+}
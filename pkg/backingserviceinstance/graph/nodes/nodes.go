@@ -0,0 +1,22 @@
+package nodes
+
+import (
+	"github.com/gonum/graph"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+// EnsureBackingServiceInstanceNode adds a graph node for the specific backing service instance if it does not exist
+func EnsureBackingServiceInstanceNode(g osgraph.MutableUniqueGraph, bsi *backingserviceinstanceapi.BackingServiceInstance) *BackingServiceInstanceNode {
+	return osgraph.EnsureUnique(
+		g,
+		BackingServiceInstanceNodeName(bsi),
+		func(node osgraph.Node) graph.Node {
+			return &BackingServiceInstanceNode{
+				Node:                   node,
+				BackingServiceInstance: bsi,
+			}
+		},
+	).(*BackingServiceInstanceNode)
+}
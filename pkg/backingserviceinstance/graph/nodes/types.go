@@ -0,0 +1,33 @@
+package nodes
+
+import (
+	"reflect"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+)
+
+var (
+	BackingServiceInstanceNodeKind = reflect.TypeOf(backingserviceinstanceapi.BackingServiceInstance{}).Name()
+)
+
+func BackingServiceInstanceNodeName(o *backingserviceinstanceapi.BackingServiceInstance) osgraph.UniqueName {
+	return osgraph.GetUniqueRuntimeObjectNodeName(BackingServiceInstanceNodeKind, o)
+}
+
+type BackingServiceInstanceNode struct {
+	osgraph.Node
+	*backingserviceinstanceapi.BackingServiceInstance
+}
+
+func (n BackingServiceInstanceNode) Object() interface{} {
+	return n.BackingServiceInstance
+}
+
+func (n BackingServiceInstanceNode) String() string {
+	return string(BackingServiceInstanceNodeName(n.BackingServiceInstance))
+}
+
+func (*BackingServiceInstanceNode) Kind() string {
+	return BackingServiceInstanceNodeKind
+}
@@ -81,6 +81,15 @@ func TestBuildAdmission(t *testing.T) {
 			expectedResource: authorizationapi.CustomBuildResource,
 			expectAccept:     true,
 		},
+		{
+			name:             "allowed pipeline build",
+			object:           testBuild(buildapi.BuildStrategy{PipelineStrategy: &buildapi.PipelineBuildStrategy{}}),
+			kind:             buildapi.Kind("Build"),
+			resource:         buildsResource,
+			reviewResponse:   reviewResponse(true, ""),
+			expectedResource: authorizationapi.PipelineBuildResource,
+			expectAccept:     true,
+		},
 		{
 			name:             "allowed build config",
 			object:           testBuildConfig(buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}}),
@@ -199,6 +199,8 @@ func convert_api_BuildStrategy_To_v1_BuildStrategy(in *newer.BuildStrategy, out
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.PipelineStrategy != nil:
+		out.Type = PipelineBuildStrategyType
 	}
 	return nil
 }
@@ -41,6 +41,17 @@ func (Build) SwaggerDoc() map[string]string {
 	return map_Build
 }
 
+var map_BuildCacheVolume = map[string]string{
+	"":          "BuildCacheVolume is a persistent volume claim that keeps files, such as downloaded dependencies, from one build of a BuildConfig to the next. The builds of each BuildConfig use their own directory of the volume, so a claim can be shared by several BuildConfigs.",
+	"name":      "Name identifies the cache volume within the build.",
+	"claimName": "ClaimName is the name of the persistent volume claim that stores the cache.",
+	"path":      "Path is the absolute path of the directory the build keeps the cache in. The cache is injected at Path into the container where the assemble script runs and the injected files are truncated once the script finishes. What the build leaves at Path is copied back to the volume after a successful build.",
+}
+
+func (BuildCacheVolume) SwaggerDoc() map[string]string {
+	return map_BuildCacheVolume
+}
+
 var map_BuildConfig = map[string]string{
 	"":         "BuildConfig is a template which can be used to create new builds.",
 	"metadata": "Standard object's metadata.",
@@ -63,8 +74,11 @@ func (BuildConfigList) SwaggerDoc() map[string]string {
 }
 
 var map_BuildConfigSpec = map[string]string{
-	"":         "BuildConfigSpec describes when and how builds are created",
-	"triggers": "Triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
+	"":           "BuildConfigSpec describes when and how builds are created",
+	"triggers":   "Triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
+	"runPolicy":  "RunPolicy describes how the new builds created from this BuildConfig run alongside each other. Defaults to Parallel.",
+	"parameters": "Parameters are the parameters of the builds of the BuildConfig. The value of a parameter replaces the ${NAME} references to the parameter in the build specification, like the parameters of templates.",
+	"matrix":     "Matrix, if set, makes each instantiation of the BuildConfig start a build for each combination of the values of its parameters. Unless the output image of the BuildConfig references a matrix parameter, the values of the combination are appended to the tag of the output image of its build, so that each build pushes to a distinct tag.",
 }
 
 func (BuildConfigSpec) SwaggerDoc() map[string]string {
@@ -116,6 +130,28 @@ func (BuildLogOptions) SwaggerDoc() map[string]string {
 	return map_BuildLogOptions
 }
 
+var map_BuildMatrixParameter = map[string]string{
+	"":       "BuildMatrixParameter is a parameter of a build matrix and the values it takes.",
+	"name":   "Name is the name of a parameter of the BuildConfig.",
+	"values": "Values are the values of the parameter a build is started for.",
+}
+
+func (BuildMatrixParameter) SwaggerDoc() map[string]string {
+	return map_BuildMatrixParameter
+}
+
+var map_BuildNotification = map[string]string{
+	"":        "BuildNotification is a target notified when a build completes. Exactly one of Webhook, Slack and Email must be set.",
+	"phases":  "Phases are the phases of the completed build the target is notified of. Defaults to Complete, Failed, Error and Cancelled.",
+	"webhook": "Webhook posts the build result as JSON to a URL.",
+	"slack":   "Slack posts the build result as a message to a Slack-compatible incoming webhook.",
+	"email":   "Email sends the build result by email through the SMTP server configured on the master.",
+}
+
+func (BuildNotification) SwaggerDoc() map[string]string {
+	return map_BuildNotification
+}
+
 var map_BuildOutput = map[string]string{
 	"":           "BuildOutput is input to a build strategy and describes the Docker image that the strategy should produce.",
 	"to":         "To defines an optional location to push the output of this build to. Kind must be one of 'ImageStreamTag' or 'DockerImage'. This value will be used to look up a Docker image repository to push to. In the case of an ImageStreamTag, the ImageStreamTag will be looked for in the namespace of the build unless Namespace is specified.",
@@ -126,6 +162,29 @@ func (BuildOutput) SwaggerDoc() map[string]string {
 	return map_BuildOutput
 }
 
+var map_BuildParameter = map[string]string{
+	"":            "BuildParameter is a parameter of the builds of a BuildConfig.",
+	"name":        "Name of the parameter. It is referenced as ${NAME} in the build specification.",
+	"description": "Description of the parameter.",
+	"type":        "Type of the parameter values. Defaults to String.",
+	"value":       "Value is the default value of the parameter.",
+	"required":    "Required indicates the parameter must have a value. Builds can't be started without a value for a required parameter which has no default value.",
+}
+
+func (BuildParameter) SwaggerDoc() map[string]string {
+	return map_BuildParameter
+}
+
+var map_BuildParameterValue = map[string]string{
+	"":      "BuildParameterValue is the value of a build parameter.",
+	"name":  "Name of the parameter.",
+	"value": "Value of the parameter.",
+}
+
+func (BuildParameterValue) SwaggerDoc() map[string]string {
+	return map_BuildParameterValue
+}
+
 var map_BuildPostCommitSpec = map[string]string{
 	"":        "A BuildPostCommitSpec holds a build post commit hook specification. The hook executes a command in a temporary container running the build output image, immediately after the last layer of the image is committed and before the image is pushed to a registry. The command is executed with the current working directory ($PWD) set to the image's WORKDIR.\n\nThe build will be marked as failed if the hook execution fails. It will fail if the script or command return a non-zero exit code, or if there is any other error related to starting the temporary container.\n\nThere are five different ways to configure the hook. As an example, all forms below are equivalent and will execute `rake test --verbose`.\n\n1. Shell script:\n\n       \"postCommit\": {\n         \"script\": \"rake test --verbose\",\n       }\n\n    The above is a convenient form which is equivalent to:\n\n       \"postCommit\": {\n         \"command\": [\"/bin/sh\", \"-ic\"],\n         \"args\":    [\"rake test --verbose\"]\n       }\n\n2. A command as the image entrypoint:\n\n       \"postCommit\": {\n         \"commit\": [\"rake\", \"test\", \"--verbose\"]\n       }\n\n    Command overrides the image entrypoint in the exec form, as documented in\n    Docker: https://docs.docker.com/engine/reference/builder/#entrypoint.\n\n3. Pass arguments to the default entrypoint:\n\n       \"postCommit\": {\n\t\t      \"args\": [\"rake\", \"test\", \"--verbose\"]\n\t      }\n\n    This form is only useful if the image entrypoint can handle arguments.\n\n4. Shell script with arguments:\n\n       \"postCommit\": {\n         \"script\": \"rake test $1\",\n         \"args\":   [\"--verbose\"]\n       }\n\n    This form is useful if you need to pass arguments that would otherwise be\n    hard to quote properly in the shell script. In the script, $0 will be\n    \"/bin/sh\" and $1, $2, etc, are the positional arguments from Args.\n\n5. Command with arguments:\n\n       \"postCommit\": {\n         \"command\": [\"rake\", \"test\"],\n         \"args\":    [\"--verbose\"]\n       }\n\n    This form is equivalent to appending the arguments to the Command slice.\n\nIt is invalid to provide both Script and Command simultaneously. If none of the fields are specified, the hook is not executed.",
 	"command": "Command is the command to run. It may not be specified with Script. This might be needed if the image doesn't have `/bin/sh`, or if you do not want to use a shell. In all other cases, using Script might be more convenient.",
@@ -146,6 +205,9 @@ var map_BuildRequest = map[string]string{
 	"binary":           "Binary indicates a request to build from a binary provided to the builder",
 	"lastVersion":      "LastVersion (optional) is the LastVersion of the BuildConfig that was used to generate the build. If the BuildConfig in the generator doesn't match, a build will not be generated.",
 	"env":              "Env contains additional environment variables you want to pass into a builder container",
+	"pullRequest":      "PullRequest (optional) is the pull request to build. Its head is built instead of the configured Git ref.",
+	"noCache":          "NoCache if set to true empties the cache volumes of the build before it runs and, for the Docker build strategy, builds without the cached layers of the Docker daemon.",
+	"parameters":       "Parameters are the values of the parameters of the BuildConfig for this build. The parameters which are not set keep their default value. Setting a matrix parameter restricts the matrix builds to its value.",
 }
 
 func (BuildRequest) SwaggerDoc() map[string]string {
@@ -178,6 +240,8 @@ var map_BuildSpec = map[string]string{
 	"resources":                 "Compute resource requirements to execute the build",
 	"postCommit":                "PostCommit is a build hook executed after the build output image is committed, before it is pushed to a registry.",
 	"completionDeadlineSeconds": "Optional duration in seconds, counted from the time when a build pod gets scheduled in the system, that the build may be active on a node before the system actively tries to terminate the build; value must be positive integer",
+	"notifications":             "Notifications are the targets notified when the build completes.",
+	"commitStatus":              "CommitStatus, if set, reports the status of the build on the commit it builds to the Git hosting service of its source.",
 }
 
 func (BuildSpec) SwaggerDoc() map[string]string {
@@ -195,6 +259,7 @@ var map_BuildStatus = map[string]string{
 	"duration":                   "Duration contains time.Duration object describing build time.",
 	"outputDockerImageReference": "OutputDockerImageReference contains a reference to the Docker image that will be built by this build. Its value is computed from Build.Spec.Output.To, and should include the registry address, so that it can be used to push and pull the image.",
 	"config":                     "Config is an ObjectReference to the BuildConfig this Build is based on.",
+	"stages":                     "Stages is the status of the stages of a Pipeline build, in the order of the stages of the strategy.",
 }
 
 func (BuildStatus) SwaggerDoc() map[string]string {
@@ -202,11 +267,12 @@ func (BuildStatus) SwaggerDoc() map[string]string {
 }
 
 var map_BuildStrategy = map[string]string{
-	"":               "BuildStrategy contains the details of how to perform a build.",
-	"type":           "Type is the kind of build strategy.",
-	"dockerStrategy": "DockerStrategy holds the parameters to the Docker build strategy.",
-	"sourceStrategy": "SourceStrategy holds the parameters to the Source build strategy.",
-	"customStrategy": "CustomStrategy holds the parameters to the Custom build strategy",
+	"":                 "BuildStrategy contains the details of how to perform a build.",
+	"type":             "Type is the kind of build strategy.",
+	"dockerStrategy":   "DockerStrategy holds the parameters to the Docker build strategy.",
+	"sourceStrategy":   "SourceStrategy holds the parameters to the Source build strategy.",
+	"customStrategy":   "CustomStrategy holds the parameters to the Custom build strategy",
+	"pipelineStrategy": "PipelineStrategy holds the parameters to the Pipeline build strategy.",
 }
 
 func (BuildStrategy) SwaggerDoc() map[string]string {
@@ -218,6 +284,9 @@ var map_BuildTriggerPolicy = map[string]string{
 	"type":        "Type is the type of build trigger",
 	"github":      "GitHubWebHook contains the parameters for a GitHub webhook type of trigger",
 	"generic":     "GenericWebHook contains the parameters for a Generic webhook type of trigger",
+	"gitlab":      "GitLabWebHook contains the parameters for a GitLab webhook type of trigger",
+	"bitbucket":   "BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger",
+	"gogs":        "GogsWebHook contains the parameters for a Gogs webhook type of trigger",
 	"imageChange": "ImageChange contains parameters for an ImageChange type of trigger",
 }
 
@@ -225,6 +294,18 @@ func (BuildTriggerPolicy) SwaggerDoc() map[string]string {
 	return map_BuildTriggerPolicy
 }
 
+var map_CommitStatusReporting = map[string]string{
+	"":            "CommitStatusReporting reports the status of builds on the commits they build, so that pull requests show whether the build of their head passed.",
+	"provider":    "Provider is the Git hosting service the statuses are posted to.",
+	"apiURL":      "APIURL is the base URL of the API of the Git hosting service. Defaults to https://api.github.com for GitHub and https://gitlab.com/api/v3 for GitLab. Loopback, link-local and private addresses are refused unless the master configuration allows them.",
+	"tokenSecret": "TokenSecret is a reference to a Secret in the namespace of the build whose CommitStatusTokenKey key is the API token the statuses are posted with. It has to be one of the secrets of the service account of the build.",
+	"context":     "Context distinguishes the status of the build from the other statuses of the commit. Defaults to \"openshift/\" followed by the name of the BuildConfig.",
+}
+
+func (CommitStatusReporting) SwaggerDoc() map[string]string {
+	return map_CommitStatusReporting
+}
+
 var map_CustomBuildStrategy = map[string]string{
 	"":                   "CustomBuildStrategy defines input parameters specific to Custom build.",
 	"from":               "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
@@ -248,12 +329,22 @@ var map_DockerBuildStrategy = map[string]string{
 	"env":            "Env contains additional environment variables you want to pass into a builder container",
 	"forcePull":      "ForcePull describes if the builder should pull the images from registry prior to building.",
 	"dockerfilePath": "DockerfilePath is the path of the Dockerfile that will be used to build the Docker image, relative to the root of the context (contextDir).",
+	"cacheVolumes":   "CacheVolumes are not supported by the Docker build strategy, as Docker builds can't mount volumes, and are rejected by validation.",
 }
 
 func (DockerBuildStrategy) SwaggerDoc() map[string]string {
 	return map_DockerBuildStrategy
 }
 
+var map_EmailNotification = map[string]string{
+	"":   "EmailNotification sends the build result by email.",
+	"to": "To are the addresses the email is sent to.",
+}
+
+func (EmailNotification) SwaggerDoc() map[string]string {
+	return map_EmailNotification
+}
+
 var map_GenericWebHookEvent = map[string]string{
 	"":     "GenericWebHookEvent is the payload expected for a generic webhook post",
 	"type": "Type is the type of source repository",
@@ -284,6 +375,16 @@ func (GitInfo) SwaggerDoc() map[string]string {
 	return map_GitInfo
 }
 
+var map_GitPullRequest = map[string]string{
+	"":       "GitPullRequest identifies a pull request of a Git repository.",
+	"number": "Number is the number of the pull request.",
+	"ref":    "Ref is the Git ref of the head of the pull request, e.g. refs/pull/1/head.",
+}
+
+func (GitPullRequest) SwaggerDoc() map[string]string {
+	return map_GitPullRequest
+}
+
 var map_GitSourceRevision = map[string]string{
 	"":          "GitSourceRevision is the commit information from a git source for a build",
 	"commit":    "Commit is the commit hash identifying a specific commit",
@@ -327,6 +428,71 @@ func (ImageSourcePath) SwaggerDoc() map[string]string {
 	return map_ImageSourcePath
 }
 
+var map_PipelineBuildStage = map[string]string{
+	"":            "PipelineBuildStage builds a BuildConfig as part of a pipeline.",
+	"buildConfig": "BuildConfig is the name of the BuildConfig to build, in the namespace of the pipeline.",
+}
+
+func (PipelineBuildStage) SwaggerDoc() map[string]string {
+	return map_PipelineBuildStage
+}
+
+var map_PipelineBuildStrategy = map[string]string{
+	"":       "PipelineBuildStrategy defines the stages of a Pipeline build. A Pipeline build doesn't run in a pod of its own: the stages run one after the other and the build fails with the first stage that fails.",
+	"stages": "Stages is the ordered list of stages of the pipeline.",
+}
+
+func (PipelineBuildStrategy) SwaggerDoc() map[string]string {
+	return map_PipelineBuildStrategy
+}
+
+var map_PipelinePromoteStage = map[string]string{
+	"":     "PipelinePromoteStage tags an image into an image stream as part of a pipeline, creating the image stream if it doesn't exist.",
+	"from": "From is a reference to the ImageStreamTag to promote, in the namespace of the pipeline. It is typically the output of an earlier build stage.",
+	"to":   "To is a reference to the ImageStreamTag to tag the promoted image as, in the namespace of the pipeline.",
+}
+
+func (PipelinePromoteStage) SwaggerDoc() map[string]string {
+	return map_PipelinePromoteStage
+}
+
+var map_PipelineStage = map[string]string{
+	"":        "PipelineStage is a stage of a Pipeline build. Exactly one of build, test and promote must be set.",
+	"name":    "Name identifies the stage within the pipeline.",
+	"build":   "Build starts a build of another BuildConfig and waits for it to complete.",
+	"test":    "Test runs a command in an image and waits for it to exit.",
+	"promote": "Promote tags an image into an image stream.",
+}
+
+func (PipelineStage) SwaggerDoc() map[string]string {
+	return map_PipelineStage
+}
+
+var map_PipelineStageStatus = map[string]string{
+	"":                    "PipelineStageStatus is the status of a stage of a Pipeline build.",
+	"name":                "Name is the name of the stage.",
+	"phase":               "Phase is the point in the stage lifecycle.",
+	"build":               "Build is the name of the build started by a build stage.",
+	"pod":                 "Pod is the name of the pod running a test stage.",
+	"message":             "Message is a human-readable message about the stage.",
+	"startTimestamp":      "StartTimestamp is the time the stage started.",
+	"completionTimestamp": "CompletionTimestamp is the time the stage completed.",
+}
+
+func (PipelineStageStatus) SwaggerDoc() map[string]string {
+	return map_PipelineStageStatus
+}
+
+var map_PipelineTestStage = map[string]string{
+	"":        "PipelineTestStage runs a command in a pod as part of a pipeline. The stage passes if the command exits with a zero exit code.",
+	"image":   "Image is a reference to the DockerImage, or to an ImageStreamTag in the namespace of the pipeline, to run the command in. It is typically the output of an earlier build stage.",
+	"command": "Command is the command to run. The entrypoint of the image runs if it is empty.",
+}
+
+func (PipelineTestStage) SwaggerDoc() map[string]string {
+	return map_PipelineTestStage
+}
+
 var map_SecretBuildSource = map[string]string{
 	"":               "SecretBuildSource describes a secret and its destination directory that will be used only at the build time. The content of the secret referenced here will be copied into the destination directory instead of mounting.",
 	"secret":         "Secret is a reference to an existing secret that you want to use in your build.",
@@ -347,14 +513,25 @@ func (SecretSpec) SwaggerDoc() map[string]string {
 	return map_SecretSpec
 }
 
+var map_SlackNotification = map[string]string{
+	"":        "SlackNotification posts the build result to a Slack-compatible incoming webhook.",
+	"url":     "URL is the URL of the incoming webhook.",
+	"channel": "Channel overrides the channel the incoming webhook posts to.",
+}
+
+func (SlackNotification) SwaggerDoc() map[string]string {
+	return map_SlackNotification
+}
+
 var map_SourceBuildStrategy = map[string]string{
-	"":            "SourceBuildStrategy defines input parameters specific to an Source build.",
-	"from":        "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
-	"pullSecret":  "PullSecret is the name of a Secret that would be used for setting up the authentication for pulling the Docker images from the private Docker registries",
-	"env":         "Env contains additional environment variables you want to pass into a builder container",
-	"scripts":     "Scripts is the location of Source scripts",
-	"incremental": "Incremental flag forces the Source build to do incremental builds if true.",
-	"forcePull":   "ForcePull describes if the builder should pull the images from registry prior to building.",
+	"":             "SourceBuildStrategy defines input parameters specific to an Source build.",
+	"from":         "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
+	"pullSecret":   "PullSecret is the name of a Secret that would be used for setting up the authentication for pulling the Docker images from the private Docker registries",
+	"env":          "Env contains additional environment variables you want to pass into a builder container",
+	"scripts":      "Scripts is the location of Source scripts",
+	"incremental":  "Incremental flag forces the Source build to do incremental builds if true.",
+	"forcePull":    "ForcePull describes if the builder should pull the images from registry prior to building.",
+	"cacheVolumes": "CacheVolumes are the cache volumes of the build.",
 }
 
 func (SourceBuildStrategy) SwaggerDoc() map[string]string {
@@ -382,10 +559,23 @@ func (SourceRevision) SwaggerDoc() map[string]string {
 }
 
 var map_WebHookTrigger = map[string]string{
-	"":       "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
-	"secret": "Secret used to validate requests.",
+	"":                  "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
+	"secret":            "Secret used to validate requests.",
+	"signatureSecret":   "SignatureSecret is a reference to a Secret in the namespace of the BuildConfig. When it is set, only payloads signed with the value of its WebHookSecretKey key are accepted. Only used by GitHub webhooks.",
+	"allowPullRequests": "AllowPullRequests enables builds of the head of pull requests opened or updated against the configured Git ref. Their output image is tagged \"pr-<number>\" rather than with the configured tag. Only used by GitHub webhooks.",
+	"includePaths":      "IncludePaths restricts the pushes that trigger a build to those changing at least one file under one of these paths, relative to the root of the repository. Paths may contain shell file name patterns. Defaults to the context directory of the source, if any. Only used by GitHub and GitLab webhooks.",
+	"excludePaths":      "ExcludePaths lists paths whose changes never trigger a build, even if they are under one of the IncludePaths. Only used by GitHub and GitLab webhooks.",
 }
 
 func (WebHookTrigger) SwaggerDoc() map[string]string {
 	return map_WebHookTrigger
 }
+
+var map_WebhookNotification = map[string]string{
+	"":    "WebhookNotification posts the build result as JSON to a URL.",
+	"url": "URL is the http or https URL the build result is posted to.",
+}
+
+func (WebhookNotification) SwaggerDoc() map[string]string {
+	return map_WebhookNotification
+}
@@ -52,6 +52,13 @@ type BuildSpec struct {
 	// scheduled in the system, that the build may be active on a node before the
 	// system actively tries to terminate the build; value must be positive integer
 	CompletionDeadlineSeconds *int64 `json:"completionDeadlineSeconds,omitempty"`
+
+	// Notifications are the targets notified when the build completes.
+	Notifications []BuildNotification `json:"notifications,omitempty"`
+
+	// CommitStatus, if set, reports the status of the build on the commit it
+	// builds to the Git hosting service of its source.
+	CommitStatus *CommitStatusReporting `json:"commitStatus,omitempty"`
 }
 
 // BuildStatus contains the status of a build
@@ -90,6 +97,34 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference `json:"config,omitempty"`
+
+	// Stages is the status of the stages of a Pipeline build, in the order of
+	// the stages of the strategy.
+	Stages []PipelineStageStatus `json:"stages,omitempty"`
+}
+
+// PipelineStageStatus is the status of a stage of a Pipeline build.
+type PipelineStageStatus struct {
+	// Name is the name of the stage.
+	Name string `json:"name"`
+
+	// Phase is the point in the stage lifecycle.
+	Phase BuildPhase `json:"phase"`
+
+	// Build is the name of the build started by a build stage.
+	Build string `json:"build,omitempty"`
+
+	// Pod is the name of the pod running a test stage.
+	Pod string `json:"pod,omitempty"`
+
+	// Message is a human-readable message about the stage.
+	Message string `json:"message,omitempty"`
+
+	// StartTimestamp is the time the stage started.
+	StartTimestamp *unversioned.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp is the time the stage completed.
+	CompletionTimestamp *unversioned.Time `json:"completionTimestamp,omitempty"`
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -303,6 +338,9 @@ type BuildStrategy struct {
 
 	// CustomStrategy holds the parameters to the Custom build strategy
 	CustomStrategy *CustomBuildStrategy `json:"customStrategy,omitempty"`
+
+	// PipelineStrategy holds the parameters to the Pipeline build strategy.
+	PipelineStrategy *PipelineBuildStrategy `json:"pipelineStrategy,omitempty"`
 }
 
 // BuildStrategyType describes a particular way of performing a build.
@@ -319,6 +357,9 @@ const (
 
 	// CustomBuildStrategyType performs builds using custom builder Docker image.
 	CustomBuildStrategyType BuildStrategyType = "Custom"
+
+	// PipelineBuildStrategyType performs builds by running a sequence of stages.
+	PipelineBuildStrategyType BuildStrategyType = "Pipeline"
 )
 
 // CustomBuildStrategy defines input parameters specific to Custom build.
@@ -376,6 +417,10 @@ type DockerBuildStrategy struct {
 	// DockerfilePath is the path of the Dockerfile that will be used to build the Docker image,
 	// relative to the root of the context (contextDir).
 	DockerfilePath string `json:"dockerfilePath,omitempty"`
+
+	// CacheVolumes are not supported by the Docker build strategy, as Docker
+	// builds can't mount volumes, and are rejected by validation.
+	CacheVolumes []BuildCacheVolume `json:"cacheVolumes,omitempty"`
 }
 
 // SourceBuildStrategy defines input parameters specific to an Source build.
@@ -400,6 +445,84 @@ type SourceBuildStrategy struct {
 
 	// ForcePull describes if the builder should pull the images from registry prior to building.
 	ForcePull bool `json:"forcePull,omitempty"`
+
+	// CacheVolumes are the cache volumes of the build.
+	CacheVolumes []BuildCacheVolume `json:"cacheVolumes,omitempty"`
+}
+
+// BuildCacheVolume is a persistent volume claim that keeps files, such as
+// downloaded dependencies, from one build of a BuildConfig to the next. The
+// builds of each BuildConfig use their own directory of the volume, so a claim
+// can be shared by several BuildConfigs.
+type BuildCacheVolume struct {
+	// Name identifies the cache volume within the build.
+	Name string `json:"name"`
+
+	// ClaimName is the name of the persistent volume claim that stores the cache.
+	ClaimName string `json:"claimName"`
+
+	// Path is the absolute path of the directory the build keeps the cache in.
+	// The cache is injected at Path into the container where the assemble
+	// script runs and the injected files are truncated once the script
+	// finishes. What the build leaves at Path is copied back to the volume
+	// after a successful build.
+	Path string `json:"path"`
+}
+
+// PipelineBuildStrategy defines the stages of a Pipeline build. A Pipeline
+// build doesn't run in a pod of its own: the stages run one after the other
+// and the build fails with the first stage that fails.
+type PipelineBuildStrategy struct {
+	// Stages is the ordered list of stages of the pipeline.
+	Stages []PipelineStage `json:"stages"`
+}
+
+// PipelineStage is a stage of a Pipeline build. Exactly one of build, test
+// and promote must be set.
+type PipelineStage struct {
+	// Name identifies the stage within the pipeline.
+	Name string `json:"name"`
+
+	// Build starts a build of another BuildConfig and waits for it to complete.
+	Build *PipelineBuildStage `json:"build,omitempty"`
+
+	// Test runs a command in an image and waits for it to exit.
+	Test *PipelineTestStage `json:"test,omitempty"`
+
+	// Promote tags an image into an image stream.
+	Promote *PipelinePromoteStage `json:"promote,omitempty"`
+}
+
+// PipelineBuildStage builds a BuildConfig as part of a pipeline.
+type PipelineBuildStage struct {
+	// BuildConfig is the name of the BuildConfig to build, in the namespace of
+	// the pipeline.
+	BuildConfig string `json:"buildConfig"`
+}
+
+// PipelineTestStage runs a command in a pod as part of a pipeline. The stage
+// passes if the command exits with a zero exit code.
+type PipelineTestStage struct {
+	// Image is a reference to the DockerImage, or to an ImageStreamTag in the
+	// namespace of the pipeline, to run the command in. It is typically the
+	// output of an earlier build stage.
+	Image kapi.ObjectReference `json:"image"`
+
+	// Command is the command to run. The entrypoint of the image runs if it
+	// is empty.
+	Command []string `json:"command,omitempty"`
+}
+
+// PipelinePromoteStage tags an image into an image stream as part of a
+// pipeline, creating the image stream if it doesn't exist.
+type PipelinePromoteStage struct {
+	// From is a reference to the ImageStreamTag to promote, in the namespace
+	// of the pipeline. It is typically the output of an earlier build stage.
+	From kapi.ObjectReference `json:"from"`
+
+	// To is a reference to the ImageStreamTag to tag the promoted image as, in
+	// the namespace of the pipeline.
+	To kapi.ObjectReference `json:"to"`
 }
 
 // A BuildPostCommitSpec holds a build post commit hook specification. The hook
@@ -490,6 +613,79 @@ type BuildPostCommitSpec struct {
 	Script string `json:"script,omitempty"`
 }
 
+// BuildNotification is a target notified when a build completes. Exactly one
+// of Webhook, Slack and Email must be set.
+type BuildNotification struct {
+	// Phases are the phases of the completed build the target is notified of.
+	// Defaults to Complete, Failed, Error and Cancelled.
+	Phases []BuildPhase `json:"phases,omitempty"`
+
+	// Webhook posts the build result as JSON to a URL.
+	Webhook *WebhookNotification `json:"webhook,omitempty"`
+
+	// Slack posts the build result as a message to a Slack-compatible
+	// incoming webhook.
+	Slack *SlackNotification `json:"slack,omitempty"`
+
+	// Email sends the build result by email through the SMTP server
+	// configured on the master.
+	Email *EmailNotification `json:"email,omitempty"`
+}
+
+// WebhookNotification posts the build result as JSON to a URL.
+type WebhookNotification struct {
+	// URL is the http or https URL the build result is posted to.
+	URL string `json:"url"`
+}
+
+// SlackNotification posts the build result to a Slack-compatible incoming webhook.
+type SlackNotification struct {
+	// URL is the URL of the incoming webhook.
+	URL string `json:"url"`
+
+	// Channel overrides the channel the incoming webhook posts to.
+	Channel string `json:"channel,omitempty"`
+}
+
+// EmailNotification sends the build result by email.
+type EmailNotification struct {
+	// To are the addresses the email is sent to.
+	To []string `json:"to"`
+}
+
+// CommitStatusReporting reports the status of builds on the commits they build,
+// so that pull requests show whether the build of their head passed.
+type CommitStatusReporting struct {
+	// Provider is the Git hosting service the statuses are posted to.
+	Provider CommitStatusProvider `json:"provider"`
+
+	// APIURL is the base URL of the API of the Git hosting service. Defaults to
+	// https://api.github.com for GitHub and https://gitlab.com/api/v3 for GitLab.
+	// Loopback, link-local and private addresses are refused unless the master
+	// configuration allows them.
+	APIURL string `json:"apiURL,omitempty"`
+
+	// TokenSecret is a reference to a Secret in the namespace of the build whose
+	// CommitStatusTokenKey key is the API token the statuses are posted with. It
+	// has to be one of the secrets of the service account of the build.
+	TokenSecret kapi.LocalObjectReference `json:"tokenSecret"`
+
+	// Context distinguishes the status of the build from the other statuses of
+	// the commit. Defaults to "openshift/" followed by the name of the BuildConfig.
+	Context string `json:"context,omitempty"`
+}
+
+// CommitStatusProvider is a Git hosting service commit statuses are posted to.
+type CommitStatusProvider string
+
+const (
+	// CommitStatusProviderGitHub posts commit statuses to the GitHub API.
+	CommitStatusProviderGitHub CommitStatusProvider = "GitHub"
+
+	// CommitStatusProviderGitLab posts commit statuses to the GitLab API.
+	CommitStatusProviderGitLab CommitStatusProvider = "GitLab"
+)
+
 // BuildOutput is input to a build strategy and describes the Docker image that the strategy
 // should produce.
 type BuildOutput struct {
@@ -525,10 +721,87 @@ type BuildConfigSpec struct {
 	// are defined, a new build can only occur as a result of an explicit client build creation.
 	Triggers []BuildTriggerPolicy `json:"triggers"`
 
+	// RunPolicy describes how the new builds created from this BuildConfig run
+	// alongside each other. Defaults to Parallel.
+	RunPolicy BuildRunPolicy `json:"runPolicy,omitempty"`
+
+	// Parameters are the parameters of the builds of the BuildConfig. The value of a
+	// parameter replaces the ${NAME} references to the parameter in the build
+	// specification, like the parameters of templates.
+	Parameters []BuildParameter `json:"parameters,omitempty"`
+
+	// Matrix, if set, makes each instantiation of the BuildConfig start a build for
+	// each combination of the values of its parameters. Unless the output image of
+	// the BuildConfig references a matrix parameter, the values of the combination
+	// are appended to the tag of the output image of its build, so that each build
+	// pushes to a distinct tag.
+	Matrix []BuildMatrixParameter `json:"matrix,omitempty"`
+
 	// BuildSpec is the desired build specification
 	BuildSpec `json:",inline"`
 }
 
+// BuildRunPolicy defines how the builds of a BuildConfig run alongside each other.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel runs the builds of a BuildConfig as soon as they are
+	// created, regardless of each other.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial runs the builds of a BuildConfig one after the other in
+	// the order they were created. New builds stay in the New phase until all
+	// earlier builds completed.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly runs the builds of a BuildConfig one after
+	// the other like BuildRunPolicySerial, but cancels queued builds which haven't
+	// started yet when a newer build is created.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
+// BuildParameter is a parameter of the builds of a BuildConfig.
+type BuildParameter struct {
+	// Name of the parameter. It is referenced as ${NAME} in the build specification.
+	Name string `json:"name"`
+
+	// Description of the parameter.
+	Description string `json:"description,omitempty"`
+
+	// Type of the parameter values. Defaults to String.
+	Type BuildParameterType `json:"type,omitempty"`
+
+	// Value is the default value of the parameter.
+	Value string `json:"value,omitempty"`
+
+	// Required indicates the parameter must have a value. Builds can't be started
+	// without a value for a required parameter which has no default value.
+	Required bool `json:"required,omitempty"`
+}
+
+// BuildParameterType is the type of the values of a build parameter.
+type BuildParameterType string
+
+const (
+	// BuildParameterTypeString accepts any value.
+	BuildParameterTypeString BuildParameterType = "String"
+
+	// BuildParameterTypeInteger accepts integers.
+	BuildParameterTypeInteger BuildParameterType = "Integer"
+
+	// BuildParameterTypeBoolean accepts true and false.
+	BuildParameterTypeBoolean BuildParameterType = "Boolean"
+)
+
+// BuildMatrixParameter is a parameter of a build matrix and the values it takes.
+type BuildMatrixParameter struct {
+	// Name is the name of a parameter of the BuildConfig.
+	Name string `json:"name"`
+
+	// Values are the values of the parameter a build is started for.
+	Values []string `json:"values"`
+}
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -539,6 +812,26 @@ type BuildConfigStatus struct {
 type WebHookTrigger struct {
 	// Secret used to validate requests.
 	Secret string `json:"secret,omitempty"`
+
+	// SignatureSecret is a reference to a Secret in the namespace of the BuildConfig. When it
+	// is set, only payloads signed with the value of its WebHookSecretKey key are accepted.
+	// Only used by GitHub webhooks.
+	SignatureSecret *kapi.LocalObjectReference `json:"signatureSecret,omitempty"`
+
+	// AllowPullRequests enables builds of the head of pull requests opened or updated against
+	// the configured Git ref. Their output image is tagged "pr-<number>" rather than with the
+	// configured tag. Only used by GitHub webhooks.
+	AllowPullRequests bool `json:"allowPullRequests,omitempty"`
+
+	// IncludePaths restricts the pushes that trigger a build to those changing at least one
+	// file under one of these paths, relative to the root of the repository. Paths may contain
+	// shell file name patterns. Defaults to the context directory of the source, if any. Only
+	// used by GitHub and GitLab webhooks.
+	IncludePaths []string `json:"includePaths,omitempty"`
+
+	// ExcludePaths lists paths whose changes never trigger a build, even if they are under
+	// one of the IncludePaths. Only used by GitHub and GitLab webhooks.
+	ExcludePaths []string `json:"excludePaths,omitempty"`
 }
 
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
@@ -565,6 +858,15 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger `json:"generic,omitempty"`
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger `json:"gitlab,omitempty"`
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger `json:"bitbucket,omitempty"`
+
+	// GogsWebHook contains the parameters for a Gogs webhook type of trigger
+	GogsWebHook *WebHookTrigger `json:"gogs,omitempty"`
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger `json:"imageChange,omitempty"`
 }
@@ -583,6 +885,18 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
+	// GogsWebHookBuildTriggerType represents a trigger that launches builds on
+	// Gogs webhook invocations
+	GogsWebHookBuildTriggerType BuildTriggerType = "Gogs"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
@@ -658,6 +972,37 @@ type BuildRequest struct {
 
 	// Env contains additional environment variables you want to pass into a builder container
 	Env []kapi.EnvVar `json:"env,omitempty"`
+
+	// PullRequest (optional) is the pull request to build. Its head is built instead of the
+	// configured Git ref.
+	PullRequest *GitPullRequest `json:"pullRequest,omitempty"`
+
+	// NoCache if set to true empties the cache volumes of the build before it runs and, for
+	// the Docker build strategy, builds without the cached layers of the Docker daemon.
+	NoCache bool `json:"noCache,omitempty"`
+
+	// Parameters are the values of the parameters of the BuildConfig for this build. The
+	// parameters which are not set keep their default value. Setting a matrix parameter
+	// restricts the matrix builds to its value.
+	Parameters []BuildParameterValue `json:"parameters,omitempty"`
+}
+
+// BuildParameterValue is the value of a build parameter.
+type BuildParameterValue struct {
+	// Name of the parameter.
+	Name string `json:"name"`
+
+	// Value of the parameter.
+	Value string `json:"value,omitempty"`
+}
+
+// GitPullRequest identifies a pull request of a Git repository.
+type GitPullRequest struct {
+	// Number is the number of the pull request.
+	Number int `json:"number"`
+
+	// Ref is the Git ref of the head of the pull request, e.g. refs/pull/1/head.
+	Ref string `json:"ref"`
 }
 
 // BinaryBuildRequestOptions are the options required to fully speficy a binary build request
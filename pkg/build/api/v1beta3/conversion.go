@@ -214,6 +214,8 @@ func convert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *newer.BuildStrategy,
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.PipelineStrategy != nil:
+		out.Type = PipelineBuildStrategyType
 	}
 	return nil
 }
@@ -2,13 +2,17 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"net/mail"
 	"net/url"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
@@ -74,6 +78,9 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 		if from == nil {
 			from = buildFrom
 		}
+		if buildutil.ReferencesBuildParameters(config, from) {
+			allErrs = append(allErrs, field.Invalid(triggersPath.Index(i).Child("imageChange"), from.Name, "can't trigger builds for an image that references build parameters"))
+		}
 		fromKey := refKey(config.Namespace, from)
 		_, exists := fromRefs[fromKey]
 		if exists {
@@ -82,7 +89,36 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 		fromRefs[fromKey] = struct{}{}
 	}
 
-	allErrs = append(allErrs, validateBuildSpec(&config.Spec.BuildSpec, specPath)...)
+	switch config.Spec.RunPolicy {
+	case "", buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("runPolicy"), config.Spec.RunPolicy, []string{string(buildapi.BuildRunPolicyParallel), string(buildapi.BuildRunPolicySerial), string(buildapi.BuildRunPolicySerialLatestOnly)}))
+	}
+
+	allErrs = append(allErrs, validateBuildParameters(config, specPath)...)
+
+	// The build specification is validated with its parameter references
+	// replaced by example values, since a reference isn't a valid value of
+	// most fields.
+	buildSpec := &config.Spec.BuildSpec
+	if len(config.Spec.Parameters) > 0 {
+		obj, err := kapi.Scheme.Copy(config)
+		if err != nil {
+			return append(allErrs, field.InternalError(specPath, err))
+		}
+		buildSpec = &obj.(*buildapi.BuildConfig).Spec.BuildSpec
+		buildutil.SubstituteBuildParameters(buildSpec, exampleParameterValues(config))
+	}
+	allErrs = append(allErrs, validateBuildSpec(buildSpec, specPath)...)
+
+	if pipeline := config.Spec.Strategy.PipelineStrategy; pipeline != nil {
+		stagesPath := specPath.Child("strategy", "pipelineStrategy", "stages")
+		for i, stage := range pipeline.Stages {
+			if stage.Build != nil && stage.Build.BuildConfig == config.Name {
+				allErrs = append(allErrs, field.Invalid(stagesPath.Index(i).Child("build", "buildConfig"), stage.Build.BuildConfig, "a pipeline may not build its own BuildConfig"))
+			}
+		}
+	}
 
 	return allErrs
 }
@@ -94,16 +130,127 @@ func ValidateBuildConfigUpdate(config *buildapi.BuildConfig, older *buildapi.Bui
 	return allErrs
 }
 
+var buildParameterNameExp = regexp.MustCompile(`^[a-zA-Z0-9\_]+$`)
+
+func validateBuildParameters(config *buildapi.BuildConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	params := map[string]buildapi.BuildParameter{}
+	paramsPath := fldPath.Child("parameters")
+	for i, param := range config.Spec.Parameters {
+		paramPath := paramsPath.Index(i)
+		switch {
+		case len(param.Name) == 0:
+			allErrs = append(allErrs, field.Required(paramPath.Child("name"), ""))
+		case !buildParameterNameExp.MatchString(param.Name):
+			allErrs = append(allErrs, field.Invalid(paramPath.Child("name"), param.Name, fmt.Sprintf("does not match %v", buildParameterNameExp)))
+		default:
+			if _, exists := params[param.Name]; exists {
+				allErrs = append(allErrs, field.Duplicate(paramPath.Child("name"), param.Name))
+			}
+		}
+		params[param.Name] = param
+
+		switch param.Type {
+		case "", buildapi.BuildParameterTypeString, buildapi.BuildParameterTypeInteger, buildapi.BuildParameterTypeBoolean:
+			if len(param.Value) > 0 {
+				if err := buildutil.ValidateBuildParameterValue(param.Type, param.Value); err != nil {
+					allErrs = append(allErrs, field.Invalid(paramPath.Child("value"), param.Value, err.Error()))
+				}
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(paramPath.Child("type"), param.Type, []string{string(buildapi.BuildParameterTypeString), string(buildapi.BuildParameterTypeInteger), string(buildapi.BuildParameterTypeBoolean)}))
+		}
+	}
+
+	matrixPath := fldPath.Child("matrix")
+	matrixParams := sets.NewString()
+	for i, matrixParam := range config.Spec.Matrix {
+		matrixParamPath := matrixPath.Index(i)
+		param, declared := params[matrixParam.Name]
+		switch {
+		case len(matrixParam.Name) == 0:
+			allErrs = append(allErrs, field.Required(matrixParamPath.Child("name"), ""))
+		case !declared:
+			allErrs = append(allErrs, field.Invalid(matrixParamPath.Child("name"), matrixParam.Name, "must be the name of a parameter"))
+		case matrixParams.Has(matrixParam.Name):
+			allErrs = append(allErrs, field.Duplicate(matrixParamPath.Child("name"), matrixParam.Name))
+		}
+		matrixParams.Insert(matrixParam.Name)
+
+		if len(matrixParam.Values) == 0 {
+			allErrs = append(allErrs, field.Required(matrixParamPath.Child("values"), ""))
+		}
+		values := sets.NewString()
+		for j, value := range matrixParam.Values {
+			if err := buildutil.ValidateBuildParameterValue(param.Type, value); err != nil {
+				allErrs = append(allErrs, field.Invalid(matrixParamPath.Child("values").Index(j), value, err.Error()))
+			} else if values.Has(value) {
+				allErrs = append(allErrs, field.Duplicate(matrixParamPath.Child("values").Index(j), value))
+			}
+			values.Insert(value)
+		}
+	}
+	if len(config.Spec.Matrix) > 0 && config.Spec.RunPolicy == buildapi.BuildRunPolicySerialLatestOnly {
+		allErrs = append(allErrs, field.Invalid(matrixPath, "", fmt.Sprintf("may not be set with the %s run policy, which would cancel the queued builds of the matrix", buildapi.BuildRunPolicySerialLatestOnly)))
+	}
+	return allErrs
+}
+
+// exampleParameterValues returns values for the parameters of config: their
+// default value, the first value of the matrix parameters or a value of their
+// type.
+func exampleParameterValues(config *buildapi.BuildConfig) map[string]string {
+	values := map[string]string{}
+	for _, param := range config.Spec.Parameters {
+		switch {
+		case len(param.Value) > 0:
+			values[param.Name] = param.Value
+		case param.Type == buildapi.BuildParameterTypeInteger:
+			values[param.Name] = "0"
+		case param.Type == buildapi.BuildParameterTypeBoolean:
+			values[param.Name] = "false"
+		default:
+			values[param.Name] = "value"
+		}
+	}
+	for _, matrixParam := range config.Spec.Matrix {
+		if _, declared := values[matrixParam.Name]; declared && len(matrixParam.Values) > 0 {
+			values[matrixParam.Name] = matrixParam.Values[0]
+		}
+	}
+	return values
+}
+
 // ValidateBuildRequest validates a BuildRequest object
 func ValidateBuildRequest(request *buildapi.BuildRequest) field.ErrorList {
-	return validation.ValidateObjectMeta(&request.ObjectMeta, true, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+	allErrs := validation.ValidateObjectMeta(&request.ObjectMeta, true, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+	if pr := request.PullRequest; pr != nil {
+		prPath := field.NewPath("pullRequest")
+		if pr.Number <= 0 {
+			allErrs = append(allErrs, field.Invalid(prPath.Child("number"), pr.Number, "must be greater than zero"))
+		}
+		if !strings.HasPrefix(pr.Ref, "refs/") {
+			allErrs = append(allErrs, field.Invalid(prPath.Child("ref"), pr.Ref, "must be a full Git ref, e.g. refs/pull/1/head"))
+		}
+	}
+	paramsPath := field.NewPath("parameters")
+	params := sets.NewString()
+	for i, param := range request.Parameters {
+		if len(param.Name) == 0 {
+			allErrs = append(allErrs, field.Required(paramsPath.Index(i).Child("name"), ""))
+		} else if params.Has(param.Name) {
+			allErrs = append(allErrs, field.Duplicate(paramsPath.Index(i).Child("name"), param.Name))
+		}
+		params.Insert(param.Name)
+	}
+	return allErrs
 }
 
 func validateBuildSpec(spec *buildapi.BuildSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	s := spec.Strategy
 
-	if s.CustomStrategy == nil && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
+	if s.CustomStrategy == nil && s.PipelineStrategy == nil && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("source"), spec.Source, "must provide a value for at least one of source, binary, or dockerfile"))
 	}
 
@@ -118,6 +265,26 @@ func validateBuildSpec(spec *buildapi.BuildSpec, fldPath *field.Path) field.Erro
 	allErrs = append(allErrs, validateOutput(&spec.Output, fldPath.Child("output"))...)
 	allErrs = append(allErrs, validateStrategy(&spec.Strategy, fldPath.Child("strategy"))...)
 	allErrs = append(allErrs, validatePostCommit(spec.PostCommit, fldPath.Child("postCommit"))...)
+	allErrs = append(allErrs, validateNotifications(spec.Notifications, fldPath.Child("notifications"))...)
+	if spec.CommitStatus != nil {
+		allErrs = append(allErrs, validateCommitStatus(spec.CommitStatus, fldPath.Child("commitStatus"))...)
+		if spec.Source.Git == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("commitStatus"), "", "may only be set for a Git source"))
+		}
+	}
+
+	// Pipeline builds produce no image of their own, their stages do.
+	if s.PipelineStrategy != nil {
+		if spec.Source.Binary != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("source", "binary"), "", "may not be set for a pipeline"))
+		}
+		if spec.Output.To != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("output", "to"), spec.Output.To, "may not be set for a pipeline"))
+		}
+		if !kapi.Semantic.DeepEqual(spec.PostCommit, buildapi.BuildPostCommitSpec{}) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("postCommit"), spec.PostCommit, "may not be set for a pipeline"))
+		}
+	}
 
 	// TODO: validate resource requirements (prereq: https://github.com/kubernetes/kubernetes/pull/7059)
 	return allErrs
@@ -383,8 +550,11 @@ func validateStrategy(strategy *buildapi.BuildStrategy, fldPath *field.Path) fie
 	if strategy.CustomStrategy != nil {
 		strategyCount++
 	}
+	if strategy.PipelineStrategy != nil {
+		strategyCount++
+	}
 	if strategyCount != 1 {
-		return append(allErrs, field.Invalid(fldPath, strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, or dockerStrategy"))
+		return append(allErrs, field.Invalid(fldPath, strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, dockerStrategy, or pipelineStrategy"))
 	}
 
 	if strategy.SourceStrategy != nil {
@@ -396,6 +566,9 @@ func validateStrategy(strategy *buildapi.BuildStrategy, fldPath *field.Path) fie
 	if strategy.CustomStrategy != nil {
 		allErrs = append(allErrs, validateCustomStrategy(strategy.CustomStrategy, fldPath.Child("customStrategy"))...)
 	}
+	if strategy.PipelineStrategy != nil {
+		allErrs = append(allErrs, validatePipelineStrategy(strategy.PipelineStrategy, fldPath.Child("pipelineStrategy"))...)
+	}
 
 	return allErrs
 }
@@ -425,6 +598,9 @@ func validateDockerStrategy(strategy *buildapi.DockerBuildStrategy, fldPath *fie
 	}
 
 	allErrs = append(allErrs, ValidateStrategyEnv(strategy.Env, fldPath.Child("env"))...)
+	if len(strategy.CacheVolumes) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("cacheVolumes"), "cache volumes are only supported by the Source strategy, Docker builds can't mount volumes"))
+	}
 
 	return allErrs
 }
@@ -434,6 +610,144 @@ func validateSourceStrategy(strategy *buildapi.SourceBuildStrategy, fldPath *fie
 	allErrs = append(allErrs, validateFromImageReference(&strategy.From, fldPath.Child("from"))...)
 	allErrs = append(allErrs, validateSecretRef(strategy.PullSecret, fldPath.Child("pullSecret"))...)
 	allErrs = append(allErrs, ValidateStrategyEnv(strategy.Env, fldPath.Child("env"))...)
+	allErrs = append(allErrs, validateCacheVolumes(strategy.CacheVolumes, fldPath.Child("cacheVolumes"))...)
+	return allErrs
+}
+
+// completedBuildPhases are the phases of a completed build.
+var completedBuildPhases = sets.NewString(
+	string(buildapi.BuildPhaseComplete),
+	string(buildapi.BuildPhaseFailed),
+	string(buildapi.BuildPhaseError),
+	string(buildapi.BuildPhaseCancelled),
+)
+
+func validateNotifications(notifications []buildapi.BuildNotification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, notification := range notifications {
+		notificationPath := fldPath.Index(i)
+
+		for j, phase := range notification.Phases {
+			if !completedBuildPhases.Has(string(phase)) {
+				allErrs = append(allErrs, field.NotSupported(notificationPath.Child("phases").Index(j), phase, completedBuildPhases.List()))
+			}
+		}
+
+		targets := 0
+		if notification.Webhook != nil {
+			targets++
+			allErrs = append(allErrs, validateNotificationURL(notification.Webhook.URL, notificationPath.Child("webhook", "url"))...)
+		}
+		if notification.Slack != nil {
+			targets++
+			allErrs = append(allErrs, validateNotificationURL(notification.Slack.URL, notificationPath.Child("slack", "url"))...)
+		}
+		if notification.Email != nil {
+			targets++
+			toPath := notificationPath.Child("email", "to")
+			if len(notification.Email.To) == 0 {
+				allErrs = append(allErrs, field.Required(toPath, ""))
+			}
+			for j, address := range notification.Email.To {
+				if _, err := mail.ParseAddress(address); err != nil {
+					allErrs = append(allErrs, field.Invalid(toPath.Index(j), address, err.Error()))
+				}
+			}
+		}
+		switch {
+		case targets == 0:
+			allErrs = append(allErrs, field.Required(notificationPath, "one of webhook, slack or email must be set"))
+		case targets > 1:
+			allErrs = append(allErrs, field.Invalid(notificationPath, "", "only one of webhook, slack or email may be set"))
+		}
+	}
+	return allErrs
+}
+
+func validateCommitStatus(commitStatus *buildapi.CommitStatusReporting, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch commitStatus.Provider {
+	case buildapi.CommitStatusProviderGitHub, buildapi.CommitStatusProviderGitLab:
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("provider"), ""))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("provider"), commitStatus.Provider, []string{string(buildapi.CommitStatusProviderGitHub), string(buildapi.CommitStatusProviderGitLab)}))
+	}
+	if len(commitStatus.APIURL) > 0 {
+		allErrs = append(allErrs, validateNotificationURL(commitStatus.APIURL, fldPath.Child("apiURL"))...)
+	}
+	allErrs = append(allErrs, validateSecretRef(&commitStatus.TokenSecret, fldPath.Child("tokenSecret"))...)
+	return allErrs
+}
+
+func validateNotificationURL(rawURL string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(rawURL) == 0 {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+	u, err := url.Parse(rawURL)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, rawURL, err.Error()))
+	case u.Scheme != "http" && u.Scheme != "https":
+		allErrs = append(allErrs, field.Invalid(fldPath, rawURL, "must be an http or https URL"))
+	case len(u.Host) == 0:
+		allErrs = append(allErrs, field.Invalid(fldPath, rawURL, "must have a host"))
+	case isLocalHost(u.Host):
+		allErrs = append(allErrs, field.Invalid(fldPath, rawURL, "must not be a loopback or link-local address"))
+	}
+	return allErrs
+}
+
+// isLocalHost returns true if the host of a URL is localhost or a loopback,
+// link-local or unspecified IP address. Private addresses may be allowed by
+// the master configuration, they are refused when the targets are reached.
+func isLocalHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if strings.ToLower(strings.TrimSuffix(host, ".")) == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && (ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsUnspecified())
+}
+
+func validateCacheVolumes(volumes []buildapi.BuildCacheVolume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names, paths := sets.NewString(), sets.NewString()
+	for i, volume := range volumes {
+		volumePath := fldPath.Index(i)
+		switch {
+		case len(volume.Name) == 0:
+			allErrs = append(allErrs, field.Required(volumePath.Child("name"), ""))
+		case !kvalidation.IsDNS1123Label(volume.Name):
+			allErrs = append(allErrs, field.Invalid(volumePath.Child("name"), volume.Name, "must be a valid DNS label"))
+		case names.Has(volume.Name):
+			allErrs = append(allErrs, field.Duplicate(volumePath.Child("name"), volume.Name))
+		}
+		names.Insert(volume.Name)
+
+		if len(volume.ClaimName) == 0 {
+			allErrs = append(allErrs, field.Required(volumePath.Child("claimName"), ""))
+		} else if ok, msg := validation.ValidatePersistentVolumeName(volume.ClaimName, false); !ok {
+			allErrs = append(allErrs, field.Invalid(volumePath.Child("claimName"), volume.ClaimName, msg))
+		}
+
+		cleaned := path.Clean(volume.Path)
+		switch {
+		case len(volume.Path) == 0:
+			allErrs = append(allErrs, field.Required(volumePath.Child("path"), ""))
+		case !path.IsAbs(volume.Path):
+			allErrs = append(allErrs, field.Invalid(volumePath.Child("path"), volume.Path, "must be an absolute path"))
+		case cleaned == "/":
+			allErrs = append(allErrs, field.Invalid(volumePath.Child("path"), volume.Path, "must not be the root directory"))
+		case paths.Has(cleaned):
+			allErrs = append(allErrs, field.Duplicate(volumePath.Child("path"), volume.Path))
+		}
+		paths.Insert(cleaned)
+	}
 	return allErrs
 }
 
@@ -445,6 +759,79 @@ func validateCustomStrategy(strategy *buildapi.CustomBuildStrategy, fldPath *fie
 	return allErrs
 }
 
+func validatePipelineStrategy(strategy *buildapi.PipelineBuildStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	stagesPath := fldPath.Child("stages")
+	if len(strategy.Stages) == 0 {
+		allErrs = append(allErrs, field.Required(stagesPath, "a pipeline must have at least one stage"))
+	}
+
+	names := sets.NewString()
+	for i, stage := range strategy.Stages {
+		stagePath := stagesPath.Index(i)
+		switch {
+		case len(stage.Name) == 0:
+			allErrs = append(allErrs, field.Required(stagePath.Child("name"), ""))
+		case !kvalidation.IsDNS1123Label(stage.Name):
+			allErrs = append(allErrs, field.Invalid(stagePath.Child("name"), stage.Name, "must be a valid DNS label"))
+		case names.Has(stage.Name):
+			allErrs = append(allErrs, field.Duplicate(stagePath.Child("name"), stage.Name))
+		}
+		names.Insert(stage.Name)
+
+		actions := 0
+		if stage.Build != nil {
+			actions++
+			if len(stage.Build.BuildConfig) == 0 {
+				allErrs = append(allErrs, field.Required(stagePath.Child("build", "buildConfig"), ""))
+			} else if ok, msg := validation.NameIsDNSSubdomain(stage.Build.BuildConfig, false); !ok {
+				allErrs = append(allErrs, field.Invalid(stagePath.Child("build", "buildConfig"), stage.Build.BuildConfig, msg))
+			}
+		}
+		if stage.Test != nil {
+			actions++
+			imagePath := stagePath.Child("test", "image")
+			switch stage.Test.Image.Kind {
+			case "DockerImage":
+				allErrs = append(allErrs, validateFromImageReference(&stage.Test.Image, imagePath)...)
+			case "ImageStreamTag":
+				allErrs = append(allErrs, validateImageStreamTagReference(&stage.Test.Image, imagePath)...)
+			case "":
+				allErrs = append(allErrs, field.Required(imagePath.Child("kind"), ""))
+			default:
+				allErrs = append(allErrs, field.Invalid(imagePath.Child("kind"), stage.Test.Image.Kind, "the image to test must be a 'DockerImage' or an 'ImageStreamTag'"))
+			}
+		}
+		if stage.Promote != nil {
+			actions++
+			allErrs = append(allErrs, validateImageStreamTagReference(&stage.Promote.From, stagePath.Child("promote", "from"))...)
+			allErrs = append(allErrs, validateImageStreamTagReference(&stage.Promote.To, stagePath.Child("promote", "to"))...)
+		}
+		if actions != 1 {
+			allErrs = append(allErrs, field.Invalid(stagePath, stage.Name, "must provide a value for exactly one of build, test, or promote"))
+		}
+	}
+	return allErrs
+}
+
+// validateImageStreamTagReference checks that reference is a valid reference
+// to an ImageStreamTag of the namespace of the pipeline. The pipeline
+// controller may read and tag image streams of any namespace, so a pipeline
+// must not reach out of its own namespace.
+func validateImageStreamTagReference(reference *kapi.ObjectReference, fldPath *field.Path) field.ErrorList {
+	switch reference.Kind {
+	case "ImageStreamTag":
+		if len(reference.Namespace) != 0 {
+			return field.ErrorList{field.Invalid(fldPath.Child("namespace"), reference.Namespace, "a pipeline may only refer to image streams in its own namespace")}
+		}
+		return validateToImageReference(reference, fldPath)
+	case "":
+		return field.ErrorList{field.Required(fldPath.Child("kind"), "")}
+	default:
+		return field.ErrorList{field.Invalid(fldPath.Child("kind"), reference.Kind, "must be an 'ImageStreamTag'")}
+	}
+}
+
 func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.ObjectReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(trigger.Type) == 0 {
@@ -458,13 +845,31 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 		if trigger.GitHubWebHook == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("github"), ""))
 		} else {
-			allErrs = append(allErrs, validateWebHook(trigger.GitHubWebHook, fldPath.Child("github"))...)
+			allErrs = append(allErrs, validateWebHook(trigger.GitHubWebHook, fldPath.Child("github"), trigger.Type)...)
 		}
 	case buildapi.GenericWebHookBuildTriggerType:
 		if trigger.GenericWebHook == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("generic"), ""))
 		} else {
-			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook, fldPath.Child("generic"))...)
+			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook, fldPath.Child("generic"), trigger.Type)...)
+		}
+	case buildapi.GitLabWebHookBuildTriggerType:
+		if trigger.GitLabWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("gitlab"), ""))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.GitLabWebHook, fldPath.Child("gitlab"), trigger.Type)...)
+		}
+	case buildapi.BitbucketWebHookBuildTriggerType:
+		if trigger.BitbucketWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("bitbucket"), ""))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.BitbucketWebHook, fldPath.Child("bitbucket"), trigger.Type)...)
+		}
+	case buildapi.GogsWebHookBuildTriggerType:
+		if trigger.GogsWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("gogs"), ""))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.GogsWebHook, fldPath.Child("gogs"), trigger.Type)...)
 		}
 	case buildapi.ImageChangeBuildTriggerType:
 		if trigger.ImageChange == nil {
@@ -500,11 +905,61 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 	return allErrs
 }
 
-func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path) field.ErrorList {
+func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path, triggerType buildapi.BuildTriggerType) field.ErrorList {
 	allErrs := field.ErrorList{}
+	gitHub := triggerType == buildapi.GitHubWebHookBuildTriggerType
+	gitLab := triggerType == buildapi.GitLabWebHookBuildTriggerType
 	if len(webHook.Secret) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("secret"), ""))
 	}
+	if webHook.SignatureSecret != nil {
+		switch {
+		case !gitHub:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("signatureSecret"), webHook.SignatureSecret.Name, "only supported by GitHub webhooks"))
+		case len(webHook.SignatureSecret.Name) == 0:
+			allErrs = append(allErrs, field.Required(fldPath.Child("signatureSecret", "name"), ""))
+		default:
+			if ok, msg := validation.ValidateSecretName(webHook.SignatureSecret.Name, false); !ok {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("signatureSecret", "name"), webHook.SignatureSecret.Name, msg))
+			}
+		}
+	}
+	if webHook.AllowPullRequests && !gitHub {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("allowPullRequests"), webHook.AllowPullRequests, "only supported by GitHub webhooks"))
+	}
+	for _, filter := range []struct {
+		name  string
+		paths []string
+	}{
+		{"includePaths", webHook.IncludePaths},
+		{"excludePaths", webHook.ExcludePaths},
+	} {
+		if len(filter.paths) > 0 && !gitHub && !gitLab {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(filter.name), filter.paths, "only supported by GitHub and GitLab webhooks"))
+			continue
+		}
+		for i, p := range filter.paths {
+			allErrs = append(allErrs, validateWebHookPath(p, fldPath.Child(filter.name).Index(i))...)
+		}
+	}
+	return allErrs
+}
+
+func validateWebHookPath(p string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(p) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, ""))
+		return allErrs
+	}
+	if path.IsAbs(p) {
+		allErrs = append(allErrs, field.Invalid(fldPath, p, "must be a relative path"))
+	}
+	if strings.HasPrefix(path.Clean(p), "..") {
+		allErrs = append(allErrs, field.Invalid(fldPath, p, "must not point outside of the repository"))
+	}
+	if _, err := path.Match(p, ""); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, p, err.Error()))
+	}
 	return allErrs
 }
 
@@ -327,6 +327,151 @@ func TestBuildConfigValidationFailureRequiredName(t *testing.T) {
 	}
 }
 
+func TestBuildConfigRunPolicy(t *testing.T) {
+	tests := []struct {
+		policy      buildapi.BuildRunPolicy
+		expectedErr bool
+	}{
+		{policy: ""},
+		{policy: buildapi.BuildRunPolicyParallel},
+		{policy: buildapi.BuildRunPolicySerial},
+		{policy: buildapi.BuildRunPolicySerialLatestOnly},
+		{policy: "Latest", expectedErr: true},
+	}
+	for _, test := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: test.policy,
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if !test.expectedErr {
+			if len(errors) > 0 {
+				t.Errorf("%q: unexpected validation errors %v", test.policy, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Errorf("%q: expected a single validation error, got %v", test.policy, errors)
+			continue
+		}
+		if errors[0].Type != field.ErrorTypeNotSupported || errors[0].Field != "spec.runPolicy" {
+			t.Errorf("%q: unexpected error %v", test.policy, errors[0])
+		}
+	}
+}
+
+func TestBuildConfigPipelineStrategy(t *testing.T) {
+	tests := []struct {
+		name          string
+		stages        []buildapi.PipelineStage
+		output        *kapi.ObjectReference
+		expectedField string
+	}{
+		{
+			name: "valid",
+			stages: []buildapi.PipelineStage{
+				{Name: "build", Build: &buildapi.PipelineBuildStage{BuildConfig: "app"}},
+				{Name: "test", Test: &buildapi.PipelineTestStage{Image: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"}, Command: []string{"make", "test"}}},
+				{Name: "promote", Promote: &buildapi.PipelinePromoteStage{
+					From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+					To:   kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:prod"},
+				}},
+			},
+		},
+		{
+			name:          "no stages",
+			expectedField: "spec.strategy.pipelineStrategy.stages",
+		},
+		{
+			name: "duplicate stage name",
+			stages: []buildapi.PipelineStage{
+				{Name: "build", Build: &buildapi.PipelineBuildStage{BuildConfig: "app"}},
+				{Name: "build", Build: &buildapi.PipelineBuildStage{BuildConfig: "other"}},
+			},
+			expectedField: "spec.strategy.pipelineStrategy.stages[1].name",
+		},
+		{
+			name: "no action",
+			stages: []buildapi.PipelineStage{
+				{Name: "build"},
+			},
+			expectedField: "spec.strategy.pipelineStrategy.stages[0]",
+		},
+		{
+			name: "builds itself",
+			stages: []buildapi.PipelineStage{
+				{Name: "build", Build: &buildapi.PipelineBuildStage{BuildConfig: "config-id"}},
+			},
+			expectedField: "spec.strategy.pipelineStrategy.stages[0].build.buildConfig",
+		},
+		{
+			name: "test image kind",
+			stages: []buildapi.PipelineStage{
+				{Name: "test", Test: &buildapi.PipelineTestStage{Image: kapi.ObjectReference{Kind: "ImageStreamImage", Name: "app@sha256:abc"}}},
+			},
+			expectedField: "spec.strategy.pipelineStrategy.stages[0].test.image.kind",
+		},
+		{
+			name: "promote to another namespace",
+			stages: []buildapi.PipelineStage{
+				{Name: "promote", Promote: &buildapi.PipelinePromoteStage{
+					From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+					To:   kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:prod", Namespace: "prod"},
+				}},
+			},
+			expectedField: "spec.strategy.pipelineStrategy.stages[0].promote.to.namespace",
+		},
+		{
+			name: "output",
+			stages: []buildapi.PipelineStage{
+				{Name: "build", Build: &buildapi.PipelineBuildStage{BuildConfig: "app"}},
+			},
+			output:        &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+			expectedField: "spec.output.to",
+		},
+	}
+	for _, test := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: buildapi.BuildRunPolicySerial,
+				BuildSpec: buildapi.BuildSpec{
+					Strategy: buildapi.BuildStrategy{
+						PipelineStrategy: &buildapi.PipelineBuildStrategy{Stages: test.stages},
+					},
+					Output: buildapi.BuildOutput{To: test.output},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if len(test.expectedField) == 0 {
+			if len(errors) > 0 {
+				t.Errorf("%s: unexpected validation errors %v", test.name, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Errorf("%s: expected a single validation error, got %v", test.name, errors)
+			continue
+		}
+		if errors[0].Field != test.expectedField {
+			t.Errorf("%s: expected an error for %s, got %v", test.name, test.expectedField, errors[0])
+		}
+	}
+}
+
 func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -639,6 +784,18 @@ func TestValidateBuildRequest(t *testing.T) {
 	testCases := map[string]*buildapi.BuildRequest{
 		string(field.ErrorTypeRequired) + "metadata.namespace": {ObjectMeta: kapi.ObjectMeta{Name: "requestName"}},
 		string(field.ErrorTypeRequired) + "metadata.name":      {ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault}},
+		string(field.ErrorTypeInvalid) + "pullRequest.number": {
+			ObjectMeta:  kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			PullRequest: &buildapi.GitPullRequest{Ref: "refs/pull/1/head"},
+		},
+		string(field.ErrorTypeInvalid) + "pullRequest.ref": {
+			ObjectMeta:  kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			PullRequest: &buildapi.GitPullRequest{Number: 1, Ref: "master"},
+		},
+		string(field.ErrorTypeDuplicate) + "parameters[1].name": {
+			ObjectMeta: kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			Parameters: []buildapi.BuildParameterValue{{Name: "JDK_VERSION", Value: "7"}, {Name: "JDK_VERSION", Value: "8"}},
+		},
 	}
 
 	for desc, tc := range testCases {
@@ -1682,6 +1839,66 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*field.Error{field.Required(field.NewPath("github"), "")},
 		},
+		"GitHub trigger with invalid signature secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:          "secret101",
+					SignatureSecret: &kapi.LocalObjectReference{Name: "Invalid_Name"},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("github", "signatureSecret", "name"), "", "")},
+		},
+		"Generic trigger with signature secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GenericWebHookBuildTriggerType,
+				GenericWebHook: &buildapi.WebHookTrigger{
+					Secret:          "secret101",
+					SignatureSecret: &kapi.LocalObjectReference{Name: "webhook"},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("generic", "signatureSecret"), "", "")},
+		},
+		"GitLab trigger allowing pull requests": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret:            "secret101",
+					AllowPullRequests: true,
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("gitlab", "allowPullRequests"), "", "")},
+		},
+		"GitHub trigger with absolute include path": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					IncludePaths: []string{"/app"},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("github", "includePaths").Index(0), "", "")},
+		},
+		"GitLab trigger with exclude path outside of the repository": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					ExcludePaths: []string{"app/../../docs"},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("gitlab", "excludePaths").Index(0), "", "")},
+		},
+		"Generic trigger with include paths": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GenericWebHookBuildTriggerType,
+				GenericWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					IncludePaths: []string{"app"},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("generic", "includePaths"), "", "")},
+		},
 		"Generic trigger with no generic webhook": {
 			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.GenericWebHookBuildTriggerType},
 			expected: []*field.Error{field.Required(field.NewPath("generic"), "")},
@@ -1702,6 +1919,44 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*field.Error{field.Required(field.NewPath("generic"), "")},
 		},
+		"GitLab trigger with no gitlab webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.GitLabWebHookBuildTriggerType},
+			expected: []*field.Error{field.Required(field.NewPath("gitlab"), "")},
+		},
+		"GitLab trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:          buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("gitlab", "secret"), "")},
+		},
+		"Bitbucket trigger with no bitbucket webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.BitbucketWebHookBuildTriggerType},
+			expected: []*field.Error{field.Required(field.NewPath("bitbucket"), "")},
+		},
+		"Bitbucket trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:             buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("bitbucket", "secret"), "")},
+		},
+		"Gogs trigger with github webhook": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GogsWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("gogs"), "")},
+		},
+		"Gogs trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:        buildapi.GogsWebHookBuildTriggerType,
+				GogsWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("gogs", "secret"), "")},
+		},
 		"ImageChange trigger without params": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1716,6 +1971,26 @@ func TestValidateTrigger(t *testing.T) {
 				},
 			},
 		},
+		"valid GitHub trigger with signature verification and pull requests": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:            "secret101",
+					SignatureSecret:   &kapi.LocalObjectReference{Name: "webhook"},
+					AllowPullRequests: true,
+				},
+			},
+		},
+		"valid GitLab trigger with path filters": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					IncludePaths: []string{"services/api", "lib/*.go"},
+					ExcludePaths: []string{"services/api/docs"},
+				},
+			},
+		},
 		"valid Generic trigger": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.GenericWebHookBuildTriggerType,
@@ -1866,6 +2141,417 @@ func TestValidateStrategyEnvVars(t *testing.T) {
 	}
 }
 
+func TestValidateCacheVolumes(t *testing.T) {
+	valid := buildapi.BuildCacheVolume{Name: "maven", ClaimName: "build-cache", Path: "/root/.m2"}
+	tests := []struct {
+		volumes  []buildapi.BuildCacheVolume
+		errField string
+		errType  field.ErrorType
+	}{
+		// 0: valid cache volumes
+		{
+			volumes: []buildapi.BuildCacheVolume{valid, {Name: "npm", ClaimName: "build-cache", Path: "/opt/app-root/src/.npm"}},
+		},
+		// 1: missing name
+		{
+			volumes:  []buildapi.BuildCacheVolume{{ClaimName: "build-cache", Path: "/root/.m2"}},
+			errField: "cacheVolumes[0].name",
+			errType:  field.ErrorTypeRequired,
+		},
+		// 2: invalid name
+		{
+			volumes:  []buildapi.BuildCacheVolume{{Name: "Maven_Repo", ClaimName: "build-cache", Path: "/root/.m2"}},
+			errField: "cacheVolumes[0].name",
+			errType:  field.ErrorTypeInvalid,
+		},
+		// 3: duplicate name
+		{
+			volumes:  []buildapi.BuildCacheVolume{valid, {Name: "maven", ClaimName: "build-cache", Path: "/root/.npm"}},
+			errField: "cacheVolumes[1].name",
+			errType:  field.ErrorTypeDuplicate,
+		},
+		// 4: missing claim name
+		{
+			volumes:  []buildapi.BuildCacheVolume{{Name: "maven", Path: "/root/.m2"}},
+			errField: "cacheVolumes[0].claimName",
+			errType:  field.ErrorTypeRequired,
+		},
+		// 5: relative path
+		{
+			volumes:  []buildapi.BuildCacheVolume{{Name: "maven", ClaimName: "build-cache", Path: ".m2"}},
+			errField: "cacheVolumes[0].path",
+			errType:  field.ErrorTypeInvalid,
+		},
+		// 6: root directory
+		{
+			volumes:  []buildapi.BuildCacheVolume{{Name: "maven", ClaimName: "build-cache", Path: "/tmp/.."}},
+			errField: "cacheVolumes[0].path",
+			errType:  field.ErrorTypeInvalid,
+		},
+		// 7: duplicate path
+		{
+			volumes:  []buildapi.BuildCacheVolume{valid, {Name: "npm", ClaimName: "build-cache", Path: "/root/.m2/"}},
+			errField: "cacheVolumes[1].path",
+			errType:  field.ErrorTypeDuplicate,
+		},
+	}
+
+	for i, tc := range tests {
+		errs := validateCacheVolumes(tc.volumes, field.NewPath("cacheVolumes"))
+		if len(tc.errField) == 0 {
+			if len(errs) > 0 {
+				t.Errorf("%d: unexpected error: %v", i, errs.ToAggregate())
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%d: expected one error, got %v", i, errs.ToAggregate())
+			continue
+		}
+		if errs[0].Field != tc.errField {
+			t.Errorf("%d: unexpected error field: %s", i, errs[0].Field)
+		}
+		if errs[0].Type != tc.errType {
+			t.Errorf("%d: unexpected error type: %s", i, errs[0].Type)
+		}
+	}
+}
+
+func TestValidateDockerStrategyCacheVolumes(t *testing.T) {
+	strategy := &buildapi.DockerBuildStrategy{
+		CacheVolumes: []buildapi.BuildCacheVolume{{Name: "maven", ClaimName: "build-cache", Path: "/root/.m2"}},
+	}
+	errs := validateDockerStrategy(strategy, field.NewPath("dockerStrategy"))
+	if len(errs) != 1 {
+		t.Fatalf("expected one error, got %v", errs.ToAggregate())
+	}
+	if errs[0].Field != "dockerStrategy.cacheVolumes" {
+		t.Errorf("unexpected error field: %s", errs[0].Field)
+	}
+	if errs[0].Type != field.ErrorTypeForbidden {
+		t.Errorf("unexpected error type: %s", errs[0].Type)
+	}
+}
+
+func TestValidateNotifications(t *testing.T) {
+	webhook := &buildapi.WebhookNotification{URL: "https://ci.example.com/hooks/builds"}
+	tests := []struct {
+		notifications []buildapi.BuildNotification
+		errField      string
+		errType       field.ErrorType
+	}{
+		// 0: valid notifications
+		{
+			notifications: []buildapi.BuildNotification{
+				{Webhook: webhook},
+				{Phases: []buildapi.BuildPhase{buildapi.BuildPhaseFailed, buildapi.BuildPhaseError}, Slack: &buildapi.SlackNotification{URL: "https://hooks.slack.com/services/T0/B0/X", Channel: "#builds"}},
+				{Email: &buildapi.EmailNotification{To: []string{"dev@example.com", "Ops <ops@example.com>"}}},
+			},
+		},
+		// 1: phase of a running build
+		{
+			notifications: []buildapi.BuildNotification{{Phases: []buildapi.BuildPhase{buildapi.BuildPhaseRunning}, Webhook: webhook}},
+			errField:      "notifications[0].phases[0]",
+			errType:       field.ErrorTypeNotSupported,
+		},
+		// 2: no target
+		{
+			notifications: []buildapi.BuildNotification{{}},
+			errField:      "notifications[0]",
+			errType:       field.ErrorTypeRequired,
+		},
+		// 3: several targets
+		{
+			notifications: []buildapi.BuildNotification{{Webhook: webhook, Email: &buildapi.EmailNotification{To: []string{"dev@example.com"}}}},
+			errField:      "notifications[0]",
+			errType:       field.ErrorTypeInvalid,
+		},
+		// 4: missing webhook URL
+		{
+			notifications: []buildapi.BuildNotification{{Webhook: &buildapi.WebhookNotification{}}},
+			errField:      "notifications[0].webhook.url",
+			errType:       field.ErrorTypeRequired,
+		},
+		// 5: invalid Slack URL scheme
+		{
+			notifications: []buildapi.BuildNotification{{Slack: &buildapi.SlackNotification{URL: "ftp://hooks.example.com"}}},
+			errField:      "notifications[0].slack.url",
+			errType:       field.ErrorTypeInvalid,
+		},
+		// 6: no email address
+		{
+			notifications: []buildapi.BuildNotification{{Email: &buildapi.EmailNotification{}}},
+			errField:      "notifications[0].email.to",
+			errType:       field.ErrorTypeRequired,
+		},
+		// 7: invalid email address
+		{
+			notifications: []buildapi.BuildNotification{{Email: &buildapi.EmailNotification{To: []string{"dev"}}}},
+			errField:      "notifications[0].email.to[0]",
+			errType:       field.ErrorTypeInvalid,
+		},
+		// 8: loopback webhook URL
+		{
+			notifications: []buildapi.BuildNotification{{Webhook: &buildapi.WebhookNotification{URL: "http://localhost:8080/hooks"}}},
+			errField:      "notifications[0].webhook.url",
+			errType:       field.ErrorTypeInvalid,
+		},
+		// 9: link-local Slack URL
+		{
+			notifications: []buildapi.BuildNotification{{Slack: &buildapi.SlackNotification{URL: "http://169.254.169.254/latest/meta-data"}}},
+			errField:      "notifications[0].slack.url",
+			errType:       field.ErrorTypeInvalid,
+		},
+		// 10: IPv6 loopback webhook URL
+		{
+			notifications: []buildapi.BuildNotification{{Webhook: &buildapi.WebhookNotification{URL: "http://[::1]:8080/hooks"}}},
+			errField:      "notifications[0].webhook.url",
+			errType:       field.ErrorTypeInvalid,
+		},
+	}
+
+	for i, tc := range tests {
+		errs := validateNotifications(tc.notifications, field.NewPath("notifications"))
+		if len(tc.errField) == 0 {
+			if len(errs) > 0 {
+				t.Errorf("%d: unexpected error: %v", i, errs.ToAggregate())
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%d: expected one error, got %v", i, errs.ToAggregate())
+			continue
+		}
+		if errs[0].Field != tc.errField {
+			t.Errorf("%d: unexpected error field: %s", i, errs[0].Field)
+		}
+		if errs[0].Type != tc.errType {
+			t.Errorf("%d: unexpected error type: %s", i, errs[0].Type)
+		}
+	}
+}
+
+func TestValidateCommitStatus(t *testing.T) {
+	tests := []struct {
+		commitStatus buildapi.CommitStatusReporting
+		errField     string
+		errType      field.ErrorType
+	}{
+		// 0: valid GitHub reporting
+		{
+			commitStatus: buildapi.CommitStatusReporting{Provider: buildapi.CommitStatusProviderGitHub, TokenSecret: kapi.LocalObjectReference{Name: "github-token"}},
+		},
+		// 1: valid GitLab reporting to a self-hosted instance
+		{
+			commitStatus: buildapi.CommitStatusReporting{Provider: buildapi.CommitStatusProviderGitLab, APIURL: "https://gitlab.example.com/api/v3", TokenSecret: kapi.LocalObjectReference{Name: "gitlab-token"}, Context: "ci/openshift"},
+		},
+		// 2: missing provider
+		{
+			commitStatus: buildapi.CommitStatusReporting{TokenSecret: kapi.LocalObjectReference{Name: "github-token"}},
+			errField:     "commitStatus.provider",
+			errType:      field.ErrorTypeRequired,
+		},
+		// 3: unsupported provider
+		{
+			commitStatus: buildapi.CommitStatusReporting{Provider: "Bitbucket", TokenSecret: kapi.LocalObjectReference{Name: "token"}},
+			errField:     "commitStatus.provider",
+			errType:      field.ErrorTypeNotSupported,
+		},
+		// 4: invalid API URL
+		{
+			commitStatus: buildapi.CommitStatusReporting{Provider: buildapi.CommitStatusProviderGitHub, APIURL: "api.github.com", TokenSecret: kapi.LocalObjectReference{Name: "github-token"}},
+			errField:     "commitStatus.apiURL",
+			errType:      field.ErrorTypeInvalid,
+		},
+		// 5: missing token secret
+		{
+			commitStatus: buildapi.CommitStatusReporting{Provider: buildapi.CommitStatusProviderGitHub},
+			errField:     "commitStatus.tokenSecret.name",
+			errType:      field.ErrorTypeRequired,
+		},
+	}
+
+	for i, tc := range tests {
+		errs := validateCommitStatus(&tc.commitStatus, field.NewPath("commitStatus"))
+		if len(tc.errField) == 0 {
+			if len(errs) > 0 {
+				t.Errorf("%d: unexpected error: %v", i, errs.ToAggregate())
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%d: expected one error, got %v", i, errs.ToAggregate())
+			continue
+		}
+		if errs[0].Field != tc.errField {
+			t.Errorf("%d: unexpected error field: %s", i, errs[0].Field)
+		}
+		if errs[0].Type != tc.errType {
+			t.Errorf("%d: unexpected error type: %s", i, errs[0].Type)
+		}
+	}
+}
+
+func TestValidateBuildParameters(t *testing.T) {
+	jdk := buildapi.BuildParameter{Name: "JDK_VERSION", Type: buildapi.BuildParameterTypeInteger, Value: "8"}
+	base := buildapi.BuildParameter{Name: "BASE", Required: true}
+	tests := []struct {
+		parameters []buildapi.BuildParameter
+		matrix     []buildapi.BuildMatrixParameter
+		runPolicy  buildapi.BuildRunPolicy
+		errField   string
+		errType    field.ErrorType
+	}{
+		// 0: valid parameters and matrix
+		{
+			parameters: []buildapi.BuildParameter{jdk, base, {Name: "DEBUG", Type: buildapi.BuildParameterTypeBoolean, Value: "false"}},
+			matrix:     []buildapi.BuildMatrixParameter{{Name: "JDK_VERSION", Values: []string{"7", "8"}}, {Name: "BASE", Values: []string{"centos7", "rhel7"}}},
+		},
+		// 1: invalid name
+		{
+			parameters: []buildapi.BuildParameter{{Name: "JDK-VERSION"}},
+			errField:   "spec.parameters[0].name",
+			errType:    field.ErrorTypeInvalid,
+		},
+		// 2: duplicate name
+		{
+			parameters: []buildapi.BuildParameter{jdk, jdk},
+			errField:   "spec.parameters[1].name",
+			errType:    field.ErrorTypeDuplicate,
+		},
+		// 3: unsupported type
+		{
+			parameters: []buildapi.BuildParameter{{Name: "JDK_VERSION", Type: "Float"}},
+			errField:   "spec.parameters[0].type",
+			errType:    field.ErrorTypeNotSupported,
+		},
+		// 4: default value of the wrong type
+		{
+			parameters: []buildapi.BuildParameter{{Name: "JDK_VERSION", Type: buildapi.BuildParameterTypeInteger, Value: "latest"}},
+			errField:   "spec.parameters[0].value",
+			errType:    field.ErrorTypeInvalid,
+		},
+		// 5: matrix of an undeclared parameter
+		{
+			parameters: []buildapi.BuildParameter{jdk},
+			matrix:     []buildapi.BuildMatrixParameter{{Name: "BASE", Values: []string{"centos7"}}},
+			errField:   "spec.matrix[0].name",
+			errType:    field.ErrorTypeInvalid,
+		},
+		// 6: matrix without values
+		{
+			parameters: []buildapi.BuildParameter{jdk},
+			matrix:     []buildapi.BuildMatrixParameter{{Name: "JDK_VERSION"}},
+			errField:   "spec.matrix[0].values",
+			errType:    field.ErrorTypeRequired,
+		},
+		// 7: matrix value of the wrong type
+		{
+			parameters: []buildapi.BuildParameter{jdk},
+			matrix:     []buildapi.BuildMatrixParameter{{Name: "JDK_VERSION", Values: []string{"8", "nine"}}},
+			errField:   "spec.matrix[0].values[1]",
+			errType:    field.ErrorTypeInvalid,
+		},
+		// 8: matrix with the SerialLatestOnly run policy
+		{
+			parameters: []buildapi.BuildParameter{jdk},
+			matrix:     []buildapi.BuildMatrixParameter{{Name: "JDK_VERSION", Values: []string{"7", "8"}}},
+			runPolicy:  buildapi.BuildRunPolicySerialLatestOnly,
+			errField:   "spec.matrix",
+			errType:    field.ErrorTypeInvalid,
+		},
+	}
+
+	for i, tc := range tests {
+		config := &buildapi.BuildConfig{
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy:  tc.runPolicy,
+				Parameters: tc.parameters,
+				Matrix:     tc.matrix,
+			},
+		}
+		errs := validateBuildParameters(config, field.NewPath("spec"))
+		if len(tc.errField) == 0 {
+			if len(errs) > 0 {
+				t.Errorf("%d: unexpected error: %v", i, errs.ToAggregate())
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%d: expected one error, got %v", i, errs.ToAggregate())
+			continue
+		}
+		if errs[0].Field != tc.errField {
+			t.Errorf("%d: unexpected error field: %s", i, errs[0].Field)
+		}
+		if errs[0].Type != tc.errType {
+			t.Errorf("%d: unexpected error type: %s", i, errs[0].Type)
+		}
+	}
+}
+
+func TestBuildConfigValidationWithParameterReferences(t *testing.T) {
+	config := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+		Spec: buildapi.BuildConfigSpec{
+			Parameters: []buildapi.BuildParameter{
+				{Name: "BASE", Required: true},
+				{Name: "JDK_VERSION", Type: buildapi.BuildParameterTypeInteger},
+			},
+			Matrix: []buildapi.BuildMatrixParameter{{Name: "BASE", Values: []string{"centos7", "rhel7"}}},
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{URI: "http://github.com/my/repository"},
+				},
+				Strategy: buildapi.BuildStrategy{
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "java:${BASE}"},
+						Env:  []kapi.EnvVar{{Name: "JDK_VERSION", Value: "${JDK_VERSION}"}},
+					},
+				},
+				Output: buildapi.BuildOutput{
+					To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:jdk${JDK_VERSION}"},
+				},
+			},
+		},
+	}
+	if errs := ValidateBuildConfig(config); len(errs) > 0 {
+		t.Errorf("Unexpected error: %v", errs.ToAggregate())
+	}
+	if config.Spec.Output.To.Name != "app:jdk${JDK_VERSION}" {
+		t.Errorf("Expected the BuildConfig to be left unchanged, got output %s", config.Spec.Output.To.Name)
+	}
+}
+
+func TestBuildConfigValidationImageChangeTriggerWithParameterReferences(t *testing.T) {
+	config := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+		Spec: buildapi.BuildConfigSpec{
+			Parameters: []buildapi.BuildParameter{{Name: "BASE", Value: "centos7"}},
+			Triggers: []buildapi.BuildTriggerPolicy{
+				{Type: buildapi.ImageChangeBuildTriggerType, ImageChange: &buildapi.ImageChangeTrigger{}},
+			},
+			BuildSpec: buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{URI: "http://github.com/my/repository"},
+				},
+				Strategy: buildapi.BuildStrategy{
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "java:${BASE}"},
+					},
+				},
+			},
+		},
+	}
+	errs := ValidateBuildConfig(config)
+	if len(errs) != 1 {
+		t.Fatalf("Expected one error, got %v", errs.ToAggregate())
+	}
+	if errs[0].Field != "spec.triggers[0].imageChange" || errs[0].Type != field.ErrorTypeInvalid {
+		t.Errorf("Unexpected error %v", errs[0])
+	}
+}
+
 func TestValidatePostCommit(t *testing.T) {
 	path := field.NewPath("postCommit")
 	invalidSpec := buildapi.BuildPostCommitSpec{
@@ -0,0 +1,155 @@
+package builder
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/glog"
+
+	"github.com/openshift/source-to-image/pkg/tar"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/controller/strategy"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// buildCacheDir returns the directory of the cache volume that keeps the
+// cache of the BuildConfig of build, <mount>/<volume name>/<BuildConfig name>.
+// The volume name keeps apart the caches of volumes sharing a claim. Builds
+// that don't belong to a BuildConfig get a directory of their own.
+func buildCacheDir(build *api.Build, volume api.BuildCacheVolume) string {
+	key := buildutil.ConfigNameForBuild(build)
+	if len(key) == 0 {
+		key = build.Name
+	}
+	return filepath.Join(strategy.BuildCacheMountPath(volume), volume.Name, key)
+}
+
+// prepareBuildCaches creates the cache directories of build, emptying them
+// first if the build was started without cache.
+func prepareBuildCaches(build *api.Build, volumes []api.BuildCacheVolume) error {
+	clear := build.Annotations[api.BuildClearCacheAnnotation] == "true"
+	for _, v := range volumes {
+		dir := buildCacheDir(build, v)
+		if clear {
+			glog.Infof("Clearing the build cache %q", v.Name)
+			if err := os.RemoveAll(dir); err != nil {
+				return fmt.Errorf("error clearing the build cache %q: %v", v.Name, err)
+			}
+		}
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			return fmt.Errorf("error creating the build cache %q: %v", v.Name, err)
+		}
+	}
+	return nil
+}
+
+// saveBuildCaches copies the cache paths of image, the image built by build,
+// back to the cache volumes.
+func saveBuildCaches(dockerClient DockerClient, build *api.Build, image string, volumes []api.BuildCacheVolume) error {
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	container, err := dockerClient.CreateContainer(docker.CreateContainerOptions{
+		Config: &docker.Config{
+			Image: image,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating build cache container: %v", err)
+	}
+	defer dockerClient.RemoveContainer(docker.RemoveContainerOptions{ID: container.ID})
+
+	tarHelper := tar.New()
+	tarHelper.SetExclusionPattern(nil)
+
+	for _, v := range volumes {
+		glog.Infof("Saving the build cache %q from %s", v.Name, v.Path)
+		if err := saveBuildCache(dockerClient, container.ID, v.Path, buildCacheDir(build, v), tarHelper); err != nil {
+			return fmt.Errorf("error saving the build cache %q: %v", v.Name, err)
+		}
+	}
+	return nil
+}
+
+// saveBuildCache downloads the contents of path in the container to a
+// temporary directory and merges them into the cache directory dir.
+func saveBuildCache(dockerClient DockerClient, containerID, path, dir string, tarHelper tar.Tar) error {
+	tmpDir, err := ioutil.TempDir("", "build-cache")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// The trailing "/." downloads the contents of path rather than path itself.
+	if err := copyImageSource(dockerClient, containerID, filepath.Clean(path)+"/.", tmpDir, tarHelper); err != nil {
+		return err
+	}
+	return mergeBuildCache(tmpDir, dir)
+}
+
+// mergeBuildCache copies the files of src to dst, replacing the files dst
+// already has. An empty file doesn't replace a file of dst: the files
+// injected into a Source build are truncated once the assemble script
+// finishes, so an empty file is most likely a file of the cache itself.
+func mergeBuildCache(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			if info.Size() == 0 {
+				if _, err := os.Lstat(target); err == nil {
+					return nil
+				}
+			}
+			return copyCacheFile(path, target, info.Mode().Perm())
+		}
+		return nil
+	})
+}
+
+// copyCacheFile copies the regular file src to dst, replacing dst.
+func copyCacheFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.RemoveAll(dst); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,126 @@
+package builder
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/controller/strategy"
+)
+
+func TestBuildCacheDir(t *testing.T) {
+	volume := api.BuildCacheVolume{Name: "maven", ClaimName: "build-cache", Path: "/root/.m2"}
+	build := &api.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:   "app-1",
+			Labels: map[string]string{api.BuildConfigLabel: "app"},
+		},
+	}
+	if e, a := "/var/run/openshift.io/build-cache/maven/maven/app", buildCacheDir(build, volume); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+
+	build.Labels = nil
+	if e, a := "/var/run/openshift.io/build-cache/maven/maven/app-1", buildCacheDir(build, volume); e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
+
+func TestBuildCacheDirSharedClaim(t *testing.T) {
+	maven := api.BuildCacheVolume{Name: "maven", ClaimName: "build-cache", Path: "/root/.m2"}
+	npm := api.BuildCacheVolume{Name: "npm", ClaimName: "build-cache", Path: "/root/.npm"}
+	build := &api.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:   "app-1",
+			Labels: map[string]string{api.BuildConfigLabel: "app"},
+		},
+	}
+
+	// The directories are compared relative to the mounts of the claim, as
+	// they are on the claim itself.
+	mavenDir, err := filepath.Rel(strategy.BuildCacheMountPath(maven), buildCacheDir(build, maven))
+	if err != nil {
+		t.Fatal(err)
+	}
+	npmDir, err := filepath.Rel(strategy.BuildCacheMountPath(npm), buildCacheDir(build, npm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, a := "maven/app", mavenDir; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+	if e, a := "npm/app", npmDir; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+}
+
+func TestMergeBuildCache(t *testing.T) {
+	src, err := ioutil.TempDir("", "build-cache-src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(src)
+	dst, err := ioutil.TempDir("", "build-cache-dst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dst)
+
+	files := map[string]string{
+		// cached file updated by the build
+		"repository/updated.jar": "new",
+		// cached file injected into a Source build, truncated after assemble
+		"repository/injected.jar": "",
+		// file downloaded by the build
+		"repository/new/added.jar": "added",
+		// empty file created by the build
+		"repository/empty": "",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(src, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(src, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cached := map[string]string{
+		"repository/updated.jar":  "old",
+		"repository/injected.jar": "injected",
+		"repository/kept.jar":     "kept",
+	}
+	for name, content := range cached {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dst, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dst, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := mergeBuildCache(src, dst); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"repository/updated.jar":   "new",
+		"repository/injected.jar":  "injected",
+		"repository/new/added.jar": "added",
+		"repository/empty":         "",
+		"repository/kept.jar":      "kept",
+	}
+	for name, content := range expected {
+		data, err := ioutil.ReadFile(filepath.Join(dst, name))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if string(data) != content {
+			t.Errorf("%s: expected %q, got %q", name, content, string(data))
+		}
+	}
+}
@@ -26,6 +26,7 @@ type KeyValue struct {
 // GitClient performs git operations
 type GitClient interface {
 	CloneWithOptions(dir string, url string, opts git.CloneOptions) error
+	FetchRef(dir string, ref string) error
 	Checkout(dir string, ref string) error
 	SubmoduleUpdate(dir string, init, recursive bool) error
 	ListRemote(url string, args ...string) (string, string, error)
@@ -217,6 +217,15 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 	if usingRef {
 		commit := gitSource.Ref
 
+		// refs outside of the branches and tags, such as pull request heads,
+		// are not part of the clone and have to be fetched explicitly
+		if isUnclonedRef(gitSource.Ref) {
+			if err := gitClient.FetchRef(dir, gitSource.Ref); err != nil {
+				return true, err
+			}
+			commit = "FETCH_HEAD"
+		}
+
 		if revision != nil && revision.Git != nil && revision.Git.Commit != "" {
 			commit = revision.Git.Commit
 		}
@@ -233,6 +242,12 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 	return true, nil
 }
 
+// isUnclonedRef returns true if ref is a full ref that a clone of the
+// repository does not fetch.
+func isUnclonedRef(ref string) bool {
+	return strings.HasPrefix(ref, "refs/") && !strings.HasPrefix(ref, "refs/heads/") && !strings.HasPrefix(ref, "refs/tags/")
+}
+
 func copyImageSource(dockerClient DockerClient, containerID, sourceDir, destDir string, tarHelper tar.Tar) error {
 	// Setup destination directory
 	fi, err := os.Stat(destDir)
@@ -41,3 +41,18 @@ func TestCheckRemoteGit(t *testing.T) {
 		t.Errorf("unexpected error %q", err)
 	}
 }
+
+func TestIsUnclonedRef(t *testing.T) {
+	tests := map[string]bool{
+		"":                  false,
+		"master":            false,
+		"refs/heads/master": false,
+		"refs/tags/v1.0":    false,
+		"refs/pull/1/head":  true,
+	}
+	for ref, expected := range tests {
+		if actual := isUnclonedRef(ref); actual != expected {
+			t.Errorf("%q: expected %t, got %t", ref, expected, actual)
+		}
+	}
+}
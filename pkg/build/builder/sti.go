@@ -158,6 +158,18 @@ func (s *S2IBuilder) Build() error {
 		})
 	}
 
+	cacheVolumes := s.build.Spec.Strategy.SourceStrategy.CacheVolumes
+	if err := prepareBuildCaches(s.build, cacheVolumes); err != nil {
+		return err
+	}
+	for _, v := range cacheVolumes {
+		glog.V(3).Infof("Injecting the build cache %q into a build into %q", v.Name, v.Path)
+		injections = append(injections, s2iapi.InjectPath{
+			SourcePath:     buildCacheDir(s.build, v),
+			DestinationDir: v.Path,
+		})
+	}
+
 	buildTag := randomBuildTag(s.build.Namespace, s.build.Name)
 	scriptDownloadProxyConfig, err := scriptProxyConfig(s.build)
 	if err != nil {
@@ -246,6 +258,10 @@ func (s *S2IBuilder) Build() error {
 		return err
 	}
 
+	if err := saveBuildCaches(s.dockerClient, s.build, buildTag, cacheVolumes); err != nil {
+		glog.Warningf("Failed to save the build caches: %v", err)
+	}
+
 	if push {
 		if err := tagImage(s.dockerClient, buildTag, pushTag); err != nil {
 			return err
@@ -0,0 +1,182 @@
+// Package commitstatus reports the status of builds on the commits they build
+// to the Git hosting services of their source.
+package commitstatus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/notifier"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	"github.com/openshift/origin/pkg/generate/git"
+)
+
+const (
+	defaultGitHubAPIURL = "https://api.github.com"
+	defaultGitLabAPIURL = "https://gitlab.com/api/v3"
+)
+
+// State is the status of a build reported on its commit.
+type State string
+
+const (
+	// StatePending is reported for builds that haven't completed yet.
+	StatePending State = "pending"
+	// StateSuccess is reported for builds that completed successfully.
+	StateSuccess State = "success"
+	// StateFailure is reported for builds that failed.
+	StateFailure State = "failure"
+	// StateError is reported for builds that couldn't run.
+	StateError State = "error"
+	// StateCancelled is reported for cancelled builds.
+	StateCancelled State = "cancelled"
+)
+
+// StateForBuild returns the state reported for build.
+func StateForBuild(build *buildapi.Build) State {
+	switch build.Status.Phase {
+	case buildapi.BuildPhaseComplete:
+		return StateSuccess
+	case buildapi.BuildPhaseFailed:
+		return StateFailure
+	case buildapi.BuildPhaseError:
+		return StateError
+	case buildapi.BuildPhaseCancelled:
+		return StateCancelled
+	}
+	return StatePending
+}
+
+// githubStates are the GitHub states of the states. GitHub has no state for
+// cancelled builds.
+var githubStates = map[State]string{
+	StatePending:   "pending",
+	StateSuccess:   "success",
+	StateFailure:   "failure",
+	StateError:     "error",
+	StateCancelled: "error",
+}
+
+// gitlabStates are the GitLab states of the states.
+var gitlabStates = map[State]string{
+	StatePending:   "pending",
+	StateSuccess:   "success",
+	StateFailure:   "failed",
+	StateError:     "failed",
+	StateCancelled: "canceled",
+}
+
+// Reporter posts commit statuses to the GitHub and GitLab APIs.
+type Reporter struct {
+	client *http.Client
+}
+
+// New returns a Reporter posting to the Git hosting services targets allows.
+func New(targets *notifier.TargetNetworks) *Reporter {
+	return &Reporter{client: targets.NewHTTPClient(30 * time.Second)}
+}
+
+// Context returns the context of the statuses of build, which distinguishes
+// them from the other statuses of the commit.
+func Context(build *buildapi.Build) string {
+	if len(build.Spec.CommitStatus.Context) > 0 {
+		return build.Spec.CommitStatus.Context
+	}
+	name := buildutil.ConfigNameForBuild(build)
+	if len(name) == 0 {
+		name = build.Name
+	}
+	return "openshift/" + name
+}
+
+// Report posts state as the status of build on the commit it builds, linking
+// to targetURL if it is set. token authenticates to the API of the Git hosting
+// service.
+func (r *Reporter) Report(build *buildapi.Build, state State, targetURL, token string) error {
+	reporting := build.Spec.CommitStatus
+	if build.Spec.Source.Git == nil || build.Spec.Revision == nil || build.Spec.Revision.Git == nil || len(build.Spec.Revision.Git.Commit) == 0 {
+		return fmt.Errorf("the build has no Git commit")
+	}
+	repository, err := repositoryPath(build.Spec.Source.Git.URI)
+	if err != nil {
+		return err
+	}
+	commit := build.Spec.Revision.Git.Commit
+	description := fmt.Sprintf("Build %s is %s", build.Name, strings.ToLower(string(build.Status.Phase)))
+
+	apiURL := strings.TrimSuffix(reporting.APIURL, "/")
+	switch reporting.Provider {
+	case buildapi.CommitStatusProviderGitHub:
+		if len(apiURL) == 0 {
+			apiURL = defaultGitHubAPIURL
+		}
+		body := map[string]string{
+			"state":       githubStates[state],
+			"target_url":  targetURL,
+			"description": description,
+			"context":     Context(build),
+		}
+		header := http.Header{"Authorization": {"token " + token}}
+		return r.post(fmt.Sprintf("%s/repos/%s/statuses/%s", apiURL, repository, commit), header, body)
+
+	case buildapi.CommitStatusProviderGitLab:
+		if len(apiURL) == 0 {
+			apiURL = defaultGitLabAPIURL
+		}
+		body := map[string]string{
+			"state":       gitlabStates[state],
+			"target_url":  targetURL,
+			"description": description,
+			"name":        Context(build),
+		}
+		header := http.Header{"Private-Token": {token}}
+		return r.post(fmt.Sprintf("%s/projects/%s/statuses/%s", apiURL, url.QueryEscape(repository), commit), header, body)
+	}
+	return fmt.Errorf("unsupported commit status provider %q", reporting.Provider)
+}
+
+// repositoryPath returns the path of the repository of the Git URI uri on its
+// hosting service, e.g. "openshift/origin".
+func repositoryPath(uri string) (string, error) {
+	u, err := git.ParseRepository(uri)
+	if err != nil {
+		return "", err
+	}
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	if !strings.Contains(path, "/") {
+		return "", fmt.Errorf("unable to determine the repository of %s", uri)
+	}
+	return path, nil
+}
+
+// post posts body as JSON to endpoint.
+func (r *Reporter) post(endpoint string, header http.Header, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", endpoint, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package commitstatus
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/notifier"
+)
+
+// loopbackTargets allows the test servers as targets.
+func loopbackTargets() *notifier.TargetNetworks {
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	return notifier.NewTargetNetworks(nil, []*net.IPNet{loopback})
+}
+
+func mockBuild(provider buildapi.CommitStatusProvider, apiURL string) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "test",
+			Name:      "app-1",
+			Labels:    map[string]string{buildapi.BuildConfigLabel: "app"},
+		},
+		Spec: buildapi.BuildSpec{
+			Source: buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{URI: "https://github.com/openshift/ruby-hello-world.git"},
+			},
+			Revision: &buildapi.SourceRevision{Git: &buildapi.GitSourceRevision{Commit: "0123456789abcdef"}},
+			CommitStatus: &buildapi.CommitStatusReporting{
+				Provider:    provider,
+				APIURL:      apiURL,
+				TokenSecret: kapi.LocalObjectReference{Name: "token"},
+			},
+		},
+		Status: buildapi.BuildStatus{Phase: buildapi.BuildPhaseCancelled},
+	}
+}
+
+func TestStateForBuild(t *testing.T) {
+	tests := map[buildapi.BuildPhase]State{
+		buildapi.BuildPhaseNew:       StatePending,
+		buildapi.BuildPhaseRunning:   StatePending,
+		buildapi.BuildPhaseComplete:  StateSuccess,
+		buildapi.BuildPhaseFailed:    StateFailure,
+		buildapi.BuildPhaseError:     StateError,
+		buildapi.BuildPhaseCancelled: StateCancelled,
+	}
+	for phase, expected := range tests {
+		build := &buildapi.Build{Status: buildapi.BuildStatus{Phase: phase}}
+		if state := StateForBuild(build); state != expected {
+			t.Errorf("%s: expected state %s, got %s", phase, expected, state)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		provider      buildapi.CommitStatusProvider
+		path          string
+		header        string
+		token         string
+		contextField  string
+		expectedState string
+	}{
+		{
+			provider:      buildapi.CommitStatusProviderGitHub,
+			path:          "/repos/openshift/ruby-hello-world/statuses/0123456789abcdef",
+			header:        "Authorization",
+			token:         "token secret",
+			contextField:  "context",
+			expectedState: "error",
+		},
+		{
+			provider:      buildapi.CommitStatusProviderGitLab,
+			path:          "/projects/openshift%2Fruby-hello-world/statuses/0123456789abcdef",
+			header:        "PRIVATE-TOKEN",
+			token:         "secret",
+			contextField:  "name",
+			expectedState: "canceled",
+		},
+	}
+
+	for _, tc := range tests {
+		var (
+			path string
+			body map[string]string
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.EscapedPath()
+			if token := r.Header.Get(tc.header); token != tc.token {
+				t.Errorf("%s: unexpected %s header: %q", tc.provider, tc.header, token)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("%s: unexpected error decoding the status: %v", tc.provider, err)
+			}
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		build := mockBuild(tc.provider, server.URL+"/")
+		err := New(loopbackTargets()).Report(build, StateCancelled, "https://console.example.com/build", "secret")
+		server.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.provider, err)
+			continue
+		}
+		if path != tc.path {
+			t.Errorf("%s: unexpected path: %s", tc.provider, path)
+		}
+		if body["state"] != tc.expectedState {
+			t.Errorf("%s: unexpected state: %q", tc.provider, body["state"])
+		}
+		if body[tc.contextField] != "openshift/app" {
+			t.Errorf("%s: unexpected context: %q", tc.provider, body[tc.contextField])
+		}
+		if body["target_url"] != "https://console.example.com/build" {
+			t.Errorf("%s: unexpected target URL: %q", tc.provider, body["target_url"])
+		}
+	}
+}
+
+func TestReportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	build := mockBuild(buildapi.CommitStatusProviderGitHub, server.URL)
+	if err := New(loopbackTargets()).Report(build, StatePending, "", "secret"); err == nil {
+		t.Errorf("expected an error")
+	}
+
+	build.Spec.Revision = nil
+	if err := New(loopbackTargets()).Report(build, StatePending, "", "secret"); err == nil {
+		t.Errorf("expected an error for a build without commit")
+	}
+}
+
+func TestReportInternalTarget(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = true
+	}))
+	defer server.Close()
+
+	build := mockBuild(buildapi.CommitStatusProviderGitHub, server.URL)
+	if err := New(nil).Report(build, StatePending, "", "secret"); err == nil {
+		t.Errorf("expected an error posting to a loopback address")
+	}
+	if posted {
+		t.Errorf("expected the loopback API not to be posted to")
+	}
+}
+
+func TestRepositoryPath(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/openshift/origin.git":       "openshift/origin",
+		"https://github.com/openshift/origin":           "openshift/origin",
+		"https://gitlab.example.com/group/sub/project/": "group/sub/project",
+	}
+	for uri, expected := range tests {
+		path, err := repositoryPath(uri)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", uri, err)
+			continue
+		}
+		if path != expected {
+			t.Errorf("%s: expected %q, got %q", uri, expected, path)
+		}
+	}
+	if _, err := repositoryPath("https://github.com/origin"); err == nil {
+		t.Errorf("expected an error for a URI without owner")
+	}
+}
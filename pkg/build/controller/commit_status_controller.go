@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/commitstatus"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// BuildCommitStatusController reports the status of builds on the commits
+// they build to the Git hosting service of their source.
+type BuildCommitStatusController struct {
+	Reporter        commitStatusReporter
+	Secrets         secretGetter
+	ServiceAccounts serviceAccountGetter
+	BuildUpdater    buildclient.BuildUpdater
+	Recorder        record.EventRecorder
+	// ConsoleURL is the public URL of the web console the statuses link to.
+	// The statuses have no link if it is empty.
+	ConsoleURL string
+	// Attempts is the number of times a status is posted before the report
+	// fails.
+	Attempts int
+	// Backoff is the delay before posting a status again. It doubles with each
+	// attempt.
+	Backoff time.Duration
+	// Pool posts the statuses, so that HandleBuild doesn't wait for the Git
+	// hosting services. The statuses are posted by HandleBuild if it is nil.
+	Pool *DeliveryPool
+
+	lock sync.Mutex
+	// posting has an entry for each build with a status being posted. The
+	// entry is the latest status of the build waiting for that post to finish,
+	// or nil if there is none.
+	posting map[string]*commitStatusPost
+}
+
+// commitStatusPost is a status to post on the commit of a build.
+type commitStatusPost struct {
+	build *buildapi.Build
+	state commitstatus.State
+}
+
+type commitStatusReporter interface {
+	Report(build *buildapi.Build, state commitstatus.State, targetURL, token string) error
+}
+
+type secretGetter interface {
+	GetSecret(namespace, name string) (*kapi.Secret, error)
+}
+
+type serviceAccountGetter interface {
+	GetServiceAccount(namespace, name string) (*kapi.ServiceAccount, error)
+}
+
+// HandleBuild reports the status of build on the commit it builds if the
+// status changed since it was last reported.
+func (c *BuildCommitStatusController) HandleBuild(build *buildapi.Build) error {
+	if build.Spec.CommitStatus == nil || build.Spec.Revision == nil || build.Spec.Revision.Git == nil || len(build.Spec.Revision.Git.Commit) == 0 {
+		return nil
+	}
+	state := commitstatus.StateForBuild(build)
+	if build.Annotations[buildapi.BuildCommitStatusAnnotation] == string(state) {
+		return nil
+	}
+
+	// Like notifications, the state is recorded before it is reported, so
+	// that a status which can't be posted is reported with an event rather
+	// than retried forever.
+	if build.Annotations == nil {
+		build.Annotations = map[string]string{}
+	}
+	build.Annotations[buildapi.BuildCommitStatusAnnotation] = string(state)
+	if err := c.BuildUpdater.Update(build.Namespace, build); err != nil {
+		return err
+	}
+
+	c.submit(&commitStatusPost{build: build, state: state})
+	return nil
+}
+
+// submit has the pool post the status of post. The statuses of a build are
+// posted one at a time, so that a retried status can't replace a later one on
+// the commit: while a status of the build is being posted, a new status waits
+// for it to finish and replaces any status still waiting, which is out of date.
+func (c *BuildCommitStatusController) submit(post *commitStatusPost) {
+	key := post.build.Namespace + "/" + post.build.Name
+	c.lock.Lock()
+	if c.posting == nil {
+		c.posting = map[string]*commitStatusPost{}
+	}
+	if _, ok := c.posting[key]; ok {
+		c.posting[key] = post
+		c.lock.Unlock()
+		return
+	}
+	c.posting[key] = nil
+	c.lock.Unlock()
+
+	c.Pool.Submit(func() {
+		for post != nil {
+			c.post(post.build, post.state)
+
+			c.lock.Lock()
+			post = c.posting[key]
+			if post == nil {
+				delete(c.posting, key)
+			} else {
+				c.posting[key] = nil
+			}
+			c.lock.Unlock()
+		}
+	})
+}
+
+// post reports state as the status of build, recording an event if it fails.
+func (c *BuildCommitStatusController) post(build *buildapi.Build, state commitstatus.State) {
+	if err := c.report(build, state); err != nil {
+		c.Recorder.Eventf(build, kapi.EventTypeWarning, "FailedCommitStatus", "Failed to report the %s status on commit %s: %v", state, build.Spec.Revision.Git.Commit, err)
+		return
+	}
+	glog.V(4).Infof("Reported the %s status of build %s/%s on commit %s", state, build.Namespace, build.Name, build.Spec.Revision.Git.Commit)
+}
+
+// report posts state as the status of build, trying again on errors.
+func (c *BuildCommitStatusController) report(build *buildapi.Build, state commitstatus.State) error {
+	secretName := build.Spec.CommitStatus.TokenSecret.Name
+	// The token is read by the controller rather than by the build, it is
+	// restricted to the secrets the build could mount like its source secret.
+	if err := c.checkServiceAccountSecret(build, secretName); err != nil {
+		return err
+	}
+	secret, err := c.Secrets.GetSecret(build.Namespace, secretName)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the token secret %s: %v", secretName, err)
+	}
+	token, ok := secret.Data[buildapi.CommitStatusTokenKey]
+	if !ok {
+		return fmt.Errorf("secret %s does not contain the %s key", secretName, buildapi.CommitStatusTokenKey)
+	}
+	targetURL := c.buildURL(build)
+
+	return retryWithBackoff(c.Attempts, c.Backoff, func() error {
+		return c.Reporter.Report(build, state, targetURL, strings.TrimSpace(string(token)))
+	}, func(err error, delay time.Duration) {
+		glog.V(4).Infof("Retrying to report the %s status of build %s/%s in %v: %v", state, build.Namespace, build.Name, delay, err)
+	})
+}
+
+// checkServiceAccountSecret returns an error if the secret secretName isn't
+// one of the secrets of the service account of build.
+func (c *BuildCommitStatusController) checkServiceAccountSecret(build *buildapi.Build, secretName string) error {
+	saName := build.Spec.ServiceAccount
+	if len(saName) == 0 {
+		return fmt.Errorf("the build has no service account to use the token secret %s with", secretName)
+	}
+	sa, err := c.ServiceAccounts.GetServiceAccount(build.Namespace, saName)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the service account %s: %v", saName, err)
+	}
+	for _, ref := range sa.Secrets {
+		if ref.Name == secretName {
+			return nil
+		}
+	}
+	return fmt.Errorf("the token secret %s is not a secret of the service account %s", secretName, saName)
+}
+
+// buildURL returns the URL of the page of build in the web console.
+func (c *BuildCommitStatusController) buildURL(build *buildapi.Build) string {
+	if len(c.ConsoleURL) == 0 {
+		return ""
+	}
+	consoleURL := strings.TrimSuffix(c.ConsoleURL, "/")
+	if config := buildutil.ConfigNameForBuild(build); len(config) > 0 {
+		return fmt.Sprintf("%s/project/%s/browse/builds/%s/%s", consoleURL, build.Namespace, config, build.Name)
+	}
+	return fmt.Sprintf("%s/project/%s/browse/builds-noconfig/%s", consoleURL, build.Namespace, build.Name)
+}
@@ -0,0 +1,232 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/commitstatus"
+)
+
+type fakeCommitStatusReporter struct {
+	err       error
+	states    []commitstatus.State
+	targetURL string
+	token     string
+}
+
+func (r *fakeCommitStatusReporter) Report(build *buildapi.Build, state commitstatus.State, targetURL, token string) error {
+	r.states = append(r.states, state)
+	r.targetURL, r.token = targetURL, token
+	return r.err
+}
+
+type fakeSecretGetter struct {
+	secrets map[string]*kapi.Secret
+}
+
+func (g *fakeSecretGetter) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	if secret, ok := g.secrets[name]; ok {
+		return secret, nil
+	}
+	return nil, kerrors.NewNotFound(kapi.Resource("secret"), name)
+}
+
+type fakeServiceAccountGetter struct {
+	serviceAccounts map[string]*kapi.ServiceAccount
+}
+
+func (g *fakeServiceAccountGetter) GetServiceAccount(namespace, name string) (*kapi.ServiceAccount, error) {
+	if sa, ok := g.serviceAccounts[name]; ok {
+		return sa, nil
+	}
+	return nil, kerrors.NewNotFound(kapi.Resource("serviceaccount"), name)
+}
+
+func mockCommitStatusBuild(phase buildapi.BuildPhase) *buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Spec.ServiceAccount = "builder"
+	build.Labels[buildapi.BuildConfigLabel] = "data"
+	build.Spec.Revision = &buildapi.SourceRevision{Git: &buildapi.GitSourceRevision{Commit: "0123456789abcdef"}}
+	build.Spec.CommitStatus = &buildapi.CommitStatusReporting{
+		Provider:    buildapi.CommitStatusProviderGitHub,
+		TokenSecret: kapi.LocalObjectReference{Name: "github-token"},
+	}
+	return build
+}
+
+func mockCommitStatusController(reporter *fakeCommitStatusReporter) (*BuildCommitStatusController, *record.FakeRecorder) {
+	recorder := &record.FakeRecorder{}
+	return &BuildCommitStatusController{
+		Reporter: reporter,
+		Secrets: &fakeSecretGetter{secrets: map[string]*kapi.Secret{
+			"github-token": {Data: map[string][]byte{buildapi.CommitStatusTokenKey: []byte("secret\n")}},
+		}},
+		ServiceAccounts: &fakeServiceAccountGetter{serviceAccounts: map[string]*kapi.ServiceAccount{
+			"builder": {Secrets: []kapi.ObjectReference{{Name: "github-token"}, {Name: "missing"}}},
+		}},
+		BuildUpdater: &okBuildUpdater{},
+		Recorder:     recorder,
+		ConsoleURL:   "https://console.example.com/console/",
+		Attempts:     2,
+	}, recorder
+}
+
+func TestHandleBuildReportsCommitStatus(t *testing.T) {
+	reporter := &fakeCommitStatusReporter{}
+	ctrl, _ := mockCommitStatusController(reporter)
+
+	build := mockCommitStatusBuild(buildapi.BuildPhaseRunning)
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The same state is reported once.
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	build.Status.Phase = buildapi.BuildPhaseComplete
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(reporter.states) != 2 || reporter.states[0] != commitstatus.StatePending || reporter.states[1] != commitstatus.StateSuccess {
+		t.Errorf("Expected the pending and success states to be reported, got %v", reporter.states)
+	}
+	if build.Annotations[buildapi.BuildCommitStatusAnnotation] != string(commitstatus.StateSuccess) {
+		t.Errorf("Unexpected annotation: %q", build.Annotations[buildapi.BuildCommitStatusAnnotation])
+	}
+	if e, a := "https://console.example.com/console/project/namespace/browse/builds/data/data-build", reporter.targetURL; e != a {
+		t.Errorf("Expected target URL %s, got %s", e, a)
+	}
+	if reporter.token != "secret" {
+		t.Errorf("Unexpected token: %q", reporter.token)
+	}
+}
+
+// blockingCommitStatusReporter holds the pending status until it is released.
+type blockingCommitStatusReporter struct {
+	started  chan struct{}
+	release  chan struct{}
+	reported chan commitstatus.State
+}
+
+func (r *blockingCommitStatusReporter) Report(build *buildapi.Build, state commitstatus.State, targetURL, token string) error {
+	if state == commitstatus.StatePending {
+		close(r.started)
+		<-r.release
+	}
+	r.reported <- state
+	return nil
+}
+
+func TestHandleBuildReportsCommitStatusesInOrder(t *testing.T) {
+	reporter := &blockingCommitStatusReporter{
+		started:  make(chan struct{}),
+		release:  make(chan struct{}),
+		reported: make(chan commitstatus.State, 2),
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	ctrl, _ := mockCommitStatusController(nil)
+	ctrl.Reporter = reporter
+	ctrl.Pool = NewDeliveryPool(5, 5, stop)
+
+	if err := ctrl.HandleBuild(mockCommitStatusBuild(buildapi.BuildPhaseRunning)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	<-reporter.started
+	// The success status waits for the pending status to be posted although
+	// the pool has idle workers.
+	complete := mockCommitStatusBuild(buildapi.BuildPhaseComplete)
+	complete.Annotations = map[string]string{buildapi.BuildCommitStatusAnnotation: string(commitstatus.StatePending)}
+	if err := ctrl.HandleBuild(complete); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	select {
+	case state := <-reporter.reported:
+		t.Fatalf("Expected no state to be reported while the pending state is posted, got %s", state)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(reporter.release)
+
+	for _, expected := range []commitstatus.State{commitstatus.StatePending, commitstatus.StateSuccess} {
+		select {
+		case state := <-reporter.reported:
+			if state != expected {
+				t.Fatalf("Expected the %s state to be reported, got %s", expected, state)
+			}
+		case <-time.After(wait.ForeverTestTimeout):
+			t.Fatalf("Expected the %s state to be reported", expected)
+		}
+	}
+}
+
+func TestHandleBuildSkipsCommitStatus(t *testing.T) {
+	noReporting := mockCommitStatusBuild(buildapi.BuildPhaseComplete)
+	noReporting.Spec.CommitStatus = nil
+	noCommit := mockCommitStatusBuild(buildapi.BuildPhaseComplete)
+	noCommit.Spec.Revision = nil
+
+	for _, build := range []*buildapi.Build{noReporting, noCommit} {
+		reporter := &fakeCommitStatusReporter{}
+		ctrl, _ := mockCommitStatusController(reporter)
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(reporter.states) != 0 {
+			t.Errorf("Expected no status to be reported, got %v", reporter.states)
+		}
+	}
+}
+
+func TestHandleBuildCommitStatusErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		secret      string
+		reportErr   error
+		reports     int
+		expectEvent string
+	}{
+		{
+			name:        "missing secret",
+			secret:      "missing",
+			expectEvent: "unable to retrieve the token secret missing",
+		},
+		{
+			name:        "secret not of the service account",
+			secret:      "other-token",
+			expectEvent: "the token secret other-token is not a secret of the service account builder",
+		},
+		{
+			name:        "report error",
+			secret:      "github-token",
+			reportErr:   errors.New("unauthorized"),
+			reports:     2,
+			expectEvent: "unauthorized",
+		},
+	}
+
+	for _, tc := range tests {
+		reporter := &fakeCommitStatusReporter{err: tc.reportErr}
+		ctrl, recorder := mockCommitStatusController(reporter)
+		build := mockCommitStatusBuild(buildapi.BuildPhaseFailed)
+		build.Spec.CommitStatus.TokenSecret.Name = tc.secret
+
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+		}
+		if len(reporter.states) != tc.reports {
+			t.Errorf("%s: expected %d reports, got %d", tc.name, tc.reports, len(reporter.states))
+		}
+		if len(recorder.Events) != 1 || !strings.Contains(recorder.Events[0], "FailedCommitStatus") || !strings.Contains(recorder.Events[0], tc.expectEvent) {
+			t.Errorf("%s: unexpected events: %v", tc.name, recorder.Events)
+		}
+	}
+}
@@ -10,10 +10,12 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/labels"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
@@ -24,6 +26,7 @@ type BuildController struct {
 	PodManager        podManager
 	BuildStrategy     BuildStrategy
 	ImageStreamClient imageStreamClient
+	BuildLister       buildLister
 	Recorder          record.EventRecorder
 }
 
@@ -42,6 +45,10 @@ type imageStreamClient interface {
 	GetImageStream(namespace, name string) (*imageapi.ImageStream, error)
 }
 
+type buildLister interface {
+	ListBuilds(namespace string, selector labels.Selector) (*buildapi.BuildList, error)
+}
+
 // CancelBuild updates a build status to Cancelled, after its associated pod is deleted.
 func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	if !isBuildCancellable(build) {
@@ -50,6 +57,7 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	}
 
 	glog.V(4).Infof("Cancelling build %s/%s.", build.Namespace, build.Name)
+	started := build.Status.Phase != buildapi.BuildPhaseNew
 
 	pod, err := bc.PodManager.GetPod(build.Namespace, buildutil.GetBuildPodName(build))
 	if err != nil {
@@ -73,6 +81,14 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	}
 
 	glog.V(4).Infof("Build %s/%s was successfully cancelled.", build.Namespace, build.Name)
+	if started && isSerial(build) {
+		builds, err := bc.BuildLister.ListBuilds(build.Namespace, buildutil.BuildConfigSelector(buildutil.ConfigNameForBuild(build)))
+		if err != nil {
+			glog.V(2).Infof("Couldn't list the builds queued after build %s/%s: %v", build.Namespace, build.Name, err)
+			return nil
+		}
+		acceptNextBuild(bc.BuildUpdater, build, buildPtrs(builds.Items))
+	}
 	return nil
 }
 
@@ -94,6 +110,11 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		return nil
 	}
 
+	// Leave the build queued if its run policy doesn't let it start yet.
+	if runnable, err := bc.runnable(build); err != nil || !runnable {
+		return err
+	}
+
 	if err := bc.nextBuildPhase(build); err != nil {
 		return err
 	}
@@ -120,6 +141,13 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 	}
 	build.Status.OutputDockerImageReference = ref
 
+	// Pipeline builds don't run in a build pod, the PipelineController runs
+	// their stages.
+	if build.Spec.Strategy.PipelineStrategy != nil {
+		startPipeline(build)
+		return nil
+	}
+
 	// Make a copy to avoid mutating the build from this point on.
 	copy, err := kapi.Scheme.Copy(build)
 	if err != nil {
@@ -284,6 +312,9 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 		glog.V(4).Infof("Build %s/%s status was updated %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
+		if buildutil.IsBuildComplete(build) {
+			acceptNextBuild(bc.BuildUpdater, build, storedBuilds(bc.BuildStore))
+		}
 	}
 	return nil
 }
@@ -335,18 +366,29 @@ func (bc *BuildPodDeleteController) HandleBuildPodDeletion(pod *kapi.Pod) error
 		if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
 			return fmt.Errorf("Failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
+		acceptNextBuild(bc.BuildUpdater, build, storedBuilds(bc.BuildStore))
 	}
 	return nil
 }
 
 // BuildDeleteController watches for builds being deleted and cleans up associated pods
+// and archived logs
 type BuildDeleteController struct {
 	PodManager podManager
+	// LogArchive is the archive of build logs, if they are archived
+	LogArchive *logarchive.Archive
 }
 
-// HandleBuildDeletion deletes a build pod if the corresponding build has been deleted
+// HandleBuildDeletion deletes a build pod and the archived log of a build if
+// the corresponding build has been deleted
 func (bc *BuildDeleteController) HandleBuildDeletion(build *buildapi.Build) error {
 	glog.V(4).Infof("Handling deletion of build %s", build.Name)
+	if bc.LogArchive != nil {
+		if err := bc.LogArchive.Remove(build.Namespace, build.Name); err != nil {
+			glog.V(2).Infof("Failed to remove the archived log of build %s/%s: %v", build.Namespace, build.Name, err)
+			return err
+		}
+	}
 	podName := buildutil.GetBuildPodName(build)
 	pod, err := bc.PodManager.GetPod(build.Namespace, podName)
 	if err != nil && !errors.IsNotFound(err) {
@@ -141,6 +141,7 @@ func mockBuildController() *BuildController {
 		PodManager:        &okPodManager{},
 		BuildStrategy:     &okStrategy{},
 		ImageStreamClient: &okImageStreamClient{},
+		BuildLister:       &fakeBuildLister{},
 		Recorder:          &record.FakeRecorder{},
 	}
 }
@@ -699,7 +700,7 @@ func (c *customPodManager) GetPod(namespace, name string) (*kapi.Pod, error) {
 func TestHandleHandleBuildDeletionOK(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -721,7 +722,7 @@ func TestHandleHandleBuildDeletionOK(t *testing.T) {
 func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -742,7 +743,7 @@ func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 
 func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, errors.New("random")
 		},
@@ -757,7 +758,7 @@ func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, kerrors.NewNotFound(kapi.Resource("Pod"), name)
 		},
@@ -779,7 +780,7 @@ func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{}, nil
 		},
@@ -800,7 +801,7 @@ func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 
 func TestHandleHandleBuildDeletionDeletePodError(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -3,6 +3,7 @@ package factory
 import (
 	"fmt"
 	"github.com/golang/glog"
+	"io"
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -19,8 +20,11 @@ import (
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/commitstatus"
 	buildcontroller "github.com/openshift/origin/pkg/build/controller"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
+	"github.com/openshift/origin/pkg/build/notifier"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
@@ -64,6 +68,16 @@ type BuildControllerFactory struct {
 	DockerBuildStrategy *strategy.DockerBuildStrategy
 	SourceBuildStrategy *strategy.SourceBuildStrategy
 	CustomBuildStrategy *strategy.CustomBuildStrategy
+	// LogArchive is the archive the logs of completed builds are stored in,
+	// if they are archived.
+	LogArchive *logarchive.Archive
+	// Notifier sends the notifications of completed builds.
+	Notifier *notifier.Notifier
+	// TargetNetworks restricts the addresses commit statuses are posted to.
+	TargetNetworks *notifier.TargetNetworks
+	// ConsoleURL is the public URL of the web console the commit statuses of
+	// builds link to, if any.
+	ConsoleURL string
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 }
@@ -81,6 +95,7 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 		BuildUpdater:      factory.BuildUpdater,
 		ImageStreamClient: client,
 		PodManager:        client,
+		BuildLister:       client,
 		BuildStrategy: &typeBasedFactoryStrategy{
 			DockerBuildStrategy: factory.DockerBuildStrategy,
 			SourceBuildStrategy: factory.SourceBuildStrategy,
@@ -126,6 +141,7 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 
 	buildDeleteController := &buildcontroller.BuildDeleteController{
 		PodManager: client,
+		LogArchive: factory.LogArchive,
 	}
 
 	return &controller.RetryController{
@@ -147,6 +163,191 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 	}
 }
 
+// pipelineResyncInterval is how often the stages of running Pipeline builds
+// are checked on.
+const pipelineResyncInterval = 5 * time.Second
+
+// CreatePipelineController constructs a PipelineController
+func (factory *BuildControllerFactory) CreatePipelineController() controller.RunnableController {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, store, 2*time.Minute).RunUntil(factory.Stop)
+
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, queue, 2*time.Minute).RunUntil(factory.Stop)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
+
+	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	pipelineController := &buildcontroller.PipelineController{
+		BuildUpdater:            factory.BuildUpdater,
+		BuildConfigInstantiator: buildclient.NewOSClientBuildConfigInstantiatorClient(factory.OSClient),
+		BuildClient:             client,
+		PodManager:              client,
+		ImageStreamClient:       client,
+		Recorder:                eventBroadcaster.NewRecorder(kapi.EventSource{Component: "pipeline-controller"}),
+	}
+
+	// requeue hands the latest version of a running pipeline to the
+	// controller again after a while, to follow the progress of its stages.
+	requeue := func(build *buildapi.Build) {
+		time.AfterFunc(pipelineResyncInterval, func() {
+			obj, exists, err := store.Get(build)
+			if err != nil || !exists {
+				return
+			}
+			copy, err := kapi.Scheme.Copy(obj.(*buildapi.Build))
+			if err != nil {
+				utilruntime.HandleError(err)
+				return
+			}
+			queue.AddIfNotPresent(copy)
+		})
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			controller.RetryNever,
+			kutil.NewTokenBucketRateLimiter(1, 10)),
+		Handle: func(obj interface{}) error {
+			build := obj.(*buildapi.Build)
+			running, err := pipelineController.HandleBuild(build)
+			if err != nil {
+				// The build in the queue may be outdated, retry with the
+				// latest version.
+				utilruntime.HandleError(err)
+				running = true
+			}
+			if running {
+				requeue(build)
+			}
+			return nil
+		},
+	}
+}
+
+// CreateLogArchiveController constructs a BuildLogArchiveController
+func (factory *BuildControllerFactory) CreateLogArchiveController() controller.RunnableController {
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, queue, 2*time.Minute).RunUntil(factory.Stop)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
+
+	logArchiveController := &buildcontroller.BuildLogArchiveController{
+		PodLogs:      ControllerClient{factory.KubeClient, factory.OSClient},
+		Archive:      factory.LogArchive,
+		BuildUpdater: factory.BuildUpdater,
+		Recorder:     eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-log-archive-controller"}),
+		Attempts:     5,
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		// Failed builds are retried when the builds are resynced.
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			controller.RetryNever,
+			kutil.NewTokenBucketRateLimiter(1, 10)),
+		Handle: func(obj interface{}) error {
+			build := obj.(*buildapi.Build)
+			if err := logArchiveController.HandleBuild(build); err != nil {
+				utilruntime.HandleError(err)
+			}
+			return nil
+		},
+	}
+}
+
+const (
+	// deliveryWorkers is the number of notifications or commit statuses sent
+	// at the same time.
+	deliveryWorkers = 5
+	// deliveryQueueSize is the number of notifications or commit statuses
+	// waiting to be sent before the controller waits for them.
+	deliveryQueueSize = 100
+)
+
+// CreateNotificationController constructs a BuildNotificationController
+func (factory *BuildControllerFactory) CreateNotificationController() controller.RunnableController {
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, queue, 2*time.Minute).RunUntil(factory.Stop)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
+
+	notificationController := &buildcontroller.BuildNotificationController{
+		Notifier:     factory.Notifier,
+		BuildUpdater: factory.BuildUpdater,
+		Recorder:     eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-notification-controller"}),
+		Attempts:     3,
+		Backoff:      5 * time.Second,
+		Pool:         buildcontroller.NewDeliveryPool(deliveryWorkers, deliveryQueueSize, factory.Stop),
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		// Builds that failed to be marked as notified are retried when the
+		// builds are resynced.
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			controller.RetryNever,
+			kutil.NewTokenBucketRateLimiter(1, 10)),
+		Handle: func(obj interface{}) error {
+			build := obj.(*buildapi.Build)
+			if err := notificationController.HandleBuild(build); err != nil {
+				utilruntime.HandleError(err)
+			}
+			return nil
+		},
+	}
+}
+
+// CreateCommitStatusController constructs a BuildCommitStatusController
+func (factory *BuildControllerFactory) CreateCommitStatusController() controller.RunnableController {
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, queue, 2*time.Minute).RunUntil(factory.Stop)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
+
+	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	commitStatusController := &buildcontroller.BuildCommitStatusController{
+		Reporter:        commitstatus.New(factory.TargetNetworks),
+		Secrets:         client,
+		ServiceAccounts: client,
+		BuildUpdater:    factory.BuildUpdater,
+		Recorder:        eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-commit-status-controller"}),
+		ConsoleURL:      factory.ConsoleURL,
+		Attempts:        3,
+		Backoff:         5 * time.Second,
+		Pool:            buildcontroller.NewDeliveryPool(deliveryWorkers, deliveryQueueSize, factory.Stop),
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		// Builds that failed to be updated with their reported status are
+		// retried when the builds are resynced.
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			controller.RetryNever,
+			kutil.NewTokenBucketRateLimiter(1, 10)),
+		Handle: func(obj interface{}) error {
+			build := obj.(*buildapi.Build)
+			if err := commitStatusController.HandleBuild(build); err != nil {
+				utilruntime.HandleError(err)
+			}
+			return nil
+		},
+	}
+}
+
 // BuildPodControllerFactory construct BuildPodController objects
 type BuildPodControllerFactory struct {
 	OSClient     osclient.Interface
@@ -628,7 +829,42 @@ func (c ControllerClient) GetPod(namespace, name string) (*kapi.Pod, error) {
 	return c.KubeClient.Pods(namespace).Get(name)
 }
 
+// StreamPodLogs streams the logs of the pod namespace/name.
+func (c ControllerClient) StreamPodLogs(namespace, name string) (io.ReadCloser, error) {
+	return c.KubeClient.Pods(namespace).GetLogs(name, &kapi.PodLogOptions{}).Stream()
+}
+
+// GetSecret gets the secret namespace/name.
+func (c ControllerClient) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	return c.KubeClient.Secrets(namespace).Get(name)
+}
+
+// GetServiceAccount gets the service account namespace/name.
+func (c ControllerClient) GetServiceAccount(namespace, name string) (*kapi.ServiceAccount, error) {
+	return c.KubeClient.ServiceAccounts(namespace).Get(name)
+}
+
+// ListBuilds lists the builds in namespace which match selector.
+func (c ControllerClient) ListBuilds(namespace string, selector labels.Selector) (*buildapi.BuildList, error) {
+	return c.Client.Builds(namespace).List(kapi.ListOptions{LabelSelector: selector})
+}
+
+// GetBuild gets a build using the OpenShift client.
+func (c ControllerClient) GetBuild(namespace, name string) (*buildapi.Build, error) {
+	return c.Client.Builds(namespace).Get(name)
+}
+
 // GetImageStream retrieves an image repository by namespace and name
 func (c ControllerClient) GetImageStream(namespace, name string) (*imageapi.ImageStream, error) {
 	return c.Client.ImageStreams(namespace).Get(name)
 }
+
+// CreateImageStream creates an image stream using the OpenShift client.
+func (c ControllerClient) CreateImageStream(namespace string, stream *imageapi.ImageStream) (*imageapi.ImageStream, error) {
+	return c.Client.ImageStreams(namespace).Create(stream)
+}
+
+// UpdateImageStream updates an image stream using the OpenShift client.
+func (c ControllerClient) UpdateImageStream(namespace string, stream *imageapi.ImageStream) (*imageapi.ImageStream, error) {
+	return c.Client.ImageStreams(namespace).Update(stream)
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/record"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/logarchive"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// BuildLogArchiveController archives the logs of completed builds, so that
+// they can still be retrieved once the build pods are deleted.
+type BuildLogArchiveController struct {
+	PodLogs      podLogStreamer
+	Archive      *logarchive.Archive
+	BuildUpdater buildclient.BuildUpdater
+	Recorder     record.EventRecorder
+	// Attempts is the number of times the log of a build is fetched before
+	// the build is marked as not archived.
+	Attempts int
+
+	// failures counts the failed attempts to fetch the log of builds, by
+	// namespace/name.
+	failures map[string]int
+}
+
+type podLogStreamer interface {
+	StreamPodLogs(namespace, name string) (io.ReadCloser, error)
+}
+
+// HandleBuild archives the log of build if it is complete and its log has not
+// been archived yet. Builds whose log can't be archived are marked with the
+// BuildLogNotArchivedAnnotation and skipped afterwards.
+func (c *BuildLogArchiveController) HandleBuild(build *buildapi.Build) error {
+	// Pipeline builds don't have a build pod, their log is made of the
+	// status of their stages.
+	if !buildutil.IsBuildComplete(build) || build.Spec.Strategy.PipelineStrategy != nil {
+		return nil
+	}
+	if _, ok := build.Annotations[buildapi.BuildLogNotArchivedAnnotation]; ok {
+		return nil
+	}
+	archived, err := c.Archive.Exists(build.Namespace, build.Name)
+	if err != nil || archived {
+		return err
+	}
+
+	key := build.Namespace + "/" + build.Name
+	podName := buildutil.GetBuildPodName(build)
+	log, err := c.PodLogs.StreamPodLogs(build.Namespace, podName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Builds that failed to start or were cancelled may have no pod.
+			glog.V(4).Infof("Not archiving the log of build %s/%s: pod %s does not exist", build.Namespace, build.Name, podName)
+			return c.markNotArchived(build, fmt.Sprintf("pod %s does not exist", podName))
+		}
+		if c.failures == nil {
+			c.failures = map[string]int{}
+		}
+		c.failures[key]++
+		if c.failures[key] >= c.Attempts {
+			c.Recorder.Eventf(build, kapi.EventTypeWarning, "FailedArchiveLog", "Failed to fetch the build log: %v", err)
+			if updateErr := c.markNotArchived(build, fmt.Sprintf("failed to fetch the log of pod %s", podName)); updateErr != nil {
+				return updateErr
+			}
+		}
+		return err
+	}
+	defer log.Close()
+	delete(c.failures, key)
+
+	glog.V(4).Infof("Archiving the log of build %s/%s", build.Namespace, build.Name)
+	if err := c.Archive.Save(build.Namespace, build.Name, log); err != nil {
+		c.Recorder.Eventf(build, kapi.EventTypeWarning, "FailedArchiveLog", "Failed to archive the build log: %v", err)
+		return err
+	}
+	return nil
+}
+
+// markNotArchived records on build that its log isn't archived for reason.
+func (c *BuildLogArchiveController) markNotArchived(build *buildapi.Build, reason string) error {
+	delete(c.failures, build.Namespace+"/"+build.Name)
+	if build.Annotations == nil {
+		build.Annotations = map[string]string{}
+	}
+	build.Annotations[buildapi.BuildLogNotArchivedAnnotation] = reason
+	return c.BuildUpdater.Update(build.Namespace, build)
+}
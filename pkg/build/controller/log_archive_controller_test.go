@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/record"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
+)
+
+type fakePodLogStreamer struct {
+	logs      map[string]string
+	err       error
+	requested []string
+}
+
+func (s *fakePodLogStreamer) StreamPodLogs(namespace, name string) (io.ReadCloser, error) {
+	s.requested = append(s.requested, name)
+	if s.err != nil {
+		return nil, s.err
+	}
+	log, ok := s.logs[name]
+	if !ok {
+		return nil, kerrors.NewNotFound(kapi.Resource("pod"), name)
+	}
+	return ioutil.NopCloser(strings.NewReader(log)), nil
+}
+
+func newTestArchive(t *testing.T) (*logarchive.Archive, func()) {
+	dir, err := ioutil.TempDir("", "logarchive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return logarchive.New(dir), func() { os.RemoveAll(dir) }
+}
+
+func readArchivedLog(t *testing.T, archive *logarchive.Archive, build *buildapi.Build) string {
+	r, err := archive.Open(build.Namespace, build.Name)
+	if err != nil {
+		t.Fatalf("Unexpected error opening the archived log: %v", err)
+	}
+	defer r.Close()
+	log, _ := ioutil.ReadAll(r)
+	return string(log)
+}
+
+func TestHandleBuildArchivesLog(t *testing.T) {
+	archive, cleanup := newTestArchive(t)
+	defer cleanup()
+
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	podLogs := &fakePodLogStreamer{logs: map[string]string{buildapi.GetBuildPodName(build): "build log"}}
+	ctrl := &BuildLogArchiveController{PodLogs: podLogs, Archive: archive, BuildUpdater: &okBuildUpdater{}, Recorder: &record.FakeRecorder{}, Attempts: 3}
+
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if log := readArchivedLog(t, archive, build); log != "build log" {
+		t.Errorf("Expected the pod log to be archived, got %q", log)
+	}
+
+	// An archived log isn't fetched again.
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(podLogs.requested) != 1 {
+		t.Errorf("Expected the pod log to be fetched once, got %v", podLogs.requested)
+	}
+}
+
+func TestHandleBuildSkipsLogArchiving(t *testing.T) {
+	pipeline := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	pipeline.Spec.Strategy = buildapi.BuildStrategy{PipelineStrategy: &buildapi.PipelineBuildStrategy{}}
+	tests := map[string]*buildapi.Build{
+		"running build":     mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{}),
+		"pipeline build":    pipeline,
+		"build without pod": mockBuild(buildapi.BuildPhaseError, buildapi.BuildOutput{}),
+	}
+	for name, build := range tests {
+		archive, cleanup := newTestArchive(t)
+		podLogs := &fakePodLogStreamer{}
+		ctrl := &BuildLogArchiveController{PodLogs: podLogs, Archive: archive, BuildUpdater: &okBuildUpdater{}, Recorder: &record.FakeRecorder{}, Attempts: 3}
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if archived, _ := archive.Exists(build.Namespace, build.Name); archived {
+			t.Errorf("%s: expected no archived log", name)
+		}
+		cleanup()
+	}
+}
+
+func TestHandleBuildMarksBuildWithoutPod(t *testing.T) {
+	archive, cleanup := newTestArchive(t)
+	defer cleanup()
+
+	build := mockBuild(buildapi.BuildPhaseError, buildapi.BuildOutput{})
+	podLogs := &fakePodLogStreamer{}
+	ctrl := &BuildLogArchiveController{PodLogs: podLogs, Archive: archive, BuildUpdater: &okBuildUpdater{}, Recorder: &record.FakeRecorder{}, Attempts: 3}
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := build.Annotations[buildapi.BuildLogNotArchivedAnnotation]; !ok {
+		t.Errorf("Expected the build to be marked as not archived, got annotations %v", build.Annotations)
+	}
+
+	// A build marked as not archived isn't fetched again.
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(podLogs.requested) != 1 {
+		t.Errorf("Expected the pod log to be fetched once, got %v", podLogs.requested)
+	}
+}
+
+func TestHandleBuildArchiveLogError(t *testing.T) {
+	archive, cleanup := newTestArchive(t)
+	defer cleanup()
+
+	build := mockBuild(buildapi.BuildPhaseFailed, buildapi.BuildOutput{})
+	podLogs := &fakePodLogStreamer{err: errors.New("unreachable")}
+	recorder := &record.FakeRecorder{}
+	ctrl := &BuildLogArchiveController{PodLogs: podLogs, Archive: archive, BuildUpdater: &okBuildUpdater{}, Recorder: recorder, Attempts: 3}
+	for i := 1; i <= 3; i++ {
+		if err := ctrl.HandleBuild(build); err == nil {
+			t.Errorf("Expected an error when the pod log can't be fetched")
+		}
+		_, marked := build.Annotations[buildapi.BuildLogNotArchivedAnnotation]
+		if marked != (i == 3) {
+			t.Errorf("Attempt %d: expected the build to be marked as not archived only after the last attempt, got annotations %v", i, build.Annotations)
+		}
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("Expected one event, got %v", recorder.Events)
+	}
+
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(podLogs.requested) != 3 {
+		t.Errorf("Expected the pod log to be fetched 3 times, got %v", podLogs.requested)
+	}
+}
+
+func TestHandleBuildDeletionRemovesArchivedLog(t *testing.T) {
+	archive, cleanup := newTestArchive(t)
+	defer cleanup()
+
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	if err := archive.Save(build.Namespace, build.Name, strings.NewReader("build log")); err != nil {
+		t.Fatal(err)
+	}
+	ctrl := BuildDeleteController{
+		PodManager: &customPodManager{
+			GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
+				return nil, kerrors.NewNotFound(kapi.Resource("Pod"), name)
+			},
+		},
+		LogArchive: archive,
+	}
+	if err := ctrl.HandleBuildDeletion(build); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if archived, _ := archive.Exists(build.Namespace, build.Name); archived {
+		t.Errorf("Expected the archived log to be removed")
+	}
+}
@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/record"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// BuildNotificationController notifies the notification targets of builds
+// once the builds complete.
+type BuildNotificationController struct {
+	Notifier     buildNotifier
+	BuildUpdater buildclient.BuildUpdater
+	Recorder     record.EventRecorder
+	// Attempts is the number of times a target is tried before the
+	// notification fails.
+	Attempts int
+	// Backoff is the delay before trying a target again. It doubles with each
+	// attempt.
+	Backoff time.Duration
+	// Pool sends the notifications, so that HandleBuild doesn't wait for the
+	// targets. The notifications are sent by HandleBuild if it is nil.
+	Pool *DeliveryPool
+}
+
+type buildNotifier interface {
+	Notify(build *buildapi.Build, notification buildapi.BuildNotification) error
+}
+
+// HandleBuild notifies the notification targets of build if it is complete
+// and its targets have not been notified yet.
+func (c *BuildNotificationController) HandleBuild(build *buildapi.Build) error {
+	if !buildutil.IsBuildComplete(build) || len(build.Spec.Notifications) == 0 {
+		return nil
+	}
+	if build.Annotations[buildapi.BuildNotifiedAnnotation] == "true" {
+		return nil
+	}
+
+	// The build is marked as notified before the targets are, so that a
+	// conflicting update of the build or a restart of the master can't notify
+	// the targets twice. Notifications that keep failing are reported with
+	// an event rather than retried forever.
+	if build.Annotations == nil {
+		build.Annotations = map[string]string{}
+	}
+	build.Annotations[buildapi.BuildNotifiedAnnotation] = "true"
+	if err := c.BuildUpdater.Update(build.Namespace, build); err != nil {
+		return err
+	}
+
+	for _, notification := range build.Spec.Notifications {
+		if !notifiesPhase(notification, build.Status.Phase) {
+			continue
+		}
+		notification := notification
+		c.Pool.Submit(func() {
+			target := notificationTarget(notification)
+			if err := c.notify(build, notification); err != nil {
+				c.Recorder.Eventf(build, kapi.EventTypeWarning, "FailedNotification", "Failed to send the %s notification: %v", target, err)
+				return
+			}
+			c.Recorder.Eventf(build, kapi.EventTypeNormal, "NotificationSent", "Sent the %s notification", target)
+		})
+	}
+	return nil
+}
+
+// notify sends notification, trying again on errors.
+func (c *BuildNotificationController) notify(build *buildapi.Build, notification buildapi.BuildNotification) error {
+	return retryWithBackoff(c.Attempts, c.Backoff, func() error {
+		return c.Notifier.Notify(build, notification)
+	}, func(err error, delay time.Duration) {
+		glog.V(4).Infof("Retrying the %s notification of build %s/%s in %v: %v", notificationTarget(notification), build.Namespace, build.Name, delay, err)
+	})
+}
+
+// DeliveryPool sends notifications and commit statuses on a bounded number of
+// goroutines, so that the retries of unavailable targets don't hold up the
+// controllers handling builds.
+type DeliveryPool struct {
+	deliveries chan func()
+}
+
+// NewDeliveryPool returns a DeliveryPool running the deliveries on workers
+// goroutines until stop is closed. Up to queueSize deliveries wait for a
+// worker before Submit blocks.
+func NewDeliveryPool(workers, queueSize int, stop <-chan struct{}) *DeliveryPool {
+	p := &DeliveryPool{deliveries: make(chan func(), queueSize)}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case deliver := <-p.deliveries:
+					deliver()
+				case <-stop:
+					return
+				}
+			}
+		}()
+	}
+	return p
+}
+
+// Submit has deliver run by a worker of the pool. A nil pool runs deliver
+// before returning.
+func (p *DeliveryPool) Submit(deliver func()) {
+	if p == nil {
+		deliver()
+		return
+	}
+	p.deliveries <- deliver
+}
+
+// retryWithBackoff calls fn until it succeeds or it has been called attempts
+// times, waiting backoff before the first retry and doubling the wait with each
+// retry. onRetry is called with the error of fn before each wait.
+func retryWithBackoff(attempts int, backoff time.Duration, fn func() error, onRetry func(err error, delay time.Duration)) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= attempts {
+			return err
+		}
+		onRetry(err, backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// notifiesPhase returns true if notification is sent for builds completing
+// in phase. Notifications without phases are sent for every phase.
+func notifiesPhase(notification buildapi.BuildNotification, phase buildapi.BuildPhase) bool {
+	if len(notification.Phases) == 0 {
+		return true
+	}
+	for _, p := range notification.Phases {
+		if p == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// notificationTarget returns the kind of target of notification, for
+// logging and events.
+func notificationTarget(notification buildapi.BuildNotification) string {
+	switch {
+	case notification.Webhook != nil:
+		return "webhook"
+	case notification.Slack != nil:
+		return "Slack"
+	case notification.Email != nil:
+		return "email"
+	}
+	return "unknown"
+}
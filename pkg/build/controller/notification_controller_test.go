@@ -0,0 +1,177 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildNotifier struct {
+	// failures is the number of calls that fail before the calls succeed.
+	failures int
+	sent     []buildapi.BuildNotification
+	calls    int
+}
+
+func (n *fakeBuildNotifier) Notify(build *buildapi.Build, notification buildapi.BuildNotification) error {
+	n.calls++
+	if n.calls <= n.failures {
+		return errors.New("unavailable")
+	}
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+func mockNotificationController(notifier *fakeBuildNotifier) (*BuildNotificationController, *record.FakeRecorder) {
+	recorder := &record.FakeRecorder{}
+	return &BuildNotificationController{
+		Notifier:     notifier,
+		BuildUpdater: &okBuildUpdater{},
+		Recorder:     recorder,
+		Attempts:     3,
+	}, recorder
+}
+
+func TestHandleBuildSendsNotifications(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseFailed, buildapi.BuildOutput{})
+	build.Spec.Notifications = []buildapi.BuildNotification{
+		{Webhook: &buildapi.WebhookNotification{URL: "https://ci.example.com/builds"}},
+		{Phases: []buildapi.BuildPhase{buildapi.BuildPhaseComplete}, Email: &buildapi.EmailNotification{To: []string{"dev@example.com"}}},
+		{Phases: []buildapi.BuildPhase{buildapi.BuildPhaseFailed, buildapi.BuildPhaseError}, Slack: &buildapi.SlackNotification{URL: "https://hooks.slack.com/services/T0/B0/X"}},
+	}
+	notifier := &fakeBuildNotifier{}
+	ctrl, recorder := mockNotificationController(notifier)
+
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(notifier.sent) != 2 || notifier.sent[0].Webhook == nil || notifier.sent[1].Slack == nil {
+		t.Errorf("Expected the webhook and Slack notifications to be sent, got %#v", notifier.sent)
+	}
+	if build.Annotations[buildapi.BuildNotifiedAnnotation] != "true" {
+		t.Errorf("Expected the build to be annotated as notified")
+	}
+	if len(recorder.Events) != 2 {
+		t.Errorf("Expected 2 events, got %v", recorder.Events)
+	}
+	for _, event := range recorder.Events {
+		if !strings.Contains(event, "NotificationSent") {
+			t.Errorf("Unexpected event: %s", event)
+		}
+	}
+
+	// The targets are notified once.
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(notifier.sent) != 2 {
+		t.Errorf("Expected no more notifications, got %d", len(notifier.sent))
+	}
+}
+
+func TestHandleBuildRetriesNotifications(t *testing.T) {
+	tests := []struct {
+		failures int
+		event    string
+	}{
+		{failures: 2, event: "NotificationSent"},
+		{failures: 3, event: "FailedNotification"},
+	}
+
+	for _, tc := range tests {
+		build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+		build.Spec.Notifications = []buildapi.BuildNotification{{Webhook: &buildapi.WebhookNotification{URL: "https://ci.example.com/builds"}}}
+		notifier := &fakeBuildNotifier{failures: tc.failures}
+		ctrl, recorder := mockNotificationController(notifier)
+
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if notifier.calls != 3 {
+			t.Errorf("Expected 3 attempts, got %d", notifier.calls)
+		}
+		if len(recorder.Events) != 1 || !strings.Contains(recorder.Events[0], tc.event) {
+			t.Errorf("Expected a %s event, got %v", tc.event, recorder.Events)
+		}
+	}
+}
+
+// blockingBuildNotifier sends notifications once it is released.
+type blockingBuildNotifier struct {
+	release chan struct{}
+	sent    chan buildapi.BuildNotification
+}
+
+func (n *blockingBuildNotifier) Notify(build *buildapi.Build, notification buildapi.BuildNotification) error {
+	<-n.release
+	n.sent <- notification
+	return nil
+}
+
+func TestHandleBuildDoesNotWaitForNotifications(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	build.Spec.Notifications = []buildapi.BuildNotification{{Webhook: &buildapi.WebhookNotification{URL: "https://ci.example.com/builds"}}}
+	notifier := &blockingBuildNotifier{release: make(chan struct{}), sent: make(chan buildapi.BuildNotification, 1)}
+	stop := make(chan struct{})
+	defer close(stop)
+	ctrl := &BuildNotificationController{
+		Notifier:     notifier,
+		BuildUpdater: &okBuildUpdater{},
+		Recorder:     &record.FakeRecorder{},
+		Attempts:     3,
+		Pool:         NewDeliveryPool(1, 1, stop),
+	}
+
+	// HandleBuild returns while the notification is being sent.
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	close(notifier.release)
+	select {
+	case <-notifier.sent:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Fatalf("Expected the notification to be sent")
+	}
+}
+
+func TestHandleBuildSkipsNotifications(t *testing.T) {
+	notifications := []buildapi.BuildNotification{{Webhook: &buildapi.WebhookNotification{URL: "https://ci.example.com/builds"}}}
+
+	running := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	running.Spec.Notifications = notifications
+	notified := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	notified.Spec.Notifications = notifications
+	notified.Annotations = map[string]string{buildapi.BuildNotifiedAnnotation: "true"}
+
+	for _, build := range []*buildapi.Build{running, notified} {
+		notifier := &fakeBuildNotifier{}
+		ctrl, _ := mockNotificationController(notifier)
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if notifier.calls != 0 {
+			t.Errorf("Expected no notification for build in phase %s", build.Status.Phase)
+		}
+	}
+}
+
+func TestHandleBuildNotificationUpdateError(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	build.Spec.Notifications = []buildapi.BuildNotification{{Webhook: &buildapi.WebhookNotification{URL: "https://ci.example.com/builds"}}}
+	notifier := &fakeBuildNotifier{}
+	ctrl, _ := mockNotificationController(notifier)
+	ctrl.BuildUpdater = &errBuildUpdater{}
+
+	if err := ctrl.HandleBuild(build); err == nil {
+		t.Errorf("Expected an error")
+	}
+	if notifier.calls != 0 {
+		t.Errorf("Expected no notification when the build can't be updated")
+	}
+}
@@ -0,0 +1,456 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/record"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/util/namer"
+)
+
+// PipelineController runs the stages of Pipeline builds once the
+// BuildController started them.
+type PipelineController struct {
+	BuildUpdater            buildclient.BuildUpdater
+	BuildConfigInstantiator buildclient.BuildConfigInstantiator
+	BuildClient             pipelineBuildClient
+	PodManager              podManager
+	ImageStreamClient       pipelineImageStreamClient
+	Recorder                record.EventRecorder
+}
+
+type pipelineBuildClient interface {
+	GetBuild(namespace, name string) (*buildapi.Build, error)
+	buildLister
+}
+
+type pipelineImageStreamClient interface {
+	GetImageStream(namespace, name string) (*imageapi.ImageStream, error)
+	CreateImageStream(namespace string, stream *imageapi.ImageStream) (*imageapi.ImageStream, error)
+	UpdateImageStream(namespace string, stream *imageapi.ImageStream) (*imageapi.ImageStream, error)
+}
+
+// startPipeline moves the new Pipeline build to the Running phase, with all
+// its stages waiting to run.
+func startPipeline(build *buildapi.Build) {
+	now := unversioned.Now()
+	build.Status.Phase = buildapi.BuildPhaseRunning
+	build.Status.StartTimestamp = &now
+	build.Status.Reason = ""
+	build.Status.Message = ""
+	build.Status.Stages = newStageStatuses(build.Spec.Strategy.PipelineStrategy)
+}
+
+// newStageStatuses returns the initial status of the stages of pipeline.
+func newStageStatuses(pipeline *buildapi.PipelineBuildStrategy) []buildapi.PipelineStageStatus {
+	statuses := make([]buildapi.PipelineStageStatus, 0, len(pipeline.Stages))
+	for _, stage := range pipeline.Stages {
+		statuses = append(statuses, buildapi.PipelineStageStatus{Name: stage.Name, Phase: buildapi.BuildPhaseNew})
+	}
+	return statuses
+}
+
+// stageKey is the value of the BuildPipelineStageAnnotation of the builds and
+// pods started by stage of build.
+func stageKey(build *buildapi.Build, stage *buildapi.PipelineStage) string {
+	return build.Name + "/" + stage.Name
+}
+
+// HandleBuild runs the stages of a running Pipeline build one after the other
+// and records their progress, and cleans up after cancelled Pipeline builds.
+// It returns whether a stage is still running, in which case build has to be
+// handled again later.
+func (c *PipelineController) HandleBuild(build *buildapi.Build) (bool, error) {
+	pipeline := build.Spec.Strategy.PipelineStrategy
+	if pipeline == nil {
+		return false, nil
+	}
+	switch build.Status.Phase {
+	case buildapi.BuildPhaseRunning:
+	case buildapi.BuildPhaseCancelled:
+		return false, c.cancelStages(build)
+	default:
+		return false, nil
+	}
+	glog.V(4).Infof("Handling pipeline %s/%s", build.Namespace, build.Name)
+
+	if len(build.Status.Stages) != len(pipeline.Stages) {
+		build.Status.Stages = newStageStatuses(pipeline)
+	}
+	changed := false
+	// finishedPods are the pods of the test stages which finished in this
+	// pass, they are deleted once their result is recorded.
+	finishedPods := []string{}
+	for i := range pipeline.Stages {
+		stage, status := &pipeline.Stages[i], &build.Status.Stages[i]
+		if status.Phase == buildapi.BuildPhaseComplete {
+			continue
+		}
+		phase := status.Phase
+		if err := c.runStage(build, stage, status); err != nil {
+			if changed {
+				c.updateAndDeletePods(build, finishedPods)
+			}
+			return false, err
+		}
+		changed = changed || status.Phase != phase
+		if status.Phase != phase && status.CompletionTimestamp != nil && len(status.Pod) > 0 {
+			finishedPods = append(finishedPods, status.Pod)
+		}
+
+		switch status.Phase {
+		case buildapi.BuildPhaseComplete:
+			c.Recorder.Eventf(build, kapi.EventTypeNormal, "StageComplete", "Stage %s completed", stage.Name)
+			continue
+		case buildapi.BuildPhaseNew, buildapi.BuildPhasePending, buildapi.BuildPhaseRunning:
+			if changed {
+				return true, c.updateAndDeletePods(build, finishedPods)
+			}
+			return true, nil
+		}
+
+		c.Recorder.Eventf(build, kapi.EventTypeWarning, "StageFailed", "Stage %s failed: %s", stage.Name, status.Message)
+		c.complete(build, buildapi.BuildPhaseFailed, buildapi.StatusReasonPipelineStageFailed, fmt.Sprintf("Stage %s failed.", stage.Name))
+		return false, c.updateAndDeletePods(build, finishedPods)
+	}
+
+	c.complete(build, buildapi.BuildPhaseComplete, "", "")
+	return false, c.updateAndDeletePods(build, finishedPods)
+}
+
+// updateAndDeletePods updates build and then deletes the test pods podNames,
+// whose result is now recorded. A pod which can't be deleted is left behind
+// rather than failing the update.
+func (c *PipelineController) updateAndDeletePods(build *buildapi.Build, podNames []string) error {
+	if err := c.update(build); err != nil {
+		return err
+	}
+	for _, name := range podNames {
+		pod := &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: build.Namespace}}
+		if err := c.PodManager.DeletePod(build.Namespace, pod); err != nil && !errors.IsNotFound(err) {
+			glog.V(2).Infof("Couldn't delete test pod %s/%s: %v", build.Namespace, name, err)
+		}
+	}
+	return nil
+}
+
+// runStage starts stage if it is new, or follows its progress if it is
+// running. The status of the stage is updated in place.
+func (c *PipelineController) runStage(build *buildapi.Build, stage *buildapi.PipelineStage, status *buildapi.PipelineStageStatus) error {
+	if status.Phase == buildapi.BuildPhaseNew {
+		now := unversioned.Now()
+		status.StartTimestamp = &now
+	}
+	var err error
+	switch {
+	case stage.Build != nil:
+		err = c.runBuildStage(build, stage, status)
+	case stage.Test != nil:
+		err = c.runTestStage(build, stage, status)
+	case stage.Promote != nil:
+		err = c.runPromoteStage(build, stage, status)
+	default:
+		finishStage(status, buildapi.BuildPhaseFailed, "The stage has nothing to run.")
+	}
+	return err
+}
+
+// finishStage records that the stage completed in phase.
+func finishStage(status *buildapi.PipelineStageStatus, phase buildapi.BuildPhase, message string) {
+	now := unversioned.Now()
+	status.Phase = phase
+	status.Message = message
+	status.CompletionTimestamp = &now
+}
+
+// runBuildStage instantiates the BuildConfig of the stage and waits for the
+// build to complete.
+func (c *PipelineController) runBuildStage(build *buildapi.Build, stage *buildapi.PipelineStage, status *buildapi.PipelineStageStatus) error {
+	if status.Phase == buildapi.BuildPhaseNew {
+		// Adopt the build of an earlier attempt which couldn't be recorded.
+		existing, err := c.stageBuild(build, stage)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			request := &buildapi.BuildRequest{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:        stage.Build.BuildConfig,
+					Annotations: map[string]string{buildapi.BuildPipelineStageAnnotation: stageKey(build, stage)},
+				},
+			}
+			existing, err = c.BuildConfigInstantiator.Instantiate(build.Namespace, request)
+			if err != nil {
+				if isRetryable(err) {
+					return fmt.Errorf("couldn't start a build of BuildConfig %s/%s: %v", build.Namespace, stage.Build.BuildConfig, err)
+				}
+				finishStage(status, buildapi.BuildPhaseFailed, fmt.Sprintf("Couldn't start a build of BuildConfig %s: %v", stage.Build.BuildConfig, err))
+				return nil
+			}
+		}
+		status.Phase = buildapi.BuildPhaseRunning
+		status.Build = existing.Name
+		return nil
+	}
+
+	child, err := c.BuildClient.GetBuild(build.Namespace, status.Build)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			finishStage(status, buildapi.BuildPhaseFailed, fmt.Sprintf("Build %s was deleted.", status.Build))
+			return nil
+		}
+		return fmt.Errorf("couldn't get build %s/%s: %v", build.Namespace, status.Build, err)
+	}
+	switch child.Status.Phase {
+	case buildapi.BuildPhaseComplete:
+		finishStage(status, buildapi.BuildPhaseComplete, "")
+	case buildapi.BuildPhaseFailed, buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled:
+		finishStage(status, buildapi.BuildPhaseFailed, fmt.Sprintf("Build %s is %s.", child.Name, child.Status.Phase))
+	}
+	return nil
+}
+
+// stageBuild returns the build started by stage of build, or nil if there is
+// none.
+func (c *PipelineController) stageBuild(build *buildapi.Build, stage *buildapi.PipelineStage) (*buildapi.Build, error) {
+	builds, err := c.BuildClient.ListBuilds(build.Namespace, buildutil.BuildConfigSelector(stage.Build.BuildConfig))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't list the builds of BuildConfig %s/%s: %v", build.Namespace, stage.Build.BuildConfig, err)
+	}
+	key := stageKey(build, stage)
+	for i := range builds.Items {
+		if builds.Items[i].Annotations[buildapi.BuildPipelineStageAnnotation] == key {
+			return &builds.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// runTestStage runs the command of the stage in a pod and waits for the pod
+// to exit.
+func (c *PipelineController) runTestStage(build *buildapi.Build, stage *buildapi.PipelineStage, status *buildapi.PipelineStageStatus) error {
+	if status.Phase == buildapi.BuildPhaseNew {
+		image, err := c.resolveImage(build, &stage.Test.Image)
+		if err != nil {
+			finishStage(status, buildapi.BuildPhaseFailed, err.Error())
+			return nil
+		}
+		pod := &kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        namer.GetPodName(build.Name, stage.Name+"-test"),
+				Annotations: map[string]string{buildapi.BuildPipelineStageAnnotation: stageKey(build, stage)},
+			},
+			Spec: kapi.PodSpec{
+				RestartPolicy: kapi.RestartPolicyNever,
+				Containers: []kapi.Container{
+					{
+						Name:    "test",
+						Image:   image,
+						Command: stage.Test.Command,
+					},
+				},
+			},
+		}
+		if _, err := c.PodManager.CreatePod(build.Namespace, pod); err != nil && !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("couldn't create test pod %s/%s: %v", build.Namespace, pod.Name, err)
+		}
+		status.Phase = buildapi.BuildPhaseRunning
+		status.Pod = pod.Name
+		return nil
+	}
+
+	pod, err := c.PodManager.GetPod(build.Namespace, status.Pod)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			finishStage(status, buildapi.BuildPhaseFailed, fmt.Sprintf("Test pod %s was deleted.", status.Pod))
+			return nil
+		}
+		return fmt.Errorf("couldn't get test pod %s/%s: %v", build.Namespace, status.Pod, err)
+	}
+	switch pod.Status.Phase {
+	case kapi.PodSucceeded:
+		finishStage(status, buildapi.BuildPhaseComplete, "")
+	case kapi.PodFailed:
+		message := "The test failed."
+		for _, container := range pod.Status.ContainerStatuses {
+			if terminated := container.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+				message = fmt.Sprintf("The test exited with code %d.", terminated.ExitCode)
+			}
+		}
+		finishStage(status, buildapi.BuildPhaseFailed, message)
+	}
+	return nil
+}
+
+// resolveImage returns the pull spec of the image ref refers to.
+func (c *PipelineController) resolveImage(build *buildapi.Build, ref *kapi.ObjectReference) (string, error) {
+	if ref.Kind == "DockerImage" {
+		return ref.Name, nil
+	}
+	event, _, err := c.latestTaggedImage(build, ref)
+	if err != nil {
+		return "", err
+	}
+	return event.DockerImageReference, nil
+}
+
+// latestTaggedImage returns the latest image of the ImageStreamTag ref refers
+// to in the namespace of build, along with the name of the image stream.
+func (c *PipelineController) latestTaggedImage(build *buildapi.Build, ref *kapi.ObjectReference) (*imageapi.TagEvent, string, error) {
+	name, tag, ok := imageapi.SplitImageStreamTag(ref.Name)
+	if !ok {
+		return nil, "", fmt.Errorf("%s is not a valid image stream tag.", ref.Name)
+	}
+	stream, err := c.ImageStreamClient.GetImageStream(build.Namespace, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, "", fmt.Errorf("Image stream %s does not exist.", name)
+		}
+		return nil, "", fmt.Errorf("Couldn't get image stream %s: %v", name, err)
+	}
+	event := imageapi.LatestTaggedImage(stream, tag)
+	if event == nil {
+		return nil, "", fmt.Errorf("No image is tagged as %s.", ref.Name)
+	}
+	return event, name, nil
+}
+
+// runPromoteStage tags the image the stage promotes into the target image
+// stream, creating the image stream if needed.
+func (c *PipelineController) runPromoteStage(build *buildapi.Build, stage *buildapi.PipelineStage, status *buildapi.PipelineStageStatus) error {
+	event, fromStream, err := c.latestTaggedImage(build, &stage.Promote.From)
+	if err != nil {
+		finishStage(status, buildapi.BuildPhaseFailed, err.Error())
+		return nil
+	}
+	toStream, toTag, ok := imageapi.SplitImageStreamTag(stage.Promote.To.Name)
+	if !ok {
+		finishStage(status, buildapi.BuildPhaseFailed, fmt.Sprintf("%s is not a valid image stream tag.", stage.Promote.To.Name))
+		return nil
+	}
+
+	target, err := c.ImageStreamClient.GetImageStream(build.Namespace, toStream)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("couldn't get image stream %s/%s: %v", build.Namespace, toStream, err)
+		}
+		target = &imageapi.ImageStream{ObjectMeta: kapi.ObjectMeta{Name: toStream, Namespace: build.Namespace}}
+	}
+	if target.Spec.Tags == nil {
+		target.Spec.Tags = make(map[string]imageapi.TagReference)
+	}
+	tagRef := target.Spec.Tags[toTag]
+	tagRef.From = &kapi.ObjectReference{
+		Kind: "ImageStreamImage",
+		Name: fmt.Sprintf("%s@%s", fromStream, event.Image),
+	}
+	target.Spec.Tags[toTag] = tagRef
+
+	if target.CreationTimestamp.IsZero() {
+		_, err = c.ImageStreamClient.CreateImageStream(build.Namespace, target)
+	} else {
+		_, err = c.ImageStreamClient.UpdateImageStream(build.Namespace, target)
+	}
+	if err != nil {
+		if isRetryable(err) {
+			return fmt.Errorf("couldn't tag %s as %s/%s: %v", stage.Promote.From.Name, build.Namespace, stage.Promote.To.Name, err)
+		}
+		finishStage(status, buildapi.BuildPhaseFailed, fmt.Sprintf("Couldn't tag %s as %s: %v", stage.Promote.From.Name, stage.Promote.To.Name, err))
+		return nil
+	}
+	finishStage(status, buildapi.BuildPhaseComplete, fmt.Sprintf("Tagged %s@%s as %s.", fromStream, event.Image, stage.Promote.To.Name))
+	return nil
+}
+
+// isRetryable returns whether err is worth retrying rather than failing the
+// stage for.
+func isRetryable(err error) bool {
+	if _, ok := err.(errors.APIStatus); !ok {
+		return true
+	}
+	return errors.IsConflict(err) || errors.IsServerTimeout(err)
+}
+
+// cancelStages stops the running stages of the cancelled Pipeline build.
+func (c *PipelineController) cancelStages(build *buildapi.Build) error {
+	changed := false
+	for i := range build.Status.Stages {
+		status := &build.Status.Stages[i]
+		if status.Phase != buildapi.BuildPhaseNew && status.Phase != buildapi.BuildPhaseRunning {
+			continue
+		}
+		if len(status.Build) > 0 {
+			if err := c.cancelStageBuild(build.Namespace, status.Build); err != nil {
+				return err
+			}
+		}
+		if len(status.Pod) > 0 {
+			pod := &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Name: status.Pod, Namespace: build.Namespace}}
+			if err := c.PodManager.DeletePod(build.Namespace, pod); err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("couldn't delete test pod %s/%s: %v", build.Namespace, status.Pod, err)
+			}
+		}
+		finishStage(status, buildapi.BuildPhaseCancelled, "")
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	glog.V(4).Infof("Cancelled the stages of pipeline %s/%s", build.Namespace, build.Name)
+	return c.update(build)
+}
+
+// cancelStageBuild requests the cancellation of the build started by a stage.
+func (c *PipelineController) cancelStageBuild(namespace, name string) error {
+	child, err := c.BuildClient.GetBuild(namespace, name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("couldn't get build %s/%s: %v", namespace, name, err)
+	}
+	if buildutil.IsBuildComplete(child) || child.Status.Cancelled {
+		return nil
+	}
+	child.Status.Cancelled = true
+	if err := c.BuildUpdater.Update(namespace, child); err != nil {
+		return fmt.Errorf("couldn't cancel build %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+// complete records that the Pipeline build completed in phase.
+func (c *PipelineController) complete(build *buildapi.Build, phase buildapi.BuildPhase, reason buildapi.StatusReason, message string) {
+	now := unversioned.Now()
+	build.Status.Phase = phase
+	build.Status.Reason = reason
+	build.Status.Message = message
+	build.Status.CompletionTimestamp = &now
+	glog.V(4).Infof("Pipeline %s/%s completed in phase %s", build.Namespace, build.Name, phase)
+}
+
+// update records the status of build, and lets the next queued build of a
+// serial BuildConfig start when build completed.
+func (c *PipelineController) update(build *buildapi.Build) error {
+	if err := c.BuildUpdater.Update(build.Namespace, build); err != nil {
+		return fmt.Errorf("couldn't update pipeline %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	if buildutil.IsBuildComplete(build) && isSerial(build) {
+		builds, err := c.BuildClient.ListBuilds(build.Namespace, buildutil.BuildConfigSelector(buildutil.ConfigNameForBuild(build)))
+		if err != nil {
+			glog.V(2).Infof("Couldn't list the builds queued after build %s/%s: %v", build.Namespace, build.Name, err)
+			return nil
+		}
+		acceptNextBuild(c.BuildUpdater, build, buildPtrs(builds.Items))
+	}
+	return nil
+}
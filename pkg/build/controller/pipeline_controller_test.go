@@ -0,0 +1,311 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/labels"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// fakePipelineClient keeps the builds, pods and image streams a
+// PipelineController works with in memory.
+type fakePipelineClient struct {
+	builds  map[string]*buildapi.Build
+	pods    map[string]*kapi.Pod
+	streams map[string]*imageapi.ImageStream
+
+	instantiated []*buildapi.BuildRequest
+	deletedPods  []string
+}
+
+func newFakePipelineClient() *fakePipelineClient {
+	return &fakePipelineClient{
+		builds:  map[string]*buildapi.Build{},
+		pods:    map[string]*kapi.Pod{},
+		streams: map[string]*imageapi.ImageStream{},
+	}
+}
+
+func (c *fakePipelineClient) Update(namespace string, build *buildapi.Build) error {
+	c.builds[build.Name] = build
+	return nil
+}
+
+func (c *fakePipelineClient) Instantiate(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error) {
+	c.instantiated = append(c.instantiated, request)
+	build := &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        request.Name + "-1",
+			Namespace:   namespace,
+			Labels:      map[string]string{buildapi.BuildConfigLabel: request.Name},
+			Annotations: request.Annotations,
+		},
+		Status: buildapi.BuildStatus{Phase: buildapi.BuildPhaseNew},
+	}
+	c.builds[build.Name] = build
+	return build, nil
+}
+
+func (c *fakePipelineClient) GetBuild(namespace, name string) (*buildapi.Build, error) {
+	if build, ok := c.builds[name]; ok {
+		return build, nil
+	}
+	return nil, errors.NewNotFound(buildapi.Resource("builds"), name)
+}
+
+func (c *fakePipelineClient) ListBuilds(namespace string, selector labels.Selector) (*buildapi.BuildList, error) {
+	list := &buildapi.BuildList{}
+	for _, build := range c.builds {
+		if selector.Matches(labels.Set(build.Labels)) {
+			list.Items = append(list.Items, *build)
+		}
+	}
+	return list, nil
+}
+
+func (c *fakePipelineClient) CreatePod(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
+	if _, ok := c.pods[pod.Name]; ok {
+		return nil, errors.NewAlreadyExists(kapi.Resource("pods"), pod.Name)
+	}
+	c.pods[pod.Name] = pod
+	return pod, nil
+}
+
+func (c *fakePipelineClient) DeletePod(namespace string, pod *kapi.Pod) error {
+	c.deletedPods = append(c.deletedPods, pod.Name)
+	delete(c.pods, pod.Name)
+	return nil
+}
+
+func (c *fakePipelineClient) GetPod(namespace, name string) (*kapi.Pod, error) {
+	if pod, ok := c.pods[name]; ok {
+		return pod, nil
+	}
+	return nil, errors.NewNotFound(kapi.Resource("pods"), name)
+}
+
+func (c *fakePipelineClient) GetImageStream(namespace, name string) (*imageapi.ImageStream, error) {
+	if stream, ok := c.streams[name]; ok {
+		return stream, nil
+	}
+	return nil, errors.NewNotFound(imageapi.Resource("imagestreams"), name)
+}
+
+func (c *fakePipelineClient) CreateImageStream(namespace string, stream *imageapi.ImageStream) (*imageapi.ImageStream, error) {
+	stream.CreationTimestamp = unversioned.Now()
+	c.streams[stream.Name] = stream
+	return stream, nil
+}
+
+func (c *fakePipelineClient) UpdateImageStream(namespace string, stream *imageapi.ImageStream) (*imageapi.ImageStream, error) {
+	c.streams[stream.Name] = stream
+	return stream, nil
+}
+
+func mockPipelineController(client *fakePipelineClient) *PipelineController {
+	return &PipelineController{
+		BuildUpdater:            client,
+		BuildConfigInstantiator: client,
+		BuildClient:             client,
+		PodManager:              client,
+		ImageStreamClient:       client,
+		Recorder:                &record.FakeRecorder{},
+	}
+}
+
+func mockPipelineBuild() *buildapi.Build {
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{})
+	build.Name = "pipeline-1"
+	build.Spec.Strategy = buildapi.BuildStrategy{
+		PipelineStrategy: &buildapi.PipelineBuildStrategy{
+			Stages: []buildapi.PipelineStage{
+				{Name: "build", Build: &buildapi.PipelineBuildStage{BuildConfig: "app"}},
+				{Name: "test", Test: &buildapi.PipelineTestStage{
+					Image:   kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+					Command: []string{"make", "test"},
+				}},
+				{Name: "promote", Promote: &buildapi.PipelinePromoteStage{
+					From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+					To:   kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app-prod:latest"},
+				}},
+			},
+		},
+	}
+	startPipeline(build)
+	return build
+}
+
+func stagePhases(build *buildapi.Build) []buildapi.BuildPhase {
+	phases := []buildapi.BuildPhase{}
+	for _, stage := range build.Status.Stages {
+		phases = append(phases, stage.Phase)
+	}
+	return phases
+}
+
+func TestPipelineRunsStagesInOrder(t *testing.T) {
+	client := newFakePipelineClient()
+	client.streams["app"] = &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "app"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{{DockerImageReference: "registry/app@sha256:abc", Image: "sha256:abc"}}},
+			},
+		},
+	}
+	controller := mockPipelineController(client)
+	build := mockPipelineBuild()
+
+	running, err := controller.HandleBuild(build)
+	if err != nil || !running {
+		t.Fatalf("expected the build stage to run, got %t, %v", running, err)
+	}
+	if len(client.instantiated) != 1 || client.instantiated[0].Name != "app" {
+		t.Fatalf("expected BuildConfig app to be instantiated, got %#v", client.instantiated)
+	}
+	if key := client.instantiated[0].Annotations[buildapi.BuildPipelineStageAnnotation]; key != "pipeline-1/build" {
+		t.Errorf("unexpected stage annotation %q", key)
+	}
+	if build.Status.Stages[0].Build != "app-1" {
+		t.Errorf("expected the stage to record build app-1, got %q", build.Status.Stages[0].Build)
+	}
+
+	// Nothing changes while the child build is running.
+	if running, err := controller.HandleBuild(build); err != nil || !running {
+		t.Fatalf("expected the build stage to keep running, got %t, %v", running, err)
+	}
+	if len(client.instantiated) != 1 {
+		t.Errorf("expected no more builds, got %d", len(client.instantiated))
+	}
+
+	client.builds["app-1"].Status.Phase = buildapi.BuildPhaseComplete
+	if running, err := controller.HandleBuild(build); err != nil || !running {
+		t.Fatalf("expected the test stage to run, got %t, %v", running, err)
+	}
+	pod, ok := client.pods[build.Status.Stages[1].Pod]
+	if !ok {
+		t.Fatalf("expected a test pod, got %#v", client.pods)
+	}
+	if image := pod.Spec.Containers[0].Image; image != "registry/app@sha256:abc" {
+		t.Errorf("unexpected test image %q", image)
+	}
+	if !reflect.DeepEqual(pod.Spec.Containers[0].Command, []string{"make", "test"}) {
+		t.Errorf("unexpected test command %v", pod.Spec.Containers[0].Command)
+	}
+
+	pod.Status.Phase = kapi.PodSucceeded
+	if running, err := controller.HandleBuild(build); err != nil || running {
+		t.Fatalf("expected the pipeline to complete, got %t, %v", running, err)
+	}
+	if _, ok := client.pods[pod.Name]; ok {
+		t.Errorf("expected the test pod to be deleted once the stage completed")
+	}
+	if build.Status.Phase != buildapi.BuildPhaseComplete || build.Status.CompletionTimestamp == nil {
+		t.Errorf("expected the pipeline to be complete, got %#v", build.Status)
+	}
+	expected := []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseComplete, buildapi.BuildPhaseComplete}
+	if phases := stagePhases(build); !reflect.DeepEqual(phases, expected) {
+		t.Errorf("expected stage phases %v, got %v", expected, phases)
+	}
+	target, ok := client.streams["app-prod"]
+	if !ok {
+		t.Fatalf("expected image stream app-prod to be created")
+	}
+	if from := target.Spec.Tags["latest"].From; from == nil || from.Kind != "ImageStreamImage" || from.Name != "app@sha256:abc" {
+		t.Errorf("unexpected promotion %#v", from)
+	}
+}
+
+func TestPipelineFailsOnFailedStage(t *testing.T) {
+	client := newFakePipelineClient()
+	client.streams["app"] = &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "app"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{{DockerImageReference: "registry/app@sha256:abc", Image: "sha256:abc"}}},
+			},
+		},
+	}
+	controller := mockPipelineController(client)
+	build := mockPipelineBuild()
+	build.Status.Stages[0].Phase = buildapi.BuildPhaseComplete
+
+	if _, err := controller.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pod := client.pods[build.Status.Stages[1].Pod]
+	pod.Status.Phase = kapi.PodFailed
+	pod.Status.ContainerStatuses = []kapi.ContainerStatus{
+		{State: kapi.ContainerState{Terminated: &kapi.ContainerStateTerminated{ExitCode: 2}}},
+	}
+	if running, err := controller.HandleBuild(build); err != nil || running {
+		t.Fatalf("expected the pipeline to fail, got %t, %v", running, err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseFailed || build.Status.Reason != buildapi.StatusReasonPipelineStageFailed {
+		t.Errorf("expected the pipeline to fail, got %#v", build.Status)
+	}
+	if message := build.Status.Stages[1].Message; message != "The test exited with code 2." {
+		t.Errorf("unexpected stage message %q", message)
+	}
+	if !reflect.DeepEqual(client.deletedPods, []string{pod.Name}) {
+		t.Errorf("expected the test pod to be deleted, got %v", client.deletedPods)
+	}
+	if build.Status.Stages[2].Phase != buildapi.BuildPhaseNew {
+		t.Errorf("expected the promote stage not to run, got %s", build.Status.Stages[2].Phase)
+	}
+	if _, ok := client.streams["app-prod"]; ok {
+		t.Errorf("expected nothing to be promoted")
+	}
+}
+
+func TestPipelineAdoptsStageBuild(t *testing.T) {
+	client := newFakePipelineClient()
+	client.builds["app-3"] = &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "app-3",
+			Labels:      map[string]string{buildapi.BuildConfigLabel: "app"},
+			Annotations: map[string]string{buildapi.BuildPipelineStageAnnotation: "pipeline-1/build"},
+		},
+		Status: buildapi.BuildStatus{Phase: buildapi.BuildPhaseRunning},
+	}
+	controller := mockPipelineController(client)
+	build := mockPipelineBuild()
+
+	if _, err := controller.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(client.instantiated) != 0 {
+		t.Errorf("expected no new build, got %#v", client.instantiated)
+	}
+	if build.Status.Stages[0].Build != "app-3" {
+		t.Errorf("expected build app-3 to be adopted, got %q", build.Status.Stages[0].Build)
+	}
+}
+
+func TestPipelineCancelStages(t *testing.T) {
+	client := newFakePipelineClient()
+	controller := mockPipelineController(client)
+	build := mockPipelineBuild()
+
+	if _, err := controller.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build.Status.Phase = buildapi.BuildPhaseCancelled
+	if running, err := controller.HandleBuild(build); err != nil || running {
+		t.Fatalf("expected the stages to be cancelled, got %t, %v", running, err)
+	}
+	if !client.builds["app-1"].Status.Cancelled {
+		t.Errorf("expected the stage build to be cancelled")
+	}
+	expected := []buildapi.BuildPhase{buildapi.BuildPhaseCancelled, buildapi.BuildPhaseCancelled, buildapi.BuildPhaseCancelled}
+	if phases := stagePhases(build); !reflect.DeepEqual(phases, expected) {
+		t.Errorf("expected stage phases %v, got %v", expected, phases)
+	}
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// runPolicyFor returns the run policy of the BuildConfig of build at the time
+// build was created.
+func runPolicyFor(build *buildapi.Build) buildapi.BuildRunPolicy {
+	return buildapi.BuildRunPolicy(build.Labels[buildapi.BuildRunPolicyLabel])
+}
+
+// isSerial returns whether build must not run alongside the other builds of
+// its BuildConfig.
+func isSerial(build *buildapi.Build) bool {
+	if len(buildutil.ConfigNameForBuild(build)) == 0 {
+		return false
+	}
+	switch runPolicyFor(build) {
+	case buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly:
+		return true
+	}
+	return false
+}
+
+// isQueued returns whether build hasn't started yet and isn't being cancelled.
+func isQueued(build *buildapi.Build) bool {
+	return build.Status.Phase == buildapi.BuildPhaseNew && !build.Status.Cancelled
+}
+
+// runnable returns whether the new build may start under the run policy of
+// its BuildConfig. Serial builds wait in the New phase while an earlier build
+// is queued or any other build is running. With SerialLatestOnly, a build
+// cancels the earlier queued builds instead of waiting for them.
+func (bc *BuildController) runnable(build *buildapi.Build) (bool, error) {
+	if !isSerial(build) {
+		return true, nil
+	}
+	configName := buildutil.ConfigNameForBuild(build)
+	builds, err := bc.BuildLister.ListBuilds(build.Namespace, buildutil.BuildConfigSelector(configName))
+	if err != nil {
+		return false, fmt.Errorf("couldn't list the builds of BuildConfig %s/%s: %v", build.Namespace, configName, err)
+	}
+
+	latestOnly := runPolicyFor(build) == buildapi.BuildRunPolicySerialLatestOnly
+	version := buildutil.VersionForBuild(build)
+	runnable := true
+	for i := range builds.Items {
+		other := &builds.Items[i]
+		if other.Name == build.Name || buildutil.IsBuildComplete(other) {
+			continue
+		}
+		switch {
+		case other.Status.Phase != buildapi.BuildPhaseNew:
+			glog.V(4).Infof("Build %s/%s waits for build %s to complete", build.Namespace, build.Name, other.Name)
+			runnable = false
+		case !isQueued(other):
+			// The build is being cancelled.
+		case buildutil.VersionForBuild(other) > version:
+			if latestOnly {
+				// The newer build cancels this one.
+				runnable = false
+			}
+		case latestOnly:
+			glog.V(4).Infof("Cancelling build %s/%s which was superseded by build %s", other.Namespace, other.Name, build.Name)
+			other.Status.Cancelled = true
+			if err := bc.BuildUpdater.Update(other.Namespace, other); err != nil {
+				return false, fmt.Errorf("couldn't cancel build %s/%s which was superseded by build %s: %v", other.Namespace, other.Name, build.Name, err)
+			}
+			bc.Recorder.Eventf(other, kapi.EventTypeNormal, "Superseded", "Cancelled because build %s was created", build.Name)
+		default:
+			glog.V(4).Infof("Build %s/%s waits for the earlier build %s", build.Namespace, build.Name, other.Name)
+			runnable = false
+		}
+	}
+	return runnable, nil
+}
+
+// nextQueuedBuild returns the earliest queued build of the BuildConfig of
+// build among builds, or nil if there is none.
+func nextQueuedBuild(build *buildapi.Build, builds []*buildapi.Build) *buildapi.Build {
+	configName := buildutil.ConfigNameForBuild(build)
+	var next *buildapi.Build
+	for _, candidate := range builds {
+		if candidate.Namespace != build.Namespace || candidate.Name == build.Name || buildutil.ConfigNameForBuild(candidate) != configName || !isQueued(candidate) {
+			continue
+		}
+		if next == nil || buildutil.VersionForBuild(candidate) < buildutil.VersionForBuild(next) {
+			next = candidate
+		}
+	}
+	return next
+}
+
+// acceptNextBuild annotates the next queued build after the serial build
+// completed, so that the build controller handles it right away instead of
+// at its next resync. Errors are only logged since the resync catches up.
+func acceptNextBuild(updater buildclient.BuildUpdater, build *buildapi.Build, builds []*buildapi.Build) {
+	if !isSerial(build) {
+		return
+	}
+	next := nextQueuedBuild(build, builds)
+	if next == nil {
+		return
+	}
+	obj, err := kapi.Scheme.Copy(next)
+	if err != nil {
+		glog.V(2).Infof("Couldn't copy build %s/%s: %v", next.Namespace, next.Name, err)
+		return
+	}
+	next = obj.(*buildapi.Build)
+	if next.Annotations == nil {
+		next.Annotations = make(map[string]string)
+	}
+	next.Annotations[buildapi.BuildAcceptedAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+	if err := updater.Update(next.Namespace, next); err != nil {
+		glog.V(2).Infof("Couldn't accept build %s/%s after build %s completed: %v", next.Namespace, next.Name, build.Name, err)
+		return
+	}
+	glog.V(4).Infof("Accepted build %s/%s after build %s completed", next.Namespace, next.Name, build.Name)
+}
+
+// buildPtrs returns pointers to builds.
+func buildPtrs(builds []buildapi.Build) []*buildapi.Build {
+	ptrs := make([]*buildapi.Build, 0, len(builds))
+	for i := range builds {
+		ptrs = append(ptrs, &builds[i])
+	}
+	return ptrs
+}
+
+// storedBuilds returns the builds in store.
+func storedBuilds(store cache.Store) []*buildapi.Build {
+	builds := []*buildapi.Build{}
+	for _, obj := range store.List() {
+		if build, ok := obj.(*buildapi.Build); ok {
+			builds = append(builds, build)
+		}
+	}
+	return builds
+}
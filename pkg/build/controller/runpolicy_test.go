@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/labels"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildLister struct {
+	builds []buildapi.Build
+}
+
+func (l *fakeBuildLister) ListBuilds(namespace string, selector labels.Selector) (*buildapi.BuildList, error) {
+	list := &buildapi.BuildList{}
+	for _, build := range l.builds {
+		if build.Namespace == namespace && selector.Matches(labels.Set(build.Labels)) {
+			list.Items = append(list.Items, build)
+		}
+	}
+	return list, nil
+}
+
+func mockConfigBuild(version int, policy buildapi.BuildRunPolicy, phase buildapi.BuildPhase) *buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Name = "config-" + strconv.Itoa(version)
+	build.Labels[buildapi.BuildConfigLabel] = "config"
+	if len(policy) > 0 {
+		build.Labels[buildapi.BuildRunPolicyLabel] = string(policy)
+	}
+	build.Annotations = map[string]string{buildapi.BuildNumberAnnotation: strconv.Itoa(version)}
+	return build
+}
+
+func TestHandleBuildRunPolicy(t *testing.T) {
+	tests := []struct {
+		name              string
+		policy            buildapi.BuildRunPolicy
+		others            map[int]buildapi.BuildPhase
+		expectedPhase     buildapi.BuildPhase
+		expectedCancelled []string
+	}{
+		{
+			name:          "parallel with a running build",
+			policy:        buildapi.BuildRunPolicyParallel,
+			others:        map[int]buildapi.BuildPhase{1: buildapi.BuildPhaseRunning},
+			expectedPhase: buildapi.BuildPhasePending,
+		},
+		{
+			name:          "serial alone",
+			policy:        buildapi.BuildRunPolicySerial,
+			others:        map[int]buildapi.BuildPhase{1: buildapi.BuildPhaseComplete},
+			expectedPhase: buildapi.BuildPhasePending,
+		},
+		{
+			name:          "serial with a running build",
+			policy:        buildapi.BuildRunPolicySerial,
+			others:        map[int]buildapi.BuildPhase{1: buildapi.BuildPhaseRunning},
+			expectedPhase: buildapi.BuildPhaseNew,
+		},
+		{
+			name:          "serial with an earlier queued build",
+			policy:        buildapi.BuildRunPolicySerial,
+			others:        map[int]buildapi.BuildPhase{1: buildapi.BuildPhaseNew},
+			expectedPhase: buildapi.BuildPhaseNew,
+		},
+		{
+			name:          "serial with a later queued build",
+			policy:        buildapi.BuildRunPolicySerial,
+			others:        map[int]buildapi.BuildPhase{3: buildapi.BuildPhaseNew},
+			expectedPhase: buildapi.BuildPhasePending,
+		},
+		{
+			name:              "latest only cancels earlier queued builds",
+			policy:            buildapi.BuildRunPolicySerialLatestOnly,
+			others:            map[int]buildapi.BuildPhase{1: buildapi.BuildPhaseNew},
+			expectedPhase:     buildapi.BuildPhasePending,
+			expectedCancelled: []string{"config-1"},
+		},
+		{
+			name:              "latest only with a running build",
+			policy:            buildapi.BuildRunPolicySerialLatestOnly,
+			others:            map[int]buildapi.BuildPhase{0: buildapi.BuildPhaseRunning, 1: buildapi.BuildPhaseNew},
+			expectedPhase:     buildapi.BuildPhaseNew,
+			expectedCancelled: []string{"config-1"},
+		},
+		{
+			name:          "latest only with a later queued build",
+			policy:        buildapi.BuildRunPolicySerialLatestOnly,
+			others:        map[int]buildapi.BuildPhase{3: buildapi.BuildPhaseNew},
+			expectedPhase: buildapi.BuildPhaseNew,
+		},
+	}
+
+	for _, test := range tests {
+		build := mockConfigBuild(2, test.policy, buildapi.BuildPhaseNew)
+		lister := &fakeBuildLister{builds: []buildapi.Build{*build}}
+		for version, phase := range test.others {
+			lister.builds = append(lister.builds, *mockConfigBuild(version, test.policy, phase))
+		}
+		cancelled := []string{}
+		ctrl := mockBuildController()
+		ctrl.BuildLister = lister
+		ctrl.BuildUpdater = &customBuildUpdater{
+			UpdateFunc: func(namespace string, updated *buildapi.Build) error {
+				if updated.Name != build.Name && updated.Status.Cancelled {
+					cancelled = append(cancelled, updated.Name)
+				}
+				return nil
+			},
+		}
+
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if build.Status.Phase != test.expectedPhase {
+			t.Errorf("%s: expected phase %s, got %s", test.name, test.expectedPhase, build.Status.Phase)
+		}
+		if test.expectedCancelled == nil {
+			test.expectedCancelled = []string{}
+		}
+		sort.Strings(cancelled)
+		if !reflect.DeepEqual(cancelled, test.expectedCancelled) {
+			t.Errorf("%s: expected cancelled builds %v, got %v", test.name, test.expectedCancelled, cancelled)
+		}
+	}
+}
+
+func TestHandlePodAcceptsNextBuild(t *testing.T) {
+	for _, policy := range []buildapi.BuildRunPolicy{buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial} {
+		running := mockConfigBuild(1, policy, buildapi.BuildPhaseRunning)
+		cancelled := mockConfigBuild(2, policy, buildapi.BuildPhaseNew)
+		cancelled.Status.Cancelled = true
+		queued := mockConfigBuild(3, policy, buildapi.BuildPhaseNew)
+		later := mockConfigBuild(4, policy, buildapi.BuildPhaseNew)
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		for _, build := range []*buildapi.Build{running, cancelled, queued, later} {
+			store.Add(build)
+		}
+
+		accepted := []string{}
+		ctrl := &BuildPodController{
+			BuildStore: store,
+			BuildUpdater: &customBuildUpdater{
+				UpdateFunc: func(namespace string, build *buildapi.Build) error {
+					if _, ok := build.Annotations[buildapi.BuildAcceptedAnnotation]; ok {
+						accepted = append(accepted, build.Name)
+					}
+					return nil
+				},
+			},
+			PodManager: &okPodManager{},
+		}
+		pod := mockPod(kapi.PodSucceeded, 0)
+		pod.Name = running.Name + "-build"
+		pod.Namespace = running.Namespace
+		pod.Annotations[buildapi.BuildAnnotation] = running.Name
+
+		if err := ctrl.HandlePod(pod); err != nil {
+			t.Fatalf("%s: unexpected error: %v", policy, err)
+		}
+		if running.Status.Phase != buildapi.BuildPhaseComplete {
+			t.Fatalf("%s: expected the build to complete, got %s", policy, running.Status.Phase)
+		}
+		expected := []string{}
+		if policy == buildapi.BuildRunPolicySerial {
+			expected = []string{queued.Name}
+		}
+		if !reflect.DeepEqual(accepted, expected) {
+			t.Errorf("%s: expected accepted builds %v, got %v", policy, expected, accepted)
+		}
+		if _, ok := queued.Annotations[buildapi.BuildAcceptedAnnotation]; ok {
+			t.Errorf("%s: expected the stored build to be left unchanged", policy)
+		}
+	}
+}
@@ -22,6 +22,7 @@ const (
 	DockerPullSecretMountPath      = "/var/run/secrets/openshift.io/pull"
 	SecretBuildSourceBaseMountPath = "/var/run/secrets/openshift.io/build"
 	SourceImagePullSecretMountPath = "/var/run/secrets/openshift.io/source-image"
+	BuildCacheBaseMountPath        = "/var/run/openshift.io/build-cache"
 	sourceSecretMountPath          = "/var/run/secrets/openshift.io/source"
 )
 
@@ -142,6 +143,34 @@ func setupSecrets(pod *kapi.Pod, secrets []buildapi.SecretBuildSource) {
 	}
 }
 
+// BuildCacheMountPath returns the directory the persistent volume claim of
+// volume is mounted in, under BuildCacheBaseMountPath.
+func BuildCacheMountPath(volume buildapi.BuildCacheVolume) string {
+	return filepath.Join(BuildCacheBaseMountPath, volume.Name)
+}
+
+// setupCacheVolumes mounts the persistent volume claims of the cache volumes
+// into the builder container, each in its own directory under
+// BuildCacheBaseMountPath.
+func setupCacheVolumes(pod *kapi.Pod, volumes []buildapi.BuildCacheVolume) {
+	for _, v := range volumes {
+		volumeName := namer.GetName(v.Name, "build-cache", kvalidation.DNS1123SubdomainMaxLength)
+		pod.Spec.Volumes = append(pod.Spec.Volumes, kapi.Volume{
+			Name: volumeName,
+			VolumeSource: kapi.VolumeSource{
+				PersistentVolumeClaim: &kapi.PersistentVolumeClaimVolumeSource{
+					ClaimName: v.ClaimName,
+				},
+			},
+		})
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, kapi.VolumeMount{
+			Name:      volumeName,
+			MountPath: BuildCacheMountPath(v),
+		})
+		glog.V(3).Infof("Persistent volume claim %s will be used as the build cache %s in %s", v.ClaimName, v.Name, pod.Name)
+	}
+}
+
 // addSourceEnvVars adds environment variables related to the source code
 // repository to builder container
 func addSourceEnvVars(source buildapi.BuildSource, output *[]kapi.EnvVar) {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
 )
 
 func TestSetupDockerSocketHostSocket(t *testing.T) {
@@ -55,6 +57,43 @@ func TestSetupDockerSocketHostSocket(t *testing.T) {
 	}
 }
 
+func TestSetupCacheVolumes(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+
+	setupCacheVolumes(&pod, []buildapi.BuildCacheVolume{{Name: "maven", ClaimName: "build-cache", Path: "/root/.m2"}})
+
+	if len(pod.Spec.Volumes) != 1 {
+		t.Fatalf("Expected 1 volume, got: %#v", pod.Spec.Volumes)
+	}
+	volume := pod.Spec.Volumes[0]
+	if volume.PersistentVolumeClaim == nil {
+		t.Fatalf("Expected a persistent volume claim, got %#v", volume.VolumeSource)
+	}
+	if e, a := "build-cache", volume.PersistentVolumeClaim.ClaimName; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+
+	if len(pod.Spec.Containers[0].VolumeMounts) != 1 {
+		t.Fatalf("Expected 1 volume mount, got: %#v", pod.Spec.Containers[0].VolumeMounts)
+	}
+	mount := pod.Spec.Containers[0].VolumeMounts[0]
+	if e, a := volume.Name, mount.Name; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+	if e, a := "/var/run/openshift.io/build-cache/maven", mount.MountPath; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+	if mount.ReadOnly {
+		t.Errorf("Expected a writable mount")
+	}
+}
+
 func isVolumeSourceEmpty(volumeSource kapi.VolumeSource) bool {
 	if volumeSource.EmptyDir == nil &&
 		volumeSource.HostPath == nil &&
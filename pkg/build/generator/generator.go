@@ -14,6 +14,7 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/credentialprovider"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -176,6 +177,9 @@ func describeBuildRequest(request *buildapi.BuildRequest) string {
 	if request.LastVersion != nil {
 		desc += fmt.Sprintf(", LastVersion: %d", *request.LastVersion)
 	}
+	if request.PullRequest != nil {
+		desc += fmt.Sprintf(", PullRequest: %d", request.PullRequest.Number)
+	}
 	return desc
 }
 
@@ -191,6 +195,9 @@ func updateBuildEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) {
 		buildEnv = &strategy.DockerStrategy.Env
 	case strategy.CustomStrategy != nil:
 		buildEnv = &strategy.CustomStrategy.Env
+	default:
+		// Pipeline builds don't have an environment of their own.
+		return
 	}
 
 	newEnv := []kapi.EnvVar{}
@@ -230,15 +237,51 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 		return nil, err
 	}
 
-	newBuild, err := g.generateBuildFromConfig(ctx, bc, request.Revision, request.Binary)
+	values, matrix, err := buildParameterValues(bc, request.Parameters)
 	if err != nil {
 		return nil, err
 	}
+	if len(matrix) > 1 && request.Binary != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("can't start the %d builds of the matrix of BuildConfig %s/%s from binary input", len(matrix), bc.Namespace, bc.Name))
+	}
+
+	newBuilds := make([]*buildapi.Build, 0, len(matrix))
+	for _, combination := range matrix {
+		buildValues := make(map[string]string, len(values)+len(combination))
+		for name, value := range values {
+			buildValues[name] = value
+		}
+		for _, param := range combination {
+			buildValues[param.Name] = param.Value
+		}
 
-	if len(request.Env) > 0 {
-		updateBuildEnv(&newBuild.Spec.Strategy, request.Env)
+		newBuild, err := g.generateBuildFromConfig(ctx, bc, request.Revision, request.Binary, buildValues)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(request.Env) > 0 {
+			updateBuildEnv(&newBuild.Spec.Strategy, request.Env)
+		}
+		if stage, ok := request.Annotations[buildapi.BuildPipelineStageAnnotation]; ok {
+			newBuild.Annotations[buildapi.BuildPipelineStageAnnotation] = stage
+		}
+		if request.PullRequest != nil {
+			if err := setPullRequest(newBuild, request.PullRequest); err != nil {
+				return nil, err
+			}
+		}
+		if len(combination) > 0 {
+			if err := setMatrixParameters(newBuild, bc, combination); err != nil {
+				return nil, err
+			}
+		}
+		if request.NoCache {
+			setNoCache(newBuild)
+		}
+		glog.V(4).Infof("Build %s/%s has been generated from %s/%s BuildConfig", newBuild.Namespace, newBuild.ObjectMeta.Name, bc.Namespace, bc.ObjectMeta.Name)
+		newBuilds = append(newBuilds, newBuild)
 	}
-	glog.V(4).Infof("Build %s/%s has been generated from %s/%s BuildConfig", newBuild.Namespace, newBuild.ObjectMeta.Name, bc.Namespace, bc.ObjectMeta.Name)
 
 	// need to update the BuildConfig because LastVersion and possibly LastTriggeredImageID changed
 	if err := g.Client.UpdateBuildConfig(ctx, bc); err != nil {
@@ -250,7 +293,155 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 	// on the BC and then fail to create the corresponding build, however doing things in that order allows for a race
 	// condition in which two builds get kicked off.  Doing it in this order ensures that we catch the race while
 	// updating the BC.
-	return g.createBuild(ctx, newBuild)
+	// The builds of a matrix are all created, the first one is returned.
+	var firstBuild *buildapi.Build
+	for _, newBuild := range newBuilds {
+		build, err := g.createBuild(ctx, newBuild)
+		if err != nil {
+			return nil, err
+		}
+		if firstBuild == nil {
+			firstBuild = build
+		}
+	}
+	return firstBuild, nil
+}
+
+// buildParameterValues returns the values of the parameters of bc requested
+// by requested, or their default value, and the combinations of the values of
+// the parameters of the matrix of bc that are not requested. The combinations
+// are made of a single empty combination if there is no matrix.
+func buildParameterValues(bc *buildapi.BuildConfig, requested []buildapi.BuildParameterValue) (map[string]string, [][]buildapi.BuildParameterValue, error) {
+	params := make(map[string]buildapi.BuildParameter, len(bc.Spec.Parameters))
+	values := make(map[string]string, len(bc.Spec.Parameters))
+	for _, param := range bc.Spec.Parameters {
+		params[param.Name] = param
+		values[param.Name] = param.Value
+	}
+
+	requestedNames := sets.NewString()
+	for _, value := range requested {
+		param, ok := params[value.Name]
+		if !ok {
+			return nil, nil, errors.NewBadRequest(fmt.Sprintf("BuildConfig %s/%s has no parameter %s", bc.Namespace, bc.Name, value.Name))
+		}
+		if err := buildutil.ValidateBuildParameterValue(param.Type, value.Value); err != nil {
+			return nil, nil, errors.NewBadRequest(fmt.Sprintf("invalid value of parameter %s: %v", value.Name, err))
+		}
+		values[value.Name] = value.Value
+		requestedNames.Insert(value.Name)
+	}
+
+	// The combinations are expanded in the order of the matrix, the values
+	// of the last matrix parameter varying first.
+	matrix := [][]buildapi.BuildParameterValue{{}}
+	matrixNames := sets.NewString()
+	for _, matrixParam := range bc.Spec.Matrix {
+		if requestedNames.Has(matrixParam.Name) {
+			continue
+		}
+		matrixNames.Insert(matrixParam.Name)
+		expanded := make([][]buildapi.BuildParameterValue, 0, len(matrix)*len(matrixParam.Values))
+		for _, combination := range matrix {
+			for _, value := range matrixParam.Values {
+				next := make([]buildapi.BuildParameterValue, len(combination), len(combination)+1)
+				copy(next, combination)
+				expanded = append(expanded, append(next, buildapi.BuildParameterValue{Name: matrixParam.Name, Value: value}))
+			}
+		}
+		matrix = expanded
+	}
+
+	for _, param := range bc.Spec.Parameters {
+		if param.Required && len(values[param.Name]) == 0 && !matrixNames.Has(param.Name) {
+			return nil, nil, errors.NewBadRequest(fmt.Sprintf("parameter %s of BuildConfig %s/%s is required", param.Name, bc.Namespace, bc.Name))
+		}
+	}
+	return values, matrix, nil
+}
+
+// setPullRequest makes build check out the head of the pull request pr and
+// push its output to the "pr-<number>" tag, so that pull request builds do
+// not overwrite the images of the branch they target.
+func setPullRequest(build *buildapi.Build, pr *buildapi.GitPullRequest) error {
+	if build.Spec.Source.Git == nil {
+		return GeneratorFatalError{fmt.Sprintf("can't build pull request %d for build %s/%s: the build has no Git source", pr.Number, build.Namespace, build.Name)}
+	}
+	build.Spec.Source.Git.Ref = pr.Ref
+	build.Annotations[buildapi.BuildPullRequestAnnotation] = strconv.Itoa(pr.Number)
+
+	to := build.Spec.Output.To
+	if to == nil {
+		return nil
+	}
+	tag := fmt.Sprintf("pr-%d", pr.Number)
+	switch to.Kind {
+	case "ImageStreamTag":
+		name, _, _ := imageapi.SplitImageStreamTag(to.Name)
+		to.Name = imageapi.JoinImageStreamTag(name, tag)
+	case "DockerImage":
+		ref, err := imageapi.ParseDockerImageReference(to.Name)
+		if err != nil {
+			return GeneratorFatalError{fmt.Sprintf("can't build pull request %d for build %s/%s: %v", pr.Number, build.Namespace, build.Name, err)}
+		}
+		ref.Tag, ref.ID = tag, ""
+		to.Name = ref.String()
+	}
+	return nil
+}
+
+// setMatrixParameters records the values of the matrix parameters of build and,
+// unless the output of bc references a matrix parameter, appends them to the
+// tag of the output of build, so that the builds of the matrix don't push to
+// the same tag.
+func setMatrixParameters(build *buildapi.Build, bc *buildapi.BuildConfig, combination []buildapi.BuildParameterValue) error {
+	pairs := make([]string, 0, len(combination))
+	suffix := make([]string, 0, len(combination))
+	referenced := false
+	for _, param := range combination {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", param.Name, param.Value))
+		suffix = append(suffix, invalidTagCharExp.ReplaceAllString(param.Value, "-"))
+		if bc.Spec.Output.To != nil && buildutil.ReferencesBuildParameter(bc.Spec.Output.To.Name, param.Name) {
+			referenced = true
+		}
+	}
+	build.Annotations[buildapi.BuildMatrixAnnotation] = strings.Join(pairs, ",")
+
+	to := build.Spec.Output.To
+	if to == nil || referenced {
+		return nil
+	}
+	switch to.Kind {
+	case "ImageStreamTag":
+		name, tag, ok := imageapi.SplitImageStreamTag(to.Name)
+		if !ok {
+			tag = imageapi.DefaultImageTag
+		}
+		to.Name = imageapi.JoinImageStreamTag(name, tag+"-"+strings.Join(suffix, "-"))
+	case "DockerImage":
+		ref, err := imageapi.ParseDockerImageReference(to.Name)
+		if err != nil {
+			return GeneratorFatalError{fmt.Sprintf("can't tag the output of matrix build %s/%s: %v", build.Namespace, build.Name, err)}
+		}
+		if len(ref.Tag) == 0 {
+			ref.Tag = imageapi.DefaultImageTag
+		}
+		ref.Tag, ref.ID = ref.Tag+"-"+strings.Join(suffix, "-"), ""
+		to.Name = ref.String()
+	}
+	return nil
+}
+
+// invalidTagCharExp matches the characters that are not valid in image tags.
+var invalidTagCharExp = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// setNoCache makes build empty its cache volumes before it runs and, for the
+// Docker strategy, build without the layers cached by the Docker daemon.
+func setNoCache(build *buildapi.Build) {
+	build.Annotations[buildapi.BuildClearCacheAnnotation] = "true"
+	if build.Spec.Strategy.DockerStrategy != nil {
+		build.Spec.Strategy.DockerStrategy.NoCache = true
+	}
 }
 
 // checkBuildConfigLastVersion will return an error if the BuildConfig's LastVersion doesn't match the passed in lastVersion
@@ -294,6 +485,12 @@ func (g *BuildGenerator) updateImageTriggers(ctx kapi.Context, bc *buildapi.Buil
 			glog.Warningf("Could not get ImageStream reference for default ImageChangeTrigger on BuildConfig %s/%s", bc.Namespace, bc.Name)
 			continue
 		}
+		// The image of a reference to build parameters is only known once the
+		// parameters are substituted for each build.
+		if buildutil.ReferencesBuildParameters(bc, triggerImageRef) {
+			glog.V(4).Infof("Not resolving ImageChangeTrigger reference %s of BuildConfig %s/%s, it references build parameters", triggerImageRef.Name, bc.Namespace, bc.Name)
+			continue
+		}
 		image, err := g.resolveImageStreamReference(ctx, *triggerImageRef, bc.Namespace)
 		if err != nil {
 			// If the trigger is for the strategy from ref, return an error
@@ -329,6 +526,9 @@ func (g *BuildGenerator) Clone(ctx kapi.Context, request *buildapi.BuildRequest)
 	}
 
 	newBuild := generateBuildFromBuild(build, buildConfig)
+	if request.NoCache {
+		setNoCache(newBuild)
+	}
 	glog.V(4).Infof("Build %s/%s has been generated from Build %s/%s", newBuild.Namespace, newBuild.ObjectMeta.Name, build.Namespace, build.ObjectMeta.Name)
 
 	// need to update the BuildConfig because LastVersion changed
@@ -361,7 +561,8 @@ func (g *BuildGenerator) createBuild(ctx kapi.Context, build *buildapi.Build) (*
 // the Strategy, or uses the Image field of the Strategy. If binary is provided, override
 // the current build strategy with a binary artifact for this specific build.
 // Takes a BuildConfig to base the build on, and an optional SourceRevision to build.
-func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.BuildConfig, revision *buildapi.SourceRevision, binary *buildapi.BinaryBuildSource) (*buildapi.Build, error) {
+// The references to build parameters are replaced by their value in params.
+func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.BuildConfig, revision *buildapi.SourceRevision, binary *buildapi.BinaryBuildSource, params map[string]string) (*buildapi.Build, error) {
 	serviceAccount := bc.Spec.ServiceAccount
 	if len(serviceAccount) == 0 {
 		serviceAccount = g.DefaultServiceAccountName
@@ -383,6 +584,8 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 			Resources:                 bcCopy.Spec.Resources,
 			PostCommit:                bcCopy.Spec.PostCommit,
 			CompletionDeadlineSeconds: bcCopy.Spec.CompletionDeadlineSeconds,
+			Notifications:             bcCopy.Spec.Notifications,
+			CommitStatus:              bcCopy.Spec.CommitStatus,
 		},
 		ObjectMeta: kapi.ObjectMeta{
 			Labels: bcCopy.Labels,
@@ -397,6 +600,8 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 		},
 	}
 
+	buildutil.SubstituteBuildParameters(&build.Spec, params)
+
 	if binary != nil {
 		build.Spec.Source.Git = nil
 		build.Spec.Source.Binary = binary
@@ -415,6 +620,7 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	}
 	build.Labels[buildapi.BuildConfigLabelDeprecated] = bcCopy.Name
 	build.Labels[buildapi.BuildConfigLabel] = bcCopy.Name
+	setRunPolicyLabel(build, bc)
 
 	builderSecrets, err := g.FetchServiceAccountSecrets(bc.Namespace, serviceAccount)
 	if err != nil {
@@ -424,6 +630,11 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 		build.Spec.Output.PushSecret = g.resolveImageSecret(ctx, builderSecrets, build.Spec.Output.To, bc.Namespace)
 	}
 	strategyImageChangeTrigger := getStrategyImageChangeTrigger(bc)
+	// The From of the strategy is resolved for each build when it references
+	// build parameters, the image of the trigger is not the image of this build.
+	if buildutil.ReferencesBuildParameters(bc, buildutil.GetInputReference(bc.Spec.Strategy)) {
+		strategyImageChangeTrigger = nil
+	}
 
 	// Resolve image source if present
 	for i, sourceImage := range build.Spec.Source.Images {
@@ -680,8 +891,15 @@ func generateBuildFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildCo
 		newBuild.Annotations = make(map[string]string)
 	}
 	newBuild.Annotations[buildapi.BuildCloneAnnotation] = build.Name
+	delete(newBuild.Annotations, buildapi.BuildAcceptedAnnotation)
+	delete(newBuild.Annotations, buildapi.BuildPipelineStageAnnotation)
+	delete(newBuild.Annotations, buildapi.BuildClearCacheAnnotation)
+	delete(newBuild.Annotations, buildapi.BuildNotifiedAnnotation)
+	delete(newBuild.Annotations, buildapi.BuildCommitStatusAnnotation)
+	delete(newBuild.Annotations, buildapi.BuildLogNotArchivedAnnotation)
 	if buildConfig != nil {
 		newBuild.Annotations[buildapi.BuildNumberAnnotation] = strconv.Itoa(buildConfig.Status.LastVersion)
+		setRunPolicyLabel(newBuild, buildConfig)
 	} else {
 		// builds without a buildconfig don't have build numbers.
 		delete(newBuild.Annotations, buildapi.BuildNumberAnnotation)
@@ -689,6 +907,19 @@ func generateBuildFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildCo
 	return newBuild
 }
 
+// setRunPolicyLabel records the current RunPolicy of bc on build, where the
+// build controller looks it up.
+func setRunPolicyLabel(build *buildapi.Build, bc *buildapi.BuildConfig) {
+	if len(bc.Spec.RunPolicy) == 0 {
+		delete(build.Labels, buildapi.BuildRunPolicyLabel)
+		return
+	}
+	if build.Labels == nil {
+		build.Labels = make(map[string]string)
+	}
+	build.Labels[buildapi.BuildRunPolicyLabel] = string(bc.Spec.RunPolicy)
+}
+
 // getNextBuildNameFromBuild returns name of the next build with random uuid added at the end
 func getNextBuildNameFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildConfig) string {
 	var buildName string
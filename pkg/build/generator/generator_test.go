@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
 
 	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
@@ -340,6 +341,207 @@ func TestInstantiateWithLastVersion(t *testing.T) {
 	}
 }
 
+func mockBuildConfigWithMatrix() *buildapi.BuildConfig {
+	strategy := mocks.MockSourceStrategyForImageRepository()
+	strategy.SourceStrategy.Env = []kapi.EnvVar{{Name: "JAVA_VERSION", Value: "${JDK_VERSION}"}}
+	bc := mocks.MockBuildConfig(mocks.MockSource(), strategy, buildapi.BuildOutput{
+		To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+	})
+	bc.Spec.Parameters = []buildapi.BuildParameter{
+		{Name: "JDK_VERSION", Type: buildapi.BuildParameterTypeInteger, Required: true},
+		{Name: "BASE", Type: buildapi.BuildParameterTypeString, Value: "centos7"},
+		{Name: "DEBUG", Type: buildapi.BuildParameterTypeBoolean},
+	}
+	bc.Spec.Matrix = []buildapi.BuildMatrixParameter{
+		{Name: "BASE", Values: []string{"centos7", "rhel7"}},
+		{Name: "JDK_VERSION", Values: []string{"7", "8"}},
+	}
+	return bc
+}
+
+func TestInstantiateWithMatrix(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      []buildapi.BuildParameterValue
+		matrices    []string
+		tags        []string
+		javaVersion []string
+	}{
+		{
+			name:        "whole matrix",
+			matrices:    []string{"BASE=centos7,JDK_VERSION=7", "BASE=centos7,JDK_VERSION=8", "BASE=rhel7,JDK_VERSION=7", "BASE=rhel7,JDK_VERSION=8"},
+			tags:        []string{"app:latest-centos7-7", "app:latest-centos7-8", "app:latest-rhel7-7", "app:latest-rhel7-8"},
+			javaVersion: []string{"7", "8", "7", "8"},
+		},
+		{
+			name:        "requested matrix parameter",
+			params:      []buildapi.BuildParameterValue{{Name: "JDK_VERSION", Value: "8"}},
+			matrices:    []string{"BASE=centos7", "BASE=rhel7"},
+			tags:        []string{"app:latest-centos7", "app:latest-rhel7"},
+			javaVersion: []string{"8", "8"},
+		},
+		{
+			name:        "requested matrix parameters",
+			params:      []buildapi.BuildParameterValue{{Name: "JDK_VERSION", Value: "8"}, {Name: "BASE", Value: "rhel7"}},
+			matrices:    []string{""},
+			tags:        []string{"app:latest"},
+			javaVersion: []string{"8"},
+		},
+	}
+
+	for _, test := range tests {
+		var builds []*buildapi.Build
+		g := mockBuildGenerator()
+		c := g.Client.(Client)
+		c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+			return mockBuildConfigWithMatrix(), nil
+		}
+		c.CreateBuildFunc = func(ctx kapi.Context, build *buildapi.Build) error {
+			builds = append(builds, build)
+			return nil
+		}
+		g.Client = c
+
+		if _, err := g.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{Parameters: test.params}); err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if len(builds) != len(test.tags) {
+			t.Errorf("%s: expected %d builds, got %d", test.name, len(test.tags), len(builds))
+			continue
+		}
+		for i, build := range builds {
+			if e, a := test.matrices[i], build.Annotations[buildapi.BuildMatrixAnnotation]; e != a {
+				t.Errorf("%s: expected matrix annotation %q, got %q", test.name, e, a)
+			}
+			if e, a := test.tags[i], build.Spec.Output.To.Name; e != a {
+				t.Errorf("%s: expected output %q, got %q", test.name, e, a)
+			}
+			if e, a := test.javaVersion[i], build.Spec.Strategy.SourceStrategy.Env[0].Value; e != a {
+				t.Errorf("%s: expected JAVA_VERSION %q, got %q", test.name, e, a)
+			}
+		}
+	}
+}
+
+func TestInstantiateWithParameterErrors(t *testing.T) {
+	tests := map[string]struct {
+		params []buildapi.BuildParameterValue
+		matrix []buildapi.BuildMatrixParameter
+	}{
+		"unknown parameter": {
+			params: []buildapi.BuildParameterValue{{Name: "UNKNOWN", Value: "value"}},
+		},
+		"invalid value": {
+			params: []buildapi.BuildParameterValue{{Name: "DEBUG", Value: "yes"}},
+		},
+		"missing required parameter": {
+			matrix: []buildapi.BuildMatrixParameter{},
+		},
+	}
+
+	for name, test := range tests {
+		g := mockBuildGenerator()
+		c := g.Client.(Client)
+		c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+			bc := mockBuildConfigWithMatrix()
+			if test.matrix != nil {
+				bc.Spec.Matrix = test.matrix
+			}
+			return bc, nil
+		}
+		c.CreateBuildFunc = func(ctx kapi.Context, build *buildapi.Build) error {
+			t.Errorf("%s: unexpected build %s", name, build.Name)
+			return nil
+		}
+		g.Client = c
+
+		_, err := g.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{Parameters: test.params})
+		if !errors.IsBadRequest(err) {
+			t.Errorf("%s: expected a bad request error, got %v", name, err)
+		}
+	}
+}
+
+func TestInstantiateWithMatrixOfStrategyImage(t *testing.T) {
+	var builds []*buildapi.Build
+	var updated *buildapi.BuildConfig
+	g := mockBuildGeneratorForInstantiate()
+	c := g.Client.(Client)
+	c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+		bc := mockBuildConfigWithMatrix()
+		bc.Spec.Strategy.SourceStrategy.From.Name = imageRepoName + ":${BASE}"
+		bc.Spec.Triggers = []buildapi.BuildTriggerPolicy{
+			{
+				Type:        buildapi.ImageChangeBuildTriggerType,
+				ImageChange: &buildapi.ImageChangeTrigger{LastTriggeredImageID: "ref@" + imageRepoName + ":" + tagName},
+			},
+		}
+		return bc, nil
+	}
+	c.UpdateBuildConfigFunc = func(ctx kapi.Context, bc *buildapi.BuildConfig) error {
+		updated = bc
+		return nil
+	}
+	c.CreateBuildFunc = func(ctx kapi.Context, build *buildapi.Build) error {
+		builds = append(builds, build)
+		return nil
+	}
+	g.Client = c
+
+	if _, err := g.Instantiate(kapi.NewDefaultContext(), &buildapi.BuildRequest{Parameters: []buildapi.BuildParameterValue{{Name: "JDK_VERSION", Value: "8"}}}); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	expected := []string{"ref@" + imageRepoName + ":centos7", "ref@" + imageRepoName + ":rhel7"}
+	if len(builds) != len(expected) {
+		t.Fatalf("Expected %d builds, got %d", len(expected), len(builds))
+	}
+	for i, build := range builds {
+		if e, a := expected[i], build.Spec.Strategy.SourceStrategy.From.Name; e != a {
+			t.Errorf("Expected build %d to be built from %q, got %q", i, e, a)
+		}
+	}
+	if e, a := "ref@"+imageRepoName+":"+tagName, updated.Spec.Triggers[0].ImageChange.LastTriggeredImageID; e != a {
+		t.Errorf("Expected the LastTriggeredImageID to be left as %q, got %q", e, a)
+	}
+}
+
+func TestSetMatrixParameters(t *testing.T) {
+	combination := []buildapi.BuildParameterValue{{Name: "BASE", Value: "centos:7"}, {Name: "JDK_VERSION", Value: "8"}}
+	tests := []struct {
+		to       string
+		expected string
+	}{
+		{
+			to:       "registry.example.com/ns/app:v1",
+			expected: "registry.example.com/ns/app:v1-centos-7-8",
+		},
+		{
+			to:       "ns/app",
+			expected: "ns/app:latest-centos-7-8",
+		},
+		{
+			to:       "ns/app:jdk${JDK_VERSION}",
+			expected: "ns/app:jdk${JDK_VERSION}",
+		},
+	}
+	for _, test := range tests {
+		output := buildapi.BuildOutput{To: &kapi.ObjectReference{Kind: "DockerImage", Name: test.to}}
+		bc := mocks.MockBuildConfig(mocks.MockSource(), mockDockerStrategyForNilImage(), output)
+		build := mockBuild(mocks.MockSource(), mockDockerStrategyForNilImage(), output)
+		build.Annotations = map[string]string{}
+		if err := setMatrixParameters(build, bc, combination); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if build.Spec.Output.To.Name != test.expected {
+			t.Errorf("Expected output %q, got %q", test.expected, build.Spec.Output.To.Name)
+		}
+		if e, a := "BASE=centos:7,JDK_VERSION=8", build.Annotations[buildapi.BuildMatrixAnnotation]; e != a {
+			t.Errorf("Expected matrix annotation %q, got %q", e, a)
+		}
+	}
+}
+
 func TestFindImageTrigger(t *testing.T) {
 	defaultTrigger := &buildapi.ImageChangeTrigger{}
 	image1Trigger := &buildapi.ImageChangeTrigger{
@@ -601,7 +803,7 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 	}
 	generator := mockBuildGenerator()
 
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, revision, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, revision, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -633,6 +835,9 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 	if build.Labels[buildapi.BuildConfigLabelDeprecated] != bc.Name {
 		t.Errorf("Build does not contain labels from BuildConfig")
 	}
+	if _, ok := build.Labels[buildapi.BuildRunPolicyLabel]; ok {
+		t.Errorf("Build has a run policy label although the BuildConfig has no run policy")
+	}
 	if build.Status.Config.Name != bc.Name || build.Status.Config.Namespace != bc.Namespace || build.Status.Config.Kind != "BuildConfig" {
 		t.Errorf("Build does not contain correct BuildConfig reference: %v", build.Status.Config)
 	}
@@ -710,7 +915,7 @@ func TestGenerateBuildWithImageTagForSourceStrategyImageRepository(t *testing.T)
 			},
 		}}
 
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -787,7 +992,7 @@ func TestGenerateBuildWithImageTagForDockerStrategyImageRepository(t *testing.T)
 			},
 		}}
 
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -864,7 +1069,7 @@ func TestGenerateBuildWithImageTagForCustomStrategyImageRepository(t *testing.T)
 			},
 		}}
 
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -980,13 +1185,131 @@ func TestGenerateBuildFromBuildWithBuildConfig(t *testing.T) {
 
 }
 
+func TestGenerateBuildRunPolicyLabel(t *testing.T) {
+	bc := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "test-build-config",
+			Namespace: "test-namespace",
+		},
+		Spec: buildapi.BuildConfigSpec{
+			RunPolicy: buildapi.BuildRunPolicySerialLatestOnly,
+			BuildSpec: buildapi.BuildSpec{
+				Source:   mocks.MockSource(),
+				Strategy: mockDockerStrategyForDockerImage(originalImage),
+				Output:   mocks.MockOutput(),
+			},
+		},
+	}
+	generator := mockBuildGenerator()
+
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if e, a := "SerialLatestOnly", build.Labels[buildapi.BuildRunPolicyLabel]; e != a {
+		t.Errorf("Expected run policy label %q, got %q", e, a)
+	}
+
+	// A clone follows the current policy of the config.
+	build.Annotations[buildapi.BuildAcceptedAnnotation] = "accepted"
+	bc.Spec.RunPolicy = buildapi.BuildRunPolicySerial
+	clone := generateBuildFromBuild(build, bc)
+	if e, a := "Serial", clone.Labels[buildapi.BuildRunPolicyLabel]; e != a {
+		t.Errorf("Expected run policy label %q, got %q", e, a)
+	}
+	if _, ok := clone.Annotations[buildapi.BuildAcceptedAnnotation]; ok {
+		t.Errorf("Expected the accepted annotation not to be cloned")
+	}
+	bc.Spec.RunPolicy = ""
+	clone = generateBuildFromBuild(build, bc)
+	if _, ok := clone.Labels[buildapi.BuildRunPolicyLabel]; ok {
+		t.Errorf("Expected no run policy label, got %q", clone.Labels[buildapi.BuildRunPolicyLabel])
+	}
+}
+
+func TestSetPullRequest(t *testing.T) {
+	pr := &buildapi.GitPullRequest{Number: 42, Ref: "refs/pull/42/head"}
+	tests := []struct {
+		to       *kapi.ObjectReference
+		expected string
+	}{
+		{
+			to:       &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+			expected: "app:pr-42",
+		},
+		{
+			to:       &kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/ns/app:latest"},
+			expected: "registry.example.com/ns/app:pr-42",
+		},
+		{
+			to:       &kapi.ObjectReference{Kind: "DockerImage", Name: "ns/app"},
+			expected: "ns/app:pr-42",
+		},
+	}
+	for _, test := range tests {
+		build := mockBuild(mocks.MockSource(), mockDockerStrategyForNilImage(), buildapi.BuildOutput{To: test.to})
+		build.Annotations = map[string]string{}
+		if err := setPullRequest(build, pr); err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+		if build.Spec.Source.Git.Ref != pr.Ref {
+			t.Errorf("Expected ref %q, got %q", pr.Ref, build.Spec.Source.Git.Ref)
+		}
+		if build.Annotations[buildapi.BuildPullRequestAnnotation] != "42" {
+			t.Errorf("Expected the pull request annotation, got %v", build.Annotations)
+		}
+		if build.Spec.Output.To.Name != test.expected {
+			t.Errorf("Expected output %q, got %q", test.expected, build.Spec.Output.To.Name)
+		}
+	}
+
+	build := mockBuild(buildapi.BuildSource{}, mockDockerStrategyForNilImage(), buildapi.BuildOutput{})
+	build.Annotations = map[string]string{}
+	if err := setPullRequest(build, pr); !IsFatal(err) {
+		t.Errorf("Expected a fatal error for a build without Git source, got %v", err)
+	}
+}
+
+func TestGenerateBuildFromBuildDropsCompletionAnnotations(t *testing.T) {
+	build := mockBuild(mocks.MockSource(), mockDockerStrategyForNilImage(), buildapi.BuildOutput{})
+	build.Annotations = map[string]string{
+		buildapi.BuildNotifiedAnnotation:       "true",
+		buildapi.BuildCommitStatusAnnotation:   "success",
+		buildapi.BuildLogNotArchivedAnnotation: "pod test-build does not exist",
+	}
+
+	clone := generateBuildFromBuild(build, nil)
+	for _, annotation := range []string{buildapi.BuildNotifiedAnnotation, buildapi.BuildCommitStatusAnnotation, buildapi.BuildLogNotArchivedAnnotation} {
+		if _, ok := clone.Annotations[annotation]; ok {
+			t.Errorf("Expected the clone not to have the %s annotation, got %v", annotation, clone.Annotations)
+		}
+	}
+}
+
+func TestSetNoCache(t *testing.T) {
+	build := mockBuild(mocks.MockSource(), mockDockerStrategyForNilImage(), buildapi.BuildOutput{})
+	build.Annotations = map[string]string{}
+	setNoCache(build)
+	if build.Annotations[buildapi.BuildClearCacheAnnotation] != "true" {
+		t.Errorf("Expected the clear cache annotation, got %v", build.Annotations)
+	}
+	if !build.Spec.Strategy.DockerStrategy.NoCache {
+		t.Errorf("Expected the Docker build not to use cached layers")
+	}
+
+	clone := generateBuildFromBuild(build, nil)
+	if _, ok := clone.Annotations[buildapi.BuildClearCacheAnnotation]; ok {
+		t.Errorf("Expected the clone not to clear the cache, got %v", clone.Annotations)
+	}
+}
+
 func TestSubstituteImageCustomAllMatch(t *testing.T) {
 	source := mocks.MockSource()
 	strategy := mockCustomStrategyForDockerImage(originalImage)
 	output := mocks.MockOutput()
 	bc := mocks.MockBuildConfig(source, strategy, output)
 	generator := mockBuildGenerator()
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -1020,7 +1343,7 @@ func TestSubstituteImageCustomAllMismatch(t *testing.T) {
 	output := mocks.MockOutput()
 	bc := mocks.MockBuildConfig(source, strategy, output)
 	generator := mockBuildGenerator()
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -1039,7 +1362,7 @@ func TestSubstituteImageCustomBaseMatchEnvMismatch(t *testing.T) {
 	output := mocks.MockOutput()
 	bc := mocks.MockBuildConfig(source, strategy, output)
 	generator := mockBuildGenerator()
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -1067,7 +1390,7 @@ func TestSubstituteImageCustomBaseMatchEnvMissing(t *testing.T) {
 	output := mocks.MockOutput()
 	bc := mocks.MockBuildConfig(source, strategy, output)
 	generator := mockBuildGenerator()
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -1095,7 +1418,7 @@ func TestSubstituteImageCustomBaseMatchEnvNil(t *testing.T) {
 	output := mocks.MockOutput()
 	bc := mocks.MockBuildConfig(source, strategy, output)
 	generator := mockBuildGenerator()
-	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil)
+	build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("Unexpected error %v", err)
 	}
@@ -1434,7 +1757,7 @@ func TestGenerateBuildFromConfigWithSecrets(t *testing.T) {
 		output := mockOutputWithImageName(imageName)
 		generator := mockBuildGenerator()
 		bc := mocks.MockBuildConfig(source, strategy, output)
-		build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, revision, nil)
+		build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, revision, nil, nil)
 
 		if build.Spec.Output.PushSecret == nil {
 			t.Errorf("Expected PushSecret for image '%s' to be set, got nil", imageName)
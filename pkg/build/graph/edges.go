@@ -34,6 +34,10 @@ const (
 
 	// BuildEdgeKind goes from a BuildConfigNode to a BuildNode and indicates that the buildConfig owns the build
 	BuildEdgeKind = "Build"
+
+	// PipelineStageEdgeKind is an edge from a BuildConfig with a Pipeline strategy to a BuildConfig
+	// one of the stages of the pipeline builds.
+	PipelineStageEdgeKind = "PipelineStage"
 )
 
 // AddBuildEdges adds edges that connect a BuildConfig to Builds to the given graph
@@ -115,11 +119,41 @@ func AddTriggerEdges(g osgraph.MutableUniqueGraph, node *buildgraph.BuildConfigN
 	}
 }
 
+// AddPipelineEdges links a pipeline build config to the build configs its stages build, to
+// the images its stages test or promote, and to the images its stages promote them to.
+func AddPipelineEdges(g osgraph.MutableUniqueGraph, node *buildgraph.BuildConfigNode) {
+	pipeline := node.BuildConfig.Spec.Strategy.PipelineStrategy
+	if pipeline == nil {
+		return
+	}
+	for _, stage := range pipeline.Stages {
+		switch {
+		case stage.Build != nil:
+			stub := &buildapi.BuildConfig{ObjectMeta: kapi.ObjectMeta{Namespace: node.BuildConfig.Namespace, Name: stage.Build.BuildConfig}}
+			if stageNode := g.Find(buildgraph.BuildConfigNodeName(stub)); stageNode != nil {
+				g.AddEdge(node, stageNode, PipelineStageEdgeKind)
+			}
+		case stage.Test != nil:
+			if input := imageRefNode(g, &stage.Test.Image, node.BuildConfig); input != nil {
+				g.AddEdge(input, node, BuildInputImageEdgeKind)
+			}
+		case stage.Promote != nil:
+			if input := imageRefNode(g, &stage.Promote.From, node.BuildConfig); input != nil {
+				g.AddEdge(input, node, BuildInputImageEdgeKind)
+			}
+			if out := imageRefNode(g, &stage.Promote.To, node.BuildConfig); out != nil {
+				g.AddEdge(node, out, BuildOutputEdgeKind)
+			}
+		}
+	}
+}
+
 // AddInputOutputEdges links the build config to other nodes for the images and source repositories it depends on.
 func AddInputOutputEdges(g osgraph.MutableUniqueGraph, node *buildgraph.BuildConfigNode) *buildgraph.BuildConfigNode {
 	AddInputEdges(g, node)
 	AddTriggerEdges(g, node)
 	AddOutputEdges(g, node)
+	AddPipelineEdges(g, node)
 	return node
 }
 
@@ -0,0 +1,82 @@
+// Package logarchive stores the logs of completed builds, so that they remain
+// available after the build pods are deleted.
+package logarchive
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Archive stores build logs as files in a directory, with a subdirectory per
+// namespace.
+type Archive struct {
+	dir string
+}
+
+// New returns an Archive storing build logs in dir.
+func New(dir string) *Archive {
+	return &Archive{dir: dir}
+}
+
+// path returns the file the log of the build namespace/name is archived to.
+// Namespaces and build names are DNS labels and subdomains, so they can't
+// point outside of the archive.
+func (a *Archive) path(namespace, name string) string {
+	return filepath.Join(a.dir, namespace, name+".log")
+}
+
+// Exists returns true if the log of the build namespace/name is archived.
+func (a *Archive) Exists(namespace, name string) (bool, error) {
+	_, err := os.Stat(a.path(namespace, name))
+	switch {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Save archives the log of the build namespace/name read from log, replacing
+// any previously archived log. The log becomes visible only once it has been
+// completely written.
+func (a *Archive) Save(namespace, name string, log io.Reader) error {
+	dir := filepath.Join(a.dir, namespace)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "."+name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, log)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), a.path(namespace, name))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return fmt.Errorf("unable to archive the log of build %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+// Open returns the archived log of the build namespace/name. The error
+// satisfies os.IsNotExist if the log is not archived.
+func (a *Archive) Open(namespace, name string) (io.ReadCloser, error) {
+	return os.Open(a.path(namespace, name))
+}
+
+// Remove deletes the archived log of the build namespace/name, if any.
+func (a *Archive) Remove(namespace, name string) error {
+	if err := os.Remove(a.path(namespace, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
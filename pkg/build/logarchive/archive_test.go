@@ -0,0 +1,57 @@
+package logarchive
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logarchive")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	archive := New(dir)
+
+	if exists, err := archive.Exists("ns", "build-1"); exists || err != nil {
+		t.Fatalf("Expected no archived log, got %t, %v", exists, err)
+	}
+	if _, err := archive.Open("ns", "build-1"); !os.IsNotExist(err) {
+		t.Fatalf("Expected a not exist error, got %v", err)
+	}
+
+	if err := archive.Save("ns", "build-1", strings.NewReader("first")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := archive.Save("ns", "build-1", strings.NewReader("build log")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if exists, err := archive.Exists("ns", "build-1"); !exists || err != nil {
+		t.Fatalf("Expected an archived log, got %t, %v", exists, err)
+	}
+	r, err := archive.Open("ns", "build-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	log, _ := ioutil.ReadAll(r)
+	r.Close()
+	if string(log) != "build log" {
+		t.Errorf("Expected the last saved log, got %q", string(log))
+	}
+	files, _ := ioutil.ReadDir(dir + "/ns")
+	if len(files) != 1 {
+		t.Errorf("Expected no temporary files to be left, got %d files", len(files))
+	}
+
+	if err := archive.Remove("ns", "build-1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := archive.Remove("ns", "build-1"); err != nil {
+		t.Fatalf("Expected removing a missing log to succeed, got %v", err)
+	}
+	if exists, _ := archive.Exists("ns", "build-1"); exists {
+		t.Errorf("Expected the archived log to be removed")
+	}
+}
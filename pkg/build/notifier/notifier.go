@@ -0,0 +1,204 @@
+// Package notifier delivers the results of completed builds to the
+// notification targets of their BuildConfig.
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// SMTPConfig is the SMTP server emails are sent through.
+type SMTPConfig struct {
+	// Address is the host:port of the SMTP server.
+	Address string
+	// From is the sender address of the emails.
+	From string
+	// Username and Password authenticate to the SMTP server if Username is set.
+	Username string
+	Password string
+}
+
+// Payload is the build result posted as JSON to webhook targets.
+type Payload struct {
+	Namespace           string              `json:"namespace"`
+	Name                string              `json:"name"`
+	BuildConfig         string              `json:"buildConfig,omitempty"`
+	Phase               buildapi.BuildPhase `json:"phase"`
+	Reason              string              `json:"reason,omitempty"`
+	Message             string              `json:"message,omitempty"`
+	DurationSeconds     int64               `json:"durationSeconds"`
+	Commit              string              `json:"commit,omitempty"`
+	OutputImage         string              `json:"outputImage,omitempty"`
+	StartTimestamp      *unversioned.Time   `json:"startTimestamp,omitempty"`
+	CompletionTimestamp *unversioned.Time   `json:"completionTimestamp,omitempty"`
+}
+
+// slackMessage is the message posted to Slack-compatible incoming webhooks.
+type slackMessage struct {
+	Text    string `json:"text"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// Notifier sends build results to notification targets.
+type Notifier struct {
+	client *http.Client
+	smtp   *SMTPConfig
+
+	// sendMail is smtp.SendMail, replaced in tests.
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// New returns a Notifier sending emails through the SMTP server described by
+// smtpConfig and posting to the webhook and Slack targets targets allows.
+// Email targets fail if smtpConfig is nil.
+func New(smtpConfig *SMTPConfig, targets *TargetNetworks) *Notifier {
+	return &Notifier{
+		client:   targets.NewHTTPClient(30 * time.Second),
+		smtp:     smtpConfig,
+		sendMail: smtp.SendMail,
+	}
+}
+
+// NewPayload returns the result of build.
+func NewPayload(build *buildapi.Build) *Payload {
+	payload := &Payload{
+		Namespace:           build.Namespace,
+		Name:                build.Name,
+		BuildConfig:         buildutil.ConfigNameForBuild(build),
+		Phase:               build.Status.Phase,
+		Reason:              string(build.Status.Reason),
+		Message:             build.Status.Message,
+		DurationSeconds:     int64(buildDuration(build) / time.Second),
+		OutputImage:         build.Status.OutputDockerImageReference,
+		StartTimestamp:      build.Status.StartTimestamp,
+		CompletionTimestamp: build.Status.CompletionTimestamp,
+	}
+	if rev := build.Spec.Revision; rev != nil && rev.Git != nil {
+		payload.Commit = rev.Git.Commit
+	}
+	return payload
+}
+
+// buildDuration returns how long build ran.
+func buildDuration(build *buildapi.Build) time.Duration {
+	if build.Status.Duration > 0 {
+		return build.Status.Duration
+	}
+	if build.Status.StartTimestamp != nil && build.Status.CompletionTimestamp != nil {
+		return build.Status.CompletionTimestamp.Sub(build.Status.StartTimestamp.Time)
+	}
+	return 0
+}
+
+// Summary returns a one line description of the result of the build.
+func (p *Payload) Summary() string {
+	summary := fmt.Sprintf("Build %s/%s %s", p.Namespace, p.Name, strings.ToLower(string(p.Phase)))
+	if p.DurationSeconds > 0 {
+		summary += fmt.Sprintf(" after %s", time.Duration(p.DurationSeconds)*time.Second)
+	}
+	if len(p.Reason) > 0 {
+		summary += fmt.Sprintf(": %s", p.Reason)
+	}
+	return summary
+}
+
+// details returns the lines describing the build result below the summary.
+func (p *Payload) details() []string {
+	var lines []string
+	if len(p.Message) > 0 {
+		lines = append(lines, p.Message)
+	}
+	if len(p.BuildConfig) > 0 {
+		lines = append(lines, fmt.Sprintf("Build config: %s", p.BuildConfig))
+	}
+	if len(p.Commit) > 0 {
+		lines = append(lines, fmt.Sprintf("Commit: %s", p.Commit))
+	}
+	if len(p.OutputImage) > 0 {
+		lines = append(lines, fmt.Sprintf("Output image: %s", p.OutputImage))
+	}
+	return lines
+}
+
+// Notify sends the result of build to the target of notification.
+func (n *Notifier) Notify(build *buildapi.Build, notification buildapi.BuildNotification) error {
+	payload := NewPayload(build)
+	switch {
+	case notification.Webhook != nil:
+		return n.post(notification.Webhook.URL, payload)
+	case notification.Slack != nil:
+		text := strings.Join(append([]string{payload.Summary()}, payload.details()...), "\n")
+		return n.post(notification.Slack.URL, &slackMessage{Text: text, Channel: notification.Slack.Channel})
+	case notification.Email != nil:
+		return n.email(notification.Email.To, payload)
+	}
+	return fmt.Errorf("the notification has no target")
+}
+
+// post posts body as JSON to url.
+func (n *Notifier) post(url string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := n.client.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", url, resp.Status)
+	}
+	return nil
+}
+
+// email sends payload by email to the addresses to.
+func (n *Notifier) email(to []string, payload *Payload) error {
+	if n.smtp == nil {
+		return fmt.Errorf("no SMTP server is configured")
+	}
+	// The envelope recipients are bare addresses, the To header keeps the
+	// display names.
+	recipients := make([]string, 0, len(to))
+	for _, address := range to {
+		addr, err := mail.ParseAddress(address)
+		if err != nil {
+			return err
+		}
+		recipients = append(recipients, addr.Address)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.smtp.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", payload.Summary())
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	for _, line := range payload.details() {
+		fmt.Fprintf(&msg, "%s\r\n", line)
+	}
+
+	var auth smtp.Auth
+	if len(n.smtp.Username) > 0 {
+		host, _, err := net.SplitHostPort(n.smtp.Address)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", n.smtp.Username, n.smtp.Password, host)
+	}
+	return n.sendMail(n.smtp.Address, auth, n.smtp.From, recipients, msg.Bytes())
+}
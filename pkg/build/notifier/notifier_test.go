@@ -0,0 +1,173 @@
+package notifier
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// loopbackTargets allows the test servers as targets.
+var loopbackTargets = NewTargetNetworks(nil, mustParseCIDRs("127.0.0.0/8", "::1/128"))
+
+func completedBuild() *buildapi.Build {
+	start := unversioned.NewTime(time.Date(2016, 5, 1, 10, 0, 0, 0, time.UTC))
+	completion := unversioned.NewTime(start.Add(90 * time.Second))
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "test",
+			Name:      "app-1",
+			Labels:    map[string]string{buildapi.BuildConfigLabel: "app"},
+		},
+		Spec: buildapi.BuildSpec{
+			Revision: &buildapi.SourceRevision{Git: &buildapi.GitSourceRevision{Commit: "0123456789abcdef"}},
+		},
+		Status: buildapi.BuildStatus{
+			Phase:                      buildapi.BuildPhaseFailed,
+			Reason:                     buildapi.StatusReasonError,
+			StartTimestamp:             &start,
+			CompletionTimestamp:        &completion,
+			OutputDockerImageReference: "172.30.0.1:5000/test/app:latest",
+		},
+	}
+}
+
+func TestNewPayload(t *testing.T) {
+	payload := NewPayload(completedBuild())
+	if payload.BuildConfig != "app" {
+		t.Errorf("unexpected build config: %q", payload.BuildConfig)
+	}
+	if payload.DurationSeconds != 90 {
+		t.Errorf("unexpected duration: %d", payload.DurationSeconds)
+	}
+	if payload.Commit != "0123456789abcdef" {
+		t.Errorf("unexpected commit: %q", payload.Commit)
+	}
+	if payload.OutputImage != "172.30.0.1:5000/test/app:latest" {
+		t.Errorf("unexpected output image: %q", payload.OutputImage)
+	}
+	if e, a := "Build test/app-1 failed after 1m30s: Error", payload.Summary(); e != a {
+		t.Errorf("expected summary %q, got %q", e, a)
+	}
+}
+
+func TestNotifyWebhook(t *testing.T) {
+	var received Payload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected content type: %q", r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("unexpected error decoding the payload: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	n := New(nil, loopbackTargets)
+	if err := n.Notify(completedBuild(), buildapi.BuildNotification{Webhook: &buildapi.WebhookNotification{URL: server.URL}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.Name != "app-1" || received.Phase != buildapi.BuildPhaseFailed || received.Commit != "0123456789abcdef" {
+		t.Errorf("unexpected payload: %#v", received)
+	}
+}
+
+func TestNotifyWebhookError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	n := New(nil, loopbackTargets)
+	if err := n.Notify(completedBuild(), buildapi.BuildNotification{Webhook: &buildapi.WebhookNotification{URL: server.URL}}); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestNotifySlack(t *testing.T) {
+	var received slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("unexpected error decoding the message: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	n := New(nil, loopbackTargets)
+	notification := buildapi.BuildNotification{Slack: &buildapi.SlackNotification{URL: server.URL, Channel: "#builds"}}
+	if err := n.Notify(completedBuild(), notification); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.Channel != "#builds" {
+		t.Errorf("unexpected channel: %q", received.Channel)
+	}
+	if !strings.HasPrefix(received.Text, "Build test/app-1 failed") || !strings.Contains(received.Text, "Commit: 0123456789abcdef") {
+		t.Errorf("unexpected text: %q", received.Text)
+	}
+}
+
+func TestNotifyEmail(t *testing.T) {
+	n := New(&SMTPConfig{Address: "smtp.example.com:587", From: "builds@example.com", Username: "builds", Password: "secret"}, nil)
+	var (
+		sent       bool
+		recipients []string
+		message    string
+	)
+	n.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sent = true
+		if addr != "smtp.example.com:587" || from != "builds@example.com" {
+			t.Errorf("unexpected address %q or sender %q", addr, from)
+		}
+		if a == nil {
+			t.Errorf("expected authentication")
+		}
+		recipients, message = to, string(msg)
+		return nil
+	}
+
+	notification := buildapi.BuildNotification{Email: &buildapi.EmailNotification{To: []string{"Dev <dev@example.com>"}}}
+	if err := n.Notify(completedBuild(), notification); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sent {
+		t.Fatalf("expected an email to be sent")
+	}
+	if len(recipients) != 1 || recipients[0] != "dev@example.com" {
+		t.Errorf("unexpected recipients: %v", recipients)
+	}
+	if !strings.Contains(message, "To: Dev <dev@example.com>\r\n") || !strings.Contains(message, "Subject: Build test/app-1 failed") {
+		t.Errorf("unexpected message: %q", message)
+	}
+}
+
+func TestNotifyEmailWithoutSMTP(t *testing.T) {
+	n := New(nil, nil)
+	notification := buildapi.BuildNotification{Email: &buildapi.EmailNotification{To: []string{"dev@example.com"}}}
+	if err := n.Notify(completedBuild(), notification); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestNotifyWebhookInternalTarget(t *testing.T) {
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted = true
+	}))
+	defer server.Close()
+
+	n := New(nil, nil)
+	if err := n.Notify(completedBuild(), buildapi.BuildNotification{Webhook: &buildapi.WebhookNotification{URL: server.URL}}); err == nil {
+		t.Errorf("expected an error posting to a loopback address")
+	}
+	if posted {
+		t.Errorf("expected the loopback target not to be posted to")
+	}
+}
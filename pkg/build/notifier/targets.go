@@ -0,0 +1,108 @@
+package notifier
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// internalNetworks are the private networks targets are refused in unless
+// they are allowed, along with loopback, link-local and unspecified addresses.
+var internalNetworks = mustParseCIDRs("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7")
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// TargetNetworks restricts the addresses the targets of notifications and
+// commit statuses are reached at, so that users can't have the master post to
+// the services of its own network. A nil TargetNetworks refuses internal
+// addresses only.
+type TargetNetworks struct {
+	reject []*net.IPNet
+	admit  []*net.IPNet
+}
+
+// NewTargetNetworks returns the TargetNetworks refusing the addresses in
+// reject, then allowing the internal addresses in admit.
+func NewTargetNetworks(reject, admit []*net.IPNet) *TargetNetworks {
+	return &TargetNetworks{reject: reject, admit: admit}
+}
+
+// IsInternal returns true if ip is a loopback, link-local, private or
+// unspecified address.
+func IsInternal(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() || containsIP(internalNetworks, ip)
+}
+
+// Check returns an error if targets may not be reached at ip.
+func (t *TargetNetworks) Check(ip net.IP) error {
+	if t != nil && containsIP(t.reject, ip) {
+		return fmt.Errorf("address %s is not an allowed target", ip)
+	}
+	if t != nil && containsIP(t.admit, ip) {
+		return nil
+	}
+	if IsInternal(ip) {
+		return fmt.Errorf("address %s is an internal address and not an allowed target", ip)
+	}
+	return nil
+}
+
+// NewHTTPClient returns a client whose connections are only made to the
+// addresses allowed by t.
+func (t *TargetNetworks) NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	// Proxies are not used, the addresses checked have to be the addresses
+	// connected to.
+	transport := &http.Transport{
+		Dial: func(network, addr string) (net.Conn, error) {
+			return t.dial(dialer, network, addr)
+		},
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}
+}
+
+// dial connects to the first allowed address of the host of addr. The
+// addresses are resolved once, so that the host can't resolve to another
+// address between the check and the connection.
+func (t *TargetNetworks) dial(dialer *net.Dialer, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, err
+	}
+	err = fmt.Errorf("no address found for %s", host)
+	for _, ip := range ips {
+		if err = t.Check(ip); err != nil {
+			continue
+		}
+		var conn net.Conn
+		if conn, err = dialer.Dial(network, net.JoinHostPort(ip.String(), port)); err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
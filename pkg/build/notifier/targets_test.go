@@ -0,0 +1,35 @@
+package notifier
+
+import (
+	"net"
+	"testing"
+)
+
+func TestTargetNetworksCheck(t *testing.T) {
+	targets := NewTargetNetworks(mustParseCIDRs("203.0.113.0/24"), mustParseCIDRs("10.1.0.0/16"))
+	tests := []struct {
+		targets *TargetNetworks
+		ip      string
+		allowed bool
+	}{
+		{nil, "93.184.216.34", true},
+		{nil, "127.0.0.1", false},
+		{nil, "::1", false},
+		{nil, "169.254.169.254", false},
+		{nil, "10.1.2.3", false},
+		{nil, "172.17.0.1", false},
+		{nil, "192.168.1.1", false},
+		{nil, "fd00::1", false},
+		{nil, "0.0.0.0", false},
+		{targets, "10.1.2.3", true},
+		{targets, "10.2.0.1", false},
+		{targets, "203.0.113.10", false},
+		{targets, "93.184.216.34", true},
+	}
+	for _, test := range tests {
+		err := test.targets.Check(net.ParseIP(test.ip))
+		if allowed := err == nil; allowed != test.allowed {
+			t.Errorf("%s: expected allowed to be %t, got error %v", test.ip, test.allowed, err)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 
@@ -49,10 +50,16 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request, ctx kap
 		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept your secret", hookType, name))
 	}
 
-	revision, proceed, err := plugin.Extract(config, secret, "", req)
+	request, proceed, err := webhook.ExtractRequest(plugin, config, secret, "", req)
 	switch err {
 	case webhook.ErrSecretMismatch, webhook.ErrHookNotEnabled:
 		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept your secret", hookType, name))
+	case webhook.ErrSignatureMismatch:
+		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept the payload signature", hookType, name))
+	case webhook.ErrNoPathsChanged:
+		glog.V(2).Infof("Ignoring webhook %q for %s/%s: %v", hookType, config.Namespace, name, err)
+		fmt.Fprintln(w, err.Error())
+		return nil
 	case nil:
 	default:
 		return errors.NewInternalError(fmt.Errorf("hook failed: %v", err))
@@ -62,10 +69,6 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request, ctx kap
 		return nil
 	}
 
-	request := &buildapi.BuildRequest{
-		ObjectMeta: kapi.ObjectMeta{Name: name},
-		Revision:   revision,
-	}
 	if _, err := c.instantiator.Instantiate(config.Namespace, request); err != nil {
 		return errors.NewInternalError(fmt.Errorf("could not generate a build: %v", err))
 	}
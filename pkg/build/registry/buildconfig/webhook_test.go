@@ -46,6 +46,7 @@ func newStorage() (*rest.WebHook, *buildConfigInstantiator, *test.BuildConfigReg
 		"ok":        &plugin{},
 		"errsecret": &plugin{Err: webhook.ErrSecretMismatch},
 		"errhook":   &plugin{Err: webhook.ErrHookNotEnabled},
+		"errpaths":  &plugin{Err: webhook.ErrNoPathsChanged},
 		"err":       &plugin{Err: fmt.Errorf("test error")},
 	})
 	return hook, bci, mockRegistry
@@ -84,12 +85,13 @@ func (r *fakeResponder) Error(err error) {
 
 func TestConnectWebHook(t *testing.T) {
 	testCases := map[string]struct {
-		Name   string
-		Path   string
-		Obj    *api.BuildConfig
-		RegErr error
-		ErrFn  func(error) bool
-		WFn    func(*httptest.ResponseRecorder) bool
+		Name    string
+		Path    string
+		Obj     *api.BuildConfig
+		RegErr  error
+		ErrFn   func(error) bool
+		WFn     func(*httptest.ResponseRecorder) bool
+		NoBuild bool
 	}{
 		"hook returns generic error": {
 			Name: "test",
@@ -123,6 +125,16 @@ func TestConnectWebHook(t *testing.T) {
 				return w.Code == http.StatusOK
 			},
 		},
+		"hook returns 200 with a message for ignored push": {
+			Name:  "test",
+			Path:  "secret/errpaths/extra",
+			Obj:   &api.BuildConfig{ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"}},
+			ErrFn: func(err error) bool { return err == nil },
+			WFn: func(w *httptest.ResponseRecorder) bool {
+				return w.Code == http.StatusOK && strings.Contains(w.Body.String(), webhook.ErrNoPathsChanged.Error())
+			},
+			NoBuild: true,
+		},
 	}
 	for k, testCase := range testCases {
 		hook, bci, registry := newStorage()
@@ -148,7 +160,7 @@ func TestConnectWebHook(t *testing.T) {
 			t.Errorf("%s: unexpected response: %#v", k, w)
 			continue
 		}
-		if testCase.Obj != nil {
+		if testCase.Obj != nil && !testCase.NoBuild {
 			if bci.Request == nil {
 				t.Errorf("%s: instantiator not invoked", k)
 				continue
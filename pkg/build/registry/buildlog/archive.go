@@ -0,0 +1,77 @@
+package buildlog
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/openshift/origin/pkg/build/logarchive"
+)
+
+// archivedLogStreamer streams the archived log of a completed build whose
+// build pod was deleted.
+type archivedLogStreamer struct {
+	archive   *logarchive.Archive
+	namespace string
+	name      string
+	// tailLines and limitBytes are the options of the log request the
+	// archived log supports.
+	tailLines  *int64
+	limitBytes *int64
+}
+
+var _ rest.ResourceStreamer = &archivedLogStreamer{}
+
+// GetObjectKind is required to satisfy runtime.Object
+func (s *archivedLogStreamer) GetObjectKind() unversioned.ObjectKind {
+	return unversioned.EmptyObjectKind
+}
+
+// InputStream returns a stream with the archived log of the build
+func (s *archivedLogStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	log, err := s.archive.Open(s.namespace, s.name)
+	if err != nil {
+		return nil, false, "", err
+	}
+	var r io.Reader = log
+	if s.tailLines != nil {
+		data, err := ioutil.ReadAll(log)
+		if err != nil {
+			log.Close()
+			return nil, false, "", err
+		}
+		r = bytes.NewReader(tailLines(data, *s.tailLines))
+	}
+	if s.limitBytes != nil {
+		r = io.LimitReader(r, *s.limitBytes)
+	}
+	return &readCloser{Reader: r, Closer: log}, false, "text/plain", nil
+}
+
+// tailLines returns the last n lines of log.
+func tailLines(log []byte, n int64) []byte {
+	end := len(log)
+	if end > 0 && log[end-1] == '\n' {
+		end--
+	}
+	start := end
+	for ; n > 0; n-- {
+		i := bytes.LastIndexByte(log[:start], '\n')
+		if i < 0 {
+			return log
+		}
+		start = i
+	}
+	if start == len(log) {
+		return nil
+	}
+	return log[start+1:]
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
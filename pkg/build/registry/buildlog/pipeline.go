@@ -0,0 +1,155 @@
+package buildlog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/registry"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// pipelineLogStreamer streams the status of the stages of a Pipeline build,
+// one line per stage phase change. Pipeline builds run no build pod of their
+// own, the logs of the individual stages are available from their builds and
+// pods.
+type pipelineLogStreamer struct {
+	getter  rest.Getter
+	watcher rest.Watcher
+	ctx     kapi.Context
+	build   *api.Build
+	follow  bool
+}
+
+var _ rest.ResourceStreamer = &pipelineLogStreamer{}
+
+// GetObjectKind is required to satisfy runtime.Object
+func (s *pipelineLogStreamer) GetObjectKind() unversioned.ObjectKind {
+	return unversioned.EmptyObjectKind
+}
+
+// InputStream returns a stream with the status of the pipeline stages
+func (s *pipelineLogStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	reader, writer := io.Pipe()
+	stream := &stoppingReadCloser{ReadCloser: reader, stop: make(chan struct{})}
+	go func() {
+		writer.CloseWithError(s.stream(writer, stream.stop))
+	}()
+	return stream, s.follow, "text/plain", nil
+}
+
+// stoppingReadCloser closes stop when it is closed, so that the writer of
+// the stream stops as soon as the client is gone rather than on its next
+// write.
+type stoppingReadCloser struct {
+	io.ReadCloser
+	once sync.Once
+	stop chan struct{}
+}
+
+func (r *stoppingReadCloser) Close() error {
+	r.once.Do(func() { close(r.stop) })
+	return r.ReadCloser.Close()
+}
+
+// stream writes the stage status lines of the build to w until the build
+// completes, or immediately when not following. Following stops when stop
+// is closed or the request is done.
+func (s *pipelineLogStreamer) stream(w io.Writer, stop <-chan struct{}) error {
+	build := s.build
+	seen := map[string]api.BuildPhase{}
+	for {
+		for _, stage := range build.Status.Stages {
+			if phase, ok := seen[stage.Name]; ok && phase == stage.Phase {
+				continue
+			}
+			seen[stage.Name] = stage.Phase
+			if _, err := fmt.Fprintln(w, formatPipelineStage(stage)); err != nil {
+				return err
+			}
+		}
+		if !s.follow || buildutil.IsBuildComplete(build) {
+			break
+		}
+		next, err := s.waitForChange(build, stop)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		build = next
+	}
+	if buildutil.IsBuildComplete(build) {
+		status := fmt.Sprintf("Pipeline %s", strings.ToLower(string(build.Status.Phase)))
+		if len(build.Status.Message) > 0 {
+			status += ": " + build.Status.Message
+		}
+		if _, err := fmt.Fprintln(w, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForChange watches build and returns it once it changes. It returns nil
+// if stop is closed or the request is done first. If the watch ends, the
+// build is read again so that following resumes from its latest state.
+func (s *pipelineLogStreamer) waitForChange(build *api.Build, stop <-chan struct{}) (*api.Build, error) {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", build.Name)
+	w, err := s.watcher.Watch(s.ctx, &kapi.ListOptions{FieldSelector: fieldSelector, ResourceVersion: build.ResourceVersion})
+	if err != nil {
+		return nil, err
+	}
+	defer w.Stop()
+
+	select {
+	case event, ok := <-w.ResultChan():
+		if !ok {
+			obj, err := s.getter.Get(s.ctx, build.Name)
+			if err != nil {
+				return nil, err
+			}
+			return obj.(*api.Build), nil
+		}
+		if event.Type == watch.Deleted {
+			return nil, registry.ErrBuildDeleted
+		}
+		changed, ok := event.Object.(*api.Build)
+		if !ok {
+			return nil, fmt.Errorf("received unknown object while watching for builds")
+		}
+		return changed, nil
+	case <-stop:
+		return nil, nil
+	case <-s.ctx.Done():
+		return nil, nil
+	}
+}
+
+// formatPipelineStage returns a single line describing the status of a stage.
+func formatPipelineStage(stage api.PipelineStageStatus) string {
+	details := []string{}
+	if len(stage.Build) > 0 {
+		details = append(details, "build/"+stage.Build)
+	}
+	if len(stage.Pod) > 0 {
+		details = append(details, "pod/"+stage.Pod)
+	}
+	if len(stage.Message) > 0 {
+		details = append(details, stage.Message)
+	}
+	line := fmt.Sprintf("--> %s: %s", stage.Name, stage.Phase)
+	if len(details) > 0 {
+		line += fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+	}
+	return line
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/api/validation"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 )
@@ -28,6 +29,9 @@ type REST struct {
 	PodGetter      pod.ResourceGetter
 	ConnectionInfo kubeletclient.ConnectionInfoGetter
 	Timeout        time.Duration
+	// LogArchive holds the logs of completed builds whose pods were deleted,
+	// if build logs are archived
+	LogArchive *logarchive.Archive
 }
 
 type podGetter struct {
@@ -47,13 +51,16 @@ const defaultTimeout time.Duration = 10 * time.Second
 // NewREST creates a new REST for BuildLog
 // Takes build registry and pod client to get necessary attributes to assemble
 // URL to which the request shall be redirected in order to get build logs.
-func NewREST(getter rest.Getter, watcher rest.Watcher, pn unversioned.PodsNamespacer, connectionInfo kubeletclient.ConnectionInfoGetter) *REST {
+// The logs of builds whose pods were deleted are read from archive, which may
+// be nil.
+func NewREST(getter rest.Getter, watcher rest.Watcher, pn unversioned.PodsNamespacer, connectionInfo kubeletclient.ConnectionInfoGetter, archive *logarchive.Archive) *REST {
 	return &REST{
 		Getter:         getter,
 		Watcher:        watcher,
 		PodGetter:      &podGetter{pn},
 		ConnectionInfo: connectionInfo,
 		Timeout:        defaultTimeout,
+		LogArchive:     archive,
 	}
 }
 
@@ -115,12 +122,42 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	case api.BuildPhaseError:
 		return nil, errors.NewBadRequest(fmt.Sprintf("build %s is in an error state. %s", build.Name, buildutil.NoBuildLogsMessage))
 	}
+	// Pipeline builds don't have a build pod, stream the status of their stages
+	if build.Spec.Strategy.PipelineStrategy != nil {
+		return &pipelineLogStreamer{
+			getter:  r.Getter,
+			watcher: r.Watcher,
+			ctx:     ctx,
+			build:   build,
+			follow:  buildLogOpts.Follow,
+		}, nil
+	}
 	// The container should be the default build container, so setting it to blank
 	buildPodName := buildutil.GetBuildPodName(build)
 	logOpts := api.BuildToPodLogOptions(buildLogOpts)
 	location, transport, err := pod.LogLocation(r.PodGetter, r.ConnectionInfo, ctx, buildPodName, logOpts)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			// The build pod may have been deleted after the log was archived
+			if r.LogArchive != nil {
+				archived, err := r.LogArchive.Exists(build.Namespace, build.Name)
+				if err != nil {
+					return nil, errors.NewInternalError(err)
+				}
+				if archived {
+					// The archived log has no timestamps to filter it by.
+					if buildLogOpts.SinceSeconds != nil || buildLogOpts.SinceTime != nil || buildLogOpts.Timestamps {
+						return nil, errors.NewBadRequest(fmt.Sprintf("the log of build %s is archived, sinceSeconds, sinceTime and timestamps are not supported", build.Name))
+					}
+					return &archivedLogStreamer{
+						archive:    r.LogArchive,
+						namespace:  build.Namespace,
+						name:       build.Name,
+						tailLines:  buildLogOpts.TailLines,
+						limitBytes: buildLogOpts.LimitBytes,
+					}, nil
+				}
+			}
 			return nil, errors.NewNotFound(kapi.Resource("pod"), buildPodName)
 		}
 		return nil, errors.NewBadRequest(err.Error())
@@ -2,21 +2,26 @@ package buildlog
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/rest"
 	kubeletclient "k8s.io/kubernetes/pkg/kubelet/client"
 	genericrest "k8s.io/kubernetes/pkg/registry/generic/rest"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
 	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry/test"
 )
 
@@ -315,3 +320,227 @@ func TestPreviousBuildLogs(t *testing.T) {
 		t.Fatalf("expected location:\n\t%s\ngot location:\n\t%s\n", exp, got)
 	}
 }
+
+func TestPipelineBuildLogs(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	build := mockBuild(api.BuildPhaseFailed, "pipeline-1", 1)
+	build.Spec.Strategy.PipelineStrategy = &api.PipelineBuildStrategy{}
+	build.Status.Message = "Stage test failed."
+	build.Status.Stages = []api.PipelineStageStatus{
+		{Name: "build", Phase: api.BuildPhaseComplete, Build: "app-1"},
+		{Name: "test", Phase: api.BuildPhaseFailed, Pod: "pipeline-1-test-build", Message: "exited with code 1"},
+		{Name: "promote", Phase: api.BuildPhaseNew},
+	}
+	storage := &REST{
+		Getter:  &test.BuildStorage{Build: build},
+		Timeout: defaultTimeout,
+	}
+	obj, err := storage.Get(ctx, build.Name, &api.BuildLogOptions{Follow: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	streamer, ok := obj.(rest.ResourceStreamer)
+	if !ok {
+		t.Fatalf("expected a ResourceStreamer, got %#v", obj)
+	}
+	stream, flush, contentType, err := streamer.InputStream("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer stream.Close()
+	if !flush || contentType != "text/plain" {
+		t.Errorf("unexpected flush %t and content type %q", flush, contentType)
+	}
+	out, err := ioutil.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `--> build: Complete (build/app-1)
+--> test: Failed (pod/pipeline-1-test-build, exited with code 1)
+--> promote: New
+Pipeline failed: Stage test failed.
+`
+	if string(out) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out)
+	}
+}
+
+func TestPipelineBuildLogsFollow(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	build := mockBuild(api.BuildPhaseRunning, "pipeline-1", 1)
+	build.Spec.Strategy.PipelineStrategy = &api.PipelineBuildStrategy{}
+	build.Status.Stages = []api.PipelineStageStatus{{Name: "build", Phase: api.BuildPhaseRunning, Build: "app-1"}}
+	ch := make(chan watch.Event, 1)
+	watcher := &buildWatcher{Build: build, Watcher: &fakeWatch{Channel: ch}}
+	storage := &REST{Getter: watcher, Watcher: watcher, Timeout: defaultTimeout}
+
+	obj, err := storage.Get(ctx, build.Name, &api.BuildLogOptions{Follow: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stream, _, _, err := obj.(rest.ResourceStreamer).InputStream("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer stream.Close()
+
+	complete := mockBuild(api.BuildPhaseComplete, "pipeline-1", 1)
+	complete.Status.Stages = []api.PipelineStageStatus{{Name: "build", Phase: api.BuildPhaseComplete, Build: "app-1"}}
+	ch <- watch.Event{Type: watch.Modified, Object: complete}
+	out, err := ioutil.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `--> build: Running (build/app-1)
+--> build: Complete (build/app-1)
+Pipeline complete
+`
+	if string(out) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out)
+	}
+}
+
+type stopWatch struct {
+	channel chan watch.Event
+	stopped chan struct{}
+}
+
+func (w *stopWatch) Stop() {
+	close(w.stopped)
+}
+
+func (w *stopWatch) ResultChan() <-chan watch.Event {
+	return w.channel
+}
+
+func TestPipelineBuildLogsFollowStopsOnClose(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	build := mockBuild(api.BuildPhaseRunning, "pipeline-1", 1)
+	build.Spec.Strategy.PipelineStrategy = &api.PipelineBuildStrategy{}
+	w := &stopWatch{channel: make(chan watch.Event), stopped: make(chan struct{})}
+	watcher := &buildWatcher{Build: build, Watcher: w}
+	storage := &REST{Getter: watcher, Watcher: watcher, Timeout: defaultTimeout}
+
+	obj, err := storage.Get(ctx, build.Name, &api.BuildLogOptions{Follow: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stream, _, _, err := obj.(rest.ResourceStreamer).InputStream("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stream.Close()
+
+	select {
+	case <-w.stopped:
+	case <-time.After(wait.ForeverTestTimeout):
+		t.Errorf("expected following to stop once the stream is closed")
+	}
+}
+
+type deletedPodGetter struct{}
+
+func (p *deletedPodGetter) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return nil, errors.NewNotFound(kapi.Resource("pod"), name)
+}
+
+func TestArchivedBuildLogs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	archive := logarchive.New(dir)
+
+	ctx := kapi.NewDefaultContext()
+	build := mockBuild(api.BuildPhaseComplete, "bc-1", 1)
+	build.Namespace = kapi.NamespaceDefault
+	storage := &REST{
+		Getter:     &test.BuildStorage{Build: build},
+		PodGetter:  &deletedPodGetter{},
+		Timeout:    defaultTimeout,
+		LogArchive: archive,
+	}
+	getter := rest.GetterWithOptions(storage)
+
+	if _, err := getter.Get(ctx, "bc-1", &api.BuildLogOptions{}); !errors.IsNotFound(err) {
+		t.Fatalf("Expected a not found error without an archived log, got %v", err)
+	}
+
+	if err := archive.Save(kapi.NamespaceDefault, "bc-1", strings.NewReader("archived log\n")); err != nil {
+		t.Fatal(err)
+	}
+	obj, err := getter.Get(ctx, "bc-1", &api.BuildLogOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	streamer, ok := obj.(rest.ResourceStreamer)
+	if !ok {
+		t.Fatalf("unexpected object: %#v", obj)
+	}
+	reader, _, contentType, err := streamer.InputStream("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer reader.Close()
+	out, _ := ioutil.ReadAll(reader)
+	if string(out) != "archived log\n" || contentType != "text/plain" {
+		t.Errorf("unexpected archived log %q of type %s", string(out), contentType)
+	}
+}
+
+func TestArchivedBuildLogsOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	archive := logarchive.New(dir)
+	if err := archive.Save(kapi.NamespaceDefault, "bc-1", strings.NewReader("first\nsecond\nthird\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := kapi.NewDefaultContext()
+	build := mockBuild(api.BuildPhaseComplete, "bc-1", 1)
+	build.Namespace = kapi.NamespaceDefault
+	getter := rest.GetterWithOptions(&REST{
+		Getter:     &test.BuildStorage{Build: build},
+		PodGetter:  &deletedPodGetter{},
+		Timeout:    defaultTimeout,
+		LogArchive: archive,
+	})
+
+	two, five, hundred := int64(2), int64(5), int64(100)
+	tests := map[string]struct {
+		opts     api.BuildLogOptions
+		expected string
+	}{
+		"tail":           {opts: api.BuildLogOptions{TailLines: &two}, expected: "second\nthird\n"},
+		"tail all":       {opts: api.BuildLogOptions{TailLines: &hundred}, expected: "first\nsecond\nthird\n"},
+		"limit":          {opts: api.BuildLogOptions{LimitBytes: &five}, expected: "first"},
+		"tail and limit": {opts: api.BuildLogOptions{TailLines: &two, LimitBytes: &five}, expected: "secon"},
+	}
+	for name, test := range tests {
+		obj, err := getter.Get(ctx, "bc-1", &test.opts)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		reader, _, _, err := obj.(rest.ResourceStreamer).InputStream("", "")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		out, _ := ioutil.ReadAll(reader)
+		reader.Close()
+		if string(out) != test.expected {
+			t.Errorf("%s: expected %q, got %q", name, test.expected, string(out))
+		}
+	}
+
+	for _, opts := range []api.BuildLogOptions{{SinceSeconds: &five}, {Timestamps: true}} {
+		if _, err := getter.Get(ctx, "bc-1", &opts); !errors.IsBadRequest(err) {
+			t.Errorf("Expected a bad request error for %#v, got %v", opts, err)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/util/stringreplace"
+)
+
+// buildParameterExp matches the ${NAME} references to build parameters, like
+// the references to template parameters.
+var buildParameterExp = regexp.MustCompile(`\$\{([a-zA-Z0-9\_]+)\}`)
+
+// SubstituteBuildParameters replaces the ${NAME} references to the parameters
+// of values in the source, strategy, output and post commit hook of spec.
+// References to other parameters are left as they are.
+func SubstituteBuildParameters(spec *buildapi.BuildSpec, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+	substitute := func(in string) string {
+		return buildParameterExp.ReplaceAllStringFunc(in, func(ref string) string {
+			if value, ok := values[buildParameterExp.FindStringSubmatch(ref)[1]]; ok {
+				return value
+			}
+			return ref
+		})
+	}
+	for _, obj := range []interface{}{&spec.Source, &spec.Strategy, &spec.Output, &spec.PostCommit} {
+		stringreplace.VisitObjectStrings(obj, substitute)
+	}
+}
+
+// ReferencesBuildParameter returns true if s references the build parameter name.
+func ReferencesBuildParameter(s, name string) bool {
+	return strings.Contains(s, "${"+name+"}")
+}
+
+// ReferencesBuildParameters returns true if the name or namespace of ref
+// references a parameter of bc.
+func ReferencesBuildParameters(bc *buildapi.BuildConfig, ref *kapi.ObjectReference) bool {
+	if ref == nil {
+		return false
+	}
+	for _, param := range bc.Spec.Parameters {
+		if ReferencesBuildParameter(ref.Name, param.Name) || ReferencesBuildParameter(ref.Namespace, param.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBuildParameterValue returns an error if value is not a value of
+// build parameters of type paramType.
+func ValidateBuildParameterValue(paramType buildapi.BuildParameterType, value string) error {
+	switch paramType {
+	case buildapi.BuildParameterTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+	case buildapi.BuildParameterTypeBoolean:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+	}
+	return nil
+}
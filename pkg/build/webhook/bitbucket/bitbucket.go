@@ -0,0 +1,147 @@
+package bitbucket
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+// signaturePrefix prefixes the hex encoded payload signature in the
+// X-Hub-Signature header.
+const signaturePrefix = "sha256="
+
+// WebHook used for processing bitbucket webhook requests.
+type WebHook struct{}
+
+// New returns bitbucket webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type author struct {
+	Raw string `json:"raw,omitempty"`
+}
+
+type commit struct {
+	Hash    string `json:"hash,omitempty"`
+	Message string `json:"message,omitempty"`
+	Author  author `json:"author,omitempty"`
+}
+
+type branch struct {
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Target commit `json:"target,omitempty"`
+}
+
+type change struct {
+	New *branch `json:"new,omitempty"`
+}
+
+type pushEvent struct {
+	Push struct {
+		Changes []change `json:"changes,omitempty"`
+	} `json:"push,omitempty"`
+}
+
+// Extract services webhooks from Bitbucket. When the webhook is configured
+// with a secret, the payload signature it sends has to be made with the secret
+// of the trigger.
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.BitbucketWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.BitbucketWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	switch event := req.Header.Get("X-Event-Key"); event {
+	case "repo:push":
+	case "diagnostics:ping":
+		return
+	default:
+		err = fmt.Errorf("Unknown X-Event-Key %s", event)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	if signature := req.Header.Get("X-Hub-Signature"); len(signature) > 0 {
+		if !strings.HasPrefix(signature, signaturePrefix) || !webhook.ValidPayloadSignature(sha256.New, trigger.BitbucketWebHook.Secret, body, strings.TrimPrefix(signature, signaturePrefix)) {
+			err = webhook.ErrSignatureMismatch
+			return
+		}
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	var ref string
+	if git := buildCfg.Spec.Source.Git; git != nil {
+		ref = git.Ref
+	}
+	// A push may update several branches, build the one of the BuildConfig.
+	for _, change := range event.Push.Changes {
+		if change.New == nil || change.New.Type != "branch" || !webhook.GitRefMatches(change.New.Name, ref) {
+			continue
+		}
+		head := change.New.Target
+		revision = &api.SourceRevision{
+			Git: &api.GitSourceRevision{
+				Commit:  head.Hash,
+				Author:  parseAuthor(head.Author.Raw),
+				Message: head.Message,
+			},
+		}
+		proceed = true
+		return
+	}
+	glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No branch of the push matches the configuration", buildCfg.Namespace, buildCfg.Name)
+	return
+}
+
+// parseAuthor splits the "Name <email>" form of a commit author.
+func parseAuthor(raw string) api.SourceControlUser {
+	address, err := mail.ParseAddress(raw)
+	if err != nil {
+		return api.SourceControlUser{Name: raw}
+	}
+	return api.SourceControlUser{Name: address.Name, Email: address.Address}
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("unsupported HTTP method %s", method)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("non-parseable Content-Type %s (%s)", contentType, err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Event-Key")) == 0 {
+		return errors.New("missing X-Event-Key")
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package bitbucket
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.BitbucketWebHookBuildTriggerType,
+					BitbucketWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://bitbucket.org/my/repo.git",
+					},
+				},
+				Strategy: mockBuildStrategy,
+			},
+		},
+	}
+}
+
+var mockBuildStrategy = api.BuildStrategy{
+	SourceStrategy: &api.SourceBuildStrategy{
+		From: kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: "repository/image",
+		},
+	},
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/bitbucket", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestMissingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/bitbucket", nil)
+	req.Header.Add("Content-Type", "application/json")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "missing X-Event-Key") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongBitbucketEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	postFile("repo:fork", "pushevent.json", "", server.URL+"/build100/secret101/bitbucket", http.StatusBadRequest, t)
+}
+
+func TestJsonPushEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	postFile("repo:push", "pushevent.json", "", server.URL+"/build100/secret101/bitbucket", http.StatusOK, t)
+}
+
+func TestJsonPushEventWithSignature(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	postFile("repo:push", "pushevent.json", "secret101", server.URL+"/build100/secret101/bitbucket", http.StatusOK, t)
+}
+
+func TestJsonPushEventWithWrongSignature(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	postFile("repo:push", "pushevent.json", "othersecret", server.URL+"/build100/secret101/bitbucket", http.StatusBadRequest, t)
+}
+
+// postFile posts the fixture filename as a Bitbucket event, signed with
+// signingSecret unless it is empty.
+func postFile(event, filename, signingSecret, url string, expStatusCode int, t *testing.T) {
+	data, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		t.Errorf("Error creating POST request: %v!", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", event)
+	if len(signingSecret) > 0 {
+		mac := hmac.New(sha256.New, []byte(signingSecret))
+		mac.Write(data)
+		req.Header.Add("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Errorf("Failed posting webhook to: %s!", url)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != expStatusCode {
+		t.Errorf("Wrong response code, expecting %d, got %s: %s!",
+			expStatusCode, resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename string) (*api.BuildConfig, *http.Request) {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", "repo:push")
+	return mockBuildConfig(), req
+}
+
+func TestJsonPingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	postFile("diagnostics:ping", "pushevent.json", "", server.URL+"/build100/secret101/bitbucket", http.StatusOK, t)
+}
+
+func TestExtractProvidesValidBuildForAPushEvent(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "9bdc3a26ff933b32f3e558636b58aea86a69f051" {
+		t.Errorf("Expecting the revision to contain the commit id of the master branch, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Author.Name != "Anonymous User" || revision.Git.Author.Email != "anonUser@example.com" {
+		t.Errorf("Expecting the revision to contain the author of the head commit, got %#v", revision.Git.Author)
+	}
+	if revision.Git.Message != "Added license\n" {
+		t.Errorf("Expecting the revision to contain the message of the head commit, got %q", revision.Git.Message)
+	}
+}
+
+func TestExtractProvidesValidBuildForAPushEventOtherThanMaster(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+	buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git.Commit != "4eb26e8c1e2b1a1fb41d87a4c5d6a9e8e4b1c2d3" {
+		t.Errorf("Expecting the revision to contain the commit id of my_other_branch, got %#v", revision)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+	buildCfg.Spec.Source.Git.Ref = "obsolete"
+
+	_, proceed, _ := New().Extract(buildCfg, "secret101", "", req)
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", buildCfg.Spec.Source.Git.Ref)
+	}
+}
@@ -23,6 +23,35 @@ type Plugin interface {
 	Extract(buildCfg *buildapi.BuildConfig, secret, path string, req *http.Request) (*buildapi.SourceRevision, bool, error)
 }
 
+// RequestExtractor is implemented by plugins that need to customize more of
+// the build request than the source revision, e.g. to build a pull request.
+type RequestExtractor interface {
+	// ExtractRequest behaves like Plugin.Extract, but returns the complete
+	// build request to instantiate. The request name is set by the caller.
+	ExtractRequest(buildCfg *buildapi.BuildConfig, secret, path string, req *http.Request) (*buildapi.BuildRequest, bool, error)
+}
+
+// ExtractRequest extracts the build request for a webhook call from plugin,
+// using the plugin's RequestExtractor implementation if there is one.
+func ExtractRequest(plugin Plugin, buildCfg *buildapi.BuildConfig, secret, path string, req *http.Request) (*buildapi.BuildRequest, bool, error) {
+	if extractor, ok := plugin.(RequestExtractor); ok {
+		request, proceed, err := extractor.ExtractRequest(buildCfg, secret, path, req)
+		if err != nil || !proceed {
+			return nil, proceed, err
+		}
+		request.Name = buildCfg.Name
+		return request, true, nil
+	}
+	revision, proceed, err := plugin.Extract(buildCfg, secret, path, req)
+	if err != nil || !proceed {
+		return nil, proceed, err
+	}
+	return &buildapi.BuildRequest{
+		ObjectMeta: kapi.ObjectMeta{Name: buildCfg.Name},
+		Revision:   revision,
+	}, true, nil
+}
+
 // controller used for processing webhook requests.
 type controller struct {
 	buildConfigInstantiator buildclient.BuildConfigInstantiator
@@ -71,7 +100,12 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		notFound(w, "Plugin ", uv.plugin, " not found")
 		return
 	}
-	revision, proceed, err := plugin.Extract(buildCfg, uv.secret, uv.path, req)
+	request, proceed, err := ExtractRequest(plugin, buildCfg, uv.secret, uv.path, req)
+	if err == ErrNoPathsChanged {
+		glog.V(2).Infof("Ignoring webhook for BuildConfig %s/%s: %v", uv.namespace, uv.buildConfigName, err)
+		fmt.Fprintln(w, err.Error())
+		return
+	}
 	if err != nil {
 		glog.V(2).Infof("Failed to extract information from webhook: %v", err)
 		badRequest(w, err.Error())
@@ -80,10 +114,6 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	if !proceed {
 		return
 	}
-	request := &buildapi.BuildRequest{
-		ObjectMeta: kapi.ObjectMeta{Name: buildCfg.Name},
-		Revision:   revision,
-	}
 	if _, err := c.buildConfigInstantiator.Instantiate(uv.namespace, request); err != nil {
 		glog.V(2).Infof("Failed to generate new Build from BuildConfig %s/%s: %v", buildCfg.Namespace, buildCfg.Name, err)
 		badRequest(w, err.Error())
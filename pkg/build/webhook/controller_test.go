@@ -79,6 +79,12 @@ func (*errPlugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 	return nil, true, errors.New("Plugin error!")
 }
 
+type ignoringPlugin struct{}
+
+func (*ignoringPlugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (*api.SourceRevision, bool, error) {
+	return nil, false, ErrNoPathsChanged
+}
+
 func TestParseUrlError(t *testing.T) {
 	server := httptest.NewServer(NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
 		nil))
@@ -306,3 +312,18 @@ func TestInvokeWebhookOK(t *testing.T) {
 		t.Fatalf("expected buildconfig names to match '%s', got '%s'", buildConfig.Name, buildRequest)
 	}
 }
+
+func TestInvokeWebhookIgnoredPush(t *testing.T) {
+	server := httptest.NewServer(NewController(&okBuildConfigGetter{}, &errorBuildConfigInstantiator{},
+		map[string]Plugin{"ignoring": &ignoringPlugin{}}))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/build100/secret101/ignoring", "application/json", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), ErrNoPathsChanged.Error()) {
+		t.Errorf("Expected OK with the reason the push was ignored, got %s: %s!", resp.Status, string(body))
+	}
+}
@@ -2,24 +2,32 @@ package github
 
 import (
 	"crypto/hmac"
+	"crypto/sha1"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"mime"
 	"net/http"
+	"strings"
 
 	"github.com/golang/glog"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/webhook"
 )
 
 // WebHook used for processing github webhook requests.
-type WebHook struct{}
+type WebHook struct {
+	secrets kclient.SecretsNamespacer
+}
 
-// New returns github webhook plugin.
-func New() *WebHook {
-	return &WebHook{}
+// New returns github webhook plugin. secrets is used to retrieve the keys
+// configured for payload signature verification and may be nil if no
+// BuildConfig requests it.
+func New(secrets kclient.SecretsNamespacer) *WebHook {
+	return &WebHook{secrets: secrets}
 }
 
 type commit struct {
@@ -27,16 +35,47 @@ type commit struct {
 	Author    api.SourceControlUser `json:"author,omitempty"`
 	Committer api.SourceControlUser `json:"committer,omitempty"`
 	Message   string                `json:"message,omitempty"`
+	webhook.ChangedFiles
 }
 
 type pushEvent struct {
-	Ref        string `json:"ref,omitempty"`
-	After      string `json:"after,omitempty"`
-	HeadCommit commit `json:"head_commit,omitempty"`
+	Ref        string   `json:"ref,omitempty"`
+	After      string   `json:"after,omitempty"`
+	HeadCommit commit   `json:"head_commit,omitempty"`
+	Commits    []commit `json:"commits,omitempty"`
+}
+
+type pullRequestEvent struct {
+	Action      string      `json:"action,omitempty"`
+	Number      int         `json:"number,omitempty"`
+	PullRequest pullRequest `json:"pull_request,omitempty"`
+}
+
+type pullRequest struct {
+	Title string `json:"title,omitempty"`
+	User  struct {
+		Login string `json:"login,omitempty"`
+	} `json:"user,omitempty"`
+	Head struct {
+		SHA string `json:"sha,omitempty"`
+	} `json:"head,omitempty"`
+	Base struct {
+		Ref string `json:"ref,omitempty"`
+	} `json:"base,omitempty"`
 }
 
 // Extract services webhooks from github.com
-func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (*api.SourceRevision, bool, error) {
+	request, proceed, err := p.ExtractRequest(buildCfg, secret, path, req)
+	if err != nil || !proceed {
+		return nil, proceed, err
+	}
+	return request.Revision, true, nil
+}
+
+// ExtractRequest services webhooks from github.com, returning a build request
+// that also describes the pull request to build for pull request events.
+func (p *WebHook) ExtractRequest(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (request *api.BuildRequest, proceed bool, err error) {
 	trigger, ok := webhook.FindTriggerPolicy(api.GitHubWebHookBuildTriggerType, buildCfg)
 	if !ok {
 		err = webhook.ErrHookNotEnabled
@@ -52,39 +91,132 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 		return
 	}
 	method := getEvent(req.Header)
-	if method != "ping" && method != "push" {
+	if method != "ping" && method != "push" && method != "pull_request" {
 		err = fmt.Errorf("Unknown X-GitHub-Event or X-Gogs-Event %s", method)
 		return
 	}
-	if method == "ping" {
-		proceed = false
-		return
-	}
 	body, err := ioutil.ReadAll(req.Body)
 	if err != nil {
 		return
 	}
+	if trigger.GitHubWebHook.SignatureSecret != nil {
+		glog.V(4).Infof("Verifying the payload signature for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+		if err = p.verifySignature(buildCfg.Namespace, trigger.GitHubWebHook.SignatureSecret.Name, body, req.Header); err != nil {
+			return
+		}
+	}
+
+	switch method {
+	case "ping":
+		return
+	case "pull_request":
+		return extractPullRequest(buildCfg, trigger.GitHubWebHook, body)
+	}
+
 	var event pushEvent
 	if err = json.Unmarshal(body, &event); err != nil {
 		return
 	}
 	proceed = webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
 	if !proceed {
-		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg, event)
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+	var files []string
+	// GitHub doesn't tell how many commits a push has, so a full list may be
+	// truncated and the changed files are treated as unknown.
+	if len(event.Commits) < webhook.MaxPushEventCommits {
+		for _, c := range event.Commits {
+			files = append(files, c.Files()...)
+		}
+	}
+	if proceed && !webhook.PathsChanged(buildCfg, trigger.GitHubWebHook, files) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Push to '%s' does not change any of the paths that trigger it", buildCfg.Namespace, buildCfg.Name, event.Ref)
+		return nil, false, webhook.ErrNoPathsChanged
 	}
 
-	revision = &api.SourceRevision{
-		Git: &api.GitSourceRevision{
-			Commit:    event.HeadCommit.ID,
-			Author:    event.HeadCommit.Author,
-			Committer: event.HeadCommit.Committer,
-			Message:   event.HeadCommit.Message,
+	request = &api.BuildRequest{
+		Revision: &api.SourceRevision{
+			Git: &api.GitSourceRevision{
+				Commit:    event.HeadCommit.ID,
+				Author:    event.HeadCommit.Author,
+				Committer: event.HeadCommit.Committer,
+				Message:   event.HeadCommit.Message,
+			},
 		},
 	}
 
 	return
 }
 
+// extractPullRequest returns a build request for the head of the pull request
+// described by body, if the trigger builds pull requests and the pull request
+// targets the branch the BuildConfig builds.
+func extractPullRequest(buildCfg *api.BuildConfig, trigger *api.WebHookTrigger, body []byte) (*api.BuildRequest, bool, error) {
+	if !trigger.AllowPullRequests {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Pull requests are not enabled for its GitHub webhook", buildCfg.Namespace, buildCfg.Name)
+		return nil, false, nil
+	}
+	var event pullRequestEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, false, err
+	}
+	switch event.Action {
+	case "opened", "reopened", "synchronize":
+	default:
+		glog.V(4).Infof("Skipping build for BuildConfig %s/%s.  Pull request #%d was %s", buildCfg.Namespace, buildCfg.Name, event.Number, event.Action)
+		return nil, false, nil
+	}
+	git := buildCfg.Spec.Source.Git
+	if git == nil {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Pull request #%d can't be built without a Git source", buildCfg.Namespace, buildCfg.Name, event.Number)
+		return nil, false, nil
+	}
+	if !webhook.GitRefMatches(event.PullRequest.Base.Ref, git.Ref) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Pull request #%d targets '%s' which does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Number, event.PullRequest.Base.Ref)
+		return nil, false, nil
+	}
+	return &api.BuildRequest{
+		Revision: &api.SourceRevision{
+			Git: &api.GitSourceRevision{
+				Commit:  event.PullRequest.Head.SHA,
+				Author:  api.SourceControlUser{Name: event.PullRequest.User.Login},
+				Message: event.PullRequest.Title,
+			},
+		},
+		PullRequest: &api.GitPullRequest{
+			Number: event.Number,
+			Ref:    fmt.Sprintf("refs/pull/%d/head", event.Number),
+		},
+	}, true, nil
+}
+
+// verifySignature checks the X-Hub-Signature header of the request against
+// the HMAC of body keyed with the webhook secret stored in the named Secret.
+func (p *WebHook) verifySignature(namespace, secretName string, body []byte, header http.Header) error {
+	signature := header.Get("X-Hub-Signature")
+	if len(signature) == 0 {
+		return errors.New("missing X-Hub-Signature")
+	}
+	if !strings.HasPrefix(signature, "sha1=") {
+		return webhook.ErrSignatureMismatch
+	}
+	if p.secrets == nil {
+		return fmt.Errorf("unable to retrieve secret %s/%s to verify the payload signature", namespace, secretName)
+	}
+	secret, err := p.secrets.Secrets(namespace).Get(secretName)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve secret %s/%s to verify the payload signature: %v", namespace, secretName, err)
+	}
+	key, ok := secret.Data[api.WebHookSecretKey]
+	if !ok {
+		return fmt.Errorf("secret %s/%s does not contain the %s key", namespace, secretName, api.WebHookSecretKey)
+	}
+	if !webhook.ValidPayloadSignature(sha1.New, string(key), body, strings.TrimPrefix(signature, "sha1=")) {
+		return webhook.ErrSignatureMismatch
+	}
+	return nil
+}
+
 func verifyRequest(req *http.Request) error {
 	if method := req.Method; method != "POST" {
 		return fmt.Errorf("unsupported HTTP method %s", method)
@@ -2,6 +2,9 @@ package github
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +12,7 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/webhook"
@@ -56,7 +60,7 @@ func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.Bui
 
 func TestWrongSecret(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -71,7 +75,7 @@ func TestWrongSecret(t *testing.T) {
 
 func TestWrongMethod(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	resp, _ := http.Get(server.URL + "/build100/secret101/github")
@@ -84,7 +88,7 @@ func TestWrongMethod(t *testing.T) {
 
 func TestWrongContentType(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -101,7 +105,7 @@ func TestWrongContentType(t *testing.T) {
 
 func TestMissingEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -117,7 +121,7 @@ func TestMissingEvent(t *testing.T) {
 
 func TestWrongGitHubEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -134,7 +138,7 @@ func TestWrongGitHubEvent(t *testing.T) {
 
 func TestJsonPingEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFile("X-GitHub-Event", "ping", "pingevent.json", server.URL+"/build100/secret101/github",
@@ -143,7 +147,7 @@ func TestJsonPingEvent(t *testing.T) {
 
 func TestJsonPushEventError(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	post("X-GitHub-Event", "push", []byte{}, server.URL+"/build100/secret101/github", http.StatusBadRequest, t)
@@ -151,7 +155,7 @@ func TestJsonPushEventError(t *testing.T) {
 
 func TestJsonGitHubPushEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFile("X-GitHub-Event", "push", "pushevent.json", server.URL+"/build100/secret101/github",
@@ -160,7 +164,7 @@ func TestJsonGitHubPushEvent(t *testing.T) {
 
 func TestJsonGitHubPushEventWithCharset(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFileWithCharset("X-GitHub-Event", "push", "pushevent.json", server.URL+"/build100/secret101/github",
@@ -169,7 +173,7 @@ func TestJsonGitHubPushEventWithCharset(t *testing.T) {
 
 func TestJsonGogsPushEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFile("X-Gogs-Event", "push", "pushevent.json", server.URL+"/build100/secret101/github",
@@ -332,3 +336,152 @@ func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
 		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", context.buildCfg.Spec.Source.Git.Ref)
 	}
 }
+
+func TestExtractSkipsPullRequestsWhenNotAllowed(t *testing.T) {
+	context := setup(t, "pullrequestevent.json", "pull_request")
+
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because pull requests are not enabled")
+	}
+}
+
+func TestExtractProvidesPullRequestForAPullRequestEvent(t *testing.T) {
+	context := setup(t, "pullrequestevent.json", "pull_request")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.AllowPullRequests = true
+
+	request, proceed, err := context.plugin.ExtractRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Fatalf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if request.Revision == nil || request.Revision.Git.Commit != "0d1a26e67d8f5eaf1f6ba5c57fc3c7d91ac0fd1c" {
+		t.Errorf("Expecting the revision to contain the head commit of the pull request, got %#v", request.Revision)
+	}
+	if request.PullRequest == nil || request.PullRequest.Number != 42 || request.PullRequest.Ref != "refs/pull/42/head" {
+		t.Errorf("Expecting the request to describe pull request 42, got %#v", request.PullRequest)
+	}
+}
+
+func TestExtractSkipsPullRequestsForOtherBranches(t *testing.T) {
+	context := setup(t, "pullrequestevent.json", "pull_request")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.AllowPullRequests = true
+	context.buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the pull request does not target '%s'", context.buildCfg.Spec.Source.Git.Ref)
+	}
+}
+
+func TestExtractSkipsPullRequestsWithoutGitSource(t *testing.T) {
+	context := setup(t, "pullrequestevent.json", "pull_request")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.AllowPullRequests = true
+	context.buildCfg.Spec.Source.Git = nil
+
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the BuildConfig has no Git source")
+	}
+}
+
+func TestExtractVerifiesPayloadSignature(t *testing.T) {
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{Name: "webhook", Namespace: "ns"},
+		Data:       map[string][]byte{api.WebHookSecretKey: []byte("signingkey")},
+	}
+	tests := map[string]struct {
+		signingKey string
+		expectErr  error
+	}{
+		"valid signature": {
+			signingKey: "signingkey",
+		},
+		"invalid signature": {
+			signingKey: "otherkey",
+			expectErr:  webhook.ErrSignatureMismatch,
+		},
+	}
+	for name, test := range tests {
+		context := setup(t, "pushevent.json", "push")
+		context.plugin.secrets = ktestclient.NewSimpleFake(secret)
+		context.buildCfg.Namespace = "ns"
+		context.buildCfg.Spec.Triggers[0].GitHubWebHook.SignatureSecret = &kapi.LocalObjectReference{Name: "webhook"}
+		data, _ := ioutil.ReadFile("fixtures/pushevent.json")
+		mac := hmac.New(sha1.New, []byte(test.signingKey))
+		mac.Write(data)
+		context.req.Header.Add("X-Hub-Signature", "sha1="+hex.EncodeToString(mac.Sum(nil)))
+
+		_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+		if err != test.expectErr {
+			t.Errorf("%s: expected error %v, got %v", name, test.expectErr, err)
+		}
+		if proceed != (test.expectErr == nil) {
+			t.Errorf("%s: unexpected proceed value %t", name, proceed)
+		}
+	}
+}
+
+func TestExtractRejectsUnsignedPayload(t *testing.T) {
+	context := setup(t, "pushevent.json", "push")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.SignatureSecret = &kapi.LocalObjectReference{Name: "webhook"}
+
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+	if err == nil || !strings.Contains(err.Error(), "missing X-Hub-Signature") {
+		t.Errorf("Expected a missing signature error, got %v", err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from an unsigned event")
+	}
+}
+
+func TestExtractSkipsBuildForPushOutsideOfContextDir(t *testing.T) {
+	context := setup(t, "pushevent.json", "push")
+	context.buildCfg.Spec.Source.ContextDir = "app"
+
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+	if err != webhook.ErrNoPathsChanged {
+		t.Errorf("Expected %v, got %v", webhook.ErrNoPathsChanged, err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because it does not change the context dir")
+	}
+}
+
+func TestExtractProvidesValidBuildForPushWithTruncatedCommits(t *testing.T) {
+	context := setup(t, "pushevent-many-commits.json", "push")
+	context.buildCfg.Spec.Source.ContextDir = "app"
+
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("Expecting to continue from this event because its commit list may be truncated")
+	}
+}
+
+func TestExtractProvidesValidBuildForPushToIncludedPath(t *testing.T) {
+	context := setup(t, "pushevent.json", "push")
+	context.buildCfg.Spec.Source.ContextDir = "app"
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.IncludePaths = []string{"LICENSE"}
+
+	_, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+}
@@ -0,0 +1,128 @@
+package gitlab
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+// WebHook used for processing gitlab webhook requests.
+type WebHook struct{}
+
+// New returns gitlab webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type commit struct {
+	ID      string                `json:"id,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Author  api.SourceControlUser `json:"author,omitempty"`
+	webhook.ChangedFiles
+}
+
+type pushEvent struct {
+	Ref               string   `json:"ref,omitempty"`
+	After             string   `json:"after,omitempty"`
+	CheckoutSHA       string   `json:"checkout_sha,omitempty"`
+	Commits           []commit `json:"commits,omitempty"`
+	TotalCommitsCount int      `json:"total_commits_count,omitempty"`
+}
+
+// Extract services webhooks from GitLab. When the webhook is configured with
+// a secret token, it has to match the secret of the trigger.
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.GitLabWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.GitLabWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	if token := req.Header.Get("X-Gitlab-Token"); len(token) > 0 && !hmac.Equal([]byte(trigger.GitLabWebHook.Secret), []byte(token)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if event := req.Header.Get("X-Gitlab-Event"); event != "Push Hook" {
+		err = fmt.Errorf("Unknown X-Gitlab-Event %s", event)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	if len(event.CheckoutSHA) == 0 {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch %s was deleted", buildCfg.Namespace, buildCfg.Name, event.Ref)
+		return
+	}
+	var ref string
+	if git := buildCfg.Spec.Source.Git; git != nil {
+		ref = git.Ref
+	}
+	proceed = webhook.GitRefMatches(event.Ref, ref)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+	var files []string
+	// The changed files of a push with more commits than listed are unknown.
+	if event.TotalCommitsCount <= len(event.Commits) {
+		for _, c := range event.Commits {
+			files = append(files, c.Files()...)
+		}
+	}
+	if proceed && !webhook.PathsChanged(buildCfg, trigger.GitLabWebHook, files) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Push to '%s' does not change any of the paths that trigger it", buildCfg.Namespace, buildCfg.Name, event.Ref)
+		return nil, false, webhook.ErrNoPathsChanged
+	}
+
+	revision = &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit: event.CheckoutSHA,
+		},
+	}
+	for _, commit := range event.Commits {
+		if commit.ID == event.CheckoutSHA {
+			revision.Git.Author = commit.Author
+			revision.Git.Message = commit.Message
+		}
+	}
+
+	return
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("unsupported HTTP method %s", method)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("non-parseable Content-Type %s (%s)", contentType, err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Gitlab-Event")) == 0 {
+		return errors.New("missing X-Gitlab-Event")
+	}
+	return nil
+}
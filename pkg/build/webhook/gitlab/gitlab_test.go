@@ -0,0 +1,287 @@
+package gitlab
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.GitLabWebHookBuildTriggerType,
+					GitLabWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://gitlab.example.com/my/repo.git",
+					},
+				},
+				Strategy: mockBuildStrategy,
+			},
+		},
+	}
+}
+
+var mockBuildStrategy = api.BuildStrategy{
+	SourceStrategy: &api.SourceBuildStrategy{
+		From: kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: "repository/image",
+		},
+	},
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/gitlab", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongToken(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/gitlab", nil)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gitlab-Event", "Push Hook")
+	req.Header.Add("X-Gitlab-Token", "wrongtoken")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongMethod(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	resp, _ := http.Get(server.URL + "/build100/secret101/gitlab")
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "method") {
+		t.Errorf("Expected BadRequest , got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestMissingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/gitlab", nil)
+	req.Header.Add("Content-Type", "application/json")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "missing X-Gitlab-Event") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongGitLabEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	postFile("Issue Hook", "pushevent.json", "", server.URL+"/build100/secret101/gitlab", http.StatusBadRequest, t)
+}
+
+func TestJsonPushEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	postFile("Push Hook", "pushevent.json", "", server.URL+"/build100/secret101/gitlab", http.StatusOK, t)
+}
+
+func TestJsonPushEventWithToken(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	postFile("Push Hook", "pushevent.json", "secret101", server.URL+"/build100/secret101/gitlab", http.StatusOK, t)
+}
+
+func postFile(event, filename, token, url string, expStatusCode int, t *testing.T) {
+	data, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		t.Errorf("Error creating POST request: %v!", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gitlab-Event", event)
+	if len(token) > 0 {
+		req.Header.Add("X-Gitlab-Token", token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Errorf("Failed posting webhook to: %s!", url)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != expStatusCode {
+		t.Errorf("Wrong response code, expecting %d, got %s: %s!",
+			expStatusCode, resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename string) (*api.BuildConfig, *http.Request) {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gitlab-Event", "Push Hook")
+	return mockBuildConfig(), req
+}
+
+func TestExtractProvidesValidBuildForAPushEvent(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "da1560886d4f094c3e6c9ef40349f7d38b5d27d7" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Author.Name != "Anonymous User" || revision.Git.Author.Email != "anonUser@example.com" {
+		t.Errorf("Expecting the revision to contain the author of the head commit, got %#v", revision.Git.Author)
+	}
+	if revision.Git.Message != "Added license" {
+		t.Errorf("Expecting the revision to contain the message of the head commit, got %q", revision.Git.Message)
+	}
+}
+
+func TestExtractProvidesValidBuildForAPushEventOtherThanMaster(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent-not-master-branch.json")
+	buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git.Commit != "da1560886d4f094c3e6c9ef40349f7d38b5d27d7" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %#v", revision)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+	buildCfg.Spec.Source.Git.Ref = "adfj32qrafdavckeaewra"
+
+	_, proceed, _ := New().Extract(buildCfg, "secret101", "", req)
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", buildCfg.Spec.Source.Git.Ref)
+	}
+}
+
+func TestExtractSkipsBuildForDeletedBranches(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent-branch-deleted.json")
+
+	_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch was deleted")
+	}
+}
+
+func TestExtractSkipsBuildForExcludedPaths(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+	buildCfg.Spec.Triggers[0].GitLabWebHook.ExcludePaths = []string{"LICENSE", "*.md"}
+
+	_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != webhook.ErrNoPathsChanged {
+		t.Errorf("Expected %v, got %v", webhook.ErrNoPathsChanged, err)
+	}
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because it only changes excluded paths")
+	}
+}
+
+func TestExtractProvidesValidBuildForPushWithTruncatedCommits(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent-truncated-commits.json")
+	buildCfg.Spec.Triggers[0].GitLabWebHook.ExcludePaths = []string{"LICENSE", "*.md"}
+
+	_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("Expecting to continue from this event because its commit list is truncated")
+	}
+}
+
+func TestIgnoredPushResponse(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&contextDirBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	postFile("Push Hook", "pushevent.json", "", server.URL+"/build100/secret101/gitlab", http.StatusOK, t)
+}
+
+type contextDirBuildConfigGetter struct{}
+
+func (c *contextDirBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	buildCfg := mockBuildConfig()
+	buildCfg.Spec.Source.ContextDir = "app"
+	return buildCfg, nil
+}
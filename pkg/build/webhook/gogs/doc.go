@@ -0,0 +1,3 @@
+// Package gogs contains webhook.Plugin implementation of gogs webhooks
+// according to https://gogs.io/docs/features/webhook
+package gogs
@@ -0,0 +1,114 @@
+package gogs
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+// WebHook used for processing gogs webhook requests.
+type WebHook struct{}
+
+// New returns gogs webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type commit struct {
+	ID        string                `json:"id,omitempty"`
+	Author    api.SourceControlUser `json:"author,omitempty"`
+	Committer api.SourceControlUser `json:"committer,omitempty"`
+	Message   string                `json:"message,omitempty"`
+}
+
+type pushEvent struct {
+	Ref     string   `json:"ref,omitempty"`
+	After   string   `json:"after,omitempty"`
+	Commits []commit `json:"commits,omitempty"`
+}
+
+// Extract services webhooks from Gogs. When the webhook is configured with a
+// secret, the payload signature it sends has to be made with the secret of
+// the trigger.
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.GogsWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.GogsWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if event := req.Header.Get("X-Gogs-Event"); event != "push" {
+		err = fmt.Errorf("Unknown X-Gogs-Event %s", event)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	if signature := req.Header.Get("X-Gogs-Signature"); len(signature) > 0 && !webhook.ValidPayloadSignature(sha256.New, trigger.GogsWebHook.Secret, body, signature) {
+		err = webhook.ErrSignatureMismatch
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	var ref string
+	if git := buildCfg.Spec.Source.Git; git != nil {
+		ref = git.Ref
+	}
+	proceed = webhook.GitRefMatches(event.Ref, ref)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+
+	revision = &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit: event.After,
+		},
+	}
+	for _, commit := range event.Commits {
+		if commit.ID == event.After {
+			revision.Git.Author = commit.Author
+			revision.Git.Committer = commit.Committer
+			revision.Git.Message = commit.Message
+		}
+	}
+
+	return
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("unsupported HTTP method %s", method)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("non-parseable Content-Type %s (%s)", contentType, err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Gogs-Event")) == 0 {
+		return errors.New("missing X-Gogs-Event")
+	}
+	return nil
+}
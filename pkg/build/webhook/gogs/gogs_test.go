@@ -0,0 +1,217 @@
+package gogs
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.GogsWebHookBuildTriggerType,
+					GogsWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://gogs.example.com/my/repo.git",
+					},
+				},
+				Strategy: mockBuildStrategy,
+			},
+		},
+	}
+}
+
+var mockBuildStrategy = api.BuildStrategy{
+	SourceStrategy: &api.SourceBuildStrategy{
+		From: kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: "repository/image",
+		},
+	},
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gogs": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/gogs", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestMissingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gogs": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/gogs", nil)
+	req.Header.Add("Content-Type", "application/json")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "missing X-Gogs-Event") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongGogsEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gogs": New()}))
+	defer server.Close()
+
+	postFile("create", "pushevent.json", "", server.URL+"/build100/secret101/gogs", http.StatusBadRequest, t)
+}
+
+func TestJsonPushEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gogs": New()}))
+	defer server.Close()
+
+	postFile("push", "pushevent.json", "", server.URL+"/build100/secret101/gogs", http.StatusOK, t)
+}
+
+func TestJsonPushEventWithSignature(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gogs": New()}))
+	defer server.Close()
+
+	postFile("push", "pushevent.json", "secret101", server.URL+"/build100/secret101/gogs", http.StatusOK, t)
+}
+
+func TestJsonPushEventWithWrongSignature(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gogs": New()}))
+	defer server.Close()
+
+	postFile("push", "pushevent.json", "othersecret", server.URL+"/build100/secret101/gogs", http.StatusBadRequest, t)
+}
+
+// postFile posts the fixture filename as a Gogs event, signed with
+// signingSecret unless it is empty.
+func postFile(event, filename, signingSecret, url string, expStatusCode int, t *testing.T) {
+	data, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		t.Errorf("Error creating POST request: %v!", err)
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gogs-Event", event)
+	if len(signingSecret) > 0 {
+		mac := hmac.New(sha256.New, []byte(signingSecret))
+		mac.Write(data)
+		req.Header.Add("X-Gogs-Signature", hex.EncodeToString(mac.Sum(nil)))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Errorf("Failed posting webhook to: %s!", url)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != expStatusCode {
+		t.Errorf("Wrong response code, expecting %d, got %s: %s!",
+			expStatusCode, resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename string) (*api.BuildConfig, *http.Request) {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gogs-Event", "push")
+	return mockBuildConfig(), req
+}
+
+func TestExtractProvidesValidBuildForAPushEvent(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "bffeb74224043ba2feb48d137756c8a9331c449a" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Author.Name != "Anonymous User" || revision.Git.Committer.Name != "Another User" {
+		t.Errorf("Expecting the revision to contain the author and committer of the head commit, got %#v", revision.Git)
+	}
+	if revision.Git.Message != "Added license\n" {
+		t.Errorf("Expecting the revision to contain the message of the head commit, got %q", revision.Git.Message)
+	}
+}
+
+func TestExtractProvidesValidBuildForAPushEventOtherThanMaster(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent-not-master-branch.json")
+	buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Errorf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git.Commit != "bffeb74224043ba2feb48d137756c8a9331c449a" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %#v", revision)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json")
+	buildCfg.Spec.Source.Git.Ref = "adfj32qrafdavckeaewra"
+
+	_, proceed, _ := New().Extract(buildCfg, "secret101", "", req)
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", buildCfg.Spec.Source.Git.Ref)
+	}
+}
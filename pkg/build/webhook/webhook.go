@@ -1,17 +1,37 @@
 package webhook
 
 import (
+	"crypto/hmac"
+	"encoding/hex"
 	"fmt"
+	"hash"
+	"path"
 	"strings"
 
 	"github.com/openshift/origin/pkg/build/api"
 )
 
 var (
-	ErrSecretMismatch = fmt.Errorf("the provided secret does not match")
-	ErrHookNotEnabled = fmt.Errorf("the specified hook is not enabled")
+	ErrSecretMismatch    = fmt.Errorf("the provided secret does not match")
+	ErrHookNotEnabled    = fmt.Errorf("the specified hook is not enabled")
+	ErrSignatureMismatch = fmt.Errorf("the payload signature does not match")
+	// ErrNoPathsChanged is returned by plugins for pushes that do not change any of the paths
+	// that trigger builds. It is not a failure of the webhook call.
+	ErrNoPathsChanged = fmt.Errorf("the push does not change any of the paths that trigger the build, ignoring it")
 )
 
+// ValidPayloadSignature determines if signature is the hex encoded HMAC of
+// payload computed with hash and keyed with secret
+func ValidPayloadSignature(hash func() hash.Hash, secret string, payload []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(hash, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
 // GitRefMatches determines if the ref from a webhook event matches a build configuration
 func GitRefMatches(eventRef, configRef string) bool {
 	const RefPrefix = "refs/heads/"
@@ -23,6 +43,70 @@ func GitRefMatches(eventRef, configRef string) bool {
 	return configRef == eventRef
 }
 
+// MaxPushEventCommits is the number of commits GitHub and GitLab list at most
+// in a push event. The files changed by the commits of a larger push are not
+// all known.
+const MaxPushEventCommits = 20
+
+// ChangedFiles lists the files added, modified and removed by a commit of a
+// GitHub or GitLab push event.
+type ChangedFiles struct {
+	Added    []string `json:"added,omitempty"`
+	Modified []string `json:"modified,omitempty"`
+	Removed  []string `json:"removed,omitempty"`
+}
+
+// Files returns all the files changed by the commit.
+func (c ChangedFiles) Files() []string {
+	files := append([]string{}, c.Added...)
+	files = append(files, c.Modified...)
+	return append(files, c.Removed...)
+}
+
+// PathsChanged determines if a push changing files should trigger a build of
+// buildCfg, according to the include and exclude paths of its webhook trigger.
+// The include paths default to the context directory of the build. A push
+// without a list of changed files always triggers a build.
+func PathsChanged(buildCfg *api.BuildConfig, trigger *api.WebHookTrigger, files []string) bool {
+	include := trigger.IncludePaths
+	if len(include) == 0 {
+		if contextDir := cleanPath(buildCfg.Spec.Source.ContextDir); contextDir != "." {
+			include = []string{contextDir}
+		}
+	}
+	if len(files) == 0 || (len(include) == 0 && len(trigger.ExcludePaths) == 0) {
+		return true
+	}
+	for _, file := range files {
+		if (len(include) == 0 || matchesAnyPath(file, include)) && !matchesAnyPath(file, trigger.ExcludePaths) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyPath returns true if file or one of its parent directories
+// matches one of paths.
+func matchesAnyPath(file string, paths []string) bool {
+	for _, p := range paths {
+		p = cleanPath(p)
+		if p == "." {
+			return true
+		}
+		for f := cleanPath(file); f != "."; f = path.Dir(f) {
+			if matched, _ := path.Match(p, f); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cleanPath returns p relative to the root of the repository.
+func cleanPath(p string) string {
+	return path.Clean(strings.TrimPrefix(path.Clean("/"+p), "/"))
+}
+
 // FindTriggerPolicy retrieves the BuildTrigger of a given type from a build configuration
 func FindTriggerPolicy(triggerType api.BuildTriggerType, config *api.BuildConfig) (*api.BuildTriggerPolicy, bool) {
 	for _, p := range config.Spec.Triggers {
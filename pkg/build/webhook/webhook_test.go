@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func TestPathsChanged(t *testing.T) {
+	tests := map[string]struct {
+		contextDir string
+		include    []string
+		exclude    []string
+		files      []string
+		expected   bool
+	}{
+		"no filter": {
+			files:    []string{"README.md"},
+			expected: true,
+		},
+		"no changed files listed": {
+			contextDir: "app",
+			expected:   true,
+		},
+		"change in the context dir": {
+			contextDir: "./app/",
+			files:      []string{"README.md", "app/main.go"},
+			expected:   true,
+		},
+		"change outside of the context dir": {
+			contextDir: "app",
+			files:      []string{"README.md", "application/main.go"},
+			expected:   false,
+		},
+		"context dir at the root": {
+			contextDir: "/",
+			files:      []string{"README.md"},
+			expected:   true,
+		},
+		"include paths override the context dir": {
+			contextDir: "app",
+			include:    []string{"lib"},
+			files:      []string{"lib/util/util.go"},
+			expected:   true,
+		},
+		"include pattern": {
+			include:  []string{"services/*/Dockerfile"},
+			files:    []string{"services/api/Dockerfile"},
+			expected: true,
+		},
+		"include pattern not matching": {
+			include:  []string{"services/*/Dockerfile"},
+			files:    []string{"services/api/main.go"},
+			expected: false,
+		},
+		"excluded change": {
+			contextDir: "app",
+			exclude:    []string{"app/docs", "app/*.md"},
+			files:      []string{"app/docs/index.html", "app/README.md"},
+			expected:   false,
+		},
+		"excluded and included changes": {
+			contextDir: "app",
+			exclude:    []string{"app/docs"},
+			files:      []string{"app/docs/index.html", "app/main.go"},
+			expected:   true,
+		},
+		"exclude only": {
+			exclude:  []string{"docs"},
+			files:    []string{"docs/index.html"},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		buildCfg := &api.BuildConfig{
+			Spec: api.BuildConfigSpec{
+				BuildSpec: api.BuildSpec{
+					Source: api.BuildSource{ContextDir: test.contextDir},
+				},
+			},
+		}
+		trigger := &api.WebHookTrigger{IncludePaths: test.include, ExcludePaths: test.exclude}
+		if actual := PathsChanged(buildCfg, trigger, test.files); actual != test.expected {
+			t.Errorf("%s: expected %t, got %t", name, test.expected, actual)
+		}
+	}
+}
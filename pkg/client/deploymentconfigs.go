@@ -27,6 +27,7 @@ type DeploymentConfigInterface interface {
 	Rollback(config *deployapi.DeploymentConfigRollback) (*deployapi.DeploymentConfig, error)
 	GetScale(name string) (*extensions.Scale, error)
 	UpdateScale(scale *extensions.Scale) (*extensions.Scale, error)
+	GetHistory(name string) (*deployapi.DeploymentConfigHistory, error)
 }
 
 // deploymentConfigs implements DeploymentConfigsNamespacer interface
@@ -129,3 +130,10 @@ func (c *deploymentConfigs) UpdateScale(scale *extensions.Scale) (result *extens
 	err = c.r.Put().Namespace(c.ns).Resource("deploymentConfigs").Name(scale.Name).SubResource("scale").Body(encodedBytes).Do().Into(result)
 	return
 }
+
+// GetHistory returns the history of the deployments of a deploymentConfig
+func (c *deploymentConfigs) GetHistory(name string) (result *deployapi.DeploymentConfigHistory, err error) {
+	result = &deployapi.DeploymentConfigHistory{}
+	err = c.r.Get().Namespace(c.ns).Resource("deploymentConfigs").Name(name).SubResource("history").Do().Into(result)
+	return
+}
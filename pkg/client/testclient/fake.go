@@ -121,9 +121,9 @@ func (c *Fake) Actions() []ktestclient.Action {
 
 var _ client.Interface = &Fake{}
 
-// Application provides a REST client for servicebroker
+// Applications provides a fake REST client for Applications
 func (c *Fake) Applications(namespace string) client.ApplicationInterface {
-	return nil // todo. here just to make compiling ok
+	return &FakeApplications{Fake: c, Namespace: namespace}
 }
 
 // Projects provides a fake REST client for ServiceBrokers
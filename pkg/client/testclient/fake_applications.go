@@ -0,0 +1,61 @@
+package testclient
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/watch"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+)
+
+// FakeApplications implements ApplicationInterface. Meant to be embedded into a struct to get a default
+// implementation. This makes faking out just the methods you want to test easier.
+type FakeApplications struct {
+	Fake      *Fake
+	Namespace string
+}
+
+func (c *FakeApplications) Get(name string) (*applicationapi.Application, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("applications", c.Namespace, name), &applicationapi.Application{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.Application), err
+}
+
+func (c *FakeApplications) List(opts kapi.ListOptions) (*applicationapi.ApplicationList, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewListAction("applications", c.Namespace, opts), &applicationapi.ApplicationList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.ApplicationList), err
+}
+
+func (c *FakeApplications) Create(inObj *applicationapi.Application) (*applicationapi.Application, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("applications", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.Application), err
+}
+
+func (c *FakeApplications) Update(inObj *applicationapi.Application) (*applicationapi.Application, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("applications", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*applicationapi.Application), err
+}
+
+func (c *FakeApplications) Delete(name string) error {
+	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("applications", c.Namespace, name), &applicationapi.Application{})
+	return err
+}
+
+func (c *FakeApplications) Watch(opts kapi.ListOptions) (watch.Interface, error) {
+	return c.Fake.InvokesWatch(ktestclient.NewWatchAction("applications", c.Namespace, opts))
+}
@@ -97,3 +97,12 @@ func (c *FakeDeploymentConfigs) UpdateScale(inObj *extensions.Scale) (*extension
 
 	return obj.(*extensions.Scale), err
 }
+
+func (c *FakeDeploymentConfigs) GetHistory(name string) (*deployapi.DeploymentConfigHistory, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("deploymentconfigs/history", c.Namespace, name), &deployapi.DeploymentConfigHistory{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*deployapi.DeploymentConfigHistory), err
+}
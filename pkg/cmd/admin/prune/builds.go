@@ -24,7 +24,9 @@ const (
 	buildsLongDesc = `Prune old completed and failed builds
 
 By default, the prune operation performs a dry run making no changes to internal registry. A
---confirm flag is needed for changes to be effective.`
+--confirm flag is needed for changes to be effective.
+
+If the master archives build logs, the archived logs of pruned builds are removed as well.`
 
 	buildsExample = `  # Dry run deleting older completed and failed builds and also including
   # all builds whose associated BuildConfig no longer exists
@@ -97,6 +97,7 @@ func NewCommandCLI(name, fullName string, in io.Reader, out, errout io.Writer) *
 				cmd.NewCmdExplain(fullName, f, out),
 				cmd.NewCmdApplication(fullName+" new-application ", f, out),
 				cmd.NewCmdDeleteApplication(fullName+" delete-application ", f, out),
+				cmd.NewCmdApplicationManagement(cmd.ApplicationRecommendedName, fullName+" "+cmd.ApplicationRecommendedName, f, out),
 				cmd.NewCmdServiceBroker(fullName+" new-servicebroker", f, out),
 				cmd.NewCmdNewBackingServiceInstance(fullName+" new-instance", f, out),
 			},
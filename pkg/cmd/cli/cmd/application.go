@@ -6,6 +6,7 @@ import (
 	applicationapi "github.com/openshift/origin/pkg/application/api"
 	applicationutil "github.com/openshift/origin/pkg/application/util"
 	"github.com/openshift/origin/pkg/client"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/spf13/cobra"
 	"io"
@@ -37,14 +38,43 @@ Create a new application to partition resources for a comfortable knowledge of m
   $ %[1]s  mobile_app  --items="Pod=php,Pod=mysql,ServiceBroker=redis"
 
   $ %[1]s  mobile_app  --items="po=php,no=mysql,sb=redis"
+
+  # Keep the DeploymentConfig api at 0 replicas until the instance db is bound
+  $ %[1]s  mobile_app  --items="dc=api,bsi=db" --depends="dc=api:bsi=db"
   `
 
 )
 
+// ApplicationRecommendedName is the recommended command name for managing existing applications
+const ApplicationRecommendedName = "application"
+
+const applicationLong = `
+Manage existing applications
+
+These commands operate on all the items of an application at once.`
+
+// NewCmdApplicationManagement is the parent command of the commands that manage an existing application
+func NewCmdApplicationManagement(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	cmds := &cobra.Command{
+		Use:   name,
+		Short: "Manage existing applications",
+		Long:  applicationLong,
+		Run:   cmdutil.DefaultSubCommandRun(out),
+	}
+
+	cmds.AddCommand(NewCmdStopApplication("stop", fullName+" stop", f, out))
+	cmds.AddCommand(NewCmdStartApplication("start", fullName+" start", f, out))
+	cmds.AddCommand(NewCmdApplicationHistory("history", fullName+" history", f, out))
+	cmds.AddCommand(NewCmdApplicationRollback("rollback", fullName+" rollback", f, out))
+
+	return cmds
+}
+
 type NewApplicationOptions struct {
 	Name  string
-	Items applicationapi.ItemList
-	Item  string
+	Items   applicationapi.ItemList
+	Item    string
+	Depends []string
 
 	Client client.Interface
 
@@ -80,6 +110,7 @@ func NewCmdApplication(fullName string, f *clientcmd.Factory, out io.Writer) *co
 	}
 
 	cmd.Flags().StringVar(&options.Item, "items", "", "application items")
+	cmd.Flags().StringSliceVar(&options.Depends, "depends", []string{}, "item dependencies as KIND=NAME:KIND=NAME, the first item waits until the second one is ready")
 
 	return cmd
 }
@@ -100,6 +131,9 @@ func (o *NewApplicationOptions) complete(cmd *cobra.Command, f *clientcmd.Factor
 	if err != nil {
 		return err
 	}
+	if err := applicationutil.ParseDependencies(items, o.Depends); err != nil {
+		return err
+	}
 
 	o.Items = items
 	o.Name = args[0]
@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	applicationHistoryLong = `
+List the recorded revisions of an application
+
+The application controller records a revision whenever the items of an application or the
+spec of one of its DeploymentConfig, BuildConfig or Route items change. Only the most recent
+revisions are kept.`
+
+	applicationHistoryExample = `  # List the revisions of the application mobile_app
+  $ %[1]s mobile_app
+
+  # Show the items recorded in revision 3
+  $ %[1]s mobile_app --revision=3`
+
+	applicationRollbackLong = `
+Roll an application back to a recorded revision
+
+The item list of the application and the specs of its DeploymentConfig, BuildConfig and
+Route items are restored to the snapshot of the revision in one operation. Items which have
+been deleted since are created again. Replica counts are not changed. Without --to-revision
+the application is rolled back to the revision before the latest one.`
+
+	applicationRollbackExample = `  # Roll the application mobile_app back to the previous revision
+  $ %[1]s mobile_app
+
+  # Roll the application mobile_app back to revision 3
+  $ %[1]s mobile_app --to-revision=3`
+)
+
+// ApplicationHistoryOptions contains all the options for listing the revisions of an application.
+type ApplicationHistoryOptions struct {
+	Name     string
+	Revision int64
+
+	Client client.Interface
+
+	Out io.Writer
+}
+
+// NewCmdApplicationHistory implements the application history command
+func NewCmdApplicationHistory(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ApplicationHistoryOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     name + " NAME [--revision=N]",
+		Short:   "List the recorded revisions of an application",
+		Long:    applicationHistoryLong,
+		Example: fmt.Sprintf(applicationHistoryExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, args))
+			kcmdutil.CheckErr(options.Run(f))
+		},
+	}
+
+	cmd.Flags().Int64Var(&options.Revision, "revision", 0, "If set, show the items and snapshots recorded in this revision")
+
+	return cmd
+}
+
+// Complete sets the application name and the client
+func (o *ApplicationHistoryOptions) Complete(f *clientcmd.Factory, args []string) error {
+	if len(args) != 1 {
+		return errors.New("must have exactly one argument")
+	}
+	if o.Revision < 0 {
+		return errors.New("--revision must be greater than 0")
+	}
+	o.Name = args[0]
+
+	var err error
+	o.Client, _, err = f.Clients()
+	return err
+}
+
+// Run prints the revisions of the application, or the details of one revision.
+func (o *ApplicationHistoryOptions) Run(f *clientcmd.Factory) error {
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	app, err := o.Client.Applications(namespace).Get(o.Name)
+	if err != nil {
+		return err
+	}
+
+	if o.Revision > 0 {
+		revision := applicationutil.FindRevision(app, o.Revision)
+		if revision == nil {
+			return fmt.Errorf("revision %d of application %s not found", o.Revision, app.Name)
+		}
+		return printApplicationRevision(o.Out, revision)
+	}
+
+	if len(app.Status.Revisions) == 0 {
+		fmt.Fprintf(o.Out, "No revisions recorded for application %s\n", app.Name)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(o.Out, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "REVISION\tCREATED\tITEMS\tSNAPSHOTS")
+	for i, revision := range app.Status.Revisions {
+		number := fmt.Sprintf("%d", revision.Revision)
+		if i == len(app.Status.Revisions)-1 {
+			number += " (latest)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", number, revision.CreationTimestamp.Format(time.RFC3339), len(revision.Items), len(revision.Snapshots))
+	}
+	return w.Flush()
+}
+
+func printApplicationRevision(out io.Writer, revision *applicationapi.ApplicationRevision) error {
+	fmt.Fprintf(out, "Revision:\t%d\n", revision.Revision)
+	fmt.Fprintf(out, "Created:\t%s\n", revision.CreationTimestamp.Format(time.RFC3339))
+
+	fmt.Fprintln(out, "Items:")
+	for _, item := range revision.Items {
+		fmt.Fprintf(out, "  %s\n", applicationutil.ItemKey(item))
+	}
+
+	keys := []string{}
+	for key := range revision.Snapshots {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	fmt.Fprintln(out, "Snapshots:")
+	for _, key := range keys {
+		fmt.Fprintf(out, "  %s\n", key)
+		fmt.Fprintf(out, "    %s\n", strings.TrimSpace(revision.Snapshots[key]))
+	}
+	return nil
+}
+
+// ApplicationRollbackOptions contains all the options for rolling back an application.
+type ApplicationRollbackOptions struct {
+	Name       string
+	ToRevision int64
+
+	Client client.Interface
+
+	Out io.Writer
+}
+
+// NewCmdApplicationRollback implements the application rollback command
+func NewCmdApplicationRollback(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ApplicationRollbackOptions{Out: out}
+
+	cmd := &cobra.Command{
+		Use:     name + " NAME [--to-revision=N]",
+		Short:   "Roll an application back to a recorded revision",
+		Long:    applicationRollbackLong,
+		Example: fmt.Sprintf(applicationRollbackExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, args))
+			kcmdutil.CheckErr(options.Run(f))
+		},
+	}
+
+	cmd.Flags().Int64Var(&options.ToRevision, "to-revision", 0, "The revision to roll back to, the revision before the latest one when 0")
+
+	return cmd
+}
+
+// Complete sets the application name and the client
+func (o *ApplicationRollbackOptions) Complete(f *clientcmd.Factory, args []string) error {
+	if len(args) != 1 {
+		return errors.New("must have exactly one argument")
+	}
+	if o.ToRevision < 0 {
+		return errors.New("--to-revision must not be negative")
+	}
+	o.Name = args[0]
+
+	var err error
+	o.Client, _, err = f.Clients()
+	return err
+}
+
+// Run requests the rollback, the application controller restores the items.
+func (o *ApplicationRollbackOptions) Run(f *clientcmd.Factory) error {
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	app, err := o.Client.Applications(namespace).Get(o.Name)
+	if err != nil {
+		return err
+	}
+
+	revision := applicationutil.FindRevision(app, o.ToRevision)
+	if revision == nil {
+		if o.ToRevision == 0 {
+			return fmt.Errorf("application %s has no previous revision", app.Name)
+		}
+		return fmt.Errorf("revision %d of application %s not found", o.ToRevision, app.Name)
+	}
+	if latest := app.Status.Revisions[len(app.Status.Revisions)-1]; latest.Revision == revision.Revision {
+		fmt.Fprintf(o.Out, "application %s is already at revision %d\n", app.Name, revision.Revision)
+		return nil
+	}
+
+	app.Spec.RollbackTo = &applicationapi.ApplicationRollback{Revision: revision.Revision}
+	if _, err := o.Client.Applications(namespace).Update(app); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "application %s is being rolled back to revision %d\n", app.Name, revision.Revision)
+	return nil
+}
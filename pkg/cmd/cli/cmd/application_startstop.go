@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	stopApplicationLong = `
+Stop an application
+
+All DeploymentConfig and ReplicationController items of the application are scaled to zero
+by the application controller, their replica counts are recorded in the application status
+and restored when the application is started again.`
+
+	stopApplicationExample = `  # Scale all deployments of the application mobile_app to zero
+  $ %[1]s mobile_app`
+
+	startApplicationLong = `
+Start a stopped application
+
+All DeploymentConfig and ReplicationController items of the application are scaled back to
+the replica counts recorded when the application was stopped.`
+
+	startApplicationExample = `  # Restore the deployments of the application mobile_app
+  $ %[1]s mobile_app`
+)
+
+// ApplicationStateOptions contains all the options for stopping or starting an application.
+type ApplicationStateOptions struct {
+	Name string
+	Stop bool
+
+	Client client.Interface
+
+	Out io.Writer
+}
+
+// NewCmdStopApplication implements the application stop command
+func NewCmdStopApplication(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	return newCmdApplicationState(name, fullName, "Scale the deployments of an application to zero", stopApplicationLong, stopApplicationExample, true, f, out)
+}
+
+// NewCmdStartApplication implements the application start command
+func NewCmdStartApplication(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	return newCmdApplicationState(name, fullName, "Restore the deployments of a stopped application", startApplicationLong, startApplicationExample, false, f, out)
+}
+
+func newCmdApplicationState(name, fullName, short, long, example string, stop bool, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	options := &ApplicationStateOptions{Stop: stop, Out: out}
+
+	cmd := &cobra.Command{
+		Use:     name + " NAME",
+		Short:   short,
+		Long:    long,
+		Example: fmt.Sprintf(example, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, args))
+			kcmdutil.CheckErr(options.Run(f))
+		},
+	}
+
+	return cmd
+}
+
+// Complete sets the application name and the client
+func (o *ApplicationStateOptions) Complete(f *clientcmd.Factory, args []string) error {
+	if len(args) != 1 {
+		return errors.New("must have exactly one argument")
+	}
+	o.Name = args[0]
+
+	var err error
+	o.Client, _, err = f.Clients()
+	return err
+}
+
+// Run marks the application stopped or started, the application controller scales its items.
+func (o *ApplicationStateOptions) Run(f *clientcmd.Factory) error {
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	app, err := o.Client.Applications(namespace).Get(o.Name)
+	if err != nil {
+		return err
+	}
+
+	state := "started"
+	if o.Stop {
+		state = "stopped"
+	}
+	if app.Spec.Stopped == o.Stop {
+		fmt.Fprintf(o.Out, "application %s is already %s\n", app.Name, state)
+		return nil
+	}
+
+	app.Spec.Stopped = o.Stop
+	if _, err := o.Client.Applications(namespace).Update(app); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.Out, "application %s %s\n", app.Name, state)
+	return nil
+}
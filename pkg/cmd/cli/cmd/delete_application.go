@@ -3,27 +3,43 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	applicationutil "github.com/openshift/origin/pkg/application/util"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/spf13/cobra"
 	"io"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"text/tabwriter"
 )
 
 const (
 	deleteApplicationLong = `
 application is a group of resource
 delete application is used to delete resources in the same application
+
+Items which also belong to other applications are never deleted, only the label
+of this application is removed from them. Cluster-scoped items (Node, ServiceBroker,
+PersistentVolume) are only deleted when --force is given.
 `
 	deleteApplicationExample = `# delete a new application with [name deletelabel]
   $ %[1]s  mobile_app  --onlylabel=true
+
+  # preview which items of the application would be deleted or unlabelled
+  $ %[1]s  mobile_app  --dry-run
+
+  # delete the application including its cluster-scoped items
+  $ %[1]s  mobile_app  --force
  `
 )
 
 type DeleteApplicationOptions struct {
 	Name      string
 	OnlyLabel bool
+	DryRun    bool
+	Force     bool
 
 	Client  client.Interface
 	KClient kclient.Interface
@@ -36,7 +52,7 @@ func NewCmdDeleteApplication(fullName string, f *clientcmd.Factory, out io.Write
 	options.Out = out
 
 	cmd := &cobra.Command{
-		Use:     `delete-application NAME [--onlylabel=true]`,
+		Use:     `delete-application NAME [--onlylabel=true] [--dry-run] [--force]`,
 		Short:   "delete a existed application",
 		Long:    deleteApplicationLong,
 		Example: fmt.Sprintf(deleteApplicationExample, fullName),
@@ -53,13 +69,15 @@ func NewCmdDeleteApplication(fullName string, f *clientcmd.Factory, out io.Write
 			}
 
 			if err := options.Run(f); err != nil {
-				fmt.Println("run err %s", err.Error())
+				fmt.Printf("run err %s\n", err.Error())
 			}
 
 		},
 	}
 
 	cmd.Flags().BoolVarP(&options.OnlyLabel, "onlylabel", "l", false, "only delete label")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "If true, only print which items would be deleted and which would only be unlabelled")
+	cmd.Flags().BoolVar(&options.Force, "force", false, "If true, also delete cluster-scoped items (Node, ServiceBroker, PersistentVolume)")
 
 	return cmd
 }
@@ -71,6 +89,10 @@ func (o *DeleteApplicationOptions) complete(cmd *cobra.Command, f *clientcmd.Fac
 		return errors.New("must have exactly one argument")
 	}
 
+	if o.OnlyLabel && o.Force {
+		return errors.New("--force can not be used with --onlylabel")
+	}
+
 	o.Name = args[0]
 
 	return nil
@@ -87,20 +109,128 @@ func (o *DeleteApplicationOptions) Run(f *clientcmd.Factory) error {
 		return err
 	}
 
+	if o.DryRun {
+		app.Spec.Destory = !o.OnlyLabel
+		app.Spec.ForceDestory = o.Force
+		return o.preview(app)
+	}
+
 	if o.OnlyLabel {
 		if err = o.Client.Applications(namespace).Delete(app.Name); err != nil {
 			return err
 		}
 
-		fmt.Printf("application %s deleted", app.Name)
+		fmt.Fprintf(o.Out, "application %s deleted\n", app.Name)
 		return nil
 	}
 
 	app.Spec.Destory = true
+	app.Spec.ForceDestory = o.Force
 	if _, err := o.Client.Applications(namespace).Update(app); err != nil {
 		return err
 	}
 
-	fmt.Printf("application %s deleted", app.Name)
+	fmt.Fprintf(o.Out, "application %s deleted\n", app.Name)
 	return nil
 }
+
+// preview prints what deleting app would do to each of its items without changing anything.
+func (o *DeleteApplicationOptions) preview(app *applicationapi.Application) error {
+	w := tabwriter.NewWriter(o.Out, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tACTION\tREASON")
+	for _, item := range app.Spec.Items {
+		labels, err := applicationItemLabels(o.Client, o.KClient, app.Namespace, item)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Kind, item.Name, "none", "resource not found")
+				continue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Kind, item.Name, "none", err.Error())
+			continue
+		}
+
+		action, reason := applicationutil.DestoryAction(app, item.Kind, labels)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Kind, item.Name, action, reason)
+	}
+	return w.Flush()
+}
+
+// applicationItemLabels returns the labels of the resource the application item refers to.
+func applicationItemLabels(oc client.Interface, kc kclient.Interface, namespace string, item applicationapi.Item) (map[string]string, error) {
+	switch item.Kind {
+	case "ServiceBroker":
+		resource, err := oc.ServiceBrokers().Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "BackingServiceInstance":
+		resource, err := oc.BackingServiceInstances(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "Build":
+		resource, err := oc.Builds(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "BuildConfig":
+		resource, err := oc.BuildConfigs(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "DeploymentConfig":
+		resource, err := oc.DeploymentConfigs(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "ImageStream":
+		resource, err := oc.ImageStreams(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "ReplicationController":
+		resource, err := kc.ReplicationControllers(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "Node":
+		resource, err := kc.Nodes().Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "Pod":
+		resource, err := kc.Pods(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "Service":
+		resource, err := kc.Services(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "PersistentVolume":
+		resource, err := kc.PersistentVolumes().Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	case "Route":
+		resource, err := oc.Routes(namespace).Get(item.Name)
+		if err != nil {
+			return nil, err
+		}
+		return resource.Labels, nil
+	}
+
+	return nil, fmt.Errorf("unsupported kind %s", item.Kind)
+}
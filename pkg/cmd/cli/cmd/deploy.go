@@ -7,7 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-
+	"text/tabwriter"
 	"time"
 
 	"github.com/docker/docker/pkg/units"
@@ -16,13 +16,18 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/wait"
 
+	latest "github.com/openshift/origin/pkg/api/latest"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/cli/describe"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/deploy/strategy/bluegreen"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
@@ -37,9 +42,19 @@ type DeployOptions struct {
 
 	deploymentConfigName string
 	deployLatest         bool
+	force                bool
 	retryDeploy          bool
 	cancelDeploy         bool
 	enableTriggers       bool
+	rollbackLast         bool
+	pauseConfig          bool
+	resumeConfig         bool
+	showHistory          bool
+	output               string
+
+	// waitForReady waits for the pods of a deployment which has been scaled up
+	// to become ready.
+	waitForReady func(deployment *kapi.ReplicationController, timeout time.Duration) error
 }
 
 const (
@@ -59,13 +74,29 @@ There are several deployment strategies defined:
   of code running at the same time (many web applications, scalable databases)
 * Recreate - scales the old deployment down to zero, then scales the new deployment up to full.
   Use when your application cannot tolerate two versions of code running at the same time
+* BlueGreen - scales the new deployment up to full next to the old one and switches a service
+  over to it once all of its pods are ready. The old deployment is scaled down after a hold period
+  during which the switch can be reverted instantly with the '--rollback-last' flag.
 * Custom - run your own deployment process inside a Docker container using your own scripts.
 
+A deployment config may restrict new deployments to recurring deployment windows. Outside of the
+windows, new versions started by its triggers or with the '--latest' flag are deferred until the next
+window opens. Add the '--force' flag to '--latest' to start the deployment immediately.
+
 If a deployment fails, you may opt to retry it (if the error was transient). Some deployments may
 never successfully complete - in which case you can use the '--latest' flag to force a redeployment.
 When rolling back to a previous deployment, a new deployment will be created with an identical copy
 of your config at the latest position.
 
+A deployment config can be paused with the '--pause' flag while several changes are made to it.
+Its triggers do not start new deployments until it is resumed with the '--resume' flag, after which
+all of the changes are rolled out in a single deployment. The '--resume' flag also continues a
+rolling deployment which was paused after its batch hook failed.
+
+The '--history' flag lists the deployments of a deployment config with their causes, start times,
+durations, strategies, replica counts, hook results and failure reasons. Use '-o json' to get the
+history in a structured form.
+
 If no options are given, shows information about the latest deployment.`
 
 	deployExample = `  # Display the latest deployment for the 'database' deployment config
@@ -74,12 +105,27 @@ If no options are given, shows information about the latest deployment.`
   # Start a new deployment based on the 'database'
   $ %[1]s deploy database --latest
 
+  # Start a new deployment based on the 'database' outside of its deployment windows
+  $ %[1]s deploy database --latest --force
+
   # Retry the latest failed deployment based on 'frontend'
   # The deployer pod and any hook pods are deleted for the latest failed deployment
   $ %[1]s deploy frontend --retry
 
   # Cancel the in-progress deployment based on 'frontend'
-  $ %[1]s deploy frontend --cancel`
+  $ %[1]s deploy frontend --cancel
+
+  # Switch the service of the blue-green deployment config 'frontend' back to the previous deployment
+  $ %[1]s deploy frontend --rollback-last
+
+  # Pause the 'frontend' deployment config, change its environment twice, and roll both changes out at once
+  $ %[1]s deploy frontend --pause
+  $ %[1]s set env dc/frontend LOG_LEVEL=debug
+  $ %[1]s set env dc/frontend WORKERS=4
+  $ %[1]s deploy frontend --resume
+
+  # Show the history of the 'frontend' deployment config as JSON
+  $ %[1]s deploy frontend --history -o json`
 )
 
 // NewCmdDeploy creates a new `deploy` command.
@@ -89,7 +135,7 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	}
 
 	cmd := &cobra.Command{
-		Use:        "deploy DEPLOYMENTCONFIG [--latest|--retry|--cancel|--enable-triggers]",
+		Use:        "deploy DEPLOYMENTCONFIG [--latest [--force]|--retry|--cancel|--enable-triggers|--rollback-last|--pause|--resume|--history]",
 		Short:      "View, start, cancel, or retry a deployment",
 		Long:       deployLong,
 		Example:    fmt.Sprintf(deployExample, fullName),
@@ -110,9 +156,15 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	}
 
 	cmd.Flags().BoolVar(&options.deployLatest, "latest", false, "Start a new deployment now.")
+	cmd.Flags().BoolVar(&options.force, "force", false, "Start the new deployment of --latest even if none of the deployment windows is open.")
 	cmd.Flags().BoolVar(&options.retryDeploy, "retry", false, "Retry the latest failed deployment.")
 	cmd.Flags().BoolVar(&options.cancelDeploy, "cancel", false, "Cancel the in-progress deployment.")
 	cmd.Flags().BoolVar(&options.enableTriggers, "enable-triggers", false, "Enables all image triggers for the deployment config.")
+	cmd.Flags().BoolVar(&options.rollbackLast, "rollback-last", false, "Switch the service of a blue-green deployment config back to the previous deployment.")
+	cmd.Flags().BoolVar(&options.pauseConfig, "pause", false, "Pause the deployment config so that its triggers do not start new deployments.")
+	cmd.Flags().BoolVar(&options.resumeConfig, "resume", false, "Resume a paused deployment config.")
+	cmd.Flags().BoolVar(&options.showHistory, "history", false, "List the deployments of the deployment config.")
+	cmd.Flags().StringVarP(&options.output, "output", "o", "", "Output format of --history. One of: json|yaml.")
 
 	return cmd
 }
@@ -136,6 +188,7 @@ func (o *DeployOptions) Complete(f *clientcmd.Factory, args []string, out io.Wri
 	o.builder = resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder())
 
 	o.out = out
+	o.waitForReady = o.waitForReadyPods
 
 	if len(args) > 0 {
 		o.deploymentConfigName = args[0]
@@ -161,8 +214,26 @@ func (o DeployOptions) Validate() error {
 	if o.enableTriggers {
 		numOptions++
 	}
+	if o.rollbackLast {
+		numOptions++
+	}
+	if o.pauseConfig {
+		numOptions++
+	}
+	if o.resumeConfig {
+		numOptions++
+	}
+	if o.showHistory {
+		numOptions++
+	}
 	if numOptions > 1 {
-		return errors.New("only one of --latest, --retry, --cancel, or --enable-triggers is allowed.")
+		return errors.New("only one of --latest, --retry, --cancel, --enable-triggers, --rollback-last, --pause, --resume, or --history is allowed.")
+	}
+	if o.force && !o.deployLatest {
+		return errors.New("--force is only supported with --latest.")
+	}
+	if len(o.output) > 0 && !o.showHistory {
+		return errors.New("--output is only supported with --history.")
 	}
 	return nil
 }
@@ -191,6 +262,14 @@ func (o DeployOptions) RunDeploy() error {
 		err = o.cancel(config, o.out)
 	case o.enableTriggers:
 		err = o.reenableTriggers(config, o.out)
+	case o.rollbackLast:
+		err = o.rollbackLastSwitch(config, o.out)
+	case o.pauseConfig:
+		err = o.pause(config, o.out)
+	case o.resumeConfig:
+		err = o.resume(config, o.out)
+	case o.showHistory:
+		err = o.history(config, o.out)
 	default:
 		describer := describe.NewLatestDeploymentsDescriber(o.osClient, o.kubeClient, -1)
 		desc, err := describer.Describe(config.Namespace, config.Name)
@@ -206,6 +285,9 @@ func (o DeployOptions) RunDeploy() error {
 // deploy launches a new deployment unless there's already a deployment
 // process in progress for config.
 func (o DeployOptions) deploy(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.Spec.Paused {
+		return fmt.Errorf("cannot deploy a paused deployment config.\nYou can resume it using the --resume option.")
+	}
 	deploymentName := deployutil.LatestDeploymentNameForConfig(config)
 	deployment, err := o.kubeClient.ReplicationControllers(config.Namespace).Get(deploymentName)
 	if err == nil {
@@ -221,11 +303,26 @@ func (o DeployOptions) deploy(config *deployapi.DeploymentConfig, out io.Writer)
 	}
 
 	config.Status.LatestVersion++
+	if o.force && len(config.Spec.Windows) > 0 {
+		if config.Annotations == nil {
+			config.Annotations = make(map[string]string)
+		}
+		config.Annotations[deployapi.DeploymentWindowOverrideAnnotation] = strconv.Itoa(config.Status.LatestVersion)
+	}
 	_, err = o.osClient.DeploymentConfigs(config.Namespace).Update(config)
-	if err == nil {
+	if err != nil {
+		return err
+	}
+	deferred, next := deployutil.DeploymentDeferred(config, time.Now())
+	switch {
+	case !deferred:
 		fmt.Fprintf(out, "Started deployment #%d\n", config.Status.LatestVersion)
+	case next.IsZero():
+		fmt.Fprintf(out, "Deployment #%d is deferred but none of the deployment windows ever opens.\nUse the --force option to deploy it now.\n", config.Status.LatestVersion)
+	default:
+		fmt.Fprintf(out, "Deployment #%d is deferred until the next deployment window at %s.\nUse the --force option to deploy it now.\n", config.Status.LatestVersion, next.Format(time.RFC3339))
 	}
-	return err
+	return nil
 }
 
 // retry resets the status of the latest deployment to New, which will cause
@@ -348,3 +445,242 @@ func (o DeployOptions) reenableTriggers(config *deployapi.DeploymentConfig, out
 	fmt.Fprintf(out, "Enabled image triggers: %s\n", strings.Join(enabled, ","))
 	return nil
 }
+
+// pause marks config as paused so that its triggers stop starting new
+// deployments, and then persists config.
+func (o DeployOptions) pause(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.Spec.Paused {
+		fmt.Fprintf(out, "%s is already paused\n", config.Name)
+		return nil
+	}
+	config.Spec.Paused = true
+	if _, err := o.osClient.DeploymentConfigs(config.Namespace).Update(config); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Paused %s\n", config.Name)
+	return nil
+}
+
+// resume clears the paused mark of config and then persists config. The
+// triggers of config roll out the changes made while it was paused. If config
+// isn't paused but its latest deployment is held after a failed batch hook,
+// the deployment is resumed instead.
+func (o DeployOptions) resume(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if !config.Spec.Paused {
+		deploymentName := deployutil.LatestDeploymentNameForConfig(config)
+		deployment, err := o.kubeClient.ReplicationControllers(config.Namespace).Get(deploymentName)
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+		if err == nil && !deployutil.IsTerminatedDeployment(deployment) {
+			if _, paused := deployment.Annotations[deployapi.DeploymentPausedAnnotation]; paused {
+				delete(deployment.Annotations, deployapi.DeploymentPausedAnnotation)
+				if _, err := o.kubeClient.ReplicationControllers(deployment.Namespace).Update(deployment); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "Resumed deployment #%d of %s\n", config.Status.LatestVersion, config.Name)
+				return nil
+			}
+		}
+		fmt.Fprintf(out, "%s is not paused\n", config.Name)
+		return nil
+	}
+	config.Spec.Paused = false
+	if _, err := o.osClient.DeploymentConfigs(config.Namespace).Update(config); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Resumed %s\n", config.Name)
+	return nil
+}
+
+// rollbackLastSwitch switches the service of a blue-green deployment config
+// back to the last complete deployment before the latest one. The latest
+// deployment fails and is scaled down: while it is still holding the previous
+// deployment its deployer does this, otherwise the latest deployment is marked
+// failed here so that the previous one becomes active again. A previous
+// deployment which has been scaled down is scaled up and the service is only
+// switched once its pods are ready.
+func (o DeployOptions) rollbackLastSwitch(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.Spec.Strategy.Type != deployapi.DeploymentStrategyTypeBlueGreen || config.Spec.Strategy.BlueGreenParams == nil {
+		return fmt.Errorf("%s/%s does not use the %s strategy; use '%s rollback' instead", config.Namespace, config.Name, deployapi.DeploymentStrategyTypeBlueGreen, o.baseCommandName)
+	}
+	if config.Status.LatestVersion == 0 {
+		return fmt.Errorf("no deployments found for %s/%s", config.Namespace, config.Name)
+	}
+
+	deployments, err := o.kubeClient.ReplicationControllers(config.Namespace).List(kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(config.Name)})
+	if err != nil {
+		return err
+	}
+	sort.Sort(deployutil.ByLatestVersionDesc(deployments.Items))
+
+	var latest, previous *kapi.ReplicationController
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		switch {
+		case latest == nil:
+			if deployutil.DeploymentVersionFor(deployment) != config.Status.LatestVersion {
+				return fmt.Errorf("unable to find the latest deployment (#%d)", config.Status.LatestVersion)
+			}
+			latest = deployment
+		case previous == nil && deployutil.DeploymentStatusFor(deployment) == deployapi.DeploymentStatusComplete:
+			previous = deployment
+		}
+	}
+	if latest == nil {
+		return fmt.Errorf("unable to find the latest deployment (#%d)", config.Status.LatestVersion)
+	}
+	if previous == nil {
+		return fmt.Errorf("there is no complete deployment before #%d to roll back to", config.Status.LatestVersion)
+	}
+
+	serviceName := config.Spec.Strategy.BlueGreenParams.ServiceName
+	services := o.kubeClient.Services(config.Namespace)
+	service, err := services.Get(serviceName)
+	if err != nil {
+		return err
+	}
+	if switched := bluegreen.SwitchedDeployment(service); switched != latest.Name {
+		return fmt.Errorf("service %s is not switched to the latest deployment #%d", serviceName, config.Status.LatestVersion)
+	}
+
+	status := deployutil.DeploymentStatusFor(latest)
+	if status != deployapi.DeploymentStatusRunning && status != deployapi.DeploymentStatusComplete {
+		return fmt.Errorf("#%d is %s; only running or complete deployments can be rolled back", config.Status.LatestVersion, strings.ToLower(string(status)))
+	}
+
+	// The previous deployment has already been scaled down once the hold
+	// period is over, bring it back before switching the service.
+	if previous.Spec.Replicas == 0 {
+		previous.Spec.Replicas = config.Spec.Replicas
+		scaled, err := o.kubeClient.ReplicationControllers(previous.Namespace).Update(previous)
+		if err != nil {
+			return err
+		}
+		timeout := deployapi.DefaultRollingTimeoutSeconds
+		if config.Spec.Strategy.BlueGreenParams.TimeoutSeconds != nil {
+			timeout = *config.Spec.Strategy.BlueGreenParams.TimeoutSeconds
+		}
+		fmt.Fprintf(out, "Waiting for the pods of deployment #%d to become ready\n", deployutil.DeploymentVersionFor(previous))
+		if err := o.waitForReady(scaled, time.Duration(timeout)*time.Second); err != nil {
+			// Leave the latest deployment serving and scale the previous one
+			// down again.
+			scaled.Spec.Replicas = 0
+			if _, scaleErr := o.kubeClient.ReplicationControllers(scaled.Namespace).Update(scaled); scaleErr != nil {
+				fmt.Fprintf(out, "Couldn't scale deployment #%d down: %v\n", deployutil.DeploymentVersionFor(previous), scaleErr)
+			}
+			return fmt.Errorf("service %s was not switched back to deployment #%d: %v", serviceName, deployutil.DeploymentVersionFor(previous), err)
+		}
+	}
+	getService := func(namespace, name string) (*kapi.Service, error) {
+		return services.Get(name)
+	}
+	updateService := func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+		return services.Update(service)
+	}
+	if err := bluegreen.SwitchService(getService, updateService, config.Namespace, serviceName, previous.Name); err != nil {
+		return err
+	}
+
+	if status == deployapi.DeploymentStatusComplete {
+		// Delete the deployer pod so that it doesn't report the deployment
+		// complete again.
+		pods, err := o.kubeClient.Pods(config.Namespace).List(kapi.ListOptions{LabelSelector: deployutil.DeployerPodSelector(latest.Name)})
+		if err != nil {
+			return fmt.Errorf("failed to list deployer/hook pods for deployment #%d: %v", config.Status.LatestVersion, err)
+		}
+		for _, pod := range pods.Items {
+			if err := o.kubeClient.Pods(pod.Namespace).Delete(pod.Name, kapi.NewDeleteOptions(0)); err != nil && !kerrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete deployer/hook pod %s for deployment #%d: %v", pod.Name, config.Status.LatestVersion, err)
+			}
+		}
+		latest.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusFailed)
+		latest.Annotations[deployapi.DeploymentStatusReasonAnnotation] = deployapi.DeploymentRolledBackByUser
+		// The deployment controller only scales failed test deployments down.
+		latest.Spec.Replicas = 0
+		if _, err := o.kubeClient.ReplicationControllers(latest.Namespace).Update(latest); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "Switched service %s back to deployment #%d\n", serviceName, deployutil.DeploymentVersionFor(previous))
+	return nil
+}
+
+// waitForReadyPods waits for deployment to have created its replicas and for
+// all its pods to become ready.
+func (o DeployOptions) waitForReadyPods(deployment *kapi.ReplicationController, timeout time.Duration) error {
+	if err := wait.Poll(bluegreen.AcceptorInterval, timeout, kclient.ControllerHasDesiredReplicas(o.kubeClient, deployment)); err != nil {
+		if err == wait.ErrWaitTimeout {
+			return fmt.Errorf("deployment %s took longer than %.f seconds to create its pods", deployment.Name, timeout.Seconds())
+		}
+		return err
+	}
+	return stratsupport.NewAcceptNewlyObservedReadyPods(o.kubeClient, timeout, bluegreen.AcceptorInterval).Accept(deployment)
+}
+
+// history prints the deployments of config, either as a table or in the
+// output format.
+func (o DeployOptions) history(config *deployapi.DeploymentConfig, out io.Writer) error {
+	history, err := o.osClient.DeploymentConfigs(config.Namespace).GetHistory(config.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(o.output) > 0 {
+		printer, _, err := kubectl.GetPrinter(o.output, "")
+		if err != nil {
+			return err
+		}
+		return kubectl.NewVersionedPrinter(printer, kapi.Scheme, latest.Version).PrintObj(history, out)
+	}
+
+	if len(history.Deployments) == 0 {
+		fmt.Fprintf(out, "There have been no deployments for %s/%s\n", config.Namespace, config.Name)
+		return nil
+	}
+	w := tabwriter.NewWriter(out, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSTATUS\tSTRATEGY\tCAUSE\tSTARTED\tDURATION\tREPLICAS\tHOOKS\tREASON")
+	for _, record := range history.Deployments {
+		started, duration := "<none>", "<none>"
+		if record.StartTime != nil {
+			started = record.StartTime.Format(time.RFC3339)
+		}
+		if record.DurationSeconds != nil {
+			duration = (time.Duration(*record.DurationSeconds) * time.Second).String()
+		}
+		replicas := strconv.Itoa(record.Replicas)
+		if record.PreviousReplicas != nil {
+			replicas = fmt.Sprintf("%d->%d", *record.PreviousReplicas, record.Replicas)
+		}
+		hooks := []string{}
+		for _, hook := range record.Hooks {
+			hooks = append(hooks, fmt.Sprintf("%s:%s", hook.Name, hook.Phase))
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", record.Version, record.Phase, record.Strategy, deploymentCauses(record.Details),
+			started, duration, replicas, orNone(strings.Join(hooks, ",")), orNone(record.Reason))
+	}
+	return w.Flush()
+}
+
+// deploymentCauses returns a short description of the causes in details.
+// Deployments started by the user have no causes.
+func deploymentCauses(details *deployapi.DeploymentDetails) string {
+	causes := []string{}
+	if details != nil {
+		for _, cause := range details.Causes {
+			causes = append(causes, string(cause.Type))
+		}
+	}
+	if len(causes) == 0 {
+		return "Manual"
+	}
+	return strings.Join(causes, ",")
+}
+
+func orNone(s string) string {
+	if len(s) == 0 {
+		return "<none>"
+	}
+	return s
+}
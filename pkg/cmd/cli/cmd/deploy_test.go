@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
@@ -72,6 +75,55 @@ func TestCmdDeploy_latestOk(t *testing.T) {
 	}
 }
 
+// TestCmdDeploy_latestWindows ensures that a new deployment outside of the
+// deployment windows is deferred unless it is forced.
+func TestCmdDeploy_latestWindows(t *testing.T) {
+	for _, force := range []bool{false, true} {
+		config := deploytest.OkDeploymentConfig(1)
+		// A window which never opens.
+		config.Spec.Windows = []deployapi.DeploymentWindow{{Schedule: "0 0 30 2 *", DurationMinutes: 60}}
+		var updatedConfig *deployapi.DeploymentConfig
+
+		osClient := &tc.Fake{}
+		osClient.AddReactor("update", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			updatedConfig = action.(ktc.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+			return true, updatedConfig, nil
+		})
+		kubeClient := &ktc.Fake{}
+		kubeClient.AddReactor("get", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			return true, deploymentFor(config, deployapi.DeploymentStatusComplete), nil
+		})
+
+		out := &bytes.Buffer{}
+		o := &DeployOptions{osClient: osClient, kubeClient: kubeClient, force: force}
+		if err := o.deploy(config, out); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if updatedConfig == nil {
+			t.Fatalf("expected updated config")
+		}
+		if e, a := 2, updatedConfig.Status.LatestVersion; e != a {
+			t.Errorf("expected updated config version %d, got %d", e, a)
+		}
+		override, forced := updatedConfig.Annotations[deployapi.DeploymentWindowOverrideAnnotation]
+		if force {
+			if override != "2" {
+				t.Errorf("expected version 2 to be forced, got %q", override)
+			}
+			if !strings.Contains(out.String(), "Started deployment #2") {
+				t.Errorf("unexpected output: %s", out.String())
+			}
+		} else {
+			if forced {
+				t.Errorf("unexpected window override %q", override)
+			}
+			if !strings.Contains(out.String(), "Deployment #2 is deferred") {
+				t.Errorf("unexpected output: %s", out.String())
+			}
+		}
+	}
+}
+
 // TestCmdDeploy_latestConcurrentRejection ensures that attempts to start a
 // deployment concurrent with a running deployment are rejected.
 func TestCmdDeploy_latestConcurrentRejection(t *testing.T) {
@@ -323,3 +375,304 @@ func TestDeploy_reenableTriggers(t *testing.T) {
 		}
 	}
 }
+
+// TestCmdDeploy_pauseAndResume ensures that pausing and resuming a config
+// persists the paused flag, and that a paused config can't be deployed.
+func TestCmdDeploy_pauseAndResume(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	osClient := &tc.Fake{}
+	osClient.AddReactor("update", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		updated = action.(ktc.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+		return true, updated, nil
+	})
+
+	config := deploytest.OkDeploymentConfig(1)
+	o := &DeployOptions{osClient: osClient, kubeClient: &ktc.Fake{}}
+	if err := o.pause(config, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || !updated.Spec.Paused {
+		t.Fatalf("expected a paused config, got %#v", updated)
+	}
+
+	if err := o.deploy(config, ioutil.Discard); err == nil {
+		t.Fatalf("expected an error deploying a paused config")
+	}
+
+	updated = nil
+	if err := o.resume(config, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || updated.Spec.Paused {
+		t.Fatalf("expected a resumed config, got %#v", updated)
+	}
+
+	updated = nil
+	if err := o.resume(config, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Fatalf("unexpected update of a config which is not paused")
+	}
+}
+
+// TestCmdDeploy_resumePausedDeployment ensures that resuming a config whose
+// latest deployment was paused by its batch hook resumes the deployment.
+func TestCmdDeploy_resumePausedDeployment(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+	deployment.Annotations[deployapi.DeploymentPausedAnnotation] = "batch 1 hook failed"
+
+	var updated *kapi.ReplicationController
+	kubeClient := &ktc.Fake{}
+	kubeClient.AddReactor("get", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, deployment, nil
+	})
+	kubeClient.AddReactor("update", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		updated = action.(ktc.UpdateAction).GetObject().(*kapi.ReplicationController)
+		return true, updated, nil
+	})
+
+	o := &DeployOptions{osClient: &tc.Fake{}, kubeClient: kubeClient}
+	out := &bytes.Buffer{}
+	if err := o.resume(config, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil {
+		t.Fatalf("expected the deployment to be updated")
+	}
+	if _, paused := updated.Annotations[deployapi.DeploymentPausedAnnotation]; paused {
+		t.Fatalf("expected the deployment to be resumed")
+	}
+	if e, a := "Resumed deployment #1 of config\n", out.String(); e != a {
+		t.Fatalf("expected output %q, got %q", e, a)
+	}
+}
+
+// TestCmdDeploy_history ensures that the history of a deployment config is
+// printed as a table and in the requested output format.
+func TestCmdDeploy_history(t *testing.T) {
+	previous := 1
+	duration := int64(75)
+	history := &deployapi.DeploymentConfigHistory{
+		ObjectMeta: kapi.ObjectMeta{Name: "config", Namespace: kapi.NamespaceDefault},
+		Deployments: []deployapi.DeploymentRecord{
+			{
+				Name:             "config-2",
+				Version:          2,
+				Phase:            deployapi.DeploymentStatusFailed,
+				Details:          &deployapi.DeploymentDetails{Causes: []*deployapi.DeploymentCause{{Type: deployapi.DeploymentTriggerOnConfigChange}}},
+				Strategy:         deployapi.DeploymentStrategyTypeRolling,
+				DurationSeconds:  &duration,
+				PreviousReplicas: &previous,
+				Replicas:         3,
+				Hooks:            []deployapi.DeploymentHookResult{{Name: deployapi.PreHookPodSuffix, Phase: kapi.PodFailed}},
+				Reason:           "Hook failed, aborting",
+			},
+			{
+				Name:     "config-1",
+				Version:  1,
+				Phase:    deployapi.DeploymentStatusComplete,
+				Strategy: deployapi.DeploymentStrategyTypeRolling,
+				Replicas: 1,
+			},
+		},
+	}
+	osClient := &tc.Fake{}
+	osClient.AddReactor("get", "deploymentconfigs/history", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, history, nil
+	})
+	config := deploytest.OkDeploymentConfig(2)
+
+	out := &bytes.Buffer{}
+	o := &DeployOptions{osClient: osClient, kubeClient: &ktc.Fake{}}
+	if err := o.history(config, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two deployments, got:\n%s", out.String())
+	}
+	for _, expected := range []string{"Failed", "ConfigChange", "1m15s", "1->3", "hook-pre:Failed", "Hook failed, aborting"} {
+		if !strings.Contains(lines[1], expected) {
+			t.Errorf("expected %q in %q", expected, lines[1])
+		}
+	}
+	if !strings.Contains(lines[2], "Manual") {
+		t.Errorf("expected a manual cause in %q", lines[2])
+	}
+
+	out.Reset()
+	o.output = "json"
+	if err := o.history(config, out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{`"kind": "DeploymentConfigHistory"`, `"previousReplicas": 1`, `"durationSeconds": 75`} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected %s in:\n%s", expected, out.String())
+		}
+	}
+}
+
+// TestCmdDeploy_rollbackLast ensures that the service of a blue-green
+// deployment config is switched back to the previous complete deployment and
+// that a complete latest deployment is marked failed.
+func TestCmdDeploy_rollbackLast(t *testing.T) {
+	for _, status := range []deployapi.DeploymentStatus{deployapi.DeploymentStatusRunning, deployapi.DeploymentStatusComplete} {
+		mkconfig := func(version int) *deployapi.DeploymentConfig {
+			config := deploytest.OkDeploymentConfig(version)
+			config.Spec.Strategy = deployapi.DeploymentStrategy{
+				Type:            deployapi.DeploymentStrategyTypeBlueGreen,
+				BlueGreenParams: &deployapi.BlueGreenDeploymentStrategyParams{ServiceName: "frontend"},
+			}
+			return config
+		}
+		config := mkconfig(3)
+		latest := deploymentFor(config, status)
+		previous := deploymentFor(mkconfig(2), deployapi.DeploymentStatusComplete)
+		if status == deployapi.DeploymentStatusRunning {
+			// The previous deployment is still running during the hold period.
+			previous.Spec.Replicas = config.Spec.Replicas
+		}
+		failed := deploymentFor(mkconfig(1), deployapi.DeploymentStatusFailed)
+		service := &kapi.Service{
+			ObjectMeta: kapi.ObjectMeta{Name: "frontend"},
+			Spec:       kapi.ServiceSpec{Selector: map[string]string{deployapi.DeploymentLabel: latest.Name}},
+		}
+
+		updatedDeployments := map[string]*kapi.ReplicationController{}
+		deletedPods := []string{}
+		var updatedService *kapi.Service
+		kubeClient := &ktc.Fake{}
+		kubeClient.AddReactor("list", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*failed, *latest, *previous}}, nil
+		})
+		kubeClient.AddReactor("update", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			updated := action.(ktc.UpdateAction).GetObject().(*kapi.ReplicationController)
+			updatedDeployments[updated.Name] = updated
+			return true, updated, nil
+		})
+		kubeClient.AddReactor("get", "services", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			return true, service, nil
+		})
+		kubeClient.AddReactor("update", "services", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			updatedService = action.(ktc.UpdateAction).GetObject().(*kapi.Service)
+			return true, updatedService, nil
+		})
+		kubeClient.AddReactor("list", "pods", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			pod := kapi.Pod{ObjectMeta: kapi.ObjectMeta{
+				Name:   "deployerpod",
+				Labels: map[string]string{deployapi.DeployerPodForDeploymentLabel: latest.Name},
+			}}
+			return true, &kapi.PodList{Items: []kapi.Pod{pod}}, nil
+		})
+		kubeClient.AddReactor("delete", "pods", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			deletedPods = append(deletedPods, action.(ktc.DeleteAction).GetName())
+			return true, nil, nil
+		})
+
+		waited := []string{}
+		o := &DeployOptions{
+			kubeClient: kubeClient,
+			waitForReady: func(deployment *kapi.ReplicationController, timeout time.Duration) error {
+				if updatedService != nil {
+					t.Errorf("%s: expected the service to be switched after %s is ready", status, deployment.Name)
+				}
+				waited = append(waited, deployment.Name)
+				return nil
+			},
+		}
+		if err := o.rollbackLastSwitch(config, ioutil.Discard); err != nil {
+			t.Fatalf("%s: unexpected error: %v", status, err)
+		}
+
+		if updatedService == nil {
+			t.Fatalf("%s: expected the service to be switched", status)
+		}
+		if e, a := previous.Name, updatedService.Spec.Selector[deployapi.DeploymentLabel]; e != a {
+			t.Errorf("%s: expected the service switched to %s, got %s", status, e, a)
+		}
+
+		switch status {
+		case deployapi.DeploymentStatusRunning:
+			if len(updatedDeployments) > 0 || len(deletedPods) > 0 || len(waited) > 0 {
+				t.Errorf("%s: unexpected deployment updates %v, pod deletions %v or waits %v", status, updatedDeployments, deletedPods, waited)
+			}
+		case deployapi.DeploymentStatusComplete:
+			if e, a := []string{previous.Name}, waited; !reflect.DeepEqual(e, a) {
+				t.Errorf("%s: expected to wait for %v, waited for %v", status, e, a)
+			}
+			if updated, ok := updatedDeployments[previous.Name]; !ok || updated.Spec.Replicas != config.Spec.Replicas {
+				t.Errorf("%s: expected %s to be scaled to %d", status, previous.Name, config.Spec.Replicas)
+			}
+			updated, ok := updatedDeployments[latest.Name]
+			if !ok {
+				t.Fatalf("%s: expected %s to be updated", status, latest.Name)
+			}
+			if e, a := deployapi.DeploymentStatusFailed, deployutil.DeploymentStatusFor(updated); e != a {
+				t.Errorf("%s: expected deployment status %s, got %s", status, e, a)
+			}
+			if updated.Spec.Replicas != 0 {
+				t.Errorf("%s: expected %s to be scaled down, got %d replicas", status, latest.Name, updated.Spec.Replicas)
+			}
+			if e, a := []string{"deployerpod"}, deletedPods; !reflect.DeepEqual(e, a) {
+				t.Errorf("%s: expected deleted pods %v, got %v", status, e, a)
+			}
+		}
+	}
+}
+
+// TestCmdDeploy_rollbackLastNotReady ensures that the service stays switched
+// to the latest deployment when the previous one doesn't become ready again.
+func TestCmdDeploy_rollbackLastNotReady(t *testing.T) {
+	mkconfig := func(version int) *deployapi.DeploymentConfig {
+		config := deploytest.OkDeploymentConfig(version)
+		config.Spec.Strategy = deployapi.DeploymentStrategy{
+			Type:            deployapi.DeploymentStrategyTypeBlueGreen,
+			BlueGreenParams: &deployapi.BlueGreenDeploymentStrategyParams{ServiceName: "frontend"},
+		}
+		return config
+	}
+	config := mkconfig(2)
+	latest := deploymentFor(config, deployapi.DeploymentStatusComplete)
+	previous := deploymentFor(mkconfig(1), deployapi.DeploymentStatusComplete)
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend"},
+		Spec:       kapi.ServiceSpec{Selector: map[string]string{deployapi.DeploymentLabel: latest.Name}},
+	}
+
+	replicas := map[string][]int{}
+	kubeClient := &ktc.Fake{}
+	kubeClient.AddReactor("list", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*latest, *previous}}, nil
+	})
+	kubeClient.AddReactor("update", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		updated := action.(ktc.UpdateAction).GetObject().(*kapi.ReplicationController)
+		replicas[updated.Name] = append(replicas[updated.Name], updated.Spec.Replicas)
+		return true, updated, nil
+	})
+	kubeClient.AddReactor("get", "services", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, service, nil
+	})
+	kubeClient.AddReactor("update", "services", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		t.Errorf("unexpected service update")
+		return true, nil, nil
+	})
+
+	o := &DeployOptions{
+		kubeClient: kubeClient,
+		waitForReady: func(deployment *kapi.ReplicationController, timeout time.Duration) error {
+			return fmt.Errorf("pods not ready")
+		},
+	}
+	if err := o.rollbackLastSwitch(config, ioutil.Discard); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if e, a := []int{config.Spec.Replicas, 0}, replicas[previous.Name]; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected %s to be scaled %v, got %v", previous.Name, e, a)
+	}
+	if _, updated := replicas[latest.Name]; updated {
+		t.Errorf("expected %s to be left serving", latest.Name)
+	}
+}
@@ -144,6 +144,7 @@ func (e *defaultExporter) Export(obj runtime.Object, exact bool) error {
 		// TODO: when internal refactor is completed use status reset
 		t.Status.LatestVersion = 0
 		t.Status.Details = nil
+		t.Status.LastTriggeredImages = nil
 		for i := range t.Spec.Triggers {
 			if p := t.Spec.Triggers[i].ImageChangeParams; p != nil {
 				p.LastTriggeredImage = ""
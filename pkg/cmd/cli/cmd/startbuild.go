@@ -62,6 +62,9 @@ base image changes will use the source specified on the build config.
   # Send the contents of a Git repository to the server from tag 'v2'
   $ %[1]s start-build hello-world --from-repo=../hello-world --commit=v2
 
+  # Start a new build for build config "hello-world" with the value 8 of its JDK_VERSION parameter
+  $ %[1]s start-build hello-world --param=JDK_VERSION=8
+
   # Start a new build for build config "hello-world" and watch the logs until the build
   # completes or fails.
   $ %[1]s start-build hello-world --follow
@@ -88,7 +91,9 @@ func NewCmdStartBuild(fullName string, f *clientcmd.Factory, in io.Reader, out i
 	}
 	cmd.Flags().StringVar(&o.LogLevel, "build-loglevel", o.LogLevel, "Specify the log level for the build log output")
 	cmd.Flags().StringSliceVarP(&o.Env, "env", "e", o.Env, "Specify key value pairs of environment variables to set for the build container.")
+	cmd.Flags().StringSliceVar(&o.Params, "param", o.Params, "Specify key value pairs of build parameters of the build config to set for the build; setting a matrix parameter restricts the builds of the matrix to that value")
 	cmd.Flags().StringVar(&o.FromBuild, "from-build", o.FromBuild, "Specify the name of a build which should be re-run")
+	cmd.Flags().BoolVar(&o.NoCache, "no-cache", o.NoCache, "Empty the cache volumes of the build before it runs, and build Docker images without cached layers")
 
 	cmd.Flags().BoolVar(&o.Follow, "follow", o.Follow, "Start a build and watch its logs until it completes or fails")
 	cmd.Flags().BoolVar(&o.WaitForComplete, "wait", o.WaitForComplete, "Wait for a build to complete and exit with a non-zero return code if the build fails")
@@ -98,7 +103,7 @@ func NewCmdStartBuild(fullName string, f *clientcmd.Factory, in io.Reader, out i
 	cmd.Flags().StringVar(&o.FromRepo, "from-repo", o.FromRepo, "The path to a local source code repository to use as the binary input for a build.")
 	cmd.Flags().StringVar(&o.Commit, "commit", o.Commit, "Specify the source code commit identifier the build should use; requires a build based on a Git repository")
 
-	cmd.Flags().StringVar(&o.ListWebhooks, "list-webhooks", o.ListWebhooks, "List the webhooks for the specified build config or build; accepts 'all', 'generic', or 'github'")
+	cmd.Flags().StringVar(&o.ListWebhooks, "list-webhooks", o.ListWebhooks, "List the webhooks for the specified build config or build; accepts 'all', 'generic', 'github', 'gitlab', 'bitbucket', or 'gogs'")
 	cmd.Flags().StringVar(&o.FromWebhook, "from-webhook", o.FromWebhook, "Specify a webhook URL for an existing build config to trigger")
 
 	cmd.Flags().StringVar(&o.GitPostReceive, "git-post-receive", o.GitPostReceive, "The contents of the post-receive hook to trigger a build")
@@ -122,7 +127,9 @@ type StartBuildOptions struct {
 	FromDir  string
 	FromRepo string
 
-	Env []string
+	Env     []string
+	Params  []string
+	NoCache bool
 
 	Follow          bool
 	WaitForComplete bool
@@ -134,10 +141,11 @@ type StartBuildOptions struct {
 	Client       osclient.Interface
 	ClientConfig kclientcmd.ClientConfig
 
-	AsBinary  bool
-	EnvVar    []kapi.EnvVar
-	Name      string
-	Namespace string
+	AsBinary    bool
+	EnvVar      []kapi.EnvVar
+	ParamValues []buildapi.BuildParameterValue
+	Name        string
+	Namespace   string
 }
 
 func (o *StartBuildOptions) Complete(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra.Command, args []string) error {
@@ -232,6 +240,14 @@ func (o *StartBuildOptions) Complete(f *clientcmd.Factory, in io.Reader, out io.
 	}
 	o.EnvVar = env
 
+	for _, param := range o.Params {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return kcmdutil.UsageError(cmd, "--param must be of the form NAME=VALUE, got %q", param)
+		}
+		o.ParamValues = append(o.ParamValues, buildapi.BuildParameterValue{Name: parts[0], Value: parts[1]})
+	}
+
 	return nil
 }
 
@@ -249,6 +265,10 @@ func (o *StartBuildOptions) Run() error {
 	if len(o.EnvVar) > 0 {
 		request.Env = o.EnvVar
 	}
+	if len(o.ParamValues) > 0 {
+		request.Parameters = o.ParamValues
+	}
+	request.NoCache = o.NoCache
 	if len(o.Commit) > 0 {
 		request.Revision = &buildapi.SourceRevision{
 			Git: &buildapi.GitSourceRevision{
@@ -271,10 +291,19 @@ func (o *StartBuildOptions) Run() error {
 		if len(o.EnvVar) > 0 {
 			fmt.Fprintf(o.ErrOut, "WARNING: Specifying environment variables with binary builds is not supported.\n")
 		}
+		if o.NoCache {
+			fmt.Fprintf(o.ErrOut, "WARNING: Clearing the build cache with binary builds is not supported.\n")
+		}
+		if len(o.ParamValues) > 0 {
+			fmt.Fprintf(o.ErrOut, "WARNING: Specifying build parameters with binary builds is not supported.\n")
+		}
 		if newBuild, err = streamPathToBuild(o.Git, o.In, o.ErrOut, o.Client.BuildConfigs(o.Namespace), o.FromDir, o.FromFile, o.FromRepo, request); err != nil {
 			return err
 		}
 	case len(o.FromBuild) > 0:
+		if len(o.ParamValues) > 0 {
+			fmt.Fprintf(o.ErrOut, "WARNING: Build parameters are not applied to builds re-run with --from-build.\n")
+		}
 		if newBuild, err = o.Client.Builds(o.Namespace).Clone(request); err != nil {
 			return err
 		}
@@ -343,18 +372,24 @@ func (o *StartBuildOptions) Run() error {
 
 // RunListBuildWebHooks prints the webhooks for the provided build config.
 func (o *StartBuildOptions) RunListBuildWebHooks() error {
-	generic, github := false, false
+	generic, github, gitlab, bitbucket, gogs := false, false, false, false, false
 	prefix := false
 	switch o.ListWebhooks {
 	case "all":
-		generic, github = true, true
+		generic, github, gitlab, bitbucket, gogs = true, true, true, true, true
 		prefix = true
 	case "generic":
 		generic = true
 	case "github":
 		github = true
+	case "gitlab":
+		gitlab = true
+	case "bitbucket":
+		bitbucket = true
+	case "gogs":
+		gogs = true
 	default:
-		return fmt.Errorf("--list-webhooks must be 'all', 'generic', or 'github'")
+		return fmt.Errorf("--list-webhooks must be 'all', 'generic', 'github', 'gitlab', 'bitbucket', or 'gogs'")
 	}
 	client := o.Client
 
@@ -374,6 +409,18 @@ func (o *StartBuildOptions) RunListBuildWebHooks() error {
 			if prefix {
 				hookType = "github "
 			}
+		case t.GitLabWebHook != nil && gitlab:
+			if prefix {
+				hookType = "gitlab "
+			}
+		case t.BitbucketWebHook != nil && bitbucket:
+			if prefix {
+				hookType = "bitbucket "
+			}
+		case t.GogsWebHook != nil && gogs:
+			if prefix {
+				hookType = "gogs "
+			}
 		default:
 			continue
 		}
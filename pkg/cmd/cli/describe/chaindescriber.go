@@ -117,10 +117,10 @@ func (d *ChainDescriber) Describe(ist *imageapi.ImageStreamTag, includeInputImag
 func partition(g osgraph.Graph, root graph.Node, buildInputEdgeKinds []string) osgraph.Graph {
 	// Filter out all but BuildConfig and ImageStreamTag nodes
 	nodeFn := osgraph.NodesOfKind(buildgraph.BuildConfigNodeKind, imagegraph.ImageStreamTagNodeKind)
-	// Filter out all but BuildInputImage and BuildOutput edges
+	// Filter out all but BuildInputImage, BuildOutput and PipelineStage edges
 	edgeKinds := []string{}
 	edgeKinds = append(edgeKinds, buildInputEdgeKinds...)
-	edgeKinds = append(edgeKinds, buildedges.BuildOutputEdgeKind)
+	edgeKinds = append(edgeKinds, buildedges.BuildOutputEdgeKind, buildedges.PipelineStageEdgeKind)
 	edgeFn := osgraph.EdgesOfKind(edgeKinds...)
 	sub := g.Subgraph(nodeFn, edgeFn)
 
@@ -3,10 +3,12 @@ package describe
 import (
 	"fmt"
 	"io"
+	"net"
 	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/openshift/origin/pkg/api/graph"
 
@@ -134,6 +136,12 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 		}
 
 		printDeploymentConfigSpec(deploymentConfig.Spec, out)
+		if len(deploymentConfig.Status.LastTriggeredImages) > 0 {
+			fmt.Fprintf(out, "Last Triggered Images:\n")
+			for _, image := range deploymentConfig.Status.LastTriggeredImages {
+				fmt.Fprintf(out, "  %s:\t%s\n", image.ContainerName, image.Image)
+			}
+		}
 		fmt.Fprintln(out)
 
 		if deploymentConfig.Status.Details != nil && len(deploymentConfig.Status.Details.Message) > 0 {
@@ -143,7 +151,11 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 		deployment, err := d.client.getDeployment(namespace, deploymentName)
 		if err != nil {
 			if kerrors.IsNotFound(err) {
-				formatString(out, "Latest Deployment", "<none>")
+				if deferred := describeDeferredDeployment(deploymentConfig); len(deferred) > 0 && deploymentConfig.Status.LatestVersion > 0 {
+					formatString(out, "Latest Deployment", fmt.Sprintf("<none>, #%d %s", deploymentConfig.Status.LatestVersion, deferred))
+				} else {
+					formatString(out, "Latest Deployment", "<none>")
+				}
 			} else {
 				formatString(out, "Latest Deployment", fmt.Sprintf("error: %v", err))
 			}
@@ -192,10 +204,51 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 	case deployapi.DeploymentStrategyTypeRolling:
 		if strategy.RollingParams != nil {
 			pre := strategy.RollingParams.Pre
+			batch := strategy.RollingParams.Batch
 			post := strategy.RollingParams.Post
 			if pre != nil {
 				printHook("Pre-deployment", pre, w)
 			}
+			if batch != nil {
+				printHook("Per-batch", batch, w)
+			}
+			if post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams != nil {
+			fmt.Fprintf(w, "\t  Service:\t%s\n", strategy.BlueGreenParams.ServiceName)
+			if strategy.BlueGreenParams.HoldSeconds != nil {
+				fmt.Fprintf(w, "\t  Hold:\t%ds\n", *strategy.BlueGreenParams.HoldSeconds)
+			}
+			pre := strategy.BlueGreenParams.Pre
+			post := strategy.BlueGreenParams.Post
+			if pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
+			if post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams != nil {
+			steps := []string{}
+			for _, step := range strategy.CanaryParams.Steps {
+				steps = append(steps, fmt.Sprintf("%d%%", step))
+			}
+			fmt.Fprintf(w, "\t  Steps:\t%s\n", strings.Join(steps, ", "))
+			if strategy.CanaryParams.BakeSeconds != nil {
+				fmt.Fprintf(w, "\t  Bake:\t%ds\n", *strategy.CanaryParams.BakeSeconds)
+			}
+			if check := strategy.CanaryParams.Check; check != nil {
+				fmt.Fprintf(w, "\t  Check:\t%s: %v\n", check.ContainerName, strings.Join(check.Command, " "))
+			}
+			pre := strategy.CanaryParams.Pre
+			post := strategy.CanaryParams.Post
+			if pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
 			if post != nil {
 				printHook("Post-deployment", post, w)
 			}
@@ -211,6 +264,37 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 			fmt.Fprintf(w, "\t  Command:\t%v\n", strings.Join(strategy.CustomParams.Command, " "))
 		}
 	}
+
+	if strategy.Verification != nil {
+		printVerification(strategy.Verification, w)
+	}
+}
+
+func printVerification(verification *deployapi.DeploymentVerification, w io.Writer) {
+	interval := deployapi.DefaultVerificationIntervalSeconds
+	if verification.IntervalSeconds != nil {
+		interval = *verification.IntervalSeconds
+	}
+	timeout := deployapi.DefaultVerificationTimeoutSeconds
+	if verification.TimeoutSeconds != nil {
+		timeout = *verification.TimeoutSeconds
+	}
+	fmt.Fprintf(w, "\t  Verification (every %ds for %ds):\n", interval, timeout)
+	if verification.Prometheus != nil {
+		fmt.Fprintf(w, "\t    Prometheus:\t%s\n", verification.Prometheus.URL)
+		fmt.Fprintf(w, "\t    Query:\t%s\n", verification.Prometheus.Query)
+	}
+	if verification.HTTPGet != nil {
+		fmt.Fprintf(w, "\t    Request:\tGET %s\n", verification.HTTPGet.URL)
+	}
+	thresholds := []string{}
+	if len(verification.MinValue) > 0 {
+		thresholds = append(thresholds, ">= "+verification.MinValue)
+	}
+	if len(verification.MaxValue) > 0 {
+		thresholds = append(thresholds, "<= "+verification.MaxValue)
+	}
+	fmt.Fprintf(w, "\t    Expected:\t%s\n", strings.Join(thresholds, ", "))
 }
 
 func printHook(prefix string, hook *deployapi.LifecycleHook, w io.Writer) {
@@ -220,6 +304,33 @@ func printHook(prefix string, hook *deployapi.LifecycleHook, w io.Writer) {
 		fmt.Fprintf(w, "\t    Command:\t%v\n", strings.Join(hook.ExecNewPod.Command, " "))
 		fmt.Fprintf(w, "\t    Env:\t%s\n", formatLabels(convertEnv(hook.ExecNewPod.Env)))
 	}
+	if hook.HTTPGet != nil {
+		method := hook.HTTPGet.Method
+		if len(method) == 0 {
+			method = "GET"
+		}
+		expected := "2xx"
+		if hook.HTTPGet.ExpectedStatus != 0 {
+			expected = strconv.Itoa(hook.HTTPGet.ExpectedStatus)
+		}
+		timeout := deployapi.DefaultHTTPGetHookTimeoutSeconds
+		if hook.HTTPGet.TimeoutSeconds != nil {
+			timeout = *hook.HTTPGet.TimeoutSeconds
+		}
+		fmt.Fprintf(w, "\t  %s hook (http type, failure policy: %s):\n", prefix, hook.FailurePolicy)
+		fmt.Fprintf(w, "\t    Request:\t%s %s\n", method, hook.HTTPGet.URL)
+		fmt.Fprintf(w, "\t    Expected Status:\t%s\n", expected)
+		fmt.Fprintf(w, "\t    Retries:\t%d (timeout %ds)\n", hook.HTTPGet.Retries, timeout)
+	}
+	if hook.TCPSocket != nil {
+		timeout := deployapi.DefaultTCPSocketHookTimeoutSeconds
+		if hook.TCPSocket.TimeoutSeconds != nil {
+			timeout = *hook.TCPSocket.TimeoutSeconds
+		}
+		fmt.Fprintf(w, "\t  %s hook (tcp type, failure policy: %s):\n", prefix, hook.FailurePolicy)
+		fmt.Fprintf(w, "\t    Address:\t%s\n", net.JoinHostPort(hook.TCPSocket.Host, strconv.Itoa(hook.TCPSocket.Port)))
+		fmt.Fprintf(w, "\t    Timeout:\t%ds\n", timeout)
+	}
 }
 
 func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Writer) {
@@ -246,6 +357,29 @@ func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Wr
 	formatString(w, "Triggers", desc)
 }
 
+// describeDeploymentWindows returns the schedules and durations of windows.
+func describeDeploymentWindows(windows []deployapi.DeploymentWindow) string {
+	descs := []string{}
+	for _, window := range windows {
+		descs = append(descs, fmt.Sprintf("%q for %dm", window.Schedule, window.DurationMinutes))
+	}
+	return strings.Join(descs, ", ")
+}
+
+// describeDeferredDeployment returns when the deferred latest version of
+// config will be deployed, or an empty string if it isn't deferred.
+func describeDeferredDeployment(config *deployapi.DeploymentConfig) string {
+	deferred, next := deployutil.DeploymentDeferred(config, time.Now())
+	switch {
+	case !deferred:
+		return ""
+	case next.IsZero():
+		return "deferred, no deployment window ever opens"
+	default:
+		return fmt.Sprintf("deferred until %s", next.Format(time.RFC3339))
+	}
+}
+
 func printDeploymentConfigSpec(spec deployapi.DeploymentConfigSpec, w *tabwriter.Writer) error {
 	// Selector
 	formatString(w, "Selector", formatLabels(spec.Selector))
@@ -257,9 +391,24 @@ func printDeploymentConfigSpec(spec deployapi.DeploymentConfigSpec, w *tabwriter
 	}
 	formatString(w, "Replicas", fmt.Sprintf("%d%s", spec.Replicas, test))
 
+	// Paused
+	if spec.Paused {
+		formatString(w, "Paused", "yes, triggers will not start new deployments until it is resumed")
+	}
+
 	// Triggers
 	printTriggers(spec.Triggers, w)
 
+	// Image change debounce
+	if spec.ImageChangeDebounceSeconds > 0 {
+		formatString(w, "Image Change Debounce", fmt.Sprintf("%ds", spec.ImageChangeDebounceSeconds))
+	}
+
+	// Windows
+	if len(spec.Windows) > 0 {
+		formatString(w, "Windows", describeDeploymentWindows(spec.Windows)+" (UTC)")
+	}
+
 	// Strategy
 	formatString(w, "Strategy", spec.Strategy.Type)
 	printStrategy(spec.Strategy, w)
@@ -282,6 +431,9 @@ func printDeploymentRc(deployment *kapi.ReplicationController, client deployment
 	timeAt := strings.ToLower(formatRelativeTime(deployment.CreationTimestamp.Time))
 	fmt.Fprintf(w, "\tCreated:\t%s ago\n", timeAt)
 	fmt.Fprintf(w, "\tStatus:\t%s\n", deployutil.DeploymentStatusFor(deployment))
+	if reason, paused := deployment.Annotations[deployapi.DeploymentPausedAnnotation]; paused {
+		fmt.Fprintf(w, "\tPaused:\t%s\n", reason)
+	}
 	fmt.Fprintf(w, "\tReplicas:\t%d current / %d desired\n", deployment.Status.Replicas, deployment.Spec.Replicas)
 
 	if verbose {
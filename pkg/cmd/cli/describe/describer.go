@@ -439,13 +439,16 @@ func (d *BuildDescriber) Describe(namespace, name string) (string, error) {
 		// Create the time object with second-level precision so we don't get
 		// output like "duration: 1.2724395728934s"
 		formatString(out, "Duration", describeBuildDuration(build))
-		formatString(out, "Build Pod", buildutil.GetBuildPodName(build))
+		if build.Spec.Strategy.PipelineStrategy == nil {
+			formatString(out, "Build Pod", buildutil.GetBuildPodName(build))
+		}
 		describeBuildSpec(build.Spec, out)
 		status := bold(build.Status.Phase)
 		if build.Status.Message != "" {
 			status += " (" + build.Status.Message + ")"
 		}
 		formatString(out, "Status", status)
+		describePipelineStageStatuses(build.Status.Stages, out)
 		kctl.DescribeEvents(events, out)
 
 		return nil
@@ -552,6 +555,8 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 		describeSourceStrategy(p.Strategy.SourceStrategy, out)
 	case p.Strategy.CustomStrategy != nil:
 		describeCustomStrategy(p.Strategy.CustomStrategy, out)
+	case p.Strategy.PipelineStrategy != nil:
+		describePipelineStrategy(p.Strategy.PipelineStrategy, out)
 	}
 
 	if p.Output.To != nil {
@@ -578,6 +583,67 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 	if p.CompletionDeadlineSeconds != nil {
 		formatString(out, "Fail Build After", time.Duration(*p.CompletionDeadlineSeconds)*time.Second)
 	}
+
+	describeNotifications(p.Notifications, out)
+
+	if p.CommitStatus != nil {
+		formatString(out, "Commit Status", fmt.Sprintf("reported to %s with secret %s", p.CommitStatus.Provider, p.CommitStatus.TokenSecret.Name))
+	}
+}
+
+func describeNotifications(notifications []buildapi.BuildNotification, out *tabwriter.Writer) {
+	for i, n := range notifications {
+		label := ""
+		if i == 0 {
+			label = "Notifications"
+		}
+		var target string
+		switch {
+		case n.Webhook != nil:
+			target = fmt.Sprintf("webhook %s", n.Webhook.URL)
+		case n.Slack != nil:
+			target = fmt.Sprintf("Slack %s", n.Slack.URL)
+			if len(n.Slack.Channel) > 0 {
+				target += fmt.Sprintf(" (%s)", n.Slack.Channel)
+			}
+		case n.Email != nil:
+			target = fmt.Sprintf("email to %s", strings.Join(n.Email.To, ", "))
+		}
+		phases := "all"
+		if len(n.Phases) > 0 {
+			names := make([]string, 0, len(n.Phases))
+			for _, phase := range n.Phases {
+				names = append(names, string(phase))
+			}
+			phases = strings.Join(names, ", ")
+		}
+		formatString(out, label, fmt.Sprintf("%s on %s", target, phases))
+	}
+}
+
+func describeBuildParameters(spec buildapi.BuildConfigSpec, out *tabwriter.Writer) {
+	for i, p := range spec.Parameters {
+		label := ""
+		if i == 0 {
+			label = "Parameters"
+		}
+		paramType := p.Type
+		if len(paramType) == 0 {
+			paramType = buildapi.BuildParameterTypeString
+		}
+		details := string(paramType)
+		if p.Required {
+			details += ", required"
+		}
+		formatString(out, label, fmt.Sprintf("%s=%s (%s)", p.Name, p.Value, details))
+	}
+	for i, m := range spec.Matrix {
+		label := ""
+		if i == 0 {
+			label = "Matrix"
+		}
+		formatString(out, label, fmt.Sprintf("%s=%s", m.Name, strings.Join(m.Values, ", ")))
+	}
 }
 
 func describePostCommitHook(hook buildapi.BuildPostCommitSpec, out *tabwriter.Writer) {
@@ -622,6 +688,7 @@ func describeSourceStrategy(s *buildapi.SourceBuildStrategy, out *tabwriter.Writ
 	if s.ForcePull {
 		formatString(out, "Force Pull", "yes")
 	}
+	describeCacheVolumes(s.CacheVolumes, out)
 }
 
 func describeDockerStrategy(s *buildapi.DockerBuildStrategy, out *tabwriter.Writer) {
@@ -642,6 +709,16 @@ func describeDockerStrategy(s *buildapi.DockerBuildStrategy, out *tabwriter.Writ
 	}
 }
 
+func describeCacheVolumes(volumes []buildapi.BuildCacheVolume, out *tabwriter.Writer) {
+	for i, v := range volumes {
+		label := ""
+		if i == 0 {
+			label = "Cache Volumes"
+		}
+		formatString(out, label, fmt.Sprintf("%s (pvc/%s at %s)", v.Name, v.ClaimName, v.Path))
+	}
+}
+
 func describeCustomStrategy(s *buildapi.CustomBuildStrategy, out *tabwriter.Writer) {
 	if len(s.From.Name) != 0 {
 		formatString(out, "Image Reference", fmt.Sprintf("%s %s", s.From.Kind, nameAndNamespace(s.From.Namespace, s.From.Name)))
@@ -664,6 +741,57 @@ func describeCustomStrategy(s *buildapi.CustomBuildStrategy, out *tabwriter.Writ
 	}
 }
 
+func describePipelineStrategy(s *buildapi.PipelineBuildStrategy, out *tabwriter.Writer) {
+	for i, stage := range s.Stages {
+		label := ""
+		if i == 0 {
+			label = "Stages"
+		}
+		formatString(out, label, fmt.Sprintf("%s (%s)", stage.Name, describePipelineStage(stage)))
+	}
+}
+
+// describePipelineStage returns a short description of what stage does.
+func describePipelineStage(stage buildapi.PipelineStage) string {
+	switch {
+	case stage.Build != nil:
+		return fmt.Sprintf("builds bc/%s", stage.Build.BuildConfig)
+	case stage.Test != nil:
+		command := "<image-entrypoint>"
+		if len(stage.Test.Command) > 0 {
+			command = strings.Join(stage.Test.Command, " ")
+		}
+		return fmt.Sprintf("runs %q in %s %s", command, stage.Test.Image.Kind, stage.Test.Image.Name)
+	case stage.Promote != nil:
+		return fmt.Sprintf("tags %s as %s", stage.Promote.From.Name, stage.Promote.To.Name)
+	}
+	return "unrecognized stage"
+}
+
+func describePipelineStageStatuses(statuses []buildapi.PipelineStageStatus, out *tabwriter.Writer) {
+	for i, status := range statuses {
+		label := ""
+		if i == 0 {
+			label = "Stage Status"
+		}
+		details := []string{}
+		if len(status.Build) > 0 {
+			details = append(details, "build/"+status.Build)
+		}
+		if len(status.Pod) > 0 {
+			details = append(details, "pod/"+status.Pod)
+		}
+		if len(status.Message) > 0 {
+			details = append(details, status.Message)
+		}
+		line := fmt.Sprintf("%s: %s", status.Name, status.Phase)
+		if len(details) > 0 {
+			line += " (" + strings.Join(details, ", ") + ")"
+		}
+		formatString(out, label, line)
+	}
+}
+
 // DescribeTriggers generates information about the triggers associated with a buildconfig
 func (d *BuildConfigDescriber) DescribeTriggers(bc *buildapi.BuildConfig, out *tabwriter.Writer) {
 	describeBuildTriggers(bc.Spec.Triggers, out)
@@ -684,7 +812,8 @@ func describeBuildTriggers(triggers []buildapi.BuildTriggerPolicy, w *tabwriter.
 
 	for _, t := range triggers {
 		switch t.Type {
-		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType:
+		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType,
+			buildapi.GitLabWebHookBuildTriggerType, buildapi.BitbucketWebHookBuildTriggerType, buildapi.GogsWebHookBuildTriggerType:
 			continue
 		case buildapi.ConfigChangeBuildTriggerType:
 			labels = append(labels, "Config")
@@ -725,6 +854,12 @@ func (d *BuildConfigDescriber) Describe(namespace, name string) (string, error)
 		} else {
 			formatString(out, "Latest Version", strconv.Itoa(buildConfig.Status.LastVersion))
 		}
+		runPolicy := buildConfig.Spec.RunPolicy
+		if len(runPolicy) == 0 {
+			runPolicy = buildapi.BuildRunPolicyParallel
+		}
+		formatString(out, "Run Policy", runPolicy)
+		describeBuildParameters(buildConfig.Spec, out)
 		describeBuildSpec(buildConfig.Spec.BuildSpec, out)
 		d.DescribeTriggers(buildConfig, out)
 		if len(buildList.Items) == 0 {
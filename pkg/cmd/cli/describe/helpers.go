@@ -146,6 +146,12 @@ func webhookURL(c *buildapi.BuildConfig, cli client.BuildConfigsNamespacer) map[
 			whTrigger = trigger.GitHubWebHook.Secret
 		case buildapi.GenericWebHookBuildTriggerType:
 			whTrigger = trigger.GenericWebHook.Secret
+		case buildapi.GitLabWebHookBuildTriggerType:
+			whTrigger = trigger.GitLabWebHook.Secret
+		case buildapi.BitbucketWebHookBuildTriggerType:
+			whTrigger = trigger.BitbucketWebHook.Secret
+		case buildapi.GogsWebHookBuildTriggerType:
+			whTrigger = trigger.GogsWebHook.Secret
 		}
 		if len(whTrigger) == 0 {
 			continue
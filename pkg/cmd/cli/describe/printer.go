@@ -683,6 +683,9 @@ func printDeploymentConfig(dc *deployapi.DeploymentConfig, w io.Writer, opts kct
 		}
 	}
 	trigger := strings.Join(triggers.List(), ",")
+	if dc.Spec.Paused {
+		trigger = strings.TrimSpace(trigger + " (paused)")
+	}
 
 	if opts.WithNamespace {
 		if _, err := fmt.Fprintf(w, "%s\t", dc.Namespace); err != nil {
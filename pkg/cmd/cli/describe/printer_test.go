@@ -25,12 +25,13 @@ import (
 // If you add something to this list, explain why it doesn't need printing.  waaaa is not a valid
 // reason.
 var PrinterCoverageExceptions = []reflect.Type{
-	reflect.TypeOf(&imageapi.DockerImage{}),           // not a top level resource
-	reflect.TypeOf(&imageapi.ImageStreamImport{}),     // normal users don't ever look at these
-	reflect.TypeOf(&buildapi.BuildLog{}),              // just a marker type
-	reflect.TypeOf(&buildapi.BuildLogOptions{}),       // just a marker type
-	reflect.TypeOf(&deployapi.DeploymentLog{}),        // just a marker type
-	reflect.TypeOf(&deployapi.DeploymentLogOptions{}), // just a marker type
+	reflect.TypeOf(&imageapi.DockerImage{}),              // not a top level resource
+	reflect.TypeOf(&imageapi.ImageStreamImport{}),        // normal users don't ever look at these
+	reflect.TypeOf(&buildapi.BuildLog{}),                 // just a marker type
+	reflect.TypeOf(&buildapi.BuildLogOptions{}),          // just a marker type
+	reflect.TypeOf(&deployapi.DeploymentLog{}),           // just a marker type
+	reflect.TypeOf(&deployapi.DeploymentLogOptions{}),    // just a marker type
+	reflect.TypeOf(&deployapi.DeploymentConfigHistory{}), // printed by oc deploy --history
 
 	// these resources can't be "GET"ed, so we probably don't need a printer for them
 	reflect.TypeOf(&authorizationapi.SubjectAccessReviewResponse{}),
@@ -20,6 +20,14 @@ import (
 	kubeedges "github.com/openshift/origin/pkg/api/kubegraph"
 	kubeanalysis "github.com/openshift/origin/pkg/api/kubegraph/analysis"
 	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
+	applicationapi "github.com/openshift/origin/pkg/application/api"
+	appedges "github.com/openshift/origin/pkg/application/graph"
+	appanalysis "github.com/openshift/origin/pkg/application/graph/analysis"
+	appgraph "github.com/openshift/origin/pkg/application/graph/nodes"
+	backingserviceinstanceapi "github.com/openshift/origin/pkg/backingserviceinstance/api"
+	bsiedges "github.com/openshift/origin/pkg/backingserviceinstance/graph"
+	bsianalysis "github.com/openshift/origin/pkg/backingserviceinstance/graph/analysis"
+	bsigraph "github.com/openshift/origin/pkg/backingserviceinstance/graph/nodes"
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildedges "github.com/openshift/origin/pkg/build/graph"
 	buildanalysis "github.com/openshift/origin/pkg/build/graph/analysis"
@@ -72,6 +80,8 @@ func (d *ProjectStatusDescriber) MakeGraph(namespace string) (osgraph.Graph, set
 		&isLoader{namespace: namespace, lister: d.C},
 		&dcLoader{namespace: namespace, lister: d.C},
 		&routeLoader{namespace: namespace, lister: d.C},
+		&applicationLoader{namespace: namespace, lister: d.C},
+		&bsiLoader{namespace: namespace, lister: d.C},
 	}
 	loadingFuncs := []func() error{}
 	for _, loader := range loaders {
@@ -114,6 +124,8 @@ func (d *ProjectStatusDescriber) MakeGraph(namespace string) (osgraph.Graph, set
 	imageedges.AddAllImageStreamRefEdges(g)
 	imageedges.AddAllImageStreamImageRefEdges(g)
 	routeedges.AddAllRouteEdges(g)
+	bsiedges.AddAllBindingEdges(g)
+	appedges.AddAllApplicationItemEdges(g)
 
 	return g, forbiddenResources, nil
 }
@@ -138,6 +150,13 @@ func (d *ProjectStatusDescriber) Describe(namespace, name string) (string, error
 		f = namespacedFormatter{currentNamespace: namespace}
 	}
 
+	// applications only group resources, their items are still shown below
+	applications := []*appgraph.ApplicationNode{}
+	for _, node := range g.NodesByKind(appgraph.ApplicationNodeKind) {
+		applications = append(applications, node.(*appgraph.ApplicationNode))
+	}
+	sort.Sort(applicationsByName(applications))
+
 	coveredNodes := graphview.IntSet{}
 
 	services, coveredByServices := graphview.AllServiceGroups(g, coveredNodes)
@@ -163,6 +182,11 @@ func (d *ProjectStatusDescriber) Describe(namespace, name string) (string, error
 			fmt.Fprintf(out, describeProjectAndServer(f, project, d.Server))
 		}
 
+		for _, appNode := range applications {
+			fmt.Fprintln(out)
+			printLines(out, indent, 0, describeApplicationTree(f, g, appNode)...)
+		}
+
 		for _, service := range services {
 			if !service.Service.Found() {
 				continue
@@ -360,6 +384,8 @@ func getMarkerScanners(logsCommandName, securityPolicyCommandFormat, setProbeCom
 		routeanalysis.FindPathBasedPassthroughRoutes,
 		routeanalysis.FindRouteAdmissionFailures,
 		routeanalysis.FindMissingRouter,
+		bsianalysis.FindUnboundInstances,
+		appanalysis.FindMissingApplicationItems,
 		// We disable this feature by default and we don't have a capability detection for this sort of thing.  Disable this check for now.
 		// kubeanalysis.FindUnmountableSecrets,
 	}
@@ -435,6 +461,11 @@ func (f namespacedFormatter) ResourceName(obj interface{}) string {
 	case *routegraph.RouteNode:
 		return namespaceNameWithType("route", t.Route.Name, t.Route.Namespace, f.currentNamespace, f.hideNamespace)
 
+	case *appgraph.ApplicationNode:
+		return namespaceNameWithType("application", t.Application.Name, t.Application.Namespace, f.currentNamespace, f.hideNamespace)
+	case *bsigraph.BackingServiceInstanceNode:
+		return namespaceNameWithType("bsi", t.BackingServiceInstance.Name, t.BackingServiceInstance.Namespace, f.currentNamespace, f.hideNamespace)
+
 	default:
 		return fmt.Sprintf("<unrecognized object: %#v>", obj)
 	}
@@ -488,6 +519,67 @@ func describeDeploymentInServiceGroup(f formatter, deploy graphview.DeploymentCo
 	return lines
 }
 
+type applicationsByName []*appgraph.ApplicationNode
+
+func (m applicationsByName) Len() int      { return len(m) }
+func (m applicationsByName) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m applicationsByName) Less(i, j int) bool {
+	return m[i].Application.Name < m[j].Application.Name
+}
+
+func describeApplicationTree(f formatter, g osgraph.Graph, appNode *appgraph.ApplicationNode) []string {
+	app := appNode.Application
+	header := fmt.Sprintf("%s is %s", f.ResourceName(appNode), app.Status.Phase)
+	if len(app.Status.Phase) == 0 {
+		header = f.ResourceName(appNode)
+	}
+	if app.Spec.Stopped {
+		header += " (stopped)"
+	}
+	lines := []string{header}
+
+	for _, item := range app.Spec.Items {
+		name, ok := appedges.ItemNodeName(app, item)
+		if !ok {
+			lines = append(lines, fmt.Sprintf("%s %s", strings.ToLower(item.Kind), item.Name))
+			continue
+		}
+		itemNode := g.Find(name)
+		if itemNode == nil {
+			lines = append(lines, fmt.Sprintf("%s %s (not found)", strings.ToLower(item.Kind), item.Name))
+			continue
+		}
+		if checker, ok := itemNode.(osgraph.ExistenceChecker); ok && !checker.Found() {
+			lines = append(lines, fmt.Sprintf("%s (not found)", f.ResourceName(itemNode)))
+			continue
+		}
+
+		bsiNode, ok := itemNode.(*bsigraph.BackingServiceInstanceNode)
+		if !ok {
+			lines = append(lines, f.ResourceName(itemNode))
+			continue
+		}
+		lines = append(lines, describeBackingServiceInstanceInApplication(f, bsiNode))
+		for _, dcNode := range g.SuccessorNodesByEdgeKind(bsiNode, bsiedges.BoundDeploymentConfigEdgeKind) {
+			lines = append(lines, indentLines("  ", fmt.Sprintf("bound to %s", f.ResourceName(dcNode)))...)
+		}
+	}
+
+	return lines
+}
+
+func describeBackingServiceInstanceInApplication(f formatter, bsiNode *bsigraph.BackingServiceInstanceNode) string {
+	bsi := bsiNode.BackingServiceInstance
+	phase := bsi.Status.Phase
+	if len(phase) == 0 {
+		phase = backingserviceinstanceapi.BackingServiceInstancePhaseProvisioning
+	}
+	if len(bsi.Spec.BackingServiceName) == 0 {
+		return fmt.Sprintf("%s is %s", f.ResourceName(bsiNode), phase)
+	}
+	return fmt.Sprintf("%s (%s) is %s", f.ResourceName(bsiNode), bsi.Spec.BackingServiceName, phase)
+}
+
 func describeRCInServiceGroup(f formatter, rcNode *kubegraph.ReplicationControllerNode) []string {
 	if rcNode.ReplicationController.Spec.Template == nil {
 		return []string{}
@@ -654,11 +746,20 @@ func describeRouteInServiceGroup(f formatter, routeNode *routegraph.RouteNode) [
 }
 
 func describeDeploymentConfigTrigger(dc *deployapi.DeploymentConfig) string {
+	if dc.Spec.Paused {
+		return "(paused)"
+	}
+	var details []string
 	if len(dc.Spec.Triggers) == 0 {
-		return "(manual)"
+		details = append(details, "manual")
 	}
-
-	return ""
+	if len(dc.Spec.Windows) > 0 {
+		details = append(details, fmt.Sprintf("deploys in UTC windows %s", describeDeploymentWindows(dc.Spec.Windows)))
+	}
+	if len(details) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%s)", strings.Join(details, ", "))
 }
 
 func describeStandaloneBuildGroup(f formatter, pipeline graphview.ImagePipeline, namespace string) []string {
@@ -725,6 +826,8 @@ func describeBuildInPipeline(f formatter, build *buildapi.BuildConfig, baseImage
 			return fmt.Sprintf("bc/%s custom build ", build.Name)
 		}
 		return fmt.Sprintf("bc/%s custom build of %s", build.Name, source)
+	case build.Spec.Strategy.PipelineStrategy != nil:
+		return fmt.Sprintf("bc/%s pipeline of %d stages", build.Name, len(build.Spec.Strategy.PipelineStrategy.Stages))
 	default:
 		return fmt.Sprintf("bc/%s unrecognized build", build.Name)
 	}
@@ -890,11 +993,28 @@ func describeDeployments(f formatter, dcNode *deploygraph.DeploymentConfigNode,
 	out := []string{}
 	deploymentsToPrint := append([]*kubegraph.ReplicationControllerNode{}, inactiveDeployments...)
 
+	latestExists := false
+	for _, deployment := range append([]*kubegraph.ReplicationControllerNode{activeDeployment}, inactiveDeployments...) {
+		if deployment != nil && deployutil.DeploymentVersionFor(deployment) == dcNode.DeploymentConfig.Status.LatestVersion {
+			latestExists = true
+		}
+	}
+	deferred := ""
+	if !latestExists && dcNode.DeploymentConfig.Status.LatestVersion > 0 {
+		deferred = describeDeferredDeployment(dcNode.DeploymentConfig)
+	}
+	if len(deferred) > 0 {
+		out = append(out, fmt.Sprintf("deployment #%d %s", dcNode.DeploymentConfig.Status.LatestVersion, deferred))
+	}
+
 	if activeDeployment == nil {
 		on, auto := describeDeploymentConfigTriggers(dcNode.DeploymentConfig)
-		if dcNode.DeploymentConfig.Status.LatestVersion == 0 {
+		switch {
+		case len(deferred) > 0:
+			// the deferred deployment was already described
+		case dcNode.DeploymentConfig.Status.LatestVersion == 0:
 			out = append(out, fmt.Sprintf("deployment #1 waiting %s", on))
-		} else if auto {
+		case auto:
 			out = append(out, fmt.Sprintf("deployment #%d pending %s", dcNode.DeploymentConfig.Status.LatestVersion, on))
 		}
 		// TODO: detect new image available?
@@ -1319,6 +1439,54 @@ func (l *buildLoader) AddToGraph(g osgraph.Graph) error {
 	return nil
 }
 
+type applicationLoader struct {
+	namespace string
+	lister    client.ApplicationsInterface
+	items     []applicationapi.Application
+}
+
+func (l *applicationLoader) Load() error {
+	list, err := l.lister.Applications(l.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return errors.TolerateNotFoundError(err)
+	}
+
+	l.items = list.Items
+	return nil
+}
+
+func (l *applicationLoader) AddToGraph(g osgraph.Graph) error {
+	for i := range l.items {
+		appgraph.EnsureApplicationNode(g, &l.items[i])
+	}
+
+	return nil
+}
+
+type bsiLoader struct {
+	namespace string
+	lister    client.BackingServiceInstancesInterface
+	items     []backingserviceinstanceapi.BackingServiceInstance
+}
+
+func (l *bsiLoader) Load() error {
+	list, err := l.lister.BackingServiceInstances(l.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		return errors.TolerateNotFoundError(err)
+	}
+
+	l.items = list.Items
+	return nil
+}
+
+func (l *bsiLoader) AddToGraph(g osgraph.Graph) error {
+	for i := range l.items {
+		bsigraph.EnsureBackingServiceInstanceNode(g, &l.items[i])
+	}
+
+	return nil
+}
+
 type routeLoader struct {
 	namespace string
 	lister    client.RoutesNamespacer
@@ -293,6 +293,25 @@ func TestProjectStatus(t *testing.T) {
 				`View details with 'oc describe <resource>/<name>' or list everything with 'oc get all'.`,
 			},
 		},
+		"application with backing service instances": {
+			Path: "../../../../pkg/api/graph/test/application-bsi.yaml",
+			Extra: []runtime.Object{
+				&projectapi.Project{
+					ObjectMeta: kapi.ObjectMeta{Name: "example", Namespace: ""},
+				},
+			},
+			ErrFn: func(err error) bool { return err == nil },
+			Contains: []string{
+				"application/shop is Active",
+				"  dc/frontend",
+				"  bsi/mysql is Unbound",
+				"    bound to dc/frontend",
+				"  service missing (not found)",
+				"  node node-1",
+				"dc/frontend is bound to bsi/mysql but the instance is in phase Unbound.",
+				"application/shop contains Service missing but it doesn't exist.",
+			},
+		},
 		"monopod": {
 			Path: "../../../../test/fixtures/app-scenarios/k8s-lonely-pod.json",
 			Extra: []runtime.Object{
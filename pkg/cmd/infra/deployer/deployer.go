@@ -3,6 +3,7 @@ package deployer
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/golang/glog"
@@ -18,8 +19,11 @@ import (
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	"github.com/openshift/origin/pkg/deploy/strategy"
+	"github.com/openshift/origin/pkg/deploy/strategy/bluegreen"
+	"github.com/openshift/origin/pkg/deploy/strategy/canary"
 	"github.com/openshift/origin/pkg/deploy/strategy/recreate"
 	"github.com/openshift/origin/pkg/deploy/strategy/rolling"
+	"github.com/openshift/origin/pkg/deploy/strategy/support"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	"github.com/openshift/origin/pkg/version"
 )
@@ -91,7 +95,23 @@ func NewDeployer(client kclient.Interface, oclient client.Interface) *Deployer {
 		getDeployments: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
 			return client.ReplicationControllers(namespace).List(kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(configName)})
 		},
+		updateDeployment: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Update(deployment)
+		},
 		scaler: scaler,
+		verify: support.NewDeploymentVerifier().Verify,
+		switchService: func(namespace, serviceName, deploymentName string) error {
+			getService := func(namespace, name string) (*kapi.Service, error) {
+				return client.Services(namespace).Get(name)
+			}
+			updateService := func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+				return client.Services(namespace).Update(service)
+			}
+			return bluegreen.SwitchService(getService, updateService, namespace, serviceName, deploymentName)
+		},
+		waitForReady: func(deployment *kapi.ReplicationController, timeout time.Duration) error {
+			return support.NewAcceptNewlyObservedReadyPods(client, timeout, bluegreen.AcceptorInterval).Accept(deployment)
+		},
 		strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
 			switch config.Spec.Strategy.Type {
 			case deployapi.DeploymentStrategyTypeRecreate:
@@ -99,6 +119,11 @@ func NewDeployer(client kclient.Interface, oclient client.Interface) *Deployer {
 			case deployapi.DeploymentStrategyTypeRolling:
 				recreate := recreate.NewRecreateDeploymentStrategy(client, oclient, kapi.Codecs.UniversalDecoder())
 				return rolling.NewRollingDeploymentStrategy(config.Namespace, client, oclient, kapi.Codecs.UniversalDecoder(), recreate), nil
+			case deployapi.DeploymentStrategyTypeBlueGreen:
+				return bluegreen.NewBlueGreenDeploymentStrategy(client, oclient, kapi.Codecs.UniversalDecoder()), nil
+			case deployapi.DeploymentStrategyTypeCanary:
+				recreate := recreate.NewRecreateDeploymentStrategy(client, oclient, kapi.Codecs.UniversalDecoder())
+				return canary.NewCanaryDeploymentStrategy(client, oclient, kapi.Codecs.UniversalDecoder(), recreate), nil
 			default:
 				return nil, fmt.Errorf("unsupported strategy type: %s", config.Spec.Strategy.Type)
 			}
@@ -114,6 +139,14 @@ func NewDeployer(client kclient.Interface, oclient client.Interface) *Deployer {
 // the last complete deployment.
 // 4. Pass the last completed deployment and the new deployment to a strategy
 // to perform the deployment.
+// 5. If the strategy succeeds and the strategy of the config has a
+// Verification, verify the new deployment, failing it on a breach.
+// 6. If the strategy or the verification fails and the strategy of the config
+// has AutoRollback set, scale the last completed deployment back to its
+// replica count, switch the service of a BlueGreen strategy back to it once
+// its pods are ready, and scale the new deployment down.
+// 7. Record the start and completion times, the replica count of the last
+// completed deployment and any failure on the new deployment.
 type Deployer struct {
 	// strategyFor returns a DeploymentStrategy for config.
 	strategyFor func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error)
@@ -121,8 +154,17 @@ type Deployer struct {
 	getDeployment func(namespace, name string) (*kapi.ReplicationController, error)
 	// getDeployments finds all deployments associated with a config.
 	getDeployments func(namespace, configName string) (*kapi.ReplicationControllerList, error)
+	// updateDeployment updates a deployment.
+	updateDeployment func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error)
 	// scaler is used to scale replication controllers.
 	scaler kubectl.Scaler
+	// verify runs the verification phase of a deployment.
+	verify func(verification *deployapi.DeploymentVerification, deployment *kapi.ReplicationController) error
+	// switchService points the selector of the named service at the pods of
+	// the named deployment.
+	switchService func(namespace, serviceName, deploymentName string) error
+	// waitForReady waits for the pods of a deployment to become ready.
+	waitForReady func(deployment *kapi.ReplicationController, timeout time.Duration) error
 }
 
 // Deploy starts the deployment process for deploymentName.
@@ -201,5 +243,92 @@ func (d *Deployer) Deploy(namespace, deploymentName string) error {
 	} else {
 		glog.Infof("Deploying from %s to %s (replicas: %d)", deployutil.LabelForDeployment(from), deployutil.LabelForDeployment(to), desiredReplicas)
 	}
-	return strategy.Deploy(from, to, desiredReplicas)
+	started := time.Now()
+	var fromReplicas int
+	if from != nil {
+		fromReplicas = from.Spec.Replicas
+	}
+	err = strategy.Deploy(from, to, desiredReplicas)
+	if err == nil && config.Spec.Strategy.Verification != nil {
+		err = d.verify(config.Spec.Strategy.Verification, to)
+	}
+	rolledBack := false
+	if err != nil && config.Spec.Strategy.AutoRollback && from != nil {
+		rolledBack = d.rollback(config, from, to, fromReplicas, err)
+	}
+
+	// Record the outcome for the deployment history.
+	annotations := map[string]string{
+		deployapi.DeploymentStartedAtAnnotation:   started.UTC().Format(time.RFC3339),
+		deployapi.DeploymentCompletedAtAnnotation: time.Now().UTC().Format(time.RFC3339),
+	}
+	if from != nil {
+		annotations[deployapi.DeploymentPreviousReplicasAnnotation] = strconv.Itoa(fromReplicas)
+	}
+	if err != nil {
+		annotations[deployapi.DeploymentStatusReasonAnnotation] = err.Error()
+	}
+	if rolledBack {
+		annotations[deployapi.DeploymentRolledBackAnnotation] = err.Error()
+	}
+	d.record(to, annotations)
+	return err
+}
+
+// rollback scales to down and from back to replicas after to failed with
+// reason. The service of a BlueGreen strategy is switched back to from once
+// its pods are ready, to keeps serving if they aren't. It returns whether from
+// was restored; errors are logged, the deployment fails with reason in any
+// case.
+func (d *Deployer) rollback(config *deployapi.DeploymentConfig, from, to *kapi.ReplicationController, replicas int, reason error) bool {
+	glog.Infof("Rolling back to %s (replicas: %d) after a failure: %v", deployutil.LabelForDeployment(from), replicas, reason)
+	retryWaitParams := kubectl.NewRetryParams(1*time.Second, 120*time.Second)
+	if err := d.scaler.Scale(from.Namespace, from.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retryWaitParams, retryWaitParams); err != nil {
+		glog.Errorf("Couldn't scale %s back to %d: %v", deployutil.LabelForDeployment(from), replicas, err)
+		return false
+	}
+	if params := config.Spec.Strategy.BlueGreenParams; config.Spec.Strategy.Type == deployapi.DeploymentStrategyTypeBlueGreen && params != nil {
+		timeout := deployapi.DefaultRollingTimeoutSeconds
+		if params.TimeoutSeconds != nil {
+			timeout = *params.TimeoutSeconds
+		}
+		if replicas > 0 {
+			if err := d.waitForReady(from, time.Duration(timeout)*time.Second); err != nil {
+				glog.Errorf("Couldn't switch service %s back to %s: %v", params.ServiceName, deployutil.LabelForDeployment(from), err)
+				return false
+			}
+		}
+		if err := d.switchService(from.Namespace, params.ServiceName, from.Name); err != nil {
+			glog.Errorf("Couldn't switch service %s back to %s: %v", params.ServiceName, deployutil.LabelForDeployment(from), err)
+			return false
+		}
+		glog.Infof("Switched service %s back to %s", params.ServiceName, deployutil.LabelForDeployment(from))
+	}
+	if err := d.scaler.Scale(to.Namespace, to.Name, uint(0), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retryWaitParams, retryWaitParams); err != nil {
+		glog.Errorf("Couldn't scale %s to 0: %v", deployutil.LabelForDeployment(to), err)
+	}
+	glog.Infof("Rolled back to %s", deployutil.LabelForDeployment(from))
+	return true
+}
+
+// record sets annotations on deployment. A status reason which is already set,
+// e.g. by a cancellation, is kept. Errors are logged.
+func (d *Deployer) record(deployment *kapi.ReplicationController, annotations map[string]string) {
+	err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		current, err := d.getDeployment(deployment.Namespace, deployment.Name)
+		if err != nil {
+			return err
+		}
+		for k, v := range annotations {
+			if _, ok := current.Annotations[k]; ok && k == deployapi.DeploymentStatusReasonAnnotation {
+				continue
+			}
+			current.Annotations[k] = v
+		}
+		_, err = d.updateDeployment(current.Namespace, current)
+		return err
+	})
+	if err != nil {
+		glog.Errorf("Couldn't record the outcome of %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 
@@ -155,6 +156,9 @@ func TestDeployer_deployScenarios(t *testing.T) {
 				}
 				return list, nil
 			},
+			updateDeployment: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				return deployment, nil
+			},
 			scaler: scaler,
 		}
 
@@ -193,6 +197,257 @@ func TestDeployer_deployScenarios(t *testing.T) {
 	}
 }
 
+func TestDeployer_autoRollback(t *testing.T) {
+	for _, autoRollback := range []bool{false, true} {
+		t.Logf("executing with autoRollback=%t", autoRollback)
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy.AutoRollback = autoRollback
+		from, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		from.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+		from.Spec.Replicas = 3
+		to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		to.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+		to.Annotations[deployapi.DesiredReplicasAnnotation] = "3"
+
+		var updated *kapi.ReplicationController
+		scaler := &scalertest.FakeScaler{}
+		deployer := &Deployer{
+			strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
+				return &testStrategy{
+					deployFunc: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+						return fmt.Errorf("pods took too long to become ready")
+					},
+				}, nil
+			},
+			getDeployment: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return to, nil
+			},
+			getDeployments: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*from, *to}}, nil
+			},
+			updateDeployment: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				updated = deployment
+				return deployment, nil
+			},
+			scaler: scaler,
+		}
+
+		if err := deployer.Deploy(to.Namespace, to.Name); err == nil {
+			t.Fatalf("expected an error")
+		}
+
+		if updated == nil {
+			t.Fatalf("expected the outcome to be recorded")
+		}
+		if e, a := "pods took too long to become ready", updated.Annotations[deployapi.DeploymentStatusReasonAnnotation]; e != a {
+			t.Fatalf("expected status reason %q, got %q", e, a)
+		}
+		if e, a := "3", updated.Annotations[deployapi.DeploymentPreviousReplicasAnnotation]; e != a {
+			t.Fatalf("expected previous replicas %q, got %q", e, a)
+		}
+		if _, ok := updated.Annotations[deployapi.DeploymentCompletedAtAnnotation]; !ok {
+			t.Fatalf("expected the completion time to be recorded")
+		}
+
+		if !autoRollback {
+			if len(scaler.Events) > 0 {
+				t.Fatalf("unexpected rollback: %v", scaler.Events)
+			}
+			if _, ok := updated.Annotations[deployapi.DeploymentRolledBackAnnotation]; ok {
+				t.Fatalf("unexpected rollback annotation")
+			}
+			continue
+		}
+		expected := []scalertest.ScaleEvent{{Name: from.Name, Size: 3}, {Name: to.Name, Size: 0}}
+		if e, a := fmt.Sprintf("%v", expected), fmt.Sprintf("%v", scaler.Events); e != a {
+			t.Fatalf("expected scale events %s, got %s", e, a)
+		}
+		if e, a := "pods took too long to become ready", updated.Annotations[deployapi.DeploymentRolledBackAnnotation]; e != a {
+			t.Fatalf("expected rollback reason %q, got %q", e, a)
+		}
+	}
+}
+
+func TestDeployer_autoRollbackBlueGreen(t *testing.T) {
+	for _, ready := range []bool{true, false} {
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy = deployapi.DeploymentStrategy{
+			Type:            deployapi.DeploymentStrategyTypeBlueGreen,
+			BlueGreenParams: &deployapi.BlueGreenDeploymentStrategyParams{ServiceName: "frontend"},
+			AutoRollback:    true,
+		}
+		from, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		from.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+		from.Spec.Replicas = 3
+		to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		to.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+		to.Annotations[deployapi.DesiredReplicasAnnotation] = "3"
+
+		var updated *kapi.ReplicationController
+		switched := ""
+		scaler := &scalertest.FakeScaler{}
+		deployer := &Deployer{
+			strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
+				return &testStrategy{
+					deployFunc: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+						return fmt.Errorf("couldn't scale down the old deployment")
+					},
+				}, nil
+			},
+			getDeployment: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return to, nil
+			},
+			getDeployments: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*from, *to}}, nil
+			},
+			updateDeployment: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				updated = deployment
+				return deployment, nil
+			},
+			scaler: scaler,
+			waitForReady: func(deployment *kapi.ReplicationController, timeout time.Duration) error {
+				if deployment.Name != from.Name {
+					t.Errorf("ready=%t: expected to wait for %s, got %s", ready, from.Name, deployment.Name)
+				}
+				if !ready {
+					return fmt.Errorf("pods took too long to become ready")
+				}
+				return nil
+			},
+			switchService: func(namespace, serviceName, deploymentName string) error {
+				if e, a := fmt.Sprintf("%v", []scalertest.ScaleEvent{{Name: from.Name, Size: 3}}), fmt.Sprintf("%v", scaler.Events); e != a {
+					t.Errorf("ready=%t: expected scale events %s before the switch, got %s", ready, e, a)
+				}
+				switched = serviceName + "=" + deploymentName
+				return nil
+			},
+		}
+
+		if err := deployer.Deploy(to.Namespace, to.Name); err == nil {
+			t.Fatalf("ready=%t: expected an error", ready)
+		}
+
+		if !ready {
+			if len(switched) > 0 {
+				t.Errorf("ready=%t: unexpected switch to %s", ready, switched)
+			}
+			if e, a := fmt.Sprintf("%v", []scalertest.ScaleEvent{{Name: from.Name, Size: 3}}), fmt.Sprintf("%v", scaler.Events); e != a {
+				t.Errorf("ready=%t: expected scale events %s, got %s", ready, e, a)
+			}
+			if _, ok := updated.Annotations[deployapi.DeploymentRolledBackAnnotation]; ok {
+				t.Errorf("ready=%t: unexpected rollback annotation", ready)
+			}
+			continue
+		}
+		if e, a := "frontend="+from.Name, switched; e != a {
+			t.Errorf("ready=%t: expected the service switched to %s, got %s", ready, e, a)
+		}
+		expected := []scalertest.ScaleEvent{{Name: from.Name, Size: 3}, {Name: to.Name, Size: 0}}
+		if e, a := fmt.Sprintf("%v", expected), fmt.Sprintf("%v", scaler.Events); e != a {
+			t.Errorf("ready=%t: expected scale events %s, got %s", ready, e, a)
+		}
+		if _, ok := updated.Annotations[deployapi.DeploymentRolledBackAnnotation]; !ok {
+			t.Errorf("ready=%t: expected the rollback to be recorded", ready)
+		}
+	}
+}
+
+func TestDeployer_verification(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategyErr error
+		verifyErr   error
+		expectedErr string
+		verified    bool
+	}{
+		{
+			name:     "verified",
+			verified: true,
+		},
+		{
+			name:        "breach",
+			verifyErr:   fmt.Errorf("verification failed: metric value 2 is above the maximum 1"),
+			expectedErr: "verification failed: metric value 2 is above the maximum 1",
+			verified:    true,
+		},
+		{
+			name:        "strategy failure",
+			strategyErr: fmt.Errorf("pods took too long to become ready"),
+			expectedErr: "pods took too long to become ready",
+		},
+	}
+
+	for _, test := range tests {
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy.AutoRollback = true
+		config.Spec.Strategy.Verification = &deployapi.DeploymentVerification{
+			HTTPGet:  &deployapi.HTTPGetMetric{URL: "http://metrics/errors"},
+			MaxValue: "1",
+		}
+		from, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		from.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+		from.Spec.Replicas = 3
+		to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		to.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+		to.Annotations[deployapi.DesiredReplicasAnnotation] = "3"
+
+		var updated *kapi.ReplicationController
+		verified := false
+		scaler := &scalertest.FakeScaler{}
+		deployer := &Deployer{
+			strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
+				return &testStrategy{
+					deployFunc: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+						return test.strategyErr
+					},
+				}, nil
+			},
+			getDeployment: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return to, nil
+			},
+			getDeployments: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*from, *to}}, nil
+			},
+			updateDeployment: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				updated = deployment
+				return deployment, nil
+			},
+			scaler: scaler,
+			verify: func(verification *deployapi.DeploymentVerification, deployment *kapi.ReplicationController) error {
+				verified = true
+				if deployment.Name != to.Name {
+					t.Errorf("%s: expected to verify %s, got %s", test.name, to.Name, deployment.Name)
+				}
+				return test.verifyErr
+			},
+		}
+
+		err := deployer.Deploy(to.Namespace, to.Name)
+		if verified != test.verified {
+			t.Errorf("%s: expected verified %t, got %t", test.name, test.verified, verified)
+		}
+		if len(test.expectedErr) == 0 {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			if len(scaler.Events) > 0 {
+				t.Errorf("%s: unexpected rollback: %v", test.name, scaler.Events)
+			}
+			continue
+		}
+		if err == nil || err.Error() != test.expectedErr {
+			t.Errorf("%s: expected error %q, got %v", test.name, test.expectedErr, err)
+		}
+		if updated == nil || updated.Annotations[deployapi.DeploymentRolledBackAnnotation] != test.expectedErr {
+			t.Errorf("%s: expected the rollback to be recorded", test.name)
+		}
+		expected := []scalertest.ScaleEvent{{Name: from.Name, Size: 3}, {Name: to.Name, Size: 0}}
+		if e, a := fmt.Sprintf("%v", expected), fmt.Sprintf("%v", scaler.Events); e != a {
+			t.Errorf("%s: expected scale events %s, got %s", test.name, e, a)
+		}
+	}
+}
+
 func mkdeployment(version int, status deployapi.DeploymentStatus) *kapi.ReplicationController {
 	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
 	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
@@ -157,6 +157,14 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 		refs = append(refs, &config.EtcdConfig.StorageDir)
 	}
 
+	if config.BuildLogArchiveConfig != nil {
+		refs = append(refs, &config.BuildLogArchiveConfig.Directory)
+	}
+
+	if config.BuildNotificationConfig != nil && config.BuildNotificationConfig.SMTP != nil {
+		refs = append(refs, GetStringSourceFileReferences(&config.BuildNotificationConfig.SMTP.Password)...)
+	}
+
 	if config.OAuthConfig != nil {
 
 		if config.OAuthConfig.MasterCA != nil {
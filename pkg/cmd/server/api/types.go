@@ -255,6 +255,11 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig
+	// BuildLogArchiveConfig, if present, archives the logs of completed builds so that they can be
+	// retrieved after their build pods are deleted
+	BuildLogArchiveConfig *BuildLogArchiveConfig
+	// BuildNotificationConfig, if present, configures the delivery of the notifications of build results
+	BuildNotificationConfig *BuildNotificationConfig
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig
@@ -281,6 +286,35 @@ type MasterConfig struct {
 	NetworkConfig MasterNetworkConfig
 }
 
+type BuildLogArchiveConfig struct {
+	// Directory is the directory on the master the build logs are archived to. It may be the mount
+	// point of a persistent volume, and must be shared by all masters running build controllers
+	// or serving the API.
+	Directory string
+}
+
+type BuildNotificationConfig struct {
+	// SMTP is the mail server email notifications are sent through. Email notifications are not
+	// delivered if it is not set.
+	SMTP *SMTPConfig
+	// TargetNetworkCIDRs controls which addresses the webhook, Slack and commit status targets of builds
+	// may be reached at. Loopback, link-local and private addresses are refused unless one of the CIDRs
+	// contains them. If a CIDR is prefixed with !, addresses in that CIDR are refused. Rejections are
+	// applied first.
+	TargetNetworkCIDRs []string
+}
+
+type SMTPConfig struct {
+	// Address is the host:port of the mail server.
+	Address string
+	// From is the sender address of the emails.
+	From string
+	// Username, if set, authenticates to the mail server.
+	Username string
+	// Password is the password of Username.
+	Password StringSource
+}
+
 type ImagePolicyConfig struct {
 	// MaxImagesBulkImportedPerRepository controls the number of images that are imported when a user
 	// does a bulk import of a Docker repository. This number is set low to prevent users from
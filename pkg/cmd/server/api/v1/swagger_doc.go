@@ -95,6 +95,25 @@ func (BasicAuthPasswordIdentityProvider) SwaggerDoc() map[string]string {
 	return map_BasicAuthPasswordIdentityProvider
 }
 
+var map_BuildLogArchiveConfig = map[string]string{
+	"":          "BuildLogArchiveConfig holds the necessary configuration options for archiving build logs",
+	"directory": "Directory is the directory on the master the build logs are archived to. It may be the mount point of a persistent volume, and must be shared by all masters running build controllers or serving the API.",
+}
+
+func (BuildLogArchiveConfig) SwaggerDoc() map[string]string {
+	return map_BuildLogArchiveConfig
+}
+
+var map_BuildNotificationConfig = map[string]string{
+	"":                   "BuildNotificationConfig holds the necessary configuration options for delivering build notifications",
+	"smtp":               "SMTP is the mail server email notifications are sent through. Email notifications are not delivered if it is not set.",
+	"targetNetworkCIDRs": "TargetNetworkCIDRs controls which addresses the webhook, Slack and commit status targets of builds may be reached at. Loopback, link-local and private addresses are refused unless one of the CIDRs contains them. If a CIDR is prefixed with !, addresses in that CIDR are refused. Rejections are applied first.",
+}
+
+func (BuildNotificationConfig) SwaggerDoc() map[string]string {
+	return map_BuildNotificationConfig
+}
+
 var map_CertInfo = map[string]string{
 	"":         "CertInfo relates a certificate with a private key",
 	"certFile": "CertFile is a file containing a PEM-encoded certificate",
@@ -382,32 +401,34 @@ func (MasterClients) SwaggerDoc() map[string]string {
 }
 
 var map_MasterConfig = map[string]string{
-	"":                       "MasterConfig holds the necessary configuration options for the OpenShift master",
-	"servingInfo":            "ServingInfo describes how to start serving",
-	"corsAllowedOrigins":     "CORSAllowedOrigins",
-	"apiLevels":              "APILevels is a list of API levels that should be enabled on startup: v1beta3 and v1 as examples",
-	"masterPublicURL":        "MasterPublicURL is how clients can access the OpenShift API server",
-	"controllers":            "Controllers is a list of the controllers that should be started. If set to \"none\", no controllers will start automatically. The default value is \"*\" which will start all controllers. When using \"*\", you may exclude controllers by prepending a \"-\" in front of their name. No other values are recognized at this time.",
-	"pauseControllers":       "PauseControllers instructs the master to not automatically start controllers, but instead to wait until a notification to the server is received before launching them.",
-	"controllerLeaseTTL":     "ControllerLeaseTTL enables controller election, instructing the master to attempt to acquire a lease before controllers start and renewing it within a number of seconds defined by this value. Setting this value non-negative forces pauseControllers=true. This value defaults off (0, or omitted) and controller election can be disabled with -1.",
-	"admissionConfig":        "AdmissionConfig contains admission control plugin configuration.",
-	"disabledFeatures":       "DisabledFeatures is a list of features that should not be started.  We omitempty here because its very unlikely that anyone will want to manually disable features and we don't want to encourage it.",
-	"etcdStorageConfig":      "EtcdStorageConfig contains information about how API resources are stored in Etcd. These values are only relevant when etcd is the backing store for the cluster.",
-	"etcdClientInfo":         "EtcdClientInfo contains information about how to connect to etcd",
-	"kubeletClientInfo":      "KubeletClientInfo contains information about how to connect to kubelets",
-	"kubernetesMasterConfig": "KubernetesMasterConfig, if present start the kubernetes master in this process",
-	"etcdConfig":             "EtcdConfig, if present start etcd in this process",
-	"oauthConfig":            "OAuthConfig, if present start the /oauth endpoint in this process",
-	"assetConfig":            "AssetConfig, if present start the asset server in this process",
-	"dnsConfig":              "DNSConfig, if present start the DNS server in this process",
-	"serviceAccountConfig":   "ServiceAccountConfig holds options related to service accounts",
-	"masterClients":          "MasterClients holds all the client connection information for controllers and other system components",
-	"imageConfig":            "ImageConfig holds options that describe how to build image names for system components",
-	"imagePolicyConfig":      "ImagePolicyConfig controls limits and behavior for importing images",
-	"policyConfig":           "PolicyConfig holds information about where to locate critical pieces of bootstrapping policy",
-	"projectConfig":          "ProjectConfig holds information about project creation and defaults",
-	"routingConfig":          "RoutingConfig holds information about routing and route generation",
-	"networkConfig":          "NetworkConfig to be passed to the compiled in network plugin",
+	"":                        "MasterConfig holds the necessary configuration options for the OpenShift master",
+	"servingInfo":             "ServingInfo describes how to start serving",
+	"corsAllowedOrigins":      "CORSAllowedOrigins",
+	"apiLevels":               "APILevels is a list of API levels that should be enabled on startup: v1beta3 and v1 as examples",
+	"masterPublicURL":         "MasterPublicURL is how clients can access the OpenShift API server",
+	"controllers":             "Controllers is a list of the controllers that should be started. If set to \"none\", no controllers will start automatically. The default value is \"*\" which will start all controllers. When using \"*\", you may exclude controllers by prepending a \"-\" in front of their name. No other values are recognized at this time.",
+	"pauseControllers":        "PauseControllers instructs the master to not automatically start controllers, but instead to wait until a notification to the server is received before launching them.",
+	"controllerLeaseTTL":      "ControllerLeaseTTL enables controller election, instructing the master to attempt to acquire a lease before controllers start and renewing it within a number of seconds defined by this value. Setting this value non-negative forces pauseControllers=true. This value defaults off (0, or omitted) and controller election can be disabled with -1.",
+	"admissionConfig":         "AdmissionConfig contains admission control plugin configuration.",
+	"disabledFeatures":        "DisabledFeatures is a list of features that should not be started.  We omitempty here because its very unlikely that anyone will want to manually disable features and we don't want to encourage it.",
+	"etcdStorageConfig":       "EtcdStorageConfig contains information about how API resources are stored in Etcd. These values are only relevant when etcd is the backing store for the cluster.",
+	"etcdClientInfo":          "EtcdClientInfo contains information about how to connect to etcd",
+	"kubeletClientInfo":       "KubeletClientInfo contains information about how to connect to kubelets",
+	"kubernetesMasterConfig":  "KubernetesMasterConfig, if present start the kubernetes master in this process",
+	"etcdConfig":              "EtcdConfig, if present start etcd in this process",
+	"oauthConfig":             "OAuthConfig, if present start the /oauth endpoint in this process",
+	"assetConfig":             "AssetConfig, if present start the asset server in this process",
+	"dnsConfig":               "DNSConfig, if present start the DNS server in this process",
+	"buildLogArchiveConfig":   "BuildLogArchiveConfig, if present, archives the logs of completed builds so that they can be retrieved after their build pods are deleted",
+	"buildNotificationConfig": "BuildNotificationConfig, if present, configures the delivery of the notifications of build results",
+	"serviceAccountConfig":    "ServiceAccountConfig holds options related to service accounts",
+	"masterClients":           "MasterClients holds all the client connection information for controllers and other system components",
+	"imageConfig":             "ImageConfig holds options that describe how to build image names for system components",
+	"imagePolicyConfig":       "ImagePolicyConfig controls limits and behavior for importing images",
+	"policyConfig":            "PolicyConfig holds information about where to locate critical pieces of bootstrapping policy",
+	"projectConfig":           "ProjectConfig holds information about project creation and defaults",
+	"routingConfig":           "RoutingConfig holds information about routing and route generation",
+	"networkConfig":           "NetworkConfig to be passed to the compiled in network plugin",
 }
 
 func (MasterConfig) SwaggerDoc() map[string]string {
@@ -636,6 +657,18 @@ func (RoutingConfig) SwaggerDoc() map[string]string {
 	return map_RoutingConfig
 }
 
+var map_SMTPConfig = map[string]string{
+	"":         "SMTPConfig holds the necessary configuration options for sending emails",
+	"address":  "Address is the host:port of the mail server.",
+	"from":     "From is the sender address of the emails.",
+	"username": "Username, if set, authenticates to the mail server.",
+	"password": "Password is the password of Username.",
+}
+
+func (SMTPConfig) SwaggerDoc() map[string]string {
+	return map_SMTPConfig
+}
+
 var map_SecurityAllocator = map[string]string{
 	"":                    "SecurityAllocator controls the automatic allocation of UIDs and MCS labels to a project. If nil, allocation is disabled.",
 	"uidAllocatorRange":   "UIDAllocatorRange defines the total set of Unix user IDs (UIDs) that will be allocated to projects automatically, and the size of the block each namespace gets. For example, 1000-1999/10 will allocate ten UIDs per namespace, and will be able to allocate up to 100 blocks before running out of space. The default is to allocate from 1 billion to 2 billion in 10k blocks (which is the expected size of the ranges Docker images will use once user namespaces are started).",
@@ -195,6 +195,11 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig `json:"assetConfig"`
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig `json:"dnsConfig"`
+	// BuildLogArchiveConfig, if present, archives the logs of completed builds so that they can be
+	// retrieved after their build pods are deleted
+	BuildLogArchiveConfig *BuildLogArchiveConfig `json:"buildLogArchiveConfig"`
+	// BuildNotificationConfig, if present, configures the delivery of the notifications of build results
+	BuildNotificationConfig *BuildNotificationConfig `json:"buildNotificationConfig"`
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig `json:"serviceAccountConfig"`
@@ -221,6 +226,38 @@ type MasterConfig struct {
 	NetworkConfig MasterNetworkConfig `json:"networkConfig"`
 }
 
+// BuildLogArchiveConfig holds the necessary configuration options for archiving build logs
+type BuildLogArchiveConfig struct {
+	// Directory is the directory on the master the build logs are archived to. It may be the mount
+	// point of a persistent volume, and must be shared by all masters running build controllers
+	// or serving the API.
+	Directory string `json:"directory"`
+}
+
+// BuildNotificationConfig holds the necessary configuration options for delivering build notifications
+type BuildNotificationConfig struct {
+	// SMTP is the mail server email notifications are sent through. Email notifications are not
+	// delivered if it is not set.
+	SMTP *SMTPConfig `json:"smtp"`
+	// TargetNetworkCIDRs controls which addresses the webhook, Slack and commit status targets of builds
+	// may be reached at. Loopback, link-local and private addresses are refused unless one of the CIDRs
+	// contains them. If a CIDR is prefixed with !, addresses in that CIDR are refused. Rejections are
+	// applied first.
+	TargetNetworkCIDRs []string `json:"targetNetworkCIDRs"`
+}
+
+// SMTPConfig holds the necessary configuration options for sending emails
+type SMTPConfig struct {
+	// Address is the host:port of the mail server.
+	Address string `json:"address"`
+	// From is the sender address of the emails.
+	From string `json:"from"`
+	// Username, if set, authenticates to the mail server.
+	Username string `json:"username"`
+	// Password is the password of Username.
+	Password StringSource `json:"password"`
+}
+
 // ImagePolicyConfig holds the necessary configuration options for limits and behavior for importing images
 type ImagePolicyConfig struct {
 	// MaxImagesBulkImportedPerRepository controls the number of images that are imported when a user
@@ -102,6 +102,15 @@ assetConfig:
     maxRequestsInFlight: 0
     namedCertificates: null
     requestTimeoutSeconds: 0
+buildLogArchiveConfig:
+  directory: ""
+buildNotificationConfig:
+  smtp:
+    address: ""
+    from: ""
+    password: ""
+    username: ""
+  targetNetworkCIDRs: null
 controllerLeaseTTL: 0
 controllers: ""
 corsAllowedOrigins: null
@@ -629,7 +638,9 @@ func TestMasterConfig(t *testing.T) {
 				PluginOrderOverride: []string{"plugin"}, // explicitly set this field because it's omitempty
 			},
 		},
-		EtcdConfig: &internal.EtcdConfig{},
+		EtcdConfig:              &internal.EtcdConfig{},
+		BuildLogArchiveConfig:   &internal.BuildLogArchiveConfig{},
+		BuildNotificationConfig: &internal.BuildNotificationConfig{SMTP: &internal.SMTPConfig{}},
 		OAuthConfig: &internal.OAuthConfig{
 			IdentityProviders: []internal.IdentityProvider{
 				{Provider: &internal.BasicAuthPasswordIdentityProvider{}},
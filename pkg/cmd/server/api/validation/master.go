@@ -3,6 +3,7 @@ package validation
 import (
 	"fmt"
 	"net"
+	"net/mail"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -106,6 +107,21 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 		}
 	}
 
+	if config.BuildLogArchiveConfig != nil && len(config.BuildLogArchiveConfig.Directory) == 0 {
+		validationResults.AddErrors(field.Required(fldPath.Child("buildLogArchiveConfig", "directory"), ""))
+	}
+
+	if config.BuildNotificationConfig != nil {
+		if config.BuildNotificationConfig.SMTP != nil {
+			validationResults.Append(ValidateSMTPConfig(config.BuildNotificationConfig.SMTP, fldPath.Child("buildNotificationConfig", "smtp")))
+		}
+		for i, s := range config.BuildNotificationConfig.TargetNetworkCIDRs {
+			if _, _, err := net.ParseCIDR(strings.TrimPrefix(s, "!")); err != nil {
+				validationResults.AddErrors(field.Invalid(fldPath.Child("buildNotificationConfig", "targetNetworkCIDRs").Index(i), s, "must be a valid CIDR notation IP range (e.g. 10.0.0.0/8) with an optional leading !"))
+			}
+		}
+	}
+
 	if config.EtcdConfig != nil {
 		etcdConfigErrs := ValidateEtcdConfig(config.EtcdConfig, fldPath.Child("etcdConfig"))
 		validationResults.Append(etcdConfigErrs)
@@ -395,6 +411,20 @@ func ValidateImageConfig(config api.ImageConfig, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+func ValidateSMTPConfig(config *api.SMTPConfig, fldPath *field.Path) ValidationResults {
+	validationResults := ValidationResults{}
+
+	validationResults.AddErrors(ValidateHostPort(config.Address, fldPath.Child("address"))...)
+	if len(config.From) == 0 {
+		validationResults.AddErrors(field.Required(fldPath.Child("from"), ""))
+	} else if _, err := mail.ParseAddress(config.From); err != nil {
+		validationResults.AddErrors(field.Invalid(fldPath.Child("from"), config.From, err.Error()))
+	}
+	validationResults.Append(ValidateStringSource(config.Password, fldPath.Child("password")))
+
+	return validationResults
+}
+
 func ValidateImagePolicyConfig(config api.ImagePolicyConfig, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
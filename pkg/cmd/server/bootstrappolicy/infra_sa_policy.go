@@ -113,20 +113,42 @@ func init() {
 				// Create permission on virtual build type resources allows builds of those types to be updated
 				{
 					Verbs:     sets.NewString("create"),
-					Resources: sets.NewString("builds/docker", "builds/source", "builds/custom"),
+					Resources: sets.NewString("builds/docker", "builds/source", "builds/custom", "builds/pipeline"),
 				},
 				// BuildController.ImageStreamClient (ControllerClient)
+				// PipelineController.ImageStreamClient (ControllerClient)
 				{
-					Verbs:     sets.NewString("get"),
+					Verbs:     sets.NewString("get", "create", "update"),
 					Resources: sets.NewString("imagestreams"),
 				},
+				// PipelineController.BuildConfigInstantiator (OSClientBuildConfigInstantiatorClient)
+				{
+					Verbs:     sets.NewString("create"),
+					Resources: sets.NewString("buildconfigs/instantiate"),
+				},
 				// BuildController.PodManager (ControllerClient)
 				// BuildDeleteController.PodManager (ControllerClient)
+				// PipelineController.PodManager (ControllerClient)
 				// BuildControllerFactory.buildDeleteLW
 				{
 					Verbs:     sets.NewString("get", "list", "create", "delete"),
 					Resources: sets.NewString("pods"),
 				},
+				// BuildLogArchiveController.PodLogs (ControllerClient)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("pods/log"),
+				},
+				// BuildCommitStatusController.Secrets (ControllerClient)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("secrets"),
+				},
+				// BuildCommitStatusController.ServiceAccounts (ControllerClient)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("serviceaccounts"),
+				},
 				// BuildController.Recorder (EventBroadcaster)
 				{
 					Verbs:     sets.NewString("create", "update", "patch"),
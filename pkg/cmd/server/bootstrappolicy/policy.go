@@ -134,6 +134,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 						authorizationapi.DockerBuildResource,
 						authorizationapi.SourceBuildResource,
 						authorizationapi.CustomBuildResource,
+						authorizationapi.PipelineBuildResource,
 						"deploymentconfigs/scale",
 						"imagestreams/secrets",
 					),
@@ -198,6 +199,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 						authorizationapi.DockerBuildResource,
 						authorizationapi.SourceBuildResource,
 						authorizationapi.CustomBuildResource,
+						authorizationapi.PipelineBuildResource,
 						"deploymentconfigs/scale",
 						"imagestreams/secrets",
 					),
@@ -398,6 +400,12 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("update"),
 					Resources: sets.NewString("imagestreamtags"),
 				},
+				{
+					// BlueGreenDeploymentStrategy.getService
+					// BlueGreenDeploymentStrategy.updateService
+					Verbs:     sets.NewString("get", "update"),
+					Resources: sets.NewString("services"),
+				},
 			},
 		},
 		{
@@ -46,6 +46,8 @@ import (
 	policybindingregistry "github.com/openshift/origin/pkg/authorization/registry/policybinding"
 	policybindingetcd "github.com/openshift/origin/pkg/authorization/registry/policybinding/etcd"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	"github.com/openshift/origin/pkg/build/logarchive"
+	"github.com/openshift/origin/pkg/build/notifier"
 	osclient "github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -58,6 +60,7 @@ import (
 	accesstokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken/etcd"
 	projectauth "github.com/openshift/origin/pkg/project/auth"
 	projectcache "github.com/openshift/origin/pkg/project/cache"
+	serviceadmit "github.com/openshift/origin/pkg/service/admission"
 	"github.com/openshift/origin/pkg/serviceaccounts"
 	usercache "github.com/openshift/origin/pkg/user/cache"
 	groupregistry "github.com/openshift/origin/pkg/user/registry/group"
@@ -102,6 +105,16 @@ type MasterConfig struct {
 
 	KubeletClientConfig *kubeletclient.KubeletClientConfig
 
+	// BuildLogArchive stores the logs of completed builds. It is nil unless
+	// BuildLogArchiveConfig is set.
+	BuildLogArchive *logarchive.Archive
+
+	// BuildNotifier sends the notifications of completed builds.
+	BuildNotifier *notifier.Notifier
+	// BuildTargetNetworks restricts the addresses the notification and commit
+	// status targets of builds are reached at.
+	BuildTargetNetworks *notifier.TargetNetworks
+
 	// ClientCAs will be used to request client certificates in connections to the API.
 	// This CertPool should contain all the CAs that will be used for client certificate verification.
 	ClientCAs *x509.CertPool
@@ -214,6 +227,20 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 
 	plug, plugStart := newControllerPlug(options, client)
 
+	var buildLogArchive *logarchive.Archive
+	if options.BuildLogArchiveConfig != nil {
+		buildLogArchive = logarchive.New(options.BuildLogArchiveConfig.Directory)
+	}
+
+	buildTargetNetworks, err := newBuildTargetNetworks(options)
+	if err != nil {
+		return nil, err
+	}
+	buildNotifier, err := newBuildNotifier(options, buildTargetNetworks)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &MasterConfig{
 		Options: options,
 
@@ -239,6 +266,10 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		EtcdHelper:          etcdHelper,
 		KubeletClientConfig: kubeletClientConfig,
 
+		BuildLogArchive:     buildLogArchive,
+		BuildNotifier:       buildNotifier,
+		BuildTargetNetworks: buildTargetNetworks,
+
 		ClientCAs:    clientCAs,
 		APIClientCAs: apiClientCAs,
 
@@ -273,6 +304,38 @@ func newControllerPlug(options configapi.MasterConfig, client *etcdclient.Client
 	}
 }
 
+// newBuildTargetNetworks returns the addresses the notification and commit
+// status targets of builds may be reached at.
+func newBuildTargetNetworks(options configapi.MasterConfig) (*notifier.TargetNetworks, error) {
+	if options.BuildNotificationConfig == nil {
+		return nil, nil
+	}
+	reject, admit, err := serviceadmit.ParseCIDRRules(options.BuildNotificationConfig.TargetNetworkCIDRs)
+	if err != nil {
+		return nil, err
+	}
+	return notifier.NewTargetNetworks(reject, admit), nil
+}
+
+// newBuildNotifier returns the notifier of completed builds, sending emails
+// through the SMTP server of the build notification configuration if any.
+func newBuildNotifier(options configapi.MasterConfig, targets *notifier.TargetNetworks) (*notifier.Notifier, error) {
+	if options.BuildNotificationConfig == nil || options.BuildNotificationConfig.SMTP == nil {
+		return notifier.New(nil, targets), nil
+	}
+	smtp := options.BuildNotificationConfig.SMTP
+	password, err := configapi.ResolveStringValue(smtp.Password)
+	if err != nil {
+		return nil, err
+	}
+	return notifier.New(&notifier.SMTPConfig{
+		Address:  smtp.Address,
+		From:     smtp.From,
+		Username: smtp.Username,
+		Password: password,
+	}, targets), nil
+}
+
 func newServiceAccountTokenGetter(options configapi.MasterConfig, client newetcdclient.Client) (serviceaccount.ServiceAccountTokenGetter, error) {
 	var tokenGetter serviceaccount.ServiceAccountTokenGetter
 	if options.KubernetesMasterConfig == nil {
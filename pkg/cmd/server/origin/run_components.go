@@ -71,6 +71,7 @@ func (c *MasterConfig) RunApplicationController() {
 	factory := applicatioincontroller.ApplicationControllerFactory{
 		Client:     osclient,
 		KubeClient: kclient,
+		Codec:      c.EtcdHelper.Codec(),
 	}
 	controller := factory.Create()
 	controller.Run()
@@ -290,12 +291,28 @@ func (c *MasterConfig) RunBuildController() {
 			// TODO: this will be set to --storage-version (the internal schema we use)
 			Codec: codec,
 		},
+		LogArchive:     c.BuildLogArchive,
+		Notifier:       c.BuildNotifier,
+		TargetNetworks: c.BuildTargetNetworks,
+	}
+	if c.WebConsoleEnabled() {
+		factory.ConsoleURL = c.Options.AssetConfig.PublicURL
 	}
 
 	controller := factory.Create()
 	controller.Run()
 	deleteController := factory.CreateDeleteController()
 	deleteController.Run()
+	pipelineController := factory.CreatePipelineController()
+	pipelineController.Run()
+	notificationController := factory.CreateNotificationController()
+	notificationController.Run()
+	commitStatusController := factory.CreateCommitStatusController()
+	commitStatusController.Run()
+	if c.BuildLogArchive != nil {
+		logArchiveController := factory.CreateLogArchiveController()
+		logArchiveController.Run()
+	}
 }
 
 // RunBuildPodController starts the build/pod status sync loop for build status
@@ -35,6 +35,10 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams
 
 	// Resources contains resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements
@@ -42,6 +46,50 @@ type DeploymentStrategy struct {
 	Labels map[string]string
 	// Annotations is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.
 	Annotations map[string]string
+	// AutoRollback restores the replica count of the last successful deployment when the deployment
+	// fails, either in the strategy or in a hook with the Abort failure policy.
+	AutoRollback bool
+	// Verification is an optional phase which runs after the strategy completed successfully. It
+	// polls a metric of the new deployment and fails the deployment as soon as the metric breaches
+	// one of its thresholds, rolling it back if AutoRollback is set. Not supported by the Custom
+	// strategy.
+	Verification *DeploymentVerification
+}
+
+// DeploymentVerification describes how the verification phase of a deployment measures and judges a
+// metric. Exactly one of Prometheus and HTTPGet must be set. In their URLs and queries, the strings
+// ${DEPLOYMENT_NAME} and ${DEPLOYMENT_NAMESPACE} are replaced with the name and namespace of the
+// deployment.
+type DeploymentVerification struct {
+	// Prometheus measures the metric with a query of a Prometheus-compatible HTTP API.
+	Prometheus *PrometheusQuery
+	// HTTPGet measures the metric with a request of a user endpoint.
+	HTTPGet *HTTPGetMetric
+	// MinValue is the lowest acceptable value of the metric as a decimal number. At least one of
+	// MinValue and MaxValue must be set.
+	MinValue string
+	// MaxValue is the highest acceptable value of the metric as a decimal number.
+	MaxValue string
+	// IntervalSeconds is the time to wait between measurements of the metric.
+	IntervalSeconds *int64
+	// TimeoutSeconds is how long the metric is verified. The deployment fails if the metric couldn't
+	// be measured at all within that time.
+	TimeoutSeconds *int64
+}
+
+// PrometheusQuery is a query of a Prometheus-compatible HTTP API.
+type PrometheusQuery struct {
+	// URL is the base URL of the API, e.g. http://prometheus.monitoring.svc:9090.
+	URL string
+	// Query is the expression to evaluate. It must result in a scalar or in a vector of one sample.
+	Query string
+}
+
+// HTTPGetMetric is a user endpoint which returns the value of a metric.
+type HTTPGetMetric struct {
+	// URL is the URL to get. The response must have a 2xx status and its body must be a decimal
+	// number.
+	URL string
 }
 
 // DeploymentStrategyType refers to a specific DeploymentStrategy implementation.
@@ -54,6 +102,12 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen runs the new deployment alongside the old one and switches
+	// a service over to it once it is ready.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
+	// DeploymentStrategyTypeCanary shifts traffic to the new deployment in steps and promotes it
+	// only while its pods stay healthy.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -83,6 +137,60 @@ type RecreateDeploymentStrategyParams struct {
 	Post *LifecycleHook
 }
 
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for the new deployment to become ready
+	// before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// ServiceName is the name of the service whose selector is switched to the
+	// pods of the new deployment once they are ready. Routes pointing to the
+	// service follow the switch.
+	ServiceName string
+	// HoldSeconds is the time to keep the old deployment running after the
+	// switch, the switch can be reverted instantly during that time. If the
+	// value is nil, a default will be used.
+	HoldSeconds *int64
+	// Pre is a lifecycle hook which is executed before the new deployment is
+	// scaled up. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Post is a lifecycle hook which is executed after the service has been
+	// switched to the new deployment. All LifecycleHookFailurePolicy values are supported.
+	Post *LifecycleHook
+}
+
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy. The traffic is not weighted: it is split in proportion to the
+// number of pods of each deployment, so the share of a step is only
+// approximated with whole pods, e.g. 10% of 3 replicas is 1 pod and a third of
+// the traffic. The deployment config needs at least 2 replicas and each step
+// has to give the new deployment more pods than the previous step.
+type CanaryDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for the pods of a step to become
+	// ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// Steps are the increasing percentages of the replicas, and so of the
+	// traffic of the services and routes selecting the pods of the deployment
+	// config, given to the new deployment before it is promoted. The number
+	// of pods of a step is rounded up. If the value is nil, a default will be
+	// used.
+	Steps []int
+	// BakeSeconds is the time the pods of the new deployment are observed
+	// after each step. The deployment is aborted and the previous deployment
+	// restored if one of them becomes unready or restarts. If the value is nil,
+	// a default will be used.
+	BakeSeconds *int64
+	// Check is an optional hook which is executed in a new pod after each
+	// bake period, the deployment is aborted if it fails.
+	Check *ExecNewPodHook
+	// Pre is a lifecycle hook which is executed before the first step. All
+	// LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Post is a lifecycle hook which is executed after the new deployment has
+	// been promoted. All LifecycleHookFailurePolicy values are supported.
+	Post *LifecycleHook
+}
+
 // LifecycleHook defines a specific deployment lifecycle action. Only one type of action may be specified at any time.
 type LifecycleHook struct {
 	// FailurePolicy specifies what action to take if the hook fails.
@@ -93,6 +201,12 @@ type LifecycleHook struct {
 
 	// TagImages instructs the deployer to tag the current image referenced under a container onto an image stream tag if the deployment succeeds.
 	TagImages []TagImageHook
+
+	// HTTPGet instructs the deployer to make an HTTP request and check the status of the response.
+	HTTPGet *HTTPGetHook
+
+	// TCPSocket instructs the deployer to wait until a TCP address accepts connections.
+	TCPSocket *TCPSocketHook
 }
 
 // LifecycleHookFailurePolicy describes possibles actions to take if a hook fails.
@@ -105,6 +219,11 @@ const (
 	LifecycleHookFailurePolicyAbort LifecycleHookFailurePolicy = "Abort"
 	// LifecycleHookFailurePolicyIgnore means ignore failure and continue the deployment.
 	LifecycleHookFailurePolicyIgnore LifecycleHookFailurePolicy = "Ignore"
+	// LifecycleHookFailurePolicyPause means hold the deployment until it is resumed or cancelled.
+	// It is only supported by the batch hook of the Rolling strategy.
+	// The deployer pod keeps running while the deployment is held and is killed once its
+	// active deadline (MaxDeploymentDurationSeconds, 6 hours) is over, which fails the deployment.
+	LifecycleHookFailurePolicyPause LifecycleHookFailurePolicy = "Pause"
 )
 
 // ExecNewPodHook is a hook implementation which runs a command in a new pod
@@ -131,6 +250,36 @@ type TagImageHook struct {
 	To kapi.ObjectReference
 }
 
+// HTTPGetHook is a hook implementation which makes an HTTP request from the
+// deployer, e.g. to trigger a migration endpoint, and succeeds if the response
+// has the expected status.
+type HTTPGetHook struct {
+	// URL is the absolute http or https URL to request.
+	URL string
+	// Method is the HTTP method of the request. Defaults to GET.
+	Method string
+	// ExpectedStatus is the status code of a successful response. If unset, any
+	// 2xx status is successful.
+	ExpectedStatus int
+	// Retries is the number of times a failed request is retried.
+	Retries int
+	// TimeoutSeconds is the time a single request may take. Defaults to 10 seconds.
+	TimeoutSeconds *int64
+}
+
+// TCPSocketHook is a hook implementation which makes the deployer wait until
+// a TCP address, e.g. of a database the deployment depends on, accepts
+// connections.
+type TCPSocketHook struct {
+	// Host is the host name or IP address to connect to.
+	Host string
+	// Port is the port to connect to.
+	Port int
+	// TimeoutSeconds is the time to wait for the address to accept connections.
+	// Defaults to 120 seconds.
+	TimeoutSeconds *int64
+}
+
 // RollingDeploymentStrategyParams are the input to the Rolling deployment
 // strategy.
 type RollingDeploymentStrategyParams struct {
@@ -173,6 +322,13 @@ type RollingDeploymentStrategyParams struct {
 	// Pre is a lifecycle hook which is executed before the deployment process
 	// begins. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook
+	// Batch is a lifecycle hook which is executed after each batch of new pods
+	// has become ready and before the old deployment is scaled down further.
+	// The names of the ready pods of the new deployment are passed to the hook
+	// in the OPENSHIFT_DEPLOYMENT_NEW_PODS environment variable. The hook is not
+	// executed for the initial deployment. Only execNewPod hooks are supported.
+	// All LifecycleHookFailurePolicy values are supported.
+	Batch *LifecycleHook
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic.
 	Post *LifecycleHook
@@ -185,6 +341,18 @@ const (
 	DefaultRollingIntervalSeconds int64 = 1
 	// DefaultRollingUpdatePeriodSeconds is the default PeriodSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingUpdatePeriodSeconds int64 = 1
+	// DefaultBlueGreenHoldSeconds is the default HoldSeconds for BlueGreenDeploymentStrategyParams.
+	DefaultBlueGreenHoldSeconds int64 = 5 * 60
+	// DefaultCanaryBakeSeconds is the default BakeSeconds for CanaryDeploymentStrategyParams.
+	DefaultCanaryBakeSeconds int64 = 60
+	// DefaultVerificationIntervalSeconds is the default IntervalSeconds for DeploymentVerification.
+	DefaultVerificationIntervalSeconds int64 = 10
+	// DefaultVerificationTimeoutSeconds is the default TimeoutSeconds for DeploymentVerification.
+	DefaultVerificationTimeoutSeconds int64 = 5 * 60
+	// DefaultHTTPGetHookTimeoutSeconds is the default TimeoutSeconds for HTTPGetHook.
+	DefaultHTTPGetHookTimeoutSeconds int64 = 10
+	// DefaultTCPSocketHookTimeoutSeconds is the default TimeoutSeconds for TCPSocketHook.
+	DefaultTCPSocketHookTimeoutSeconds int64 = 2 * 60
 )
 
 // These constants represent keys used for correlating objects related to deployments.
@@ -236,12 +404,44 @@ const (
 	// DeploymentReplicasAnnotation is for internal use only and is for
 	// detecting external modifications to deployment replica counts.
 	DeploymentReplicasAnnotation = "openshift.io/deployment.replicas"
+	// DeploymentRolledBackAnnotation is set by the deployer on a failed deployment after it
+	// restored the last successful deployment. The annotation value is the reason of the failure.
+	DeploymentRolledBackAnnotation = "openshift.io/deployment.rolled-back"
+	// DeploymentStartedAtAnnotation is set by the deployer to the RFC3339 time at which it
+	// started the deployment.
+	DeploymentStartedAtAnnotation = "openshift.io/deployment.started-at"
+	// DeploymentCompletedAtAnnotation is set by the deployer to the RFC3339 time at which the
+	// strategy completed or failed.
+	DeploymentCompletedAtAnnotation = "openshift.io/deployment.completed-at"
+	// DeploymentPreviousReplicasAnnotation is set by the deployer to the replica count of the
+	// last completed deployment at the time the deployment started.
+	DeploymentPreviousReplicasAnnotation = "openshift.io/deployment.previous-replicas"
+	// DeploymentHookAnnotation is set on hook pods to the label of the hook (e.g. hook-pre).
+	DeploymentHookAnnotation = "openshift.io/deployment.hook"
+	// DeploymentPausedAnnotation is set by the deployer on a deployment whose rollout is held
+	// after a failed batch hook with the Pause failure policy. The annotation value is the
+	// reason of the failure; removing the annotation resumes the rollout.
+	DeploymentPausedAnnotation = "openshift.io/deployment.paused"
+	// DeploymentWindowOverrideAnnotation is set on a deployment config by clients which force a new
+	// version to deploy outside of the deployment windows. The annotation value is the forced
+	// LatestVersion.
+	DeploymentWindowOverrideAnnotation = "openshift.io/deployment.window-override"
+	// DeploymentImageChangePendingAnnotation is set on a deployment config by the image change
+	// controller while it waits for ImageChangeDebounceSeconds to pass. The annotation value is the
+	// RFC3339 time at which the first pending image change was detected.
+	DeploymentImageChangePendingAnnotation = "openshift.io/deployment.image-change-pending"
 	// PostHookPodSuffix is the suffix added to all pre hook pods
 	PreHookPodSuffix = "hook-pre"
 	// PostHookPodSuffix is the suffix added to all mid hook pods
 	MidHookPodSuffix = "hook-mid"
 	// PostHookPodSuffix is the suffix added to all post hook pods
 	PostHookPodSuffix = "hook-post"
+	// CanaryCheckHookPodSuffix is the prefix of the suffix added to canary check hook pods, it is
+	// followed by the percentage of the step
+	CanaryCheckHookPodSuffix = "hook-canary"
+	// BatchHookPodSuffix is the prefix of the suffix added to rolling batch hook pods, it is
+	// followed by the number of the batch
+	BatchHookPodSuffix = "hook-batch"
 )
 
 // These constants represent the various reasons for cancelling a deployment
@@ -251,6 +451,7 @@ const (
 	DeploymentCancelledNewerDeploymentExists  = "cancelled as a newer deployment was found running"
 	DeploymentFailedUnrelatedDeploymentExists = "unrelated pod with the same name as this deployment is already running"
 	DeploymentFailedDeployerPodNoLongerExists = "deployer pod no longer exists"
+	DeploymentRolledBackByUser                = "switched back to the previous deployment by the user"
 )
 
 // MaxDeploymentDurationSeconds represents the maximum duration that a deployment is allowed to run
@@ -295,6 +496,21 @@ type DeploymentConfigSpec struct {
 	// or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.
 	Test bool
 
+	// Paused indicates that the deployment config is paused: its triggers do not start new deployments,
+	// so several changes can be made to it and rolled out at once when it is resumed.
+	Paused bool
+
+	// Windows restricts new deployments to recurring deployment windows. Outside of the windows a new
+	// version, whether it comes from a trigger or from a client, is deferred until the next window
+	// opens unless it was forced. If empty, deployments may start at any time.
+	Windows []DeploymentWindow
+
+	// ImageChangeDebounceSeconds is how long to wait after an image change trigger detects a new image
+	// for further image changes before starting a deployment, so that images pushed close together
+	// (e.g. for the main container and its sidecars) are rolled out in a single deployment. If 0,
+	// new images are deployed immediately.
+	ImageChangeDebounceSeconds int64
+
 	// Selector is a label query over pods that should match the Replicas count.
 	Selector map[string]string
 
@@ -303,6 +519,15 @@ type DeploymentConfigSpec struct {
 	Template *kapi.PodTemplateSpec
 }
 
+// DeploymentWindow is a recurring period of time during which new deployments may start.
+type DeploymentWindow struct {
+	// Schedule is a cron expression with the fields minute, hour, day of month, month and day of
+	// week, evaluated in UTC, which matches the times at which the window opens.
+	Schedule string
+	// DurationMinutes is how long the window stays open after each time it opens.
+	DurationMinutes int
+}
+
 // DeploymentConfigStatus represents the current deployment state.
 type DeploymentConfigStatus struct {
 	// LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig
@@ -311,6 +536,17 @@ type DeploymentConfigStatus struct {
 	// Details are the reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails
+	// LastTriggeredImages are the images last set on the containers of the pod template by image
+	// change triggers.
+	LastTriggeredImages []ContainerImage
+}
+
+// ContainerImage is the image of a container of a pod template.
+type ContainerImage struct {
+	// ContainerName is the name of the container.
+	ContainerName string
+	// Image is the image reference set on the container.
+	Image string
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -335,6 +571,10 @@ const (
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
 )
 
+// DeploymentCauseRollback is not a trigger. It is the type of the cause recorded when a failed
+// deployment was automatically rolled back to the last successful deployment.
+const DeploymentCauseRollback DeploymentTriggerType = "Rollback"
+
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
 type DeploymentTriggerImageChangeParams struct {
 	// Automatic means that the detection of a new tag value should result in a new deployment.
@@ -363,6 +603,8 @@ type DeploymentCause struct {
 	Type DeploymentTriggerType
 	// ImageTrigger contains the image trigger details, if this trigger was fired based on an image change
 	ImageTrigger *DeploymentCauseImageTrigger
+	// Message describes the cause, it is set for Rollback causes.
+	Message string
 }
 
 // DeploymentCauseImageTrigger contains information about a deployment caused by an image trigger
@@ -446,3 +688,52 @@ type DeploymentLogOptions struct {
 	// Version of the deployment for which to view logs.
 	Version *int64
 }
+
+// DeploymentConfigHistory is the history of the deployments of a deployment config.
+type DeploymentConfigHistory struct {
+	unversioned.TypeMeta
+	// ObjectMeta has the name and namespace of the deployment config.
+	kapi.ObjectMeta
+
+	// Deployments are the records of the existing deployments of the config, latest first.
+	Deployments []DeploymentRecord
+}
+
+// DeploymentRecord describes a single deployment of a deployment config.
+type DeploymentRecord struct {
+	// Name is the name of the deployment (a replication controller).
+	Name string
+	// Version is the version of the deployment config the deployment is based on.
+	Version int
+	// Phase is the phase of the deployment.
+	Phase DeploymentStatus
+	// Details are the causes of the deployment.
+	Details *DeploymentDetails
+	// Strategy is the type of the strategy used for the deployment.
+	Strategy DeploymentStrategyType
+	// StartTime is the time at which the deployer started the deployment.
+	StartTime *unversioned.Time
+	// CompletionTime is the time at which the deployment completed or failed.
+	CompletionTime *unversioned.Time
+	// DurationSeconds is the number of seconds between StartTime and CompletionTime.
+	DurationSeconds *int64
+	// PreviousReplicas is the replica count of the previous deployment when the deployment
+	// started.
+	PreviousReplicas *int
+	// Replicas is the replica count the deployment was rolled out to.
+	Replicas int
+	// Hooks are the results of the hook pods run for the deployment.
+	Hooks []DeploymentHookResult
+	// Reason is the reason for the failure or the cancellation of the deployment.
+	Reason string
+}
+
+// DeploymentHookResult is the result of a hook pod run for a deployment.
+type DeploymentHookResult struct {
+	// Name is the label of the hook (e.g. hook-pre, hook-post).
+	Name string
+	// Phase is the phase of the hook pod.
+	Phase kapi.PodPhase
+	// Message is the termination reason and message of a failed hook pod.
+	Message string
+}
@@ -59,6 +59,11 @@ func convert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategy
 			return err
 		}
 	}
+	if in.Batch != nil {
+		if err := s.Convert(&in.Batch, &out.Batch, 0); err != nil {
+			return err
+		}
+	}
 	if in.Post != nil {
 		if err := s.Convert(&in.Post, &out.Post, 0); err != nil {
 			return err
@@ -94,6 +99,11 @@ func convert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategy
 			return err
 		}
 	}
+	if in.Batch != nil {
+		if err := s.Convert(&in.Batch, &out.Batch, 0); err != nil {
+			return err
+		}
+	}
 	if in.Post != nil {
 		if err := s.Convert(&in.Post, &out.Post, 0); err != nil {
 			return err
@@ -124,6 +134,16 @@ func convert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategy
 	return nil
 }
 
+func convert_v1_DeploymentPhase_To_api_DeploymentStatus(in *DeploymentPhase, out *newer.DeploymentStatus, s conversion.Scope) error {
+	*out = newer.DeploymentStatus(*in)
+	return nil
+}
+
+func convert_api_DeploymentStatus_To_v1_DeploymentPhase(in *newer.DeploymentStatus, out *DeploymentPhase, s conversion.Scope) error {
+	*out = DeploymentPhase(*in)
+	return nil
+}
+
 func addConversionFuncs(scheme *runtime.Scheme) {
 	err := scheme.AddConversionFuncs(
 		convert_v1_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
@@ -131,6 +151,9 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 
 		convert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
 		convert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategyParams,
+
+		convert_v1_DeploymentPhase_To_api_DeploymentStatus,
+		convert_api_DeploymentStatus_To_v1_DeploymentPhase,
 	)
 	if err != nil {
 		panic(err)
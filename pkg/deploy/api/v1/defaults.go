@@ -46,6 +46,14 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 					defaultTagImagesHookContainerName(p.Pre, containerName)
 					defaultTagImagesHookContainerName(p.Post, containerName)
 				}
+				if p := obj.Strategy.BlueGreenParams; p != nil {
+					defaultTagImagesHookContainerName(p.Pre, containerName)
+					defaultTagImagesHookContainerName(p.Post, containerName)
+				}
+				if p := obj.Strategy.CanaryParams; p != nil {
+					defaultTagImagesHookContainerName(p.Pre, containerName)
+					defaultTagImagesHookContainerName(p.Post, containerName)
+				}
 			}
 		},
 		func(obj *DeploymentStrategy) {
@@ -69,6 +77,28 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
 			}
 		},
+		func(obj *BlueGreenDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
+			}
+
+			if obj.HoldSeconds == nil {
+				obj.HoldSeconds = mkintp(deployapi.DefaultBlueGreenHoldSeconds)
+			}
+		},
+		func(obj *CanaryDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
+			}
+
+			if obj.Steps == nil {
+				obj.Steps = []int{10, 50}
+			}
+
+			if obj.BakeSeconds == nil {
+				obj.BakeSeconds = mkintp(deployapi.DefaultCanaryBakeSeconds)
+			}
+		},
 		func(obj *RollingDeploymentStrategyParams) {
 			if obj.IntervalSeconds == nil {
 				obj.IntervalSeconds = mkintp(deployapi.DefaultRollingIntervalSeconds)
@@ -231,6 +231,72 @@ func TestDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			original: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type: deployv1.DeploymentStrategyTypeBlueGreen,
+						BlueGreenParams: &deployv1.BlueGreenDeploymentStrategyParams{
+							ServiceName: "frontend",
+						},
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+						},
+					},
+				},
+			},
+			expected: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type: deployv1.DeploymentStrategyTypeBlueGreen,
+						BlueGreenParams: &deployv1.BlueGreenDeploymentStrategyParams{
+							ServiceName:    "frontend",
+							TimeoutSeconds: newInt64(deployapi.DefaultRollingTimeoutSeconds),
+							HoldSeconds:    newInt64(deployapi.DefaultBlueGreenHoldSeconds),
+						},
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+						},
+					},
+				},
+			},
+		},
+		{
+			original: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type:         deployv1.DeploymentStrategyTypeCanary,
+						CanaryParams: &deployv1.CanaryDeploymentStrategyParams{},
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+						},
+					},
+				},
+			},
+			expected: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type: deployv1.DeploymentStrategyTypeCanary,
+						CanaryParams: &deployv1.CanaryDeploymentStrategyParams{
+							TimeoutSeconds: newInt64(deployapi.DefaultRollingTimeoutSeconds),
+							Steps:          []int{10, 50},
+							BakeSeconds:    newInt64(deployapi.DefaultCanaryBakeSeconds),
+						},
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, test := range tests {
@@ -24,6 +24,7 @@ func addKnownTypes(scheme *runtime.Scheme) {
 		&DeploymentConfigRollback{},
 		&DeploymentLog{},
 		&DeploymentLogOptions{},
+		&DeploymentConfigHistory{},
 	)
 }
 
@@ -32,3 +33,4 @@ func (obj *DeploymentConfigList) GetObjectKind() unversioned.ObjectKind     { re
 func (obj *DeploymentConfigRollback) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
 func (obj *DeploymentLog) GetObjectKind() unversioned.ObjectKind            { return &obj.TypeMeta }
 func (obj *DeploymentLogOptions) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
+func (obj *DeploymentConfigHistory) GetObjectKind() unversioned.ObjectKind  { return &obj.TypeMeta }
@@ -5,6 +5,43 @@ package v1
 // by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
 // ==== DO NOT EDIT THIS FILE MANUALLY ====
 
+var map_BlueGreenDeploymentStrategyParams = map[string]string{
+	"":               "BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment strategy.",
+	"timeoutSeconds": "TimeoutSeconds is the time to wait for the new deployment to become ready before giving up. If the value is nil, a default will be used.",
+	"serviceName":    "ServiceName is the name of the service whose selector is switched to the pods of the new deployment once they are ready. Routes pointing to the service follow the switch.",
+	"holdSeconds":    "HoldSeconds is the time to keep the old deployment running after the switch, the switch can be reverted instantly during that time. If the value is nil, a default will be used.",
+	"pre":            "Pre is a lifecycle hook which is executed before the new deployment is scaled up. All LifecycleHookFailurePolicy values are supported.",
+	"post":           "Post is a lifecycle hook which is executed after the service has been switched to the new deployment. All LifecycleHookFailurePolicy values are supported.",
+}
+
+func (BlueGreenDeploymentStrategyParams) SwaggerDoc() map[string]string {
+	return map_BlueGreenDeploymentStrategyParams
+}
+
+var map_CanaryDeploymentStrategyParams = map[string]string{
+	"":               "CanaryDeploymentStrategyParams are the input to the Canary deployment strategy. The traffic is not weighted: it is split in proportion to the number of pods of each deployment, so the share of a step is only approximated with whole pods, e.g. 10% of 3 replicas is 1 pod and a third of the traffic. The deployment config needs at least 2 replicas and each step has to give the new deployment more pods than the previous step.",
+	"timeoutSeconds": "TimeoutSeconds is the time to wait for the pods of a step to become ready before giving up. If the value is nil, a default will be used.",
+	"steps":          "Steps are the increasing percentages of the replicas, and so of the traffic of the services and routes selecting the pods of the deployment config, given to the new deployment before it is promoted. The number of pods of a step is rounded up. If the value is nil, a default will be used.",
+	"bakeSeconds":    "BakeSeconds is the time the pods of the new deployment are observed after each step. The deployment is aborted and the previous deployment restored if one of them becomes unready or restarts. If the value is nil, a default will be used.",
+	"check":          "Check is an optional hook which is executed in a new pod after each bake period, the deployment is aborted if it fails.",
+	"pre":            "Pre is a lifecycle hook which is executed before the first step. All LifecycleHookFailurePolicy values are supported.",
+	"post":           "Post is a lifecycle hook which is executed after the new deployment has been promoted. All LifecycleHookFailurePolicy values are supported.",
+}
+
+func (CanaryDeploymentStrategyParams) SwaggerDoc() map[string]string {
+	return map_CanaryDeploymentStrategyParams
+}
+
+var map_ContainerImage = map[string]string{
+	"":              "ContainerImage is the image of a container of a pod template.",
+	"containerName": "ContainerName is the name of the container.",
+	"image":         "Image is the image reference set on the container.",
+}
+
+func (ContainerImage) SwaggerDoc() map[string]string {
+	return map_ContainerImage
+}
+
 var map_CustomDeploymentStrategyParams = map[string]string{
 	"":            "CustomDeploymentStrategyParams are the input to the Custom deployment strategy.",
 	"image":       "Image specifies a Docker image which can carry out a deployment.",
@@ -20,6 +57,7 @@ var map_DeploymentCause = map[string]string{
 	"":             "DeploymentCause captures information about a particular cause of a deployment.",
 	"type":         "Type of the trigger that resulted in the creation of a new deployment",
 	"imageTrigger": "ImageTrigger contains the image trigger details, if this trigger was fired based on an image change",
+	"message":      "Message describes the cause, it is set for Rollback causes.",
 }
 
 func (DeploymentCause) SwaggerDoc() map[string]string {
@@ -46,6 +84,16 @@ func (DeploymentConfig) SwaggerDoc() map[string]string {
 	return map_DeploymentConfig
 }
 
+var map_DeploymentConfigHistory = map[string]string{
+	"":            "DeploymentConfigHistory is the history of the deployments of a deployment config.",
+	"metadata":    "Standard object's metadata, it has the name and namespace of the deployment config.",
+	"deployments": "Deployments are the records of the existing deployments of the config, latest first.",
+}
+
+func (DeploymentConfigHistory) SwaggerDoc() map[string]string {
+	return map_DeploymentConfigHistory
+}
+
 var map_DeploymentConfigList = map[string]string{
 	"":         "DeploymentConfigList is a collection of deployment configs.",
 	"metadata": "Standard object's metadata.",
@@ -79,13 +127,16 @@ func (DeploymentConfigRollbackSpec) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentConfigSpec = map[string]string{
-	"":         "DeploymentConfigSpec represents the desired state of the deployment.",
-	"strategy": "Strategy describes how a deployment is executed.",
-	"triggers": "Triggers determine how updates to a DeploymentConfig result in new deployments. If no triggers are defined, a new deployment can only occur as a result of an explicit client update to the DeploymentConfig with a new LatestVersion.",
-	"replicas": "Replicas is the number of desired replicas.",
-	"test":     "Test ensures that this deployment config will have zero replicas except while a deployment is running. This allows the deployment config to be used as a continuous deployment test - triggering on images, running the deployment, and then succeeding or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.",
-	"selector": "Selector is a label query over pods that should match the Replicas count.",
-	"template": "Template is the object that describes the pod that will be created if insufficient replicas are detected.",
+	"":                           "DeploymentConfigSpec represents the desired state of the deployment.",
+	"strategy":                   "Strategy describes how a deployment is executed.",
+	"triggers":                   "Triggers determine how updates to a DeploymentConfig result in new deployments. If no triggers are defined, a new deployment can only occur as a result of an explicit client update to the DeploymentConfig with a new LatestVersion.",
+	"replicas":                   "Replicas is the number of desired replicas.",
+	"test":                       "Test ensures that this deployment config will have zero replicas except while a deployment is running. This allows the deployment config to be used as a continuous deployment test - triggering on images, running the deployment, and then succeeding or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.",
+	"paused":                     "Paused indicates that the deployment config is paused: its triggers do not start new deployments, so several changes can be made to it and rolled out at once when it is resumed.",
+	"windows":                    "Windows restricts new deployments to recurring deployment windows. Outside of the windows a new version, whether it comes from a trigger or from a client, is deferred until the next window opens unless it was forced. If empty, deployments may start at any time.",
+	"imageChangeDebounceSeconds": "ImageChangeDebounceSeconds is how long to wait after an image change trigger detects a new image for further image changes before starting a deployment, so that images pushed close together (e.g. for the main container and its sidecars) are rolled out in a single deployment. If 0, new images are deployed immediately.",
+	"selector":                   "Selector is a label query over pods that should match the Replicas count.",
+	"template":                   "Template is the object that describes the pod that will be created if insufficient replicas are detected.",
 }
 
 func (DeploymentConfigSpec) SwaggerDoc() map[string]string {
@@ -93,9 +144,10 @@ func (DeploymentConfigSpec) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentConfigStatus = map[string]string{
-	"":              "DeploymentConfigStatus represents the current deployment state.",
-	"latestVersion": "LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig is out of sync.",
-	"details":       "Details are the reasons for the update to this deployment config. This could be based on a change made by the user or caused by an automatic trigger",
+	"":                    "DeploymentConfigStatus represents the current deployment state.",
+	"latestVersion":       "LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig is out of sync.",
+	"details":             "Details are the reasons for the update to this deployment config. This could be based on a change made by the user or caused by an automatic trigger",
+	"lastTriggeredImages": "LastTriggeredImages are the images last set on the containers of the pod template by image change triggers.",
 }
 
 func (DeploymentConfigStatus) SwaggerDoc() map[string]string {
@@ -112,6 +164,17 @@ func (DeploymentDetails) SwaggerDoc() map[string]string {
 	return map_DeploymentDetails
 }
 
+var map_DeploymentHookResult = map[string]string{
+	"":        "DeploymentHookResult is the result of a hook pod run for a deployment.",
+	"name":    "Name is the label of the hook (e.g. hook-pre, hook-post).",
+	"phase":   "Phase is the phase of the hook pod.",
+	"message": "Message is the termination reason and message of a failed hook pod.",
+}
+
+func (DeploymentHookResult) SwaggerDoc() map[string]string {
+	return map_DeploymentHookResult
+}
+
 var map_DeploymentLog = map[string]string{
 	"": "DeploymentLog represents the logs for a deployment",
 }
@@ -138,15 +201,39 @@ func (DeploymentLogOptions) SwaggerDoc() map[string]string {
 	return map_DeploymentLogOptions
 }
 
+var map_DeploymentRecord = map[string]string{
+	"":                 "DeploymentRecord describes a single deployment of a deployment config.",
+	"name":             "Name is the name of the deployment (a replication controller).",
+	"version":          "Version is the version of the deployment config the deployment is based on.",
+	"phase":            "Phase is the phase of the deployment.",
+	"details":          "Details are the causes of the deployment.",
+	"strategy":         "Strategy is the type of the strategy used for the deployment.",
+	"startTime":        "StartTime is the time at which the deployer started the deployment.",
+	"completionTime":   "CompletionTime is the time at which the deployment completed or failed.",
+	"durationSeconds":  "DurationSeconds is the number of seconds between StartTime and CompletionTime.",
+	"previousReplicas": "PreviousReplicas is the replica count of the previous deployment when the deployment started.",
+	"replicas":         "Replicas is the replica count the deployment was rolled out to.",
+	"hooks":            "Hooks are the results of the hook pods run for the deployment.",
+	"reason":           "Reason is the reason for the failure or the cancellation of the deployment.",
+}
+
+func (DeploymentRecord) SwaggerDoc() map[string]string {
+	return map_DeploymentRecord
+}
+
 var map_DeploymentStrategy = map[string]string{
-	"":               "DeploymentStrategy describes how to perform a deployment.",
-	"type":           "Type is the name of a deployment strategy.",
-	"customParams":   "CustomParams are the input to the Custom deployment strategy.",
-	"recreateParams": "RecreateParams are the input to the Recreate deployment strategy.",
-	"rollingParams":  "RollingParams are the input to the Rolling deployment strategy.",
-	"resources":      "Resources contains resource requirements to execute the deployment and any hooks",
-	"labels":         "Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
-	"annotations":    "Annotations is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
+	"":                "DeploymentStrategy describes how to perform a deployment.",
+	"type":            "Type is the name of a deployment strategy.",
+	"customParams":    "CustomParams are the input to the Custom deployment strategy.",
+	"recreateParams":  "RecreateParams are the input to the Recreate deployment strategy.",
+	"rollingParams":   "RollingParams are the input to the Rolling deployment strategy.",
+	"blueGreenParams": "BlueGreenParams are the input to the BlueGreen deployment strategy.",
+	"canaryParams":    "CanaryParams are the input to the Canary deployment strategy.",
+	"resources":       "Resources contains resource requirements to execute the deployment and any hooks",
+	"labels":          "Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
+	"annotations":     "Annotations is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
+	"autoRollback":    "AutoRollback restores the replica count of the last successful deployment when the deployment fails, either in the strategy or in a hook with the Abort failure policy.",
+	"verification":    "Verification is an optional phase which runs after the strategy completed successfully. It polls a metric of the new deployment and fails the deployment as soon as the metric breaches one of its thresholds, rolling it back if AutoRollback is set. Not supported by the Custom strategy.",
 }
 
 func (DeploymentStrategy) SwaggerDoc() map[string]string {
@@ -175,6 +262,30 @@ func (DeploymentTriggerPolicy) SwaggerDoc() map[string]string {
 	return map_DeploymentTriggerPolicy
 }
 
+var map_DeploymentVerification = map[string]string{
+	"":                "DeploymentVerification describes how the verification phase of a deployment measures and judges a metric. Exactly one of Prometheus and HTTPGet must be set. In their URLs and queries, the strings ${DEPLOYMENT_NAME} and ${DEPLOYMENT_NAMESPACE} are replaced with the name and namespace of the deployment.",
+	"prometheus":      "Prometheus measures the metric with a query of a Prometheus-compatible HTTP API.",
+	"httpGet":         "HTTPGet measures the metric with a request of a user endpoint.",
+	"minValue":        "MinValue is the lowest acceptable value of the metric as a decimal number. At least one of MinValue and MaxValue must be set.",
+	"maxValue":        "MaxValue is the highest acceptable value of the metric as a decimal number.",
+	"intervalSeconds": "IntervalSeconds is the time to wait between measurements of the metric.",
+	"timeoutSeconds":  "TimeoutSeconds is how long the metric is verified. The deployment fails if the metric couldn't be measured at all within that time.",
+}
+
+func (DeploymentVerification) SwaggerDoc() map[string]string {
+	return map_DeploymentVerification
+}
+
+var map_DeploymentWindow = map[string]string{
+	"":                "DeploymentWindow is a recurring period of time during which new deployments may start.",
+	"schedule":        "Schedule is a cron expression with the fields minute, hour, day of month, month and day of week, evaluated in UTC, which matches the times at which the window opens.",
+	"durationMinutes": "DurationMinutes is how long the window stays open after each time it opens.",
+}
+
+func (DeploymentWindow) SwaggerDoc() map[string]string {
+	return map_DeploymentWindow
+}
+
 var map_ExecNewPodHook = map[string]string{
 	"":              "ExecNewPodHook is a hook implementation which runs a command in a new pod based on the specified container which is assumed to be part of the deployment template.",
 	"command":       "Command is the action command and its arguments.",
@@ -187,17 +298,51 @@ func (ExecNewPodHook) SwaggerDoc() map[string]string {
 	return map_ExecNewPodHook
 }
 
+var map_HTTPGetHook = map[string]string{
+	"":               "HTTPGetHook is a hook implementation which makes an HTTP request from the deployer, e.g. to trigger a migration endpoint, and succeeds if the response has the expected status.",
+	"url":            "URL is the absolute http or https URL to request.",
+	"method":         "Method is the HTTP method of the request. Defaults to GET.",
+	"expectedStatus": "ExpectedStatus is the status code of a successful response. If unset, any 2xx status is successful.",
+	"retries":        "Retries is the number of times a failed request is retried.",
+	"timeoutSeconds": "TimeoutSeconds is the time a single request may take. Defaults to 10 seconds.",
+}
+
+func (HTTPGetHook) SwaggerDoc() map[string]string {
+	return map_HTTPGetHook
+}
+
+var map_HTTPGetMetric = map[string]string{
+	"":    "HTTPGetMetric is a user endpoint which returns the value of a metric.",
+	"url": "URL is the URL to get. The response must have a 2xx status and its body must be a decimal number.",
+}
+
+func (HTTPGetMetric) SwaggerDoc() map[string]string {
+	return map_HTTPGetMetric
+}
+
 var map_LifecycleHook = map[string]string{
 	"":              "LifecycleHook defines a specific deployment lifecycle action. Only one type of action may be specified at any time.",
 	"failurePolicy": "FailurePolicy specifies what action to take if the hook fails.",
 	"execNewPod":    "ExecNewPod specifies the options for a lifecycle hook backed by a pod.",
 	"tagImages":     "TagImages instructs the deployer to tag the current image referenced under a container onto an image stream tag.",
+	"httpGet":       "HTTPGet instructs the deployer to make an HTTP request and check the status of the response.",
+	"tcpSocket":     "TCPSocket instructs the deployer to wait until a TCP address accepts connections.",
 }
 
 func (LifecycleHook) SwaggerDoc() map[string]string {
 	return map_LifecycleHook
 }
 
+var map_PrometheusQuery = map[string]string{
+	"":      "PrometheusQuery is a query of a Prometheus-compatible HTTP API.",
+	"url":   "URL is the base URL of the API, e.g. http://prometheus.monitoring.svc:9090.",
+	"query": "Query is the expression to evaluate. It must result in a scalar or in a vector of one sample.",
+}
+
+func (PrometheusQuery) SwaggerDoc() map[string]string {
+	return map_PrometheusQuery
+}
+
 var map_RecreateDeploymentStrategyParams = map[string]string{
 	"":               "RecreateDeploymentStrategyParams are the input to the Recreate deployment strategy.",
 	"timeoutSeconds": "TimeoutSeconds is the time to wait for updates before giving up. If the value is nil, a default will be used.",
@@ -211,7 +356,7 @@ func (RecreateDeploymentStrategyParams) SwaggerDoc() map[string]string {
 }
 
 var map_RollingDeploymentStrategyParams = map[string]string{
-	"": "RollingDeploymentStrategyParams are the input to the Rolling deployment strategy.",
+	"":                    "RollingDeploymentStrategyParams are the input to the Rolling deployment strategy.",
 	"updatePeriodSeconds": "UpdatePeriodSeconds is the time to wait between individual pod updates. If the value is nil, a default will be used.",
 	"intervalSeconds":     "IntervalSeconds is the time to wait between polling deployment status after update. If the value is nil, a default will be used.",
 	"timeoutSeconds":      "TimeoutSeconds is the time to wait for updates before giving up. If the value is nil, a default will be used.",
@@ -219,6 +364,7 @@ var map_RollingDeploymentStrategyParams = map[string]string{
 	"maxSurge":            "MaxSurge is the maximum number of pods that can be scheduled above the original number of pods. Value can be an absolute number (ex: 5) or a percentage of total pods at the start of the update (ex: 10%). Absolute number is calculated from percentage by rounding up.\n\nThis cannot be 0 if MaxUnavailable is 0. By default, 25% is used.\n\nExample: when this is set to 30%, the new RC can be scaled up by 30% immediately when the rolling update starts. Once old pods have been killed, new RC can be scaled up further, ensuring that total number of pods running at any time during the update is atmost 130% of original pods.",
 	"updatePercent":       "UpdatePercent is the percentage of replicas to scale up or down each interval. If nil, one replica will be scaled up and down each interval. If negative, the scale order will be down/up instead of up/down. DEPRECATED: Use MaxUnavailable/MaxSurge instead.",
 	"pre":                 "Pre is a lifecycle hook which is executed before the deployment process begins. All LifecycleHookFailurePolicy values are supported.",
+	"batch":               "Batch is a lifecycle hook which is executed after each batch of new pods has become ready and before the old deployment is scaled down further. The names of the ready pods of the new deployment are passed to the hook in the OPENSHIFT_DEPLOYMENT_NEW_PODS environment variable. The hook is not executed for the initial deployment. Only execNewPod hooks are supported. All LifecycleHookFailurePolicy values are supported.",
 	"post":                "Post is a lifecycle hook which is executed after the strategy has finished all deployment logic. The LifecycleHookFailurePolicyAbort policy is NOT supported.",
 }
 
@@ -226,6 +372,17 @@ func (RollingDeploymentStrategyParams) SwaggerDoc() map[string]string {
 	return map_RollingDeploymentStrategyParams
 }
 
+var map_TCPSocketHook = map[string]string{
+	"":               "TCPSocketHook is a hook implementation which makes the deployer wait until a TCP address, e.g. of a database the deployment depends on, accepts connections.",
+	"host":           "Host is the host name or IP address to connect to.",
+	"port":           "Port is the port to connect to.",
+	"timeoutSeconds": "TimeoutSeconds is the time to wait for the address to accept connections. Defaults to 120 seconds.",
+}
+
+func (TCPSocketHook) SwaggerDoc() map[string]string {
+	return map_TCPSocketHook
+}
+
 var map_TagImageHook = map[string]string{
 	"":              "TagImageHook is a request to tag the image in a particular container onto an ImageStreamTag.",
 	"containerName": "ContainerName is the name of a container in the deployment config whose image value will be used as the source of the tag. If there is only a single container this value will be defaulted to the name of that container.",
@@ -58,6 +58,11 @@ func convert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStr
 			return err
 		}
 	}
+	if in.Batch != nil {
+		if err := s.Convert(&in.Batch, &out.Batch, 0); err != nil {
+			return err
+		}
+	}
 	if in.Post != nil {
 		if err := s.Convert(&in.Post, &out.Post, 0); err != nil {
 			return err
@@ -93,6 +98,11 @@ func convert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStr
 			return err
 		}
 	}
+	if in.Batch != nil {
+		if err := s.Convert(&in.Batch, &out.Batch, 0); err != nil {
+			return err
+		}
+	}
 	if in.Post != nil {
 		if err := s.Convert(&in.Post, &out.Post, 0); err != nil {
 			return err
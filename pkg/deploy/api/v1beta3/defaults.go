@@ -44,6 +44,28 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
 			}
 		},
+		func(obj *BlueGreenDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
+			}
+
+			if obj.HoldSeconds == nil {
+				obj.HoldSeconds = mkintp(deployapi.DefaultBlueGreenHoldSeconds)
+			}
+		},
+		func(obj *CanaryDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
+			}
+
+			if obj.Steps == nil {
+				obj.Steps = []int{10, 50}
+			}
+
+			if obj.BakeSeconds == nil {
+				obj.BakeSeconds = mkintp(deployapi.DefaultCanaryBakeSeconds)
+			}
+		},
 		func(obj *RollingDeploymentStrategyParams) {
 			if obj.IntervalSeconds == nil {
 				obj.IntervalSeconds = mkintp(deployapi.DefaultRollingIntervalSeconds)
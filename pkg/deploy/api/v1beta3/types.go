@@ -35,6 +35,10 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams `json:"recreateParams,omitempty"`
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams `json:"rollingParams,omitempty"`
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams `json:"blueGreenParams,omitempty"`
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams `json:"canaryParams,omitempty"`
 
 	// Compute resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements `json:"resources,omitempty"`
@@ -42,6 +46,50 @@ type DeploymentStrategy struct {
 	Labels map[string]string `json:"labels,omitempty"`
 	// Annotations is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// AutoRollback restores the replica count of the last successful deployment when the deployment
+	// fails, either in the strategy or in a hook with the Abort failure policy.
+	AutoRollback bool `json:"autoRollback,omitempty"`
+	// Verification is an optional phase which runs after the strategy completed successfully. It
+	// polls a metric of the new deployment and fails the deployment as soon as the metric breaches
+	// one of its thresholds, rolling it back if AutoRollback is set. Not supported by the Custom
+	// strategy.
+	Verification *DeploymentVerification `json:"verification,omitempty"`
+}
+
+// DeploymentVerification describes how the verification phase of a deployment measures and judges a
+// metric. Exactly one of Prometheus and HTTPGet must be set. In their URLs and queries, the strings
+// ${DEPLOYMENT_NAME} and ${DEPLOYMENT_NAMESPACE} are replaced with the name and namespace of the
+// deployment.
+type DeploymentVerification struct {
+	// Prometheus measures the metric with a query of a Prometheus-compatible HTTP API.
+	Prometheus *PrometheusQuery `json:"prometheus,omitempty"`
+	// HTTPGet measures the metric with a request of a user endpoint.
+	HTTPGet *HTTPGetMetric `json:"httpGet,omitempty"`
+	// MinValue is the lowest acceptable value of the metric as a decimal number. At least one of
+	// MinValue and MaxValue must be set.
+	MinValue string `json:"minValue,omitempty"`
+	// MaxValue is the highest acceptable value of the metric as a decimal number.
+	MaxValue string `json:"maxValue,omitempty"`
+	// IntervalSeconds is the time to wait between measurements of the metric.
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+	// TimeoutSeconds is how long the metric is verified. The deployment fails if the metric couldn't
+	// be measured at all within that time.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// PrometheusQuery is a query of a Prometheus-compatible HTTP API.
+type PrometheusQuery struct {
+	// URL is the base URL of the API, e.g. http://prometheus.monitoring.svc:9090.
+	URL string `json:"url"`
+	// Query is the expression to evaluate. It must result in a scalar or in a vector of one sample.
+	Query string `json:"query"`
+}
+
+// HTTPGetMetric is a user endpoint which returns the value of a metric.
+type HTTPGetMetric struct {
+	// URL is the URL to get. The response must have a 2xx status and its body must be a decimal
+	// number.
+	URL string `json:"url"`
 }
 
 // DeploymentStrategyType refers to a specific DeploymentStrategy implementation.
@@ -54,6 +102,12 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeBlueGreen runs the new deployment alongside the old one and switches
+	// a service over to it once it is ready.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
+	// DeploymentStrategyTypeCanary shifts traffic to the new deployment in steps and promotes it
+	// only while its pods stay healthy.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomParams are the input to the Custom deployment strategy.
@@ -84,6 +138,60 @@ type RecreateDeploymentStrategyParams struct {
 	Post *LifecycleHook `json:"post,omitempty"`
 }
 
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for the new deployment to become ready
+	// before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// ServiceName is the name of the service whose selector is switched to the
+	// pods of the new deployment once they are ready. Routes pointing to the
+	// service follow the switch.
+	ServiceName string `json:"serviceName"`
+	// HoldSeconds is the time to keep the old deployment running after the
+	// switch, the switch can be reverted instantly during that time. If the
+	// value is nil, a default will be used.
+	HoldSeconds *int64 `json:"holdSeconds,omitempty"`
+	// Pre is a lifecycle hook which is executed before the new deployment is
+	// scaled up. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty"`
+	// Post is a lifecycle hook which is executed after the service has been
+	// switched to the new deployment. All LifecycleHookFailurePolicy values are supported.
+	Post *LifecycleHook `json:"post,omitempty"`
+}
+
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy. The traffic is not weighted: it is split in proportion to the
+// number of pods of each deployment, so the share of a step is only
+// approximated with whole pods, e.g. 10% of 3 replicas is 1 pod and a third of
+// the traffic. The deployment config needs at least 2 replicas and each step
+// has to give the new deployment more pods than the previous step.
+type CanaryDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for the pods of a step to become
+	// ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// Steps are the increasing percentages of the replicas, and so of the
+	// traffic of the services and routes selecting the pods of the deployment
+	// config, given to the new deployment before it is promoted. The number
+	// of pods of a step is rounded up. If the value is nil, a default will be
+	// used.
+	Steps []int `json:"steps,omitempty"`
+	// BakeSeconds is the time the pods of the new deployment are observed
+	// after each step. The deployment is aborted and the previous deployment
+	// restored if one of them becomes unready or restarts. If the value is nil,
+	// a default will be used.
+	BakeSeconds *int64 `json:"bakeSeconds,omitempty"`
+	// Check is an optional hook which is executed in a new pod after each
+	// bake period, the deployment is aborted if it fails.
+	Check *ExecNewPodHook `json:"check,omitempty"`
+	// Pre is a lifecycle hook which is executed before the first step. All
+	// LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty"`
+	// Post is a lifecycle hook which is executed after the new deployment has
+	// been promoted. All LifecycleHookFailurePolicy values are supported.
+	Post *LifecycleHook `json:"post,omitempty"`
+}
+
 // LifecycleHook defines a specific deployment lifecycle action. Only one type of action may be specified at any time.
 type LifecycleHook struct {
 	// FailurePolicy specifies what action to take if the hook fails.
@@ -94,6 +202,12 @@ type LifecycleHook struct {
 
 	// TagImages instructs the deployer to tag the current image referenced under a container onto an image stream tag if the deployment succeeds.
 	TagImages []TagImageHook `json:"tagImages,omitempty"`
+
+	// HTTPGet instructs the deployer to make an HTTP request and check the status of the response.
+	HTTPGet *HTTPGetHook `json:"httpGet,omitempty"`
+
+	// TCPSocket instructs the deployer to wait until a TCP address accepts connections.
+	TCPSocket *TCPSocketHook `json:"tcpSocket,omitempty"`
 }
 
 // HandlerFailurePolicy describes possibles actions to take if a hook fails.
@@ -106,6 +220,11 @@ const (
 	LifecycleHookFailurePolicyAbort LifecycleHookFailurePolicy = "Abort"
 	// LifecycleHookFailurePolicyIgnore means ignore failure and continue the deployment.
 	LifecycleHookFailurePolicyIgnore LifecycleHookFailurePolicy = "Ignore"
+	// LifecycleHookFailurePolicyPause means hold the deployment until it is resumed or cancelled.
+	// It is only supported by the batch hook of the Rolling strategy.
+	// The deployer pod keeps running while the deployment is held and is killed once its
+	// active deadline (MaxDeploymentDurationSeconds, 6 hours) is over, which fails the deployment.
+	LifecycleHookFailurePolicyPause LifecycleHookFailurePolicy = "Pause"
 )
 
 // ExecNewPodHook is a hook implementation which runs a command in a new pod
@@ -133,6 +252,36 @@ type TagImageHook struct {
 	To kapi.ObjectReference `json:"to"`
 }
 
+// HTTPGetHook is a hook implementation which makes an HTTP request from the
+// deployer, e.g. to trigger a migration endpoint, and succeeds if the response
+// has the expected status.
+type HTTPGetHook struct {
+	// URL is the absolute http or https URL to request.
+	URL string `json:"url"`
+	// Method is the HTTP method of the request. Defaults to GET.
+	Method string `json:"method,omitempty"`
+	// ExpectedStatus is the status code of a successful response. If unset, any
+	// 2xx status is successful.
+	ExpectedStatus int `json:"expectedStatus,omitempty"`
+	// Retries is the number of times a failed request is retried.
+	Retries int `json:"retries,omitempty"`
+	// TimeoutSeconds is the time a single request may take. Defaults to 10 seconds.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// TCPSocketHook is a hook implementation which makes the deployer wait until
+// a TCP address, e.g. of a database the deployment depends on, accepts
+// connections.
+type TCPSocketHook struct {
+	// Host is the host name or IP address to connect to.
+	Host string `json:"host"`
+	// Port is the port to connect to.
+	Port int `json:"port"`
+	// TimeoutSeconds is the time to wait for the address to accept connections.
+	// Defaults to 120 seconds.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
 // RollingDeploymentStrategyParams are the input to the Rolling deployment
 // strategy.
 type RollingDeploymentStrategyParams struct {
@@ -179,6 +328,13 @@ type RollingDeploymentStrategyParams struct {
 	// Pre is a lifecycle hook which is executed before the deployment process
 	// begins. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook `json:"pre,omitempty"`
+	// Batch is a lifecycle hook which is executed after each batch of new pods
+	// has become ready and before the old deployment is scaled down further.
+	// The names of the ready pods of the new deployment are passed to the hook
+	// in the OPENSHIFT_DEPLOYMENT_NEW_PODS environment variable. The hook is not
+	// executed for the initial deployment. Only execNewPod hooks are supported.
+	// All LifecycleHookFailurePolicy values are supported.
+	Batch *LifecycleHook `json:"batch,omitempty"`
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -261,6 +417,15 @@ type DeploymentConfig struct {
 }
 
 // DeploymentTemplate contains all the necessary information to create a deployment from a
+// DeploymentWindow is a recurring period of time during which new deployments may start.
+type DeploymentWindow struct {
+	// Schedule is a cron expression with the fields minute, hour, day of month, month and day of
+	// week, evaluated in UTC, which matches the times at which the window opens.
+	Schedule string `json:"schedule"`
+	// DurationMinutes is how long the window stays open after each time it opens.
+	DurationMinutes int `json:"durationMinutes"`
+}
+
 // DeploymentStrategy.
 type DeploymentConfigSpec struct {
 	// Strategy describes how a deployment is executed.
@@ -279,6 +444,21 @@ type DeploymentConfigSpec struct {
 	// or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.
 	Test bool `json:"test"`
 
+	// Paused indicates that the deployment config is paused: its triggers do not start new deployments,
+	// so several changes can be made to it and rolled out at once when it is resumed.
+	Paused bool `json:"paused,omitempty"`
+
+	// Windows restricts new deployments to recurring deployment windows. Outside of the windows a new
+	// version, whether it comes from a trigger or from a client, is deferred until the next window
+	// opens unless it was forced. If empty, deployments may start at any time.
+	Windows []DeploymentWindow `json:"windows,omitempty"`
+
+	// ImageChangeDebounceSeconds is how long to wait after an image change trigger detects a new image
+	// for further image changes before starting a deployment, so that images pushed close together
+	// (e.g. for the main container and its sidecars) are rolled out in a single deployment. If 0,
+	// new images are deployed immediately.
+	ImageChangeDebounceSeconds int64 `json:"imageChangeDebounceSeconds,omitempty"`
+
 	// Selector is a label query over pods that should match the Replicas count.
 	Selector map[string]string `json:"selector,omitempty"`
 
@@ -294,6 +474,17 @@ type DeploymentConfigStatus struct {
 	// The reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails `json:"details,omitempty"`
+	// LastTriggeredImages are the images last set on the containers of the pod template by image
+	// change triggers.
+	LastTriggeredImages []ContainerImage `json:"lastTriggeredImages,omitempty"`
+}
+
+// ContainerImage is the image of a container of a pod template.
+type ContainerImage struct {
+	// ContainerName is the name of the container.
+	ContainerName string `json:"containerName"`
+	// Image is the image reference set on the container.
+	Image string `json:"image"`
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -315,6 +506,10 @@ const (
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
 )
 
+// DeploymentCauseRollback is not a trigger. It is the type of the cause recorded when a failed
+// deployment was automatically rolled back to the last successful deployment.
+const DeploymentCauseRollback DeploymentTriggerType = "Rollback"
+
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
 type DeploymentTriggerImageChangeParams struct {
 	// Automatic means that the detection of a new tag value should result in a new deployment.
@@ -343,6 +538,8 @@ type DeploymentCause struct {
 	Type DeploymentTriggerType `json:"type"`
 	// The image trigger details, if this trigger was fired based on an image change
 	ImageTrigger *DeploymentCauseImageTrigger `json:"imageTrigger,omitempty"`
+	// Message describes the cause, it is set for Rollback causes.
+	Message string `json:"message,omitempty"`
 }
 
 // DeploymentCauseImageTrigger represents details about the cause of a deployment originating
@@ -425,3 +622,52 @@ type DeploymentLogOptions struct {
 	// Version of the deployment for which to view logs.
 	Version *int64 `json:"version,omitempty"`
 }
+
+// DeploymentConfigHistory is the history of the deployments of a deployment config.
+type DeploymentConfigHistory struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata, it has the name and namespace of the deployment config.
+	kapi.ObjectMeta `json:"metadata,omitempty"`
+
+	// Deployments are the records of the existing deployments of the config, latest first.
+	Deployments []DeploymentRecord `json:"deployments"`
+}
+
+// DeploymentRecord describes a single deployment of a deployment config.
+type DeploymentRecord struct {
+	// Name is the name of the deployment (a replication controller).
+	Name string `json:"name"`
+	// Version is the version of the deployment config the deployment is based on.
+	Version int `json:"version"`
+	// Phase is the phase of the deployment.
+	Phase DeploymentPhase `json:"phase,omitempty"`
+	// Details are the causes of the deployment.
+	Details *DeploymentDetails `json:"details,omitempty"`
+	// Strategy is the type of the strategy used for the deployment.
+	Strategy DeploymentStrategyType `json:"strategy,omitempty"`
+	// StartTime is the time at which the deployer started the deployment.
+	StartTime *unversioned.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time at which the deployment completed or failed.
+	CompletionTime *unversioned.Time `json:"completionTime,omitempty"`
+	// DurationSeconds is the number of seconds between StartTime and CompletionTime.
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+	// PreviousReplicas is the replica count of the previous deployment when the deployment
+	// started.
+	PreviousReplicas *int `json:"previousReplicas,omitempty"`
+	// Replicas is the replica count the deployment was rolled out to.
+	Replicas int `json:"replicas"`
+	// Hooks are the results of the hook pods run for the deployment.
+	Hooks []DeploymentHookResult `json:"hooks,omitempty"`
+	// Reason is the reason for the failure or the cancellation of the deployment.
+	Reason string `json:"reason,omitempty"`
+}
+
+// DeploymentHookResult is the result of a hook pod run for a deployment.
+type DeploymentHookResult struct {
+	// Name is the label of the hook (e.g. hook-pre, hook-post).
+	Name string `json:"name"`
+	// Phase is the phase of the hook pod.
+	Phase kapi.PodPhase `json:"phase,omitempty"`
+	// Message is the termination reason and message of a failed hook pod.
+	Message string `json:"message,omitempty"`
+}
@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
 
@@ -13,6 +14,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	imageval "github.com/openshift/origin/pkg/image/api/validation"
 )
@@ -31,6 +33,9 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) field.ErrorLis
 	}
 	specPath := field.NewPath("spec")
 	allErrs = append(allErrs, validateDeploymentStrategy(&config.Spec.Strategy, spec, field.NewPath("spec", "strategy"))...)
+	if config.Spec.Strategy.Type == deployapi.DeploymentStrategyTypeCanary && config.Spec.Strategy.CanaryParams != nil {
+		allErrs = append(allErrs, validateCanaryReplicas(config.Spec.Strategy.CanaryParams, config.Spec.Replicas, specPath)...)
+	}
 	if config.Spec.Template == nil {
 		allErrs = append(allErrs, field.Required(specPath.Child("template"), ""))
 	} else {
@@ -45,9 +50,30 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) field.ErrorLis
 	if len(config.Spec.Selector) == 0 {
 		allErrs = append(allErrs, field.Invalid(specPath.Child("selector"), config.Spec.Selector, "selector cannot be empty"))
 	}
+	if config.Spec.ImageChangeDebounceSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("imageChangeDebounceSeconds"), config.Spec.ImageChangeDebounceSeconds, "imageChangeDebounceSeconds cannot be negative"))
+	}
+	for i := range config.Spec.Windows {
+		allErrs = append(allErrs, validateDeploymentWindow(&config.Spec.Windows[i], specPath.Child("windows").Index(i))...)
+	}
 	return allErrs
 }
 
+func validateDeploymentWindow(window *deployapi.DeploymentWindow, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(window.Schedule) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("schedule"), ""))
+	} else if _, err := deployutil.ParseWindowSchedule(window.Schedule); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("schedule"), window.Schedule, err.Error()))
+	}
+	if window.DurationMinutes <= 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("durationMinutes"), window.DurationMinutes, "must be greater than zero"))
+	}
+
+	return errs
+}
+
 func ValidateDeploymentConfigUpdate(newConfig *deployapi.DeploymentConfig, oldConfig *deployapi.DeploymentConfig) field.ErrorList {
 	allErrs := validation.ValidateObjectMetaUpdate(&newConfig.ObjectMeta, &oldConfig.ObjectMeta, field.NewPath("metadata"))
 	allErrs = append(allErrs, ValidateDeploymentConfig(newConfig)...)
@@ -97,6 +123,18 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kap
 		} else {
 			errs = append(errs, validateRollingParams(strategy.RollingParams, pod, fldPath.Child("rollingParams"))...)
 		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("blueGreenParams"), ""))
+		} else {
+			errs = append(errs, validateBlueGreenParams(strategy.BlueGreenParams, pod, fldPath.Child("blueGreenParams"))...)
+		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("canaryParams"), ""))
+		} else {
+			errs = append(errs, validateCanaryParams(strategy.CanaryParams, pod, fldPath.Child("canaryParams"))...)
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		if strategy.CustomParams == nil {
 			errs = append(errs, field.Required(fldPath.Child("customParams"), ""))
@@ -105,6 +143,14 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kap
 		}
 	}
 
+	if strategy.Verification != nil {
+		if strategy.Type == deployapi.DeploymentStrategyTypeCustom {
+			errs = append(errs, field.Invalid(fldPath.Child("verification"), "", "not supported by the Custom strategy"))
+		} else {
+			errs = append(errs, validateVerification(strategy.Verification, fldPath.Child("verification"))...)
+		}
+	}
+
 	if strategy.Labels != nil {
 		errs = append(errs, validation.ValidateLabels(strategy.Labels, fldPath.Child("labels"))...)
 	}
@@ -147,16 +193,115 @@ func validateRecreateParams(params *deployapi.RecreateDeploymentStrategyParams,
 	return errs
 }
 
+func validateBlueGreenParams(params *deployapi.BlueGreenDeploymentStrategyParams, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(params.ServiceName) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("serviceName"), ""))
+	} else if ok, msg := validation.ValidateServiceName(params.ServiceName, false); !ok {
+		errs = append(errs, field.Invalid(fldPath.Child("serviceName"), params.ServiceName, msg))
+	}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if params.HoldSeconds != nil && *params.HoldSeconds < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("holdSeconds"), *params.HoldSeconds, "must be >=0"))
+	}
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, pod, fldPath.Child("pre"))...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, pod, fldPath.Child("post"))...)
+	}
+
+	return errs
+}
+
+func validateCanaryParams(params *deployapi.CanaryDeploymentStrategyParams, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *params.TimeoutSeconds, "must be >0"))
+	}
+
+	previous := 0
+	for i, step := range params.Steps {
+		switch {
+		case step < 1 || step > 99:
+			errs = append(errs, field.Invalid(fldPath.Child("steps").Index(i), step, "must be between 1 and 99"))
+		case step <= previous:
+			errs = append(errs, field.Invalid(fldPath.Child("steps").Index(i), step, "must be greater than the previous step"))
+		}
+		previous = step
+	}
+
+	if params.BakeSeconds != nil && *params.BakeSeconds < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("bakeSeconds"), *params.BakeSeconds, "must be >=0"))
+	}
+
+	if params.Check != nil {
+		errs = append(errs, validateExecNewPod(params.Check, fldPath.Child("check"))...)
+	}
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, pod, fldPath.Child("pre"))...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, pod, fldPath.Child("post"))...)
+	}
+
+	return errs
+}
+
+// validateCanaryReplicas checks that the steps of params can be represented
+// with replicas pods, the Canary strategy splitting the traffic by the number
+// of pods of each deployment.
+func validateCanaryReplicas(params *deployapi.CanaryDeploymentStrategyParams, replicas int, specPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	if replicas == 0 {
+		return errs
+	}
+	if replicas < 2 {
+		return append(errs, field.Invalid(specPath.Child("replicas"), replicas, "must be at least 2 with the Canary strategy, which splits the traffic by the number of pods"))
+	}
+
+	previous := 0
+	stepsPath := specPath.Child("strategy", "canaryParams", "steps")
+	for i, step := range params.Steps {
+		if step < 1 || step > 99 {
+			continue
+		}
+		canaryReplicas := deployutil.CanaryReplicas(replicas, step)
+		if canaryReplicas == previous {
+			errs = append(errs, field.Invalid(stepsPath.Index(i), step, fmt.Sprintf("gives the new deployment %d of the %d replicas like the previous step, the traffic is split by the number of pods", canaryReplicas, replicas)))
+		}
+		previous = canaryReplicas
+	}
+	return errs
+}
+
 func validateLifecycleHook(hook *deployapi.LifecycleHook, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
-	if len(hook.FailurePolicy) == 0 {
+	switch hook.FailurePolicy {
+	case "":
 		errs = append(errs, field.Required(fldPath.Child("failurePolicy"), ""))
+	case deployapi.LifecycleHookFailurePolicyPause:
+		errs = append(errs, field.Invalid(fldPath.Child("failurePolicy"), hook.FailurePolicy, "only the batch hook of the Rolling strategy may pause the deployment"))
+	}
+
+	actions := 0
+	for _, set := range []bool{hook.ExecNewPod != nil, len(hook.TagImages) > 0, hook.HTTPGet != nil, hook.TCPSocket != nil} {
+		if set {
+			actions++
+		}
 	}
 
 	switch {
-	case hook.ExecNewPod != nil && len(hook.TagImages) > 0:
-		errs = append(errs, field.Invalid(fldPath, "<hook>", "only one of 'execNewPod' of 'tagImages' may be specified"))
+	case actions > 1:
+		errs = append(errs, field.Invalid(fldPath, "<hook>", "only one of 'execNewPod', 'tagImages', 'httpGet' or 'tcpSocket' may be specified"))
 	case hook.ExecNewPod != nil:
 		errs = append(errs, validateExecNewPod(hook.ExecNewPod, fldPath.Child("execNewPod"))...)
 	case len(hook.TagImages) > 0:
@@ -175,13 +320,141 @@ func validateLifecycleHook(hook *deployapi.LifecycleHook, pod *kapi.PodSpec, fld
 				errs = append(errs, field.Required(fldPath.Child("tagImages").Index(i).Child("to", "name"), "a destination tag name is required"))
 			}
 		}
+	case hook.HTTPGet != nil:
+		errs = append(errs, validateHTTPGetHook(hook.HTTPGet, fldPath.Child("httpGet"))...)
+	case hook.TCPSocket != nil:
+		errs = append(errs, validateTCPSocketHook(hook.TCPSocket, fldPath.Child("tcpSocket"))...)
 	default:
-		errs = append(errs, field.Invalid(fldPath, "<empty>", "One of execNewPod or tagImages must be specified"))
+		errs = append(errs, field.Invalid(fldPath, "<empty>", "One of execNewPod, tagImages, httpGet or tcpSocket must be specified"))
 	}
 
 	return errs
 }
 
+func validateHTTPGetHook(hook *deployapi.HTTPGetHook, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(hook.URL) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("url"), ""))
+	} else if u, err := url.Parse(hook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("url"), hook.URL, "must be an absolute http or https URL"))
+	}
+	switch hook.Method {
+	case "", "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE":
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("method"), hook.Method, []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"}))
+	}
+	if hook.ExpectedStatus != 0 && (hook.ExpectedStatus < 100 || hook.ExpectedStatus > 599) {
+		errs = append(errs, field.Invalid(fldPath.Child("expectedStatus"), hook.ExpectedStatus, "must be a valid HTTP status code"))
+	}
+	if hook.Retries < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("retries"), hook.Retries, "must be >=0"))
+	}
+	if hook.TimeoutSeconds != nil && *hook.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *hook.TimeoutSeconds, "must be >0"))
+	}
+
+	return errs
+}
+
+func validateTCPSocketHook(hook *deployapi.TCPSocketHook, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(hook.Host) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("host"), ""))
+	}
+	if hook.Port < 1 || hook.Port > 65535 {
+		errs = append(errs, field.Invalid(fldPath.Child("port"), hook.Port, "must be between 1 and 65535, inclusive"))
+	}
+	if hook.TimeoutSeconds != nil && *hook.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *hook.TimeoutSeconds, "must be >0"))
+	}
+
+	return errs
+}
+
+func validateVerification(verification *deployapi.DeploymentVerification, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	switch {
+	case verification.Prometheus != nil && verification.HTTPGet != nil:
+		errs = append(errs, field.Invalid(fldPath, "", "only one of prometheus or httpGet may be specified"))
+	case verification.Prometheus != nil:
+		errs = append(errs, validateVerificationURL(verification.Prometheus.URL, fldPath.Child("prometheus", "url"))...)
+		if len(verification.Prometheus.Query) == 0 {
+			errs = append(errs, field.Required(fldPath.Child("prometheus", "query"), ""))
+		}
+	case verification.HTTPGet != nil:
+		errs = append(errs, validateVerificationURL(verification.HTTPGet.URL, fldPath.Child("httpGet", "url"))...)
+	default:
+		errs = append(errs, field.Invalid(fldPath, "", "one of prometheus or httpGet must be specified"))
+	}
+
+	if len(verification.MinValue) == 0 && len(verification.MaxValue) == 0 {
+		errs = append(errs, field.Invalid(fldPath, "", "at least one of minValue or maxValue must be specified"))
+	}
+	var min, max float64
+	var err error
+	minOK, maxOK := false, false
+	if len(verification.MinValue) > 0 {
+		if min, err = strconv.ParseFloat(verification.MinValue, 64); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("minValue"), verification.MinValue, "must be a decimal number"))
+		} else {
+			minOK = true
+		}
+	}
+	if len(verification.MaxValue) > 0 {
+		if max, err = strconv.ParseFloat(verification.MaxValue, 64); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("maxValue"), verification.MaxValue, "must be a decimal number"))
+		} else {
+			maxOK = true
+		}
+	}
+	if minOK && maxOK && min > max {
+		errs = append(errs, field.Invalid(fldPath.Child("maxValue"), verification.MaxValue, "must not be less than minValue"))
+	}
+
+	if verification.IntervalSeconds != nil && *verification.IntervalSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("intervalSeconds"), *verification.IntervalSeconds, "must be >0"))
+	}
+	if verification.TimeoutSeconds != nil && *verification.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *verification.TimeoutSeconds, "must be >0"))
+	}
+
+	return errs
+}
+
+// validateVerificationURL validates a verification URL as it will be used,
+// after the deployment placeholders have been replaced.
+func validateVerificationURL(rawURL string, fldPath *field.Path) field.ErrorList {
+	if len(rawURL) == 0 {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	expanded := deployutil.ExpandVerificationPlaceholders(rawURL, "name", "namespace")
+	if u, err := url.Parse(expanded); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return field.ErrorList{field.Invalid(fldPath, rawURL, "must be an absolute http or https URL")}
+	}
+	return nil
+}
+
+// validateBatchHook validates the batch hook of the Rolling strategy, which
+// unlike the other hooks must run a pod and may pause the deployment.
+func validateBatchHook(hook *deployapi.LifecycleHook, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if hook.ExecNewPod == nil {
+		errs = append(errs, field.Required(fldPath.Child("execNewPod"), "the batch hook must specify execNewPod"))
+	}
+	if hook.FailurePolicy == deployapi.LifecycleHookFailurePolicyPause {
+		copied := *hook
+		copied.FailurePolicy = deployapi.LifecycleHookFailurePolicyAbort
+		hook = &copied
+	}
+	errs = append(errs, validateLifecycleHook(hook, pod, fldPath)...)
+
+	return errs
+}
+
 func validateExecNewPod(hook *deployapi.ExecNewPodHook, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -267,6 +540,9 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, po
 	if params.Pre != nil {
 		errs = append(errs, validateLifecycleHook(params.Pre, pod, fldPath.Child("pre"))...)
 	}
+	if params.Batch != nil {
+		errs = append(errs, validateBatchHook(params.Batch, pod, fldPath.Child("batch"))...)
+	}
 	if params.Post != nil {
 		errs = append(errs, validateLifecycleHook(params.Post, pod, fldPath.Child("post"))...)
 	}
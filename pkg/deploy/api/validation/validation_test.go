@@ -79,6 +79,147 @@ func TestValidateDeploymentConfigOK(t *testing.T) {
 	}
 }
 
+func blueGreenConfig(serviceName string, timeout, hold int) api.DeploymentConfig {
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Triggers: manualTrigger(),
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeBlueGreen,
+				BlueGreenParams: &api.BlueGreenDeploymentStrategyParams{
+					ServiceName:    serviceName,
+					TimeoutSeconds: mkint64p(timeout),
+					HoldSeconds:    mkint64p(hold),
+				},
+			},
+			Template: test.OkPodTemplate(),
+			Selector: test.OkSelector(),
+		},
+	}
+}
+
+func canaryConfig(steps []int, bake int) api.DeploymentConfig {
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Triggers: manualTrigger(),
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeCanary,
+				CanaryParams: &api.CanaryDeploymentStrategyParams{
+					TimeoutSeconds: mkint64p(1),
+					Steps:          steps,
+					BakeSeconds:    mkint64p(bake),
+				},
+			},
+			Template: test.OkPodTemplate(),
+			Selector: test.OkSelector(),
+		},
+	}
+}
+
+func canaryReplicasConfig(replicas int, steps []int) api.DeploymentConfig {
+	config := canaryConfig(steps, 0)
+	config.Spec.Replicas = replicas
+	return config
+}
+
+func rollingHookConfig(pre, batch *api.LifecycleHook) api.DeploymentConfig {
+	config := rollingConfig(1, 1, 1)
+	config.Spec.Strategy.RollingParams.Pre = pre
+	config.Spec.Strategy.RollingParams.Batch = batch
+	return config
+}
+
+func windowConfig(windows ...api.DeploymentWindow) api.DeploymentConfig {
+	config := api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec:       test.OkDeploymentConfigSpec(),
+	}
+	config.Spec.Windows = windows
+	return config
+}
+
+func verificationConfig(verification *api.DeploymentVerification) api.DeploymentConfig {
+	config := api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec:       test.OkDeploymentConfigSpec(),
+	}
+	config.Spec.Strategy.Verification = verification
+	return config
+}
+
+func podHook(policy api.LifecycleHookFailurePolicy) *api.LifecycleHook {
+	return &api.LifecycleHook{
+		FailurePolicy: policy,
+		ExecNewPod: &api.ExecNewPodHook{
+			Command:       []string{"cmd"},
+			ContainerName: "container1",
+		},
+	}
+}
+
+func TestValidateDeploymentConfigBatchHookOK(t *testing.T) {
+	config := rollingHookConfig(nil, podHook(api.LifecycleHookFailurePolicyPause))
+	if errs := ValidateDeploymentConfig(&config); len(errs) > 0 {
+		t.Errorf("Unxpected non-empty error list: %#v", errs)
+	}
+}
+
+func TestValidateDeploymentConfigNetworkHooksOK(t *testing.T) {
+	config := rollingHookConfig(&api.LifecycleHook{
+		FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+		HTTPGet: &api.HTTPGetHook{
+			URL:            "http://migrations:8080/run",
+			Method:         "POST",
+			ExpectedStatus: 202,
+			Retries:        3,
+			TimeoutSeconds: mkint64p(30),
+		},
+	}, nil)
+	config.Spec.Strategy.RollingParams.Post = &api.LifecycleHook{
+		FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+		TCPSocket:     &api.TCPSocketHook{Host: "database", Port: 5432},
+	}
+	if errs := ValidateDeploymentConfig(&config); len(errs) > 0 {
+		t.Errorf("Unxpected non-empty error list: %#v", errs)
+	}
+}
+
+func TestValidateDeploymentConfigWindowsOK(t *testing.T) {
+	config := windowConfig(
+		api.DeploymentWindow{Schedule: "0 2 * * 6", DurationMinutes: 120},
+		api.DeploymentWindow{Schedule: "*/30 9-17 1,15 * 1-5", DurationMinutes: 10},
+	)
+	if errs := ValidateDeploymentConfig(&config); len(errs) > 0 {
+		t.Errorf("Unxpected non-empty error list: %#v", errs)
+	}
+}
+
+func TestValidateDeploymentConfigVerificationOK(t *testing.T) {
+	verifications := []*api.DeploymentVerification{
+		{
+			Prometheus: &api.PrometheusQuery{
+				URL:   "http://prometheus:9090",
+				Query: `sum(rate(http_errors{deployment="${DEPLOYMENT_NAME}"}[1m]))`,
+			},
+			MaxValue:        "0.5",
+			IntervalSeconds: mkint64p(30),
+			TimeoutSeconds:  mkint64p(600),
+		},
+		{
+			HTTPGet:  &api.HTTPGetMetric{URL: "https://${DEPLOYMENT_NAME}.${DEPLOYMENT_NAMESPACE}.svc/health/score"},
+			MinValue: "0.9",
+			MaxValue: "1",
+		},
+	}
+	for _, verification := range verifications {
+		config := verificationConfig(verification)
+		if errs := ValidateDeploymentConfig(&config); len(errs) > 0 {
+			t.Errorf("Unxpected non-empty error list: %#v", errs)
+		}
+	}
+}
+
 func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 	errorCases := map[string]struct {
 		DeploymentConfig api.DeploymentConfig
@@ -553,6 +694,143 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeRequired,
 			"spec.strategy.rollingParams.pre.failurePolicy",
 		},
+		"invalid spec.strategy.rollingParams.pre.failurePolicy": {
+			rollingHookConfig(podHook(api.LifecycleHookFailurePolicyPause), nil),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pre.failurePolicy",
+		},
+		"invalid spec.strategy.rollingParams.pre.httpGet.url": {
+			rollingHookConfig(&api.LifecycleHook{
+				FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+				HTTPGet:       &api.HTTPGetHook{URL: "migrations/run"},
+			}, nil),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pre.httpGet.url",
+		},
+		"invalid spec.strategy.rollingParams.pre.httpGet.method": {
+			rollingHookConfig(&api.LifecycleHook{
+				FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+				HTTPGet:       &api.HTTPGetHook{URL: "https://migrations/run", Method: "FETCH"},
+			}, nil),
+			field.ErrorTypeNotSupported,
+			"spec.strategy.rollingParams.pre.httpGet.method",
+		},
+		"invalid spec.strategy.rollingParams.pre.tcpSocket.port": {
+			rollingHookConfig(&api.LifecycleHook{
+				FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+				TCPSocket:     &api.TCPSocketHook{Host: "database", Port: 70000},
+			}, nil),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pre.tcpSocket.port",
+		},
+		"multiple actions in spec.strategy.rollingParams.pre": {
+			rollingHookConfig(&api.LifecycleHook{
+				FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+				ExecNewPod:    &api.ExecNewPodHook{Command: []string{"cmd"}, ContainerName: "container1"},
+				TCPSocket:     &api.TCPSocketHook{Host: "database", Port: 5432},
+			}, nil),
+			field.ErrorTypeInvalid,
+			"spec.strategy.rollingParams.pre",
+		},
+		"invalid spec.imageChangeDebounceSeconds": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas:                   1,
+					ImageChangeDebounceSeconds: -1,
+					Selector:                   test.OkSelector(),
+					Strategy:                   test.OkStrategy(),
+					Template:                   test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.imageChangeDebounceSeconds",
+		},
+		"missing spec.windows[0].schedule": {
+			windowConfig(api.DeploymentWindow{DurationMinutes: 60}),
+			field.ErrorTypeRequired,
+			"spec.windows[0].schedule",
+		},
+		"invalid spec.windows[0].schedule": {
+			windowConfig(api.DeploymentWindow{Schedule: "0 25 * * *", DurationMinutes: 60}),
+			field.ErrorTypeInvalid,
+			"spec.windows[0].schedule",
+		},
+		"invalid spec.windows[0].durationMinutes": {
+			windowConfig(api.DeploymentWindow{Schedule: "0 2 * * 6"}),
+			field.ErrorTypeInvalid,
+			"spec.windows[0].durationMinutes",
+		},
+		"missing spec.strategy.verification source": {
+			verificationConfig(&api.DeploymentVerification{MaxValue: "1"}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification",
+		},
+		"both spec.strategy.verification sources": {
+			verificationConfig(&api.DeploymentVerification{
+				Prometheus: &api.PrometheusQuery{URL: "http://prometheus:9090", Query: "up"},
+				HTTPGet:    &api.HTTPGetMetric{URL: "http://metrics"},
+				MaxValue:   "1",
+			}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification",
+		},
+		"missing spec.strategy.verification thresholds": {
+			verificationConfig(&api.DeploymentVerification{HTTPGet: &api.HTTPGetMetric{URL: "http://metrics"}}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification",
+		},
+		"missing spec.strategy.verification.prometheus.query": {
+			verificationConfig(&api.DeploymentVerification{Prometheus: &api.PrometheusQuery{URL: "http://prometheus:9090"}, MaxValue: "1"}),
+			field.ErrorTypeRequired,
+			"spec.strategy.verification.prometheus.query",
+		},
+		"invalid spec.strategy.verification.httpGet.url": {
+			verificationConfig(&api.DeploymentVerification{HTTPGet: &api.HTTPGetMetric{URL: "/metrics"}, MaxValue: "1"}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification.httpGet.url",
+		},
+		"invalid spec.strategy.verification.minValue": {
+			verificationConfig(&api.DeploymentVerification{HTTPGet: &api.HTTPGetMetric{URL: "http://metrics"}, MinValue: "low"}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification.minValue",
+		},
+		"spec.strategy.verification.maxValue less than minValue": {
+			verificationConfig(&api.DeploymentVerification{HTTPGet: &api.HTTPGetMetric{URL: "http://metrics"}, MinValue: "2", MaxValue: "1"}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification.maxValue",
+		},
+		"invalid spec.strategy.verification.intervalSeconds": {
+			verificationConfig(&api.DeploymentVerification{HTTPGet: &api.HTTPGetMetric{URL: "http://metrics"}, MaxValue: "1", IntervalSeconds: mkint64p(0)}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification.intervalSeconds",
+		},
+		"spec.strategy.verification with the Custom strategy": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: manualTrigger(),
+					Strategy: api.DeploymentStrategy{
+						Type:         api.DeploymentStrategyTypeCustom,
+						CustomParams: &api.CustomDeploymentStrategyParams{Image: "deployer"},
+						Verification: &api.DeploymentVerification{HTTPGet: &api.HTTPGetMetric{URL: "http://metrics"}, MaxValue: "1"},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.verification",
+		},
+		"missing spec.strategy.rollingParams.batch.execNewPod": {
+			rollingHookConfig(nil, &api.LifecycleHook{
+				FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+				TagImages:     []api.TagImageHook{{ContainerName: "container1", To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:batch"}}},
+			}),
+			field.ErrorTypeRequired,
+			"spec.strategy.rollingParams.batch.execNewPod",
+		},
 		"both maxSurge and maxUnavailable 0 spec.strategy.rollingParams.maxUnavailable": {
 			rollingConfigMax(intstr.FromInt(0), intstr.FromInt(0)),
 			field.ErrorTypeInvalid,
@@ -593,6 +871,91 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.rollingParams.maxSurge",
 		},
+		"missing spec.strategy.blueGreenParams": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeBlueGreen,
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeRequired,
+			"spec.strategy.blueGreenParams",
+		},
+		"missing spec.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("", 1, 0),
+			field.ErrorTypeRequired,
+			"spec.strategy.blueGreenParams.serviceName",
+		},
+		"invalid spec.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("-frontend", 1, 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.serviceName",
+		},
+		"invalid spec.strategy.blueGreenParams.timeoutSeconds": {
+			blueGreenConfig("frontend", -20, 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.timeoutSeconds",
+		},
+		"invalid spec.strategy.blueGreenParams.holdSeconds": {
+			blueGreenConfig("frontend", 1, -1),
+			field.ErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.holdSeconds",
+		},
+		"missing spec.strategy.canaryParams": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeCanary,
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeRequired,
+			"spec.strategy.canaryParams",
+		},
+		"valid spec.strategy.canaryParams": {
+			canaryConfig([]int{10, 50}, 0),
+			"",
+			"",
+		},
+		"out of range spec.strategy.canaryParams.steps": {
+			canaryConfig([]int{10, 100}, 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[1]",
+		},
+		"decreasing spec.strategy.canaryParams.steps": {
+			canaryConfig([]int{50, 10}, 0),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[1]",
+		},
+		"invalid spec.strategy.canaryParams.bakeSeconds": {
+			canaryConfig([]int{10}, -1),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.bakeSeconds",
+		},
+		"valid spec.strategy.canaryParams.steps for spec.replicas": {
+			canaryReplicasConfig(3, []int{10, 50}),
+			"",
+			"",
+		},
+		"single spec.replicas with spec.strategy.canaryParams": {
+			canaryReplicasConfig(1, []int{10, 50}),
+			field.ErrorTypeInvalid,
+			"spec.replicas",
+		},
+		"spec.strategy.canaryParams.steps not represented by spec.replicas": {
+			canaryReplicasConfig(2, []int{10, 50}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[1]",
+		},
 	}
 
 	for testName, v := range errorCases {
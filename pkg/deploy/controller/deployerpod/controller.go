@@ -167,6 +167,39 @@ func (c *DeployerPodController) Handle(pod *kapi.Pod) error {
 				return err
 			})
 		}
+
+		// If the deployer rolled back the failed deployment, record the reason as
+		// a cause of the latest version of its deployment config.
+		if reason, ok := deployment.Annotations[deployapi.DeploymentRolledBackAnnotation]; ok && nextStatus == deployapi.DeploymentStatusFailed {
+			c.recorder.Eventf(deployment, kapi.EventTypeWarning, "RolledBack", "Rolled back after a failure: %s", reason)
+			name := deployutil.DeploymentConfigNameFor(deployment)
+			err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+				config, err := c.client.DeploymentConfigs(deployment.Namespace).Get(name)
+				if err != nil {
+					return err
+				}
+				if config.Status.LatestVersion != deployutil.DeploymentVersionFor(deployment) {
+					return nil
+				}
+				if config.Status.Details == nil {
+					config.Status.Details = &deployapi.DeploymentDetails{}
+				}
+				for _, cause := range config.Status.Details.Causes {
+					if cause.Type == deployapi.DeploymentCauseRollback {
+						return nil
+					}
+				}
+				config.Status.Details.Causes = append(config.Status.Details.Causes, &deployapi.DeploymentCause{
+					Type:    deployapi.DeploymentCauseRollback,
+					Message: reason,
+				})
+				_, err = c.client.DeploymentConfigs(config.Namespace).Update(config)
+				return err
+			})
+			if err != nil {
+				glog.V(2).Infof("Couldn't record the rollback of %s: %v", deployutil.LabelForDeployment(deployment), err)
+			}
+		}
 	}
 
 	return nil
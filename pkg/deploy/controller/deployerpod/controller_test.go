@@ -6,6 +6,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/client/cache"
+	"k8s.io/kubernetes/pkg/client/record"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util/sets"
@@ -468,3 +469,56 @@ func TestHandle_canceledDeploymentTriggerTest(t *testing.T) {
 		t.Fatalf("expected config update")
 	}
 }
+
+// TestHandle_rolledBackDeployment ensures that the rollback of a failed
+// deployment is recorded as a cause of its deploymentconfig.
+func TestHandle_rolledBackDeployment(t *testing.T) {
+	var updatedConfig *deployapi.DeploymentConfig
+
+	config := deploytest.OkDeploymentConfig(1)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+	deployment.Annotations[deployapi.DeploymentRolledBackAnnotation] = "pods took too long to become ready"
+
+	kFake := &ktestclient.Fake{}
+	kFake.PrependReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, deployment, nil
+	})
+	kFake.PrependReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, deployment, nil
+	})
+	fake := &testclient.Fake{}
+	fake.PrependReactor("get", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, config, nil
+	})
+	fake.PrependReactor("update", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		updatedConfig = action.(ktestclient.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+		return true, updatedConfig, nil
+	})
+
+	controller := &DeployerPodController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.UniversalDecoder())
+		},
+		store:    cache.NewStore(cache.MetaNamespaceKeyFunc),
+		client:   fake,
+		kClient:  kFake,
+		recorder: &record.FakeRecorder{},
+	}
+
+	if err := controller.Handle(terminatedPod(deployment)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updatedConfig == nil {
+		t.Fatalf("expected config update")
+	}
+	causes := updatedConfig.Status.Details.Causes
+	cause := causes[len(causes)-1]
+	if e, a := deployapi.DeploymentCauseRollback, cause.Type; e != a {
+		t.Fatalf("expected cause %s, got %s", e, a)
+	}
+	if e, a := "pods took too long to become ready", cause.Message; e != a {
+		t.Fatalf("expected cause message %q, got %q", e, a)
+	}
+}
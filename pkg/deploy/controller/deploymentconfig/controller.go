@@ -3,6 +3,7 @@ package deploymentconfig
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -21,7 +22,8 @@ import (
 // when:
 //
 //    1. The config version is > 0 and,
-//    2. No deployment for the version exists.
+//    2. No deployment for the version exists and,
+//    3. One of the deployment windows of the config is open, if it has any.
 //
 // The controller reconciles deployments with the replica count specified on
 // the config. The active deployment (that is, the latest successful
@@ -40,6 +42,32 @@ type DeploymentConfigController struct {
 	codec runtime.Codec
 	// recorder is used to record events.
 	recorder record.EventRecorder
+	// now returns the current time, used to evaluate deployment windows.
+	now func() time.Time
+	// deferrals records, by config, the version and the next deployment
+	// window of the deferral last reported with an event, so that resyncs
+	// don't report the same deferral again.
+	deferrals map[string]string
+}
+
+// recordDeferral records that the latest version of config is deferred until
+// next and returns true if that deferral is new.
+func (c *DeploymentConfigController) recordDeferral(config *deployapi.DeploymentConfig, next time.Time) bool {
+	if c.deferrals == nil {
+		c.deferrals = make(map[string]string)
+	}
+	key := config.Namespace + "/" + config.Name
+	deferral := fmt.Sprintf("%d@%s", config.Status.LatestVersion, next.Format(time.RFC3339))
+	if c.deferrals[key] == deferral {
+		return false
+	}
+	c.deferrals[key] = deferral
+	return true
+}
+
+// clearDeferral forgets the deferral recorded for config, if any.
+func (c *DeploymentConfigController) clearDeferral(config *deployapi.DeploymentConfig) {
+	delete(c.deferrals, config.Namespace+"/"+config.Name)
 }
 
 // fatalError is an error which can't be retried.
@@ -61,6 +89,7 @@ func NewDeploymentConfigController(kubeClient kclient.Interface, osClient osclie
 		osClient:   osClient,
 		codec:      codec,
 		recorder:   recorder,
+		now:        time.Now,
 	}
 }
 
@@ -79,6 +108,24 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 	}
 
 	latestIsDeployed, latestDeployment := deployutil.LatestDeploymentInfo(config, existingDeployments)
+	// If the latest deployment doesn't exist yet and none of the deployment
+	// windows is open, leave the running deployments alone and wait for the
+	// next window. The config is resynced periodically, so the deployment is
+	// created soon after the window opens.
+	if !latestIsDeployed {
+		if deferred, next := deployutil.DeploymentDeferred(config, c.now()); deferred {
+			if !c.recordDeferral(config, next) {
+				return nil
+			}
+			if next.IsZero() {
+				c.recorder.Eventf(config, kapi.EventTypeWarning, "DeploymentDeferred", "Deployment of version %d deferred: none of the deployment windows ever opens", config.Status.LatestVersion)
+			} else {
+				c.recorder.Eventf(config, kapi.EventTypeNormal, "DeploymentDeferred", "Deployment of version %d deferred until the next deployment window at %s", config.Status.LatestVersion, next.Format(time.RFC3339))
+			}
+			return nil
+		}
+	}
+	c.clearDeferral(config)
 	// If the latest deployment doesn't exist yet, cancel any running
 	// deployments to allow them to be superceded by the new config version.
 	awaitingCancellations := false
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/client/record"
@@ -653,6 +654,7 @@ func TestHandleScenarios(t *testing.T) {
 			osClient:   oc,
 			codec:      kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion),
 			recorder:   recorder,
+			now:        time.Now,
 		}
 
 		config := deploytest.OkDeploymentConfig(test.newVersion)
@@ -697,3 +699,91 @@ func TestHandleScenarios(t *testing.T) {
 func newint(i int) *int {
 	return &i
 }
+
+func TestHandleDeploymentWindows(t *testing.T) {
+	// A Saturday.
+	now := time.Date(2016, 3, 5, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		windows  []deployapi.DeploymentWindow
+		override string
+		// expectCreated is whether the deployment of version 2 should be created
+		expectCreated bool
+	}{
+		{
+			name:          "no windows",
+			expectCreated: true,
+		},
+		{
+			name:          "window open",
+			windows:       []deployapi.DeploymentWindow{{Schedule: "0 2 * * 6", DurationMinutes: 60}, {Schedule: "30 9 * * *", DurationMinutes: 60}},
+			expectCreated: true,
+		},
+		{
+			name:          "window closed",
+			windows:       []deployapi.DeploymentWindow{{Schedule: "0 2 * * 6", DurationMinutes: 60}},
+			expectCreated: false,
+		},
+		{
+			name:          "window closed and latest version forced",
+			windows:       []deployapi.DeploymentWindow{{Schedule: "0 2 * * 6", DurationMinutes: 60}},
+			override:      "2",
+			expectCreated: true,
+		},
+		{
+			name:          "window closed and older version forced",
+			windows:       []deployapi.DeploymentWindow{{Schedule: "0 2 * * 6", DurationMinutes: 60}},
+			override:      "1",
+			expectCreated: false,
+		},
+	}
+
+	for _, test := range tests {
+		existing, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		existing.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+
+		var created *kapi.ReplicationController
+		kc := &ktestclient.Fake{}
+		kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*existing}}, nil
+		})
+		kc.AddReactor("create", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			created = action.(ktestclient.CreateAction).GetObject().(*kapi.ReplicationController)
+			return true, created, nil
+		})
+
+		recorder := &record.FakeRecorder{}
+		controller := &DeploymentConfigController{
+			kubeClient: kc,
+			osClient:   &testclient.Fake{},
+			codec:      kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion),
+			recorder:   recorder,
+			now:        func() time.Time { return now },
+		}
+
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Windows = test.windows
+		if len(test.override) > 0 {
+			config.Annotations = map[string]string{deployapi.DeploymentWindowOverrideAnnotation: test.override}
+		}
+		if err := controller.Handle(config); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if e, a := test.expectCreated, created != nil; e != a {
+			t.Errorf("%s: expected deployment created to be %t, got %t; events:\n%s", test.name, e, a, strings.Join(recorder.Events, "\t\n"))
+		}
+		if !test.expectCreated && (len(recorder.Events) != 1 || !strings.Contains(recorder.Events[0], "until the next deployment window at 2016-03-12T02:00:00Z")) {
+			t.Errorf("%s: expected a deferral event, got %v", test.name, recorder.Events)
+		}
+
+		// A resync doesn't report the same deferral again.
+		if err := controller.Handle(config); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.expectCreated && len(recorder.Events) != 1 {
+			t.Errorf("%s: expected a single deferral event, got %v", test.name, recorder.Events)
+		}
+	}
+}
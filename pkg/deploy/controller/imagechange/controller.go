@@ -2,9 +2,13 @@ package imagechange
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang/glog"
 
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
@@ -13,9 +17,18 @@ import (
 // ImageChangeController increments the version of a DeploymentConfig which has an image
 // change trigger when a tag update to a triggered ImageStream is detected.
 //
+// Configs with ImageChangeDebounceSeconds set are only updated a fixed period
+// after the first detected change, so that several image changes within that
+// period result in a single new version. Later changes don't extend the period.
+//
 // Use the ImageChangeControllerFactory to create this controller.
 type ImageChangeController struct {
 	deploymentConfigClient deploymentConfigClient
+	// requeue handles the image stream again after the given delay. It is used
+	// to update configs once their debounce period has passed.
+	requeue func(imageRepo *imageapi.ImageStream, after time.Duration)
+	// now returns the current time.
+	now func() time.Time
 }
 
 // fatalError is an error which can't be retried.
@@ -35,6 +48,11 @@ func (c *ImageChangeController) Handle(imageRepo *imageapi.ImageStream) error {
 	// Find any configs which should be updated based on the new image state
 	configsToUpdate := map[string]*deployapi.DeploymentConfig{}
 	for _, config := range configs {
+		if config.Spec.Paused {
+			glog.V(5).Infof("Ignoring DeploymentConfig %s; paused", deployutil.LabelForDeploymentConfig(config))
+			continue
+		}
+
 		glog.V(4).Infof("Detecting changed images for DeploymentConfig %s", deployutil.LabelForDeploymentConfig(config))
 
 		for _, trigger := range config.Spec.Triggers {
@@ -74,7 +92,17 @@ func (c *ImageChangeController) Handle(imageRepo *imageapi.ImageStream) error {
 	// Attempt to regenerate all configs which may contain image updates
 	anyFailed := false
 	for _, config := range configsToUpdate {
-		err := c.regenerate(config)
+		wait, err := c.debounce(config, imageRepo)
+		if err != nil {
+			anyFailed = true
+			glog.V(2).Infof("Couldn't debounce image changes for DeploymentConfig %s: %s", deployutil.LabelForDeploymentConfig(config), err)
+			continue
+		}
+		if wait > 0 {
+			glog.V(4).Infof("Waiting %s for further image changes before regenerating DeploymentConfig %s", wait, deployutil.LabelForDeploymentConfig(config))
+			continue
+		}
+		err = c.regenerate(config)
 		if err != nil {
 			anyFailed = true
 			glog.V(2).Infof("Couldn't regenerate DeploymentConfig %s: %s", deployutil.LabelForDeploymentConfig(config), err)
@@ -106,6 +134,48 @@ func triggerMatchesImage(config *deployapi.DeploymentConfig, params *deployapi.D
 	return false
 }
 
+// debounce returns how long to wait for further image changes before config
+// may be regenerated. The first time a change is detected, the config is
+// annotated with the time of detection. The image stream is requeued so that
+// it is handled again when the debounce period has passed.
+func (c *ImageChangeController) debounce(config *deployapi.DeploymentConfig, imageRepo *imageapi.ImageStream) (time.Duration, error) {
+	period := time.Duration(config.Spec.ImageChangeDebounceSeconds) * time.Second
+	if period == 0 {
+		return 0, nil
+	}
+
+	now := c.now()
+	if value, ok := config.Annotations[deployapi.DeploymentImageChangePendingAnnotation]; ok {
+		if since, err := time.Parse(time.RFC3339, value); err == nil {
+			wait := since.Add(period).Sub(now)
+			if wait > 0 {
+				c.requeue(imageRepo, wait)
+			}
+			return wait, nil
+		}
+	}
+
+	// Record when the first pending change was detected, leaving the cached
+	// config untouched.
+	obj, err := kapi.Scheme.Copy(config)
+	if err != nil {
+		return 0, err
+	}
+	pending := obj.(*deployapi.DeploymentConfig)
+	if pending.Annotations == nil {
+		pending.Annotations = make(map[string]string)
+	}
+	pending.Annotations[deployapi.DeploymentImageChangePendingAnnotation] = now.UTC().Format(time.RFC3339)
+	// A conflict means the config was updated concurrently, most likely to
+	// record a change detected in another image stream; waiting for the full
+	// period is correct either way.
+	if _, err := c.deploymentConfigClient.updateDeploymentConfig(pending.Namespace, pending); err != nil && !kerrors.IsConflict(err) {
+		return 0, err
+	}
+	c.requeue(imageRepo, period)
+	return period, nil
+}
+
 // regenerate calls the generator to get a new config. If the newly generated
 // config's version is newer, update the old config to be the new config.
 // Otherwise do nothing.
@@ -116,8 +186,12 @@ func (c *ImageChangeController) regenerate(config *deployapi.DeploymentConfig) e
 		return fmt.Errorf("error generating new version of DeploymentConfig %s: %v", deployutil.LabelForDeploymentConfig(config), err)
 	}
 
+	// The pending image changes are handled by this update
+	_, pending := newConfig.Annotations[deployapi.DeploymentImageChangePendingAnnotation]
+	delete(newConfig.Annotations, deployapi.DeploymentImageChangePendingAnnotation)
+
 	// No update occurred
-	if config.Status.LatestVersion == newConfig.Status.LatestVersion {
+	if config.Status.LatestVersion == newConfig.Status.LatestVersion && !pending {
 		glog.V(5).Infof("No version difference for generated DeploymentConfig %s", deployutil.LabelForDeploymentConfig(config))
 		return nil
 	}
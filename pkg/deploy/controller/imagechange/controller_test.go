@@ -3,6 +3,7 @@ package imagechange
 import (
 	"flag"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 
@@ -52,6 +53,43 @@ func TestHandle_changeForNonAutomaticTag(t *testing.T) {
 	}
 }
 
+// TestHandle_changeForPausedConfig ensures that an image update for which
+// there is a matching automatic trigger results in a no-op when the config is
+// paused.
+func TestHandle_changeForPausedConfig(t *testing.T) {
+	controller := &ImageChangeController{
+		deploymentConfigClient: &deploymentConfigClientImpl{
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected DeploymentConfig update")
+				return nil, nil
+			},
+			generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected generator call")
+				return nil, nil
+			},
+			listDeploymentConfigsFunc: func() ([]*deployapi.DeploymentConfig, error) {
+				config := deployapitest.OkDeploymentConfig(1)
+				config.Spec.Paused = true
+
+				return []*deployapi.DeploymentConfig{config}, nil
+			},
+		},
+	}
+
+	// verify no-op
+	tagUpdate := makeRepo(
+		"test-image-repo",
+		imageapi.DefaultImageTag,
+		"registry:8080/openshift/test-image@sha256:00000000000000000000000000000001",
+		"00000000000000000000000000000001",
+	)
+	err := controller.Handle(tagUpdate)
+
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
 // TestHandle_changeForUnregisteredTag ensures that an image update for which
 // there is a matching trigger results in a no-op due to the tag specified on
 // the trigger not matching the tags defined on the image repo.
@@ -239,3 +277,109 @@ func makeRepo(name, tag, dir, image string) *imageapi.ImageStream {
 		},
 	}
 }
+
+// TestHandle_debounce ensures that image changes for a config with a debounce
+// period are only deployed after no new change was detected for that period.
+func TestHandle_debounce(t *testing.T) {
+	now := time.Date(2016, 3, 5, 10, 0, 0, 0, time.UTC)
+
+	scenarios := []struct {
+		name string
+		// pendingSince is the value of the pending annotation, if any
+		pendingSince string
+		// expectedPending is the expected pending annotation of the update, if any
+		expectedPending string
+		// expectedRequeue is the expected requeue delay, if any
+		expectedRequeue time.Duration
+		generates       bool
+	}{
+		{
+			name:            "first change",
+			expectedPending: "2016-03-05T10:00:00Z",
+			expectedRequeue: time.Minute,
+		},
+		{
+			name:            "change within the debounce period",
+			pendingSince:    "2016-03-05T09:59:30Z",
+			expectedRequeue: 30 * time.Second,
+		},
+		{
+			name:         "debounce period passed",
+			pendingSince: "2016-03-05T09:58:30Z",
+			generates:    true,
+		},
+	}
+
+	for _, s := range scenarios {
+		config := deployapitest.OkDeploymentConfig(1)
+		config.Namespace = kapi.NamespaceDefault
+		config.Spec.ImageChangeDebounceSeconds = 60
+		if len(s.pendingSince) > 0 {
+			config.Annotations = map[string]string{deployapi.DeploymentImageChangePendingAnnotation: s.pendingSince}
+		}
+
+		var updated *deployapi.DeploymentConfig
+		generated := false
+		var requeued time.Duration
+		controller := &ImageChangeController{
+			deploymentConfigClient: &deploymentConfigClientImpl{
+				updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+					updated = config
+					return config, nil
+				},
+				generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+					generated = true
+					newConfig := deployapitest.OkDeploymentConfig(2)
+					newConfig.Namespace = config.Namespace
+					newConfig.Annotations = map[string]string{deployapi.DeploymentImageChangePendingAnnotation: s.pendingSince}
+					return newConfig, nil
+				},
+				listDeploymentConfigsFunc: func() ([]*deployapi.DeploymentConfig, error) {
+					return []*deployapi.DeploymentConfig{config}, nil
+				},
+			},
+			requeue: func(repo *imageapi.ImageStream, after time.Duration) {
+				requeued = after
+			},
+			now: func() time.Time { return now },
+		}
+
+		tagUpdate := makeRepo(
+			"test-image-stream",
+			imageapi.DefaultImageTag,
+			"registry:8080/openshift/test-image@sha256:00000000000000000000000000000001",
+			"00000000000000000000000000000001",
+		)
+		tagUpdate.Namespace = kapi.NamespaceDefault
+		if err := controller.Handle(tagUpdate); err != nil {
+			t.Fatalf("%s: unexpected error: %v", s.name, err)
+		}
+
+		if e, a := s.generates, generated; e != a {
+			t.Errorf("%s: expected generation %t, got %t", s.name, e, a)
+		}
+		if e, a := s.expectedRequeue, requeued; e != a {
+			t.Errorf("%s: expected requeue after %s, got %s", s.name, e, a)
+		}
+		switch {
+		case len(s.expectedPending) > 0:
+			if updated == nil || updated.Annotations[deployapi.DeploymentImageChangePendingAnnotation] != s.expectedPending {
+				t.Errorf("%s: expected an update with pending annotation %q, got %#v", s.name, s.expectedPending, updated)
+			}
+			if _, ok := config.Annotations[deployapi.DeploymentImageChangePendingAnnotation]; ok {
+				t.Errorf("%s: unexpected mutation of the cached config", s.name)
+			}
+		case s.generates:
+			if updated == nil || updated.Status.LatestVersion != 2 {
+				t.Fatalf("%s: expected an update of the generated config, got %#v", s.name, updated)
+			}
+			if _, ok := updated.Annotations[deployapi.DeploymentImageChangePendingAnnotation]; ok {
+				t.Errorf("%s: expected the pending annotation to be removed", s.name)
+			}
+		default:
+			if updated != nil {
+				t.Errorf("%s: unexpected update %#v", s.name, updated)
+			}
+		}
+	}
+}
@@ -64,6 +64,14 @@ func (factory *ImageChangeControllerFactory) Create() controller.RunnableControl
 				return factory.Client.DeploymentConfigs(namespace).Update(config)
 			},
 		},
+		requeue: func(repo *imageapi.ImageStream, after time.Duration) {
+			time.AfterFunc(after, func() {
+				if err := queue.AddIfNotPresent(repo); err != nil {
+					utilruntime.HandleError(err)
+				}
+			})
+		},
+		now: time.Now,
 	}
 
 	return &controller.RetryController{
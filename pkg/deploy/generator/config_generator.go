@@ -78,6 +78,7 @@ func (g *DeploymentConfigGenerator) Generate(ctx kapi.Context, name string) (*de
 				container.Image = latestEvent.DockerImageReference
 				// Log the last triggered image ID
 				params.LastTriggeredImage = latestEvent.DockerImageReference
+				recordTriggeredImage(&config.Status, container.Name, latestEvent.DockerImageReference)
 				containerChanged = true
 			}
 		}
@@ -114,6 +115,18 @@ func (g *DeploymentConfigGenerator) Generate(ctx kapi.Context, name string) (*de
 	return config, nil
 }
 
+// recordTriggeredImage records image as the last triggered image of the named
+// container in status.
+func recordTriggeredImage(status *deployapi.DeploymentConfigStatus, containerName, image string) {
+	for i := range status.LastTriggeredImages {
+		if status.LastTriggeredImages[i].ContainerName == containerName {
+			status.LastTriggeredImages[i].Image = image
+			return
+		}
+	}
+	status.LastTriggeredImages = append(status.LastTriggeredImages, deployapi.ContainerImage{ContainerName: containerName, Image: image})
+}
+
 func (g *DeploymentConfigGenerator) findImageStream(config *deployapi.DeploymentConfig, params *deployapi.DeploymentTriggerImageChangeParams) (*imageapi.ImageStream, error) {
 	if len(params.From.Name) > 0 {
 		namespace := params.From.Namespace
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"flag"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -159,6 +160,51 @@ func TestGenerate_fromConfigWithUpdatedImageRef(t *testing.T) {
 	}
 }
 
+func TestGenerate_recordsLastTriggeredImagesPerContainer(t *testing.T) {
+	appImage := "registry:8080/openshift/test-image@sha256:00000000000000000000000000000002"
+	sidecarImage := "registry:8080/openshift/sidecar@sha256:00000000000000000000000000000003"
+
+	generator := &DeploymentConfigGenerator{
+		Client: Client{
+			DCFn: func(ctx kapi.Context, id string) (*deployapi.DeploymentConfig, error) {
+				config := deploytest.OkDeploymentConfig(1)
+				config.Spec.Template.Spec.Containers = append(config.Spec.Template.Spec.Containers, kapi.Container{Name: "sidecar", Image: "sidecar:old"})
+				sidecarTrigger := deploytest.OkImageChangeTrigger()
+				sidecarTrigger.ImageChangeParams.ContainerNames = []string{"sidecar"}
+				sidecarTrigger.ImageChangeParams.From.Name = imageapi.JoinImageStreamTag("sidecar-stream", imageapi.DefaultImageTag)
+				config.Spec.Triggers = append(config.Spec.Triggers, sidecarTrigger)
+				config.Status.LastTriggeredImages = []deployapi.ContainerImage{{ContainerName: "container1", Image: "test-image:old"}}
+				return config, nil
+			},
+			ISFn: func(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
+				if name == "sidecar-stream" {
+					return makeStream(name, imageapi.DefaultImageTag, sidecarImage, "00000000000000000000000000000003"), nil
+				}
+				return makeStream(name, imageapi.DefaultImageTag, appImage, "00000000000000000000000000000002"), nil
+			},
+		},
+	}
+
+	config, err := generator.Generate(kapi.NewDefaultContext(), "deploy1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if config.Status.LatestVersion != 2 {
+		t.Fatalf("Expected config LatestVersion=2, got %d", config.Status.LatestVersion)
+	}
+	if e, a := 2, len(config.Status.Details.Causes); e != a {
+		t.Fatalf("Expected %d causes, got %d", e, a)
+	}
+	expected := []deployapi.ContainerImage{
+		{ContainerName: "container1", Image: appImage},
+		{ContainerName: "sidecar", Image: sidecarImage},
+	}
+	if !reflect.DeepEqual(expected, config.Status.LastTriggeredImages) {
+		t.Fatalf("Expected LastTriggeredImages %#v, got %#v", expected, config.Status.LastTriggeredImages)
+	}
+}
+
 func TestGenerate_reportsInvalidErrorWhenMissingRepo(t *testing.T) {
 	generator := &DeploymentConfigGenerator{
 		Client: Client{
@@ -0,0 +1,2 @@
+// Package deployhistory provides the history subresource of deployment configs
+package deployhistory
@@ -0,0 +1,187 @@
+package deployhistory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// REST is an implementation of RESTStorage for the api server.
+type REST struct {
+	ConfigGetter     client.DeploymentConfigsNamespacer
+	DeploymentGetter kclient.ReplicationControllersNamespacer
+	PodGetter        kclient.PodsNamespacer
+	Decoder          runtime.Decoder
+}
+
+// REST implements Getter
+var _ = rest.Getter(&REST{})
+
+// NewREST creates a new REST for DeploymentConfigHistory. It uses one client
+// for configs, one for deployments (replication controllers) and one for the
+// deployer and hook pods.
+func NewREST(dn client.DeploymentConfigsNamespacer, rn kclient.ReplicationControllersNamespacer, pn kclient.PodsNamespacer, decoder runtime.Decoder) *REST {
+	return &REST{
+		ConfigGetter:     dn,
+		DeploymentGetter: rn,
+		PodGetter:        pn,
+		Decoder:          decoder,
+	}
+}
+
+// New creates an empty DeploymentConfigHistory resource
+func (r *REST) New() runtime.Object {
+	return &deployapi.DeploymentConfigHistory{}
+}
+
+// Get returns the history of the deployments of the deployment config name.
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	namespace, ok := kapi.NamespaceFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("namespace parameter required.")
+	}
+
+	config, err := r.ConfigGetter.DeploymentConfigs(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	deployments, err := r.DeploymentGetter.ReplicationControllers(namespace).List(kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(config.Name)})
+	if err != nil {
+		return nil, err
+	}
+	selector, err := labels.Parse(deployapi.DeployerPodForDeploymentLabel)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := r.PodGetter.Pods(namespace).List(kapi.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	podsByDeployment := map[string][]kapi.Pod{}
+	for _, pod := range pods.Items {
+		deploymentName := pod.Labels[deployapi.DeployerPodForDeploymentLabel]
+		podsByDeployment[deploymentName] = append(podsByDeployment[deploymentName], pod)
+	}
+
+	history := &deployapi.DeploymentConfigHistory{
+		ObjectMeta:  kapi.ObjectMeta{Name: config.Name, Namespace: config.Namespace},
+		Deployments: []deployapi.DeploymentRecord{},
+	}
+	sort.Sort(deployutil.ByLatestVersionDesc(deployments.Items))
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		history.Deployments = append(history.Deployments, r.recordFor(deployment, podsByDeployment[deployment.Name]))
+	}
+	return history, nil
+}
+
+// recordFor builds the record of deployment from its annotations, the config
+// it is based on and its deployer and hook pods.
+func (r *REST) recordFor(deployment *kapi.ReplicationController, pods []kapi.Pod) deployapi.DeploymentRecord {
+	record := deployapi.DeploymentRecord{
+		Name:     deployment.Name,
+		Version:  deployutil.DeploymentVersionFor(deployment),
+		Phase:    deployutil.DeploymentStatusFor(deployment),
+		Replicas: deployment.Spec.Replicas,
+		Reason:   deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation],
+	}
+	if replicas, ok := deployutil.DeploymentDesiredReplicas(deployment); ok {
+		record.Replicas = replicas
+	} else if replicas, ok := deployutil.DeploymentReplicas(deployment); ok {
+		record.Replicas = replicas
+	}
+	if value, ok := deployment.Annotations[deployapi.DeploymentPreviousReplicasAnnotation]; ok {
+		if replicas, err := strconv.Atoi(value); err == nil {
+			record.PreviousReplicas = &replicas
+		}
+	}
+	if config, err := deployutil.DecodeDeploymentConfig(deployment, r.Decoder); err == nil {
+		record.Details = config.Status.Details
+		record.Strategy = config.Spec.Strategy.Type
+	}
+	record.StartTime = timeAnnotationFor(deployment, deployapi.DeploymentStartedAtAnnotation)
+	record.CompletionTime = timeAnnotationFor(deployment, deployapi.DeploymentCompletedAtAnnotation)
+
+	// Custom strategies don't record the times, fall back to the deployer pod.
+	deployerPodName := deployutil.DeployerPodNameForDeployment(deployment.Name)
+	sort.Sort(byCreationTimestamp(pods))
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Name == deployerPodName {
+			if record.StartTime == nil {
+				record.StartTime = pod.Status.StartTime
+			}
+			if record.CompletionTime == nil {
+				if state := terminatedState(pod); state != nil {
+					finished := state.FinishedAt
+					record.CompletionTime = &finished
+				}
+			}
+			continue
+		}
+		hook, ok := pod.Annotations[deployapi.DeploymentHookAnnotation]
+		if !ok {
+			continue
+		}
+		result := deployapi.DeploymentHookResult{Name: hook, Phase: pod.Status.Phase}
+		if state := terminatedState(pod); state != nil && pod.Status.Phase == kapi.PodFailed {
+			result.Message = state.Reason
+			if len(state.Message) > 0 {
+				result.Message = fmt.Sprintf("%s: %s", state.Reason, state.Message)
+			}
+		}
+		record.Hooks = append(record.Hooks, result)
+	}
+
+	if record.StartTime != nil && record.CompletionTime != nil {
+		duration := int64(record.CompletionTime.Sub(record.StartTime.Time) / time.Second)
+		record.DurationSeconds = &duration
+	}
+	return record
+}
+
+// timeAnnotationFor returns the RFC3339 time of the annotation key of
+// deployment, or nil.
+func timeAnnotationFor(deployment *kapi.ReplicationController, key string) *unversioned.Time {
+	value, ok := deployment.Annotations[key]
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	result := unversioned.NewTime(t)
+	return &result
+}
+
+// terminatedState returns the terminated state of the first container of pod,
+// or nil.
+func terminatedState(pod *kapi.Pod) *kapi.ContainerStateTerminated {
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return nil
+	}
+	return pod.Status.ContainerStatuses[0].State.Terminated
+}
+
+// byCreationTimestamp sorts pods by their creation timestamp.
+type byCreationTimestamp []kapi.Pod
+
+func (p byCreationTimestamp) Len() int      { return len(p) }
+func (p byCreationTimestamp) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p byCreationTimestamp) Less(i, j int) bool {
+	return p[i].CreationTimestamp.Before(p[j].CreationTimestamp)
+}
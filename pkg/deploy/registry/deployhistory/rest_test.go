@@ -0,0 +1,124 @@
+package deployhistory
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+
+	// install all APIs
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func makeDeployment(version int) kapi.ReplicationController {
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	deployment.Namespace = kapi.NamespaceDefault
+	return *deployment
+}
+
+func makePod(name, deployment string, phase kapi.PodPhase, terminated *kapi.ContainerStateTerminated) kapi.Pod {
+	return kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Namespace:   kapi.NamespaceDefault,
+			Labels:      map[string]string{deployapi.DeployerPodForDeploymentLabel: deployment},
+			Annotations: map[string]string{},
+		},
+		Status: kapi.PodStatus{
+			Phase:             phase,
+			ContainerStatuses: []kapi.ContainerStatus{{State: kapi.ContainerState{Terminated: terminated}}},
+		},
+	}
+}
+
+func TestGet(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(2)
+
+	complete := makeDeployment(1)
+	complete.Spec.Replicas = 2
+	complete.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+	complete.Annotations[deployapi.DeploymentReplicasAnnotation] = "2"
+	delete(complete.Annotations, deployapi.DesiredReplicasAnnotation)
+	complete.Annotations[deployapi.DeploymentStartedAtAnnotation] = "2016-02-01T01:00:00Z"
+	complete.Annotations[deployapi.DeploymentCompletedAtAnnotation] = "2016-02-01T01:01:30Z"
+
+	failed := makeDeployment(2)
+	failed.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusFailed)
+	failed.Annotations[deployapi.DesiredReplicasAnnotation] = "3"
+	failed.Annotations[deployapi.DeploymentPreviousReplicasAnnotation] = "2"
+	failed.Annotations[deployapi.DeploymentStatusReasonAnnotation] = "Hook failed, aborting"
+
+	started := unversioned.Date(2016, time.February, 1, 2, 0, 0, 0, time.UTC)
+	deployer := makePod(deployutil.DeployerPodNameForDeployment(failed.Name), failed.Name, kapi.PodFailed, &kapi.ContainerStateTerminated{
+		FinishedAt: unversioned.Date(2016, time.February, 1, 2, 0, 10, 0, time.UTC),
+	})
+	deployer.Status.StartTime = &started
+	hook := makePod(failed.Name+"-hook-pre", failed.Name, kapi.PodFailed, &kapi.ContainerStateTerminated{Reason: "Error", Message: "migration failed"})
+	hook.Annotations[deployapi.DeploymentHookAnnotation] = deployapi.PreHookPodSuffix
+
+	fakeDn := testclient.NewSimpleFake()
+	fakeDn.PrependReactor("get", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, config, nil
+	})
+	fakeRn := ktestclient.NewSimpleFake(&kapi.ReplicationControllerList{Items: []kapi.ReplicationController{complete, failed}})
+	fakePn := ktestclient.NewSimpleFake(&kapi.PodList{Items: []kapi.Pod{deployer, hook}})
+	r := NewREST(fakeDn, fakeRn, fakePn, kapi.Codecs.UniversalDecoder())
+
+	obj, err := r.Get(kapi.NewDefaultContext(), config.Name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	history := obj.(*deployapi.DeploymentConfigHistory)
+	if e, a := 2, len(history.Deployments); e != a {
+		t.Fatalf("expected %d records, got %d", e, a)
+	}
+
+	latest := history.Deployments[0]
+	if e, a := failed.Name, latest.Name; e != a {
+		t.Errorf("expected the latest deployment %s first, got %s", e, a)
+	}
+	if e, a := deployapi.DeploymentStatusFailed, latest.Phase; e != a {
+		t.Errorf("expected phase %s, got %s", e, a)
+	}
+	if e, a := 3, latest.Replicas; e != a {
+		t.Errorf("expected %d replicas, got %d", e, a)
+	}
+	if latest.PreviousReplicas == nil || *latest.PreviousReplicas != 2 {
+		t.Errorf("expected 2 previous replicas, got %v", latest.PreviousReplicas)
+	}
+	if e, a := "Hook failed, aborting", latest.Reason; e != a {
+		t.Errorf("expected reason %q, got %q", e, a)
+	}
+	if e, a := deployapi.DeploymentStrategyTypeRecreate, latest.Strategy; e != a {
+		t.Errorf("expected strategy %s, got %s", e, a)
+	}
+	if latest.DurationSeconds == nil || *latest.DurationSeconds != 10 {
+		t.Errorf("expected a duration of 10s from the deployer pod, got %v", latest.DurationSeconds)
+	}
+	if len(latest.Hooks) != 1 {
+		t.Fatalf("expected one hook result, got %v", latest.Hooks)
+	}
+	expectedHook := deployapi.DeploymentHookResult{Name: deployapi.PreHookPodSuffix, Phase: kapi.PodFailed, Message: "Error: migration failed"}
+	if e, a := expectedHook, latest.Hooks[0]; e != a {
+		t.Errorf("expected hook result %#v, got %#v", e, a)
+	}
+
+	previous := history.Deployments[1]
+	if e, a := 2, previous.Replicas; e != a {
+		t.Errorf("expected %d replicas, got %d", e, a)
+	}
+	if previous.DurationSeconds == nil || *previous.DurationSeconds != 90 {
+		t.Errorf("expected a duration of 90s from the annotations, got %v", previous.DurationSeconds)
+	}
+	if previous.PreviousReplicas != nil {
+		t.Errorf("unexpected previous replicas for the first deployment: %d", *previous.PreviousReplicas)
+	}
+}
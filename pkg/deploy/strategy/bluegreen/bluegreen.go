@@ -0,0 +1,262 @@
+package bluegreen
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// BlueGreenDeploymentStrategy is a Strategy which runs the new deployment at
+// full scale alongside the old one and switches a service over to it once all
+// of its pods are ready.
+//
+// The old deployment is kept running for a hold period after the switch so
+// that the switch can be reverted instantly by pointing the service back at
+// the old deployment. If that happens, the new deployment is scaled down and
+// the deployment fails; otherwise the old deployment is scaled down.
+type BlueGreenDeploymentStrategy struct {
+	// getReplicationController knows how to get a replication controller.
+	getReplicationController func(namespace, name string) (*kapi.ReplicationController, error)
+	// getService knows how to get a service.
+	getService func(namespace, name string) (*kapi.Service, error)
+	// updateService knows how to update a service.
+	updateService func(namespace string, service *kapi.Service) (*kapi.Service, error)
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the pods of the
+	// new deployment.
+	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// decoder is used to decode DeploymentConfigs contained in deployments.
+	decoder runtime.Decoder
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// retryTimeout is how long to wait for the replica count update to succeed
+	// before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count, and how
+	// often the service is checked for a reverted switch during the hold
+	// period.
+	retryPeriod time.Duration
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
+// NewBlueGreenDeploymentStrategy makes a BlueGreenDeploymentStrategy backed
+// by a real HookExecutor and client.
+func NewBlueGreenDeploymentStrategy(client kclient.Interface, tagClient client.ImageStreamTagsNamespacer, decoder runtime.Decoder) *BlueGreenDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
+	return &BlueGreenDeploymentStrategy{
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Get(name)
+		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			return client.Services(namespace).Get(name)
+		},
+		updateService: func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+			return client.Services(namespace).Update(service)
+		},
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		scaler:       scaler,
+		decoder:      decoder,
+		hookExecutor: stratsupport.NewHookExecutor(client, tagClient, os.Stdout, decoder),
+		retryTimeout: 120 * time.Second,
+		retryPeriod:  1 * time.Second,
+	}
+}
+
+// Deploy scales to up fully, switches the service to it once its pods are
+// ready and scales from down after the hold period.
+func (s *BlueGreenDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.decoder)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Spec.Strategy.BlueGreenParams
+	if params == nil {
+		return fmt.Errorf("deployment config %s has no blue-green parameters", deployutil.LabelForDeploymentConfig(config))
+	}
+	timeout := deployapi.DefaultRollingTimeoutSeconds
+	if params.TimeoutSeconds != nil {
+		timeout = *params.TimeoutSeconds
+	}
+	hold := deployapi.DefaultBlueGreenHoldSeconds
+	if params.HoldSeconds != nil {
+		hold = *params.HoldSeconds
+	}
+	retryParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, deployapi.PreHookPodSuffix); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	// Scale up the to deployment next to the from deployment and wait for all
+	// of its pods to become ready.
+	if desiredReplicas > 0 {
+		glog.Infof("Scaling %s to %d", deployutil.LabelForDeployment(to), desiredReplicas)
+		updatedTo, err := s.scaleAndWait(to, desiredReplicas, retryParams, waitParams)
+		if err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), desiredReplicas, err)
+		}
+		glog.Infof("Performing acceptance check of %s", deployutil.LabelForDeployment(to))
+		if err := s.getUpdateAcceptor(time.Duration(timeout) * time.Second).Accept(updatedTo); err != nil {
+			s.scaleDownAfterFailure(to, retryParams, waitParams)
+			return fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err)
+		}
+		to = updatedTo
+	}
+
+	// Switch the service to the to deployment.
+	glog.Infof("Switching service %s/%s to %s", to.Namespace, params.ServiceName, deployutil.LabelForDeployment(to))
+	if err := SwitchService(s.getService, s.updateService, to.Namespace, params.ServiceName, to.Name); err != nil {
+		s.scaleDownAfterFailure(to, retryParams, waitParams)
+		return fmt.Errorf("couldn't switch service %s/%s to %s: %v", to.Namespace, params.ServiceName, deployutil.LabelForDeployment(to), err)
+	}
+
+	// Execute any post-hook.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, deployapi.PostHookPodSuffix); err != nil {
+			// The service already selects the failed deployment, give the
+			// traffic back to the from deployment.
+			if from != nil {
+				glog.Infof("Switching service %s/%s back to %s", to.Namespace, params.ServiceName, deployutil.LabelForDeployment(from))
+				if err := SwitchService(s.getService, s.updateService, to.Namespace, params.ServiceName, from.Name); err != nil {
+					glog.Errorf("Couldn't switch service %s/%s back to %s: %v", to.Namespace, params.ServiceName, deployutil.LabelForDeployment(from), err)
+				}
+			}
+			s.scaleDownAfterFailure(to, retryParams, waitParams)
+			return fmt.Errorf("post hook failed: %s", err)
+		}
+		glog.Infof("Post hook finished")
+	}
+
+	if from == nil {
+		glog.Infof("Deployment %s successfully made active", to.Name)
+		return nil
+	}
+
+	// Keep the from deployment running for the hold period so that the switch
+	// can be reverted instantly.
+	glog.Infof("Holding %s for %d seconds before scaling it down", deployutil.LabelForDeployment(from), hold)
+	reverted, err := s.waitForRevert(to.Namespace, params.ServiceName, to.Name, time.Duration(hold)*time.Second)
+	if err != nil {
+		return fmt.Errorf("couldn't check service %s/%s: %v", to.Namespace, params.ServiceName, err)
+	}
+	if reverted {
+		s.scaleDownAfterFailure(to, retryParams, waitParams)
+		return fmt.Errorf("the switch of service %s/%s to %s was reverted", to.Namespace, params.ServiceName, deployutil.LabelForDeployment(to))
+	}
+
+	glog.Infof("Scaling %s down to zero", deployutil.LabelForDeployment(from))
+	if _, err := s.scaleAndWait(from, 0, retryParams, waitParams); err != nil {
+		return fmt.Errorf("couldn't scale %s to 0: %v", deployutil.LabelForDeployment(from), err)
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// waitForRevert checks the service every retry period until the hold period
+// is over and returns true as soon as it no longer selects the pods of
+// deploymentName.
+func (s *BlueGreenDeploymentStrategy) waitForRevert(namespace, serviceName, deploymentName string, hold time.Duration) (bool, error) {
+	reverted := func() (bool, error) {
+		service, err := s.getService(namespace, serviceName)
+		if err != nil {
+			return false, err
+		}
+		return SwitchedDeployment(service) != deploymentName, nil
+	}
+
+	if hold > 0 {
+		err := wait.Poll(s.retryPeriod, hold, reverted)
+		if err != wait.ErrWaitTimeout {
+			return err == nil, err
+		}
+	}
+	return reverted()
+}
+
+func (s *BlueGreenDeploymentStrategy) scaleDownAfterFailure(deployment *kapi.ReplicationController, retry *kubectl.RetryParams, wait *kubectl.RetryParams) {
+	glog.Infof("Scaling %s down to zero", deployutil.LabelForDeployment(deployment))
+	if _, err := s.scaleAndWait(deployment, 0, retry, wait); err != nil {
+		glog.Errorf("Couldn't scale %s to 0: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+}
+
+func (s *BlueGreenDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int, retry *kubectl.RetryParams, wait *kubectl.RetryParams) (*kapi.ReplicationController, error) {
+	if err := s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, wait); err != nil {
+		return nil, err
+	}
+	updatedDeployment, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+	if err != nil {
+		return nil, err
+	}
+	return updatedDeployment, nil
+}
+
+// SwitchService points the selector of the named service at the pods of
+// deploymentName.
+func SwitchService(getService func(namespace, name string) (*kapi.Service, error), updateService func(namespace string, service *kapi.Service) (*kapi.Service, error), namespace, serviceName, deploymentName string) error {
+	service, err := getService(namespace, serviceName)
+	if err != nil {
+		return err
+	}
+	if SwitchedDeployment(service) == deploymentName {
+		return nil
+	}
+
+	selector := map[string]string{}
+	for k, v := range service.Spec.Selector {
+		selector[k] = v
+	}
+	selector[deployapi.DeploymentLabel] = deploymentName
+	service.Spec.Selector = selector
+	_, err = updateService(namespace, service)
+	return err
+}
+
+// SwitchedDeployment returns the name of the deployment whose pods are
+// selected by service, or an empty string if the service has not been
+// switched by a blue-green deployment.
+func SwitchedDeployment(service *kapi.Service) string {
+	return service.Spec.Selector[deployapi.DeploymentLabel]
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
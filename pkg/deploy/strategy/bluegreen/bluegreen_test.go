@@ -0,0 +1,280 @@
+package bluegreen
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	"github.com/openshift/origin/pkg/deploy/strategy"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func TestBlueGreen_initialDeployment(t *testing.T) {
+	to := makeDeployment(1, 0, "")
+	service := makeService(nil)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, service, acceptAll)
+
+	if err := strategy.Deploy(nil, to, 3); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	if e, a := 1, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d: %v", e, a, scaler.Events)
+	}
+	if e, a := (scalertest.ScaleEvent{Name: to.Name, Size: 3}), scaler.Events[0]; e != a {
+		t.Errorf("expected scale event %v, got %v", e, a)
+	}
+	if e, a := to.Name, SwitchedDeployment(service); e != a {
+		t.Errorf("expected service switched to %s, got %q", e, a)
+	}
+	if e, a := "bar", service.Spec.Selector["app"]; e != a {
+		t.Errorf("expected the existing selector to be kept, got %q", a)
+	}
+}
+
+func TestBlueGreen_switchAndScaleDown(t *testing.T) {
+	from := makeDeployment(1, 0, "")
+	to := makeDeployment(2, 0, "")
+	service := makeService(from)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, service, acceptAll)
+
+	if err := strategy.Deploy(from, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expected := []scalertest.ScaleEvent{{Name: to.Name, Size: 2}, {Name: from.Name, Size: 0}}
+	if e, a := len(expected), len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d: %v", e, a, scaler.Events)
+	}
+	for i := range expected {
+		if e, a := expected[i], scaler.Events[i]; e != a {
+			t.Errorf("expected scale event %v, got %v", e, a)
+		}
+	}
+	if e, a := to.Name, SwitchedDeployment(service); e != a {
+		t.Errorf("expected service switched to %s, got %q", e, a)
+	}
+}
+
+func TestBlueGreen_acceptorFail(t *testing.T) {
+	from := makeDeployment(1, 0, "")
+	to := makeDeployment(2, 0, "")
+	service := makeService(from)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, service, func(timeout time.Duration) strategy.UpdateAcceptor {
+		return &testAcceptor{
+			acceptFn: func(deployment *kapi.ReplicationController) error {
+				return fmt.Errorf("rejected")
+			},
+		}
+	})
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expected := []scalertest.ScaleEvent{{Name: to.Name, Size: 2}, {Name: to.Name, Size: 0}}
+	if e, a := len(expected), len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d: %v", e, a, scaler.Events)
+	}
+	for i := range expected {
+		if e, a := expected[i], scaler.Events[i]; e != a {
+			t.Errorf("expected scale event %v, got %v", e, a)
+		}
+	}
+	if e, a := from.Name, SwitchedDeployment(service); e != a {
+		t.Errorf("expected service to stay on %s, got %q", e, a)
+	}
+}
+
+func TestBlueGreen_revertedDuringHold(t *testing.T) {
+	from := makeDeployment(1, 1, "")
+	to := makeDeployment(2, 1, "")
+	service := makeService(from)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, service, acceptAll)
+
+	// Revert the switch on the first check of the hold period.
+	getService := strategy.getService
+	switched := false
+	strategy.getService = func(namespace, name string) (*kapi.Service, error) {
+		if SwitchedDeployment(service) == to.Name {
+			if switched {
+				service.Spec.Selector[deployapi.DeploymentLabel] = from.Name
+			}
+			switched = true
+		}
+		return getService(namespace, name)
+	}
+
+	err := strategy.Deploy(from, to, 2)
+	if err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expected := []scalertest.ScaleEvent{{Name: to.Name, Size: 2}, {Name: to.Name, Size: 0}}
+	if e, a := len(expected), len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d: %v", e, a, scaler.Events)
+	}
+	for i := range expected {
+		if e, a := expected[i], scaler.Events[i]; e != a {
+			t.Errorf("expected scale event %v, got %v", e, a)
+		}
+	}
+}
+
+func TestBlueGreen_hooks(t *testing.T) {
+	from := makeDeployment(1, 0, "")
+	to := makeDeployment(2, 0, deployapi.LifecycleHookFailurePolicyAbort)
+	service := makeService(from)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, service, acceptAll)
+
+	executed := []string{}
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			// The post hook runs once the service is switched.
+			if label == deployapi.PostHookPodSuffix && SwitchedDeployment(service) != to.Name {
+				t.Errorf("expected the service to be switched before the post hook")
+			}
+			executed = append(executed, label)
+			return nil
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 1); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+	if e, a := fmt.Sprintf("%v", []string{deployapi.PreHookPodSuffix, deployapi.PostHookPodSuffix}), fmt.Sprintf("%v", executed); e != a {
+		t.Errorf("expected hooks %s, got %s", e, a)
+	}
+
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			return fmt.Errorf("hook execution failure")
+		},
+	}
+	scaler.Events = nil
+	if err := strategy.Deploy(from, to, 1); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	if len(scaler.Events) > 0 {
+		t.Fatalf("unexpected scaling events: %v", scaler.Events)
+	}
+}
+
+func TestBlueGreen_postHookFail(t *testing.T) {
+	from := makeDeployment(1, 0, "")
+	to := makeDeployment(2, 0, deployapi.LifecycleHookFailurePolicyAbort)
+	service := makeService(from)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, service, acceptAll)
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			if label == deployapi.PostHookPodSuffix {
+				return fmt.Errorf("hook execution failure")
+			}
+			return nil
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expected := []scalertest.ScaleEvent{{Name: to.Name, Size: 2}, {Name: to.Name, Size: 0}}
+	if e, a := len(expected), len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d: %v", e, a, scaler.Events)
+	}
+	for i := range expected {
+		if e, a := expected[i], scaler.Events[i]; e != a {
+			t.Errorf("expected scale event %v, got %v", e, a)
+		}
+	}
+	if e, a := from.Name, SwitchedDeployment(service); e != a {
+		t.Errorf("expected service switched back to %s, got %q", e, a)
+	}
+}
+
+func newTestStrategy(scaler *scalertest.FakeScaler, deployment *kapi.ReplicationController, service *kapi.Service, getUpdateAcceptor func(time.Duration) strategy.UpdateAcceptor) *BlueGreenDeploymentStrategy {
+	return &BlueGreenDeploymentStrategy{
+		decoder:      kapi.Codecs.UniversalDecoder(),
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			copied := *service
+			copied.Spec.Selector = map[string]string{}
+			for k, v := range service.Spec.Selector {
+				copied.Spec.Selector[k] = v
+			}
+			return &copied, nil
+		},
+		updateService: func(namespace string, updated *kapi.Service) (*kapi.Service, error) {
+			*service = *updated
+			return service, nil
+		},
+		getUpdateAcceptor: getUpdateAcceptor,
+		scaler:            scaler,
+	}
+}
+
+func makeDeployment(version int, hold int64, hookFailurePolicy deployapi.LifecycleHookFailurePolicy) *kapi.ReplicationController {
+	config := deploytest.OkDeploymentConfig(version)
+	timeout := int64(30)
+	params := &deployapi.BlueGreenDeploymentStrategyParams{
+		ServiceName:    "frontend",
+		TimeoutSeconds: &timeout,
+		HoldSeconds:    &hold,
+	}
+	if len(hookFailurePolicy) > 0 {
+		params.Pre = &deployapi.LifecycleHook{FailurePolicy: hookFailurePolicy, ExecNewPod: &deployapi.ExecNewPodHook{}}
+		params.Post = &deployapi.LifecycleHook{FailurePolicy: hookFailurePolicy, ExecNewPod: &deployapi.ExecNewPodHook{}}
+	}
+	config.Spec.Strategy = deployapi.DeploymentStrategy{
+		Type:            deployapi.DeploymentStrategyTypeBlueGreen,
+		BlueGreenParams: params,
+	}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	return deployment
+}
+
+func makeService(active *kapi.ReplicationController) *kapi.Service {
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: kapi.NamespaceDefault},
+		Spec: kapi.ServiceSpec{
+			Selector: map[string]string{"app": "bar"},
+		},
+	}
+	if active != nil {
+		service.Spec.Selector[deployapi.DeploymentLabel] = active.Name
+	}
+	return service
+}
+
+func acceptAll(timeout time.Duration) strategy.UpdateAcceptor {
+	return &testAcceptor{
+		acceptFn: func(deployment *kapi.ReplicationController) error {
+			return nil
+		},
+	}
+}
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
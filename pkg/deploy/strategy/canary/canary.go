@@ -0,0 +1,245 @@
+package canary
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// CanaryDeploymentStrategy is a Strategy which shifts the traffic to the new
+// deployment in steps and promotes it only while its pods stay healthy.
+//
+// The pods of both deployments carry the labels of the deployment config, so
+// the services and routes selecting them split the traffic in proportion to
+// the number of pods of each deployment. Each step scales the new deployment
+// to its percentage of the desired replicas and the old deployment to the
+// remainder, then observes the new pods for the bake period and executes the
+// optional check hook. If any of that fails, the old deployment is scaled
+// back to its original size and the new deployment to zero.
+//
+// The split is approximated by whole pods, see deployutil.CanaryReplicas:
+// steps which give the new deployment as many pods as the previous step are
+// skipped, and a deployment config with a single replica keeps its old pod
+// next to the canary, which then receives half of the traffic.
+//
+// When there is no existing prior deployment, there is no traffic to shift and
+// deployment delegates to another strategy, the Recreate strategy in the
+// deployer, as the Rolling strategy does. Unlike the Rolling strategy, the
+// steps don't use the surge and unavailability limits of
+// RollingDeploymentStrategyParams: each step sets the replicas of both
+// deployments to the split of its percentage.
+type CanaryDeploymentStrategy struct {
+	// initialStrategy is used when there are no prior deployments.
+	initialStrategy acceptingDeploymentStrategy
+	// getReplicationController knows how to get a replication controller.
+	getReplicationController func(namespace, name string) (*kapi.ReplicationController, error)
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the pods of the
+	// new deployment.
+	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
+	// observe returns an error if the pods of a deployment become unready or
+	// restart during the given period.
+	observe func(deployment *kapi.ReplicationController, period time.Duration) error
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// decoder is used to decode DeploymentConfigs contained in deployments.
+	decoder runtime.Decoder
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// retryTimeout is how long to wait for the replica count update to succeed
+	// before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count.
+	retryPeriod time.Duration
+}
+
+// acceptingDeploymentStrategy is a DeploymentStrategy which accepts an
+// injected UpdateAcceptor as part of the deploy function.
+type acceptingDeploymentStrategy interface {
+	DeployWithAcceptor(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor strat.UpdateAcceptor) error
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness, and how often the pods are checked during the bake period.
+const AcceptorInterval = 1 * time.Second
+
+// NewCanaryDeploymentStrategy makes a CanaryDeploymentStrategy backed by a
+// real HookExecutor and client.
+func NewCanaryDeploymentStrategy(client kclient.Interface, tagClient client.ImageStreamTagsNamespacer, decoder runtime.Decoder, initialStrategy acceptingDeploymentStrategy) *CanaryDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
+	observer := stratsupport.NewObserveHealthyPods(client, AcceptorInterval)
+	return &CanaryDeploymentStrategy{
+		initialStrategy: initialStrategy,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Get(name)
+		},
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		observe:      observer.Observe,
+		scaler:       scaler,
+		decoder:      decoder,
+		hookExecutor: stratsupport.NewHookExecutor(client, tagClient, os.Stdout, decoder),
+		retryTimeout: 120 * time.Second,
+		retryPeriod:  1 * time.Second,
+	}
+}
+
+// Deploy shifts the traffic from from to to in the configured steps and
+// promotes to once all of the steps succeeded.
+func (s *CanaryDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.decoder)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Spec.Strategy.CanaryParams
+	if params == nil {
+		return fmt.Errorf("deployment config %s has no canary parameters", deployutil.LabelForDeploymentConfig(config))
+	}
+	timeout := deployapi.DefaultRollingTimeoutSeconds
+	if params.TimeoutSeconds != nil {
+		timeout = *params.TimeoutSeconds
+	}
+	bake := deployapi.DefaultCanaryBakeSeconds
+	if params.BakeSeconds != nil {
+		bake = *params.BakeSeconds
+	}
+	updateAcceptor := s.getUpdateAcceptor(time.Duration(timeout) * time.Second)
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, deployapi.PreHookPodSuffix); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	// There is no traffic to shift without a prior deployment.
+	if from == nil {
+		if err := s.initialStrategy.DeployWithAcceptor(from, to, desiredReplicas, updateAcceptor); err != nil {
+			return err
+		}
+	} else {
+		if err := s.shift(from, to, desiredReplicas, params, time.Duration(bake)*time.Second, updateAcceptor); err != nil {
+			return err
+		}
+	}
+
+	// Execute any post-hook.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, deployapi.PostHookPodSuffix); err != nil {
+			return fmt.Errorf("post hook failed: %s", err)
+		}
+		glog.Infof("Post hook finished")
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// shift runs the steps of params and then promotes to. If a step fails, from
+// is restored and to is scaled down.
+func (s *CanaryDeploymentStrategy) shift(from, to *kapi.ReplicationController, desiredReplicas int, params *deployapi.CanaryDeploymentStrategyParams, bake time.Duration, updateAcceptor strat.UpdateAcceptor) error {
+	retryParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	originalReplicas := from.Spec.Replicas
+
+	abort := func(reason string, err error) error {
+		glog.Infof("Aborting: %s: %v", reason, err)
+		if _, scaleErr := s.scaleAndWait(from, originalReplicas, retryParams, waitParams); scaleErr != nil {
+			glog.Errorf("Couldn't scale %s back to %d: %v", deployutil.LabelForDeployment(from), originalReplicas, scaleErr)
+		}
+		if _, scaleErr := s.scaleAndWait(to, 0, retryParams, waitParams); scaleErr != nil {
+			glog.Errorf("Couldn't scale %s to 0: %v", deployutil.LabelForDeployment(to), scaleErr)
+		}
+		return fmt.Errorf("%s: %v", reason, err)
+	}
+
+	if desiredReplicas > 0 {
+		previousReplicas := 0
+		for _, step := range params.Steps {
+			replicas := deployutil.CanaryReplicas(desiredReplicas, step)
+			if replicas == previousReplicas {
+				glog.Infof("Skipping the %d%% step: %s already has %d of the %d replicas", step, deployutil.LabelForDeployment(to), replicas, desiredReplicas)
+				continue
+			}
+			previousReplicas = replicas
+			// A single replica is not taken from the old deployment, so
+			// that the canary doesn't receive all of the traffic.
+			fromReplicas := desiredReplicas - replicas
+			if fromReplicas < 1 {
+				fromReplicas = 1
+			}
+			glog.Infof("Shifting %d%% of the traffic: scaling %s to %d and %s to %d", step, deployutil.LabelForDeployment(to), replicas, deployutil.LabelForDeployment(from), fromReplicas)
+
+			updatedTo, err := s.scaleAndWait(to, replicas, retryParams, waitParams)
+			if err != nil {
+				return abort(fmt.Sprintf("couldn't scale %s to %d", deployutil.LabelForDeployment(to), replicas), err)
+			}
+			if err := updateAcceptor.Accept(updatedTo); err != nil {
+				return abort(fmt.Sprintf("update acceptor rejected %s at %d%%", deployutil.LabelForDeployment(to), step), err)
+			}
+			if _, err := s.scaleAndWait(from, fromReplicas, retryParams, waitParams); err != nil {
+				return abort(fmt.Sprintf("couldn't scale %s to %d", deployutil.LabelForDeployment(from), fromReplicas), err)
+			}
+
+			if bake > 0 {
+				if err := s.observe(updatedTo, bake); err != nil {
+					return abort(fmt.Sprintf("canary %s failed at %d%%", deployutil.LabelForDeployment(to), step), err)
+				}
+			}
+			if params.Check != nil {
+				hook := &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort, ExecNewPod: params.Check}
+				label := fmt.Sprintf("%s-%d", deployapi.CanaryCheckHookPodSuffix, step)
+				if err := s.hookExecutor.Execute(hook, to, label); err != nil {
+					return abort(fmt.Sprintf("check of %s failed at %d%%", deployutil.LabelForDeployment(to), step), err)
+				}
+			}
+		}
+	}
+
+	// Promote the to deployment.
+	glog.Infof("Promoting %s: scaling it to %d and %s to 0", deployutil.LabelForDeployment(to), desiredReplicas, deployutil.LabelForDeployment(from))
+	updatedTo, err := s.scaleAndWait(to, desiredReplicas, retryParams, waitParams)
+	if err != nil {
+		return abort(fmt.Sprintf("couldn't scale %s to %d", deployutil.LabelForDeployment(to), desiredReplicas), err)
+	}
+	if desiredReplicas > 0 {
+		if err := updateAcceptor.Accept(updatedTo); err != nil {
+			return abort(fmt.Sprintf("update acceptor rejected %s", deployutil.LabelForDeployment(to)), err)
+		}
+	}
+	if _, err := s.scaleAndWait(from, 0, retryParams, waitParams); err != nil {
+		return fmt.Errorf("couldn't scale %s to 0: %v", deployutil.LabelForDeployment(from), err)
+	}
+	return nil
+}
+
+func (s *CanaryDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int, retry *kubectl.RetryParams, wait *kubectl.RetryParams) (*kapi.ReplicationController, error) {
+	if err := s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, wait); err != nil {
+		return nil, err
+	}
+	updatedDeployment, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+	if err != nil {
+		return nil, err
+	}
+	return updatedDeployment, nil
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
@@ -0,0 +1,255 @@
+package canary
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	"github.com/openshift/origin/pkg/deploy/strategy"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func TestCanary_initialDeployment(t *testing.T) {
+	to := makeDeployment(1, nil)
+	canary := newTestStrategy(&scalertest.FakeScaler{}, to, acceptAll)
+	var initialStrategyInvoked bool
+	canary.initialStrategy = &testStrategy{
+		deployFn: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor strategy.UpdateAcceptor) error {
+			initialStrategyInvoked = true
+			return nil
+		},
+	}
+
+	if err := canary.Deploy(nil, to, 3); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+	if !initialStrategyInvoked {
+		t.Fatalf("expected initial strategy to be invoked")
+	}
+}
+
+func TestCanary_steps(t *testing.T) {
+	from := makeDeployment(1, nil)
+	from.Spec.Replicas = 4
+	to := makeDeployment(2, nil)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, acceptAll)
+	checks := []string{}
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			checks = append(checks, label)
+			return nil
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 4); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1}, {Name: from.Name, Size: 3},
+		{Name: to.Name, Size: 2}, {Name: from.Name, Size: 2},
+		{Name: to.Name, Size: 4}, {Name: from.Name, Size: 0},
+	}
+	expectScaleEvents(t, expected, scaler.Events)
+	if e, a := fmt.Sprintf("%v", []string{"hook-canary-10", "hook-canary-50"}), fmt.Sprintf("%v", checks); e != a {
+		t.Errorf("expected checks %s, got %s", e, a)
+	}
+}
+
+// TestCanary_singleReplica ensures that the old pod of a deployment config
+// with a single replica is kept during the steps.
+func TestCanary_singleReplica(t *testing.T) {
+	from := makeDeployment(1, nil)
+	from.Spec.Replicas = 1
+	to := makeDeployment(2, nil)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, acceptAll)
+	checks := []string{}
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			checks = append(checks, label)
+			return nil
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 1); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1}, {Name: from.Name, Size: 1},
+		{Name: to.Name, Size: 1}, {Name: from.Name, Size: 0},
+	}
+	expectScaleEvents(t, expected, scaler.Events)
+	if e, a := fmt.Sprintf("%v", []string{"hook-canary-10"}), fmt.Sprintf("%v", checks); e != a {
+		t.Errorf("expected checks %s, got %s", e, a)
+	}
+}
+
+func TestCanary_unhealthyAborts(t *testing.T) {
+	from := makeDeployment(1, nil)
+	from.Spec.Replicas = 4
+	to := makeDeployment(2, nil)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, acceptAll)
+	observed := 0
+	strategy.observe = func(deployment *kapi.ReplicationController, period time.Duration) error {
+		observed++
+		if observed == 2 {
+			return fmt.Errorf("pod restarted")
+		}
+		return nil
+	}
+
+	if err := strategy.Deploy(from, to, 4); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1}, {Name: from.Name, Size: 3},
+		{Name: to.Name, Size: 2}, {Name: from.Name, Size: 2},
+		{Name: from.Name, Size: 4}, {Name: to.Name, Size: 0},
+	}
+	expectScaleEvents(t, expected, scaler.Events)
+}
+
+func TestCanary_checkFailureAborts(t *testing.T) {
+	from := makeDeployment(1, nil)
+	from.Spec.Replicas = 4
+	to := makeDeployment(2, nil)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, acceptAll)
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			if hook.FailurePolicy != deployapi.LifecycleHookFailurePolicyAbort {
+				t.Errorf("expected the check to abort on failure, got %s", hook.FailurePolicy)
+			}
+			return fmt.Errorf("check failed")
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 4); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expected := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1}, {Name: from.Name, Size: 3},
+		{Name: from.Name, Size: 4}, {Name: to.Name, Size: 0},
+	}
+	expectScaleEvents(t, expected, scaler.Events)
+}
+
+func TestCanary_hooks(t *testing.T) {
+	from := makeDeployment(1, nil)
+	to := makeDeployment(2, &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort, ExecNewPod: &deployapi.ExecNewPodHook{}})
+	scaler := &scalertest.FakeScaler{}
+	strategy := newTestStrategy(scaler, to, acceptAll)
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			if label == deployapi.PreHookPodSuffix {
+				return fmt.Errorf("hook execution failure")
+			}
+			return nil
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	if len(scaler.Events) > 0 {
+		t.Fatalf("unexpected scaling events: %v", scaler.Events)
+	}
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
+
+func newTestStrategy(scaler *scalertest.FakeScaler, deployment *kapi.ReplicationController, getUpdateAcceptor func(time.Duration) strategy.UpdateAcceptor) *CanaryDeploymentStrategy {
+	return &CanaryDeploymentStrategy{
+		decoder:      kapi.Codecs.UniversalDecoder(),
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		getUpdateAcceptor: getUpdateAcceptor,
+		observe: func(deployment *kapi.ReplicationController, period time.Duration) error {
+			return nil
+		},
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				return nil
+			},
+		},
+		scaler: scaler,
+	}
+}
+
+func makeDeployment(version int, hook *deployapi.LifecycleHook) *kapi.ReplicationController {
+	config := deploytest.OkDeploymentConfig(version)
+	timeout := int64(30)
+	bake := int64(1)
+	config.Spec.Strategy = deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeCanary,
+		CanaryParams: &deployapi.CanaryDeploymentStrategyParams{
+			TimeoutSeconds: &timeout,
+			Steps:          []int{10, 50},
+			BakeSeconds:    &bake,
+			Check:          &deployapi.ExecNewPodHook{ContainerName: "container1", Command: []string{"/bin/check"}},
+			Pre:            hook,
+			Post:           hook,
+		},
+	}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	return deployment
+}
+
+func expectScaleEvents(t *testing.T, expected, events []scalertest.ScaleEvent) {
+	if e, a := len(expected), len(events); e != a {
+		t.Fatalf("expected %d scale calls, got %d: %v", e, a, events)
+	}
+	for i := range expected {
+		if e, a := expected[i], events[i]; e != a {
+			t.Errorf("expected scale event %v, got %v", e, a)
+		}
+	}
+}
+
+func acceptAll(timeout time.Duration) strategy.UpdateAcceptor {
+	return &testAcceptor{
+		acceptFn: func(deployment *kapi.ReplicationController) error {
+			return nil
+		},
+	}
+}
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
+
+type testStrategy struct {
+	deployFn func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor strategy.UpdateAcceptor) error
+}
+
+func (s *testStrategy) DeployWithAcceptor(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor strategy.UpdateAcceptor) error {
+	return s.deployFn(from, to, desiredReplicas, updateAcceptor)
+}
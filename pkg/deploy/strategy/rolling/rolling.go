@@ -3,6 +3,8 @@ package rolling
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -11,7 +13,9 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
 	"k8s.io/kubernetes/pkg/util/wait"
 
 	"github.com/openshift/origin/pkg/client"
@@ -28,6 +32,10 @@ const sourceIdAnnotation = "kubectl.kubernetes.io/update-source-id"
 const DefaultApiRetryPeriod = 1 * time.Second
 const DefaultApiRetryTimeout = 10 * time.Second
 
+// NewPodsEnvVar is the environment variable through which the batch hook
+// receives the comma separated names of the ready pods of the new deployment.
+const NewPodsEnvVar = "OPENSHIFT_DEPLOYMENT_NEW_PODS"
+
 // RollingDeploymentStrategy is a Strategy which implements rolling
 // deployments using the upstream Kubernetes RollingUpdater.
 //
@@ -36,6 +44,8 @@ const DefaultApiRetryTimeout = 10 * time.Second
 // 1. When there is no existing prior deployment, deployment delegates to
 // another strategy.
 // 2. The interface to the RollingUpdater is not very clean.
+// 3. The RollingUpdater can't stop between batches, so the strategy scales
+// the deployments itself when a batch hook is configured.
 //
 // These caveats can be resolved with future upstream refactorings to
 // RollingUpdater[1][2].
@@ -51,6 +61,11 @@ type RollingDeploymentStrategy struct {
 	tags client.ImageStreamTagsNamespacer
 	// rollingUpdate knows how to perform a rolling update.
 	rollingUpdate func(config *kubectl.RollingUpdaterConfig) error
+	// scaler is used to scale replication controllers between batch hooks.
+	scaler kubectl.Scaler
+	// pause holds a deployment after its batch hook failed until it is
+	// resumed.
+	pause func(deployment *kapi.ReplicationController, reason string) error
 	// decoder is used to access the encoded config on a deployment.
 	decoder runtime.Decoder
 	// hookExecutor can execute a lifecycle hook.
@@ -79,7 +94,8 @@ const AcceptorInterval = 1 * time.Second
 
 // NewRollingDeploymentStrategy makes a new RollingDeploymentStrategy.
 func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, tags client.ImageStreamTagsNamespacer, decoder runtime.Decoder, initialStrategy acceptingDeploymentStrategy) *RollingDeploymentStrategy {
-	return &RollingDeploymentStrategy{
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
+	strategy := &RollingDeploymentStrategy{
 		decoder:         decoder,
 		initialStrategy: initialStrategy,
 		client:          client,
@@ -90,11 +106,14 @@ func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, ta
 			updater := kubectl.NewRollingUpdater(namespace, client)
 			return updater.Update(config)
 		},
+		scaler:       scaler,
 		hookExecutor: stratsupport.NewHookExecutor(client, tags, os.Stdout, decoder),
 		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
 			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
 		},
 	}
+	strategy.pause = strategy.waitForResume
+	return strategy
 }
 
 func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
@@ -147,12 +166,35 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		glog.Infof("Pre hook finished")
 	}
 
+	// Perform a rolling update, executing any batch hook after each batch.
+	if params.Batch != nil {
+		err = s.rollInBatches(from, to, desiredReplicas, params, updateAcceptor)
+	} else {
+		err = s.rollWithUpdater(from, to, params)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Execute any post-hook.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, deployapi.PostHookPodSuffix); err != nil {
+			return fmt.Errorf("post hook failed: %s", err)
+		}
+		glog.Info("Post hook finished")
+	}
+	return nil
+}
+
+// rollWithUpdater transitions from from to to with the upstream
+// RollingUpdater.
+func (s *RollingDeploymentStrategy) rollWithUpdater(from, to *kapi.ReplicationController, params *deployapi.RollingDeploymentStrategyParams) error {
 	// HACK: Assign the source ID annotation that the rolling updater expects,
 	// unless it already exists on the deployment.
 	//
 	// Related upstream issue:
 	// https://github.com/kubernetes/kubernetes/pull/7183
-	err = wait.Poll(s.apiRetryPeriod, s.apiRetryTimeout, func() (done bool, err error) {
+	err := wait.Poll(s.apiRetryPeriod, s.apiRetryTimeout, func() (done bool, err error) {
 		existing, err := s.client.ReplicationControllers(to.Namespace).Get(to.Name)
 		if err != nil {
 			msg := fmt.Sprintf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(to), err)
@@ -206,21 +248,152 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		MaxSurge:       params.MaxSurge,
 		MaxUnavailable: params.MaxUnavailable,
 	}
-	err = s.rollingUpdate(rollingConfig)
+	return s.rollingUpdate(rollingConfig)
+}
+
+// rollInBatches transitions from from to to in batches and executes the
+// batch hook of params once the new pods of each batch are ready. A batch
+// scales to up by the surge and then from down by the same number of
+// replicas, or in the reverse order if no surge is allowed.
+func (s *RollingDeploymentStrategy) rollInBatches(from, to *kapi.ReplicationController, desiredReplicas int, params *deployapi.RollingDeploymentStrategyParams, updateAcceptor strat.UpdateAcceptor) error {
+	surge, err := intstr.GetValueFromIntOrPercent(&params.MaxSurge, desiredReplicas, true)
 	if err != nil {
 		return err
 	}
+	batchSize := surge
+	if batchSize == 0 {
+		if batchSize, err = intstr.GetValueFromIntOrPercent(&params.MaxUnavailable, desiredReplicas, false); err != nil {
+			return err
+		}
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
 
-	// Execute any post-hook.
-	if params.Post != nil {
-		if err := s.hookExecutor.Execute(params.Post, to, deployapi.PostHookPodSuffix); err != nil {
-			return fmt.Errorf("post hook failed: %s", err)
+	timeout := time.Duration(*params.TimeoutSeconds) * time.Second
+	updatePeriod := time.Duration(*params.UpdatePeriodSeconds) * time.Second
+	retryParams := kubectl.NewRetryParams(s.apiRetryPeriod, s.apiRetryTimeout)
+	waitParams := kubectl.NewRetryParams(s.apiRetryPeriod, timeout)
+
+	newReplicas, oldReplicas := 0, from.Spec.Replicas
+	for batch := 1; newReplicas < desiredReplicas || oldReplicas > 0; batch++ {
+		nextNew := newReplicas + batchSize
+		if nextNew > desiredReplicas {
+			nextNew = desiredReplicas
+		}
+		nextOld := desiredReplicas - nextNew
+		if nextOld > oldReplicas {
+			nextOld = oldReplicas
+		}
+		glog.Infof("Batch %d: scaling %s to %d and %s to %d", batch, deployutil.LabelForDeployment(to), nextNew, deployutil.LabelForDeployment(from), nextOld)
+
+		if surge == 0 {
+			if err := s.scaler.Scale(from.Namespace, from.Name, uint(nextOld), nil, retryParams, waitParams); err != nil {
+				return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(from), nextOld, err)
+			}
+		}
+		if nextNew > newReplicas {
+			if err := s.scaler.Scale(to.Namespace, to.Name, uint(nextNew), nil, retryParams, waitParams); err != nil {
+				return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), nextNew, err)
+			}
+			updatedTo, err := s.client.ReplicationControllers(to.Namespace).Get(to.Name)
+			if err != nil {
+				return err
+			}
+			if err := updateAcceptor.Accept(updatedTo); err != nil {
+				return fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err)
+			}
+			if err := s.executeBatchHook(params.Batch, updatedTo, batch); err != nil {
+				return err
+			}
+		}
+		if surge > 0 {
+			if err := s.scaler.Scale(from.Namespace, from.Name, uint(nextOld), nil, retryParams, waitParams); err != nil {
+				return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(from), nextOld, err)
+			}
+		}
+
+		newReplicas, oldReplicas = nextNew, nextOld
+		if newReplicas < desiredReplicas || oldReplicas > 0 {
+			time.Sleep(updatePeriod)
 		}
-		glog.Info("Post hook finished")
 	}
 	return nil
 }
 
+// executeBatchHook executes hook with the names of the ready pods of
+// deployment. If the hook fails with the Pause failure policy, the deployment
+// is held until it is resumed.
+func (s *RollingDeploymentStrategy) executeBatchHook(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, batch int) error {
+	pods, err := s.client.Pods(deployment.Namespace).List(kapi.ListOptions{LabelSelector: labels.SelectorFromSet(deployment.Spec.Selector)})
+	if err != nil {
+		return fmt.Errorf("couldn't list the pods of %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	names := []string{}
+	for i := range pods.Items {
+		if kapi.IsPodReady(&pods.Items[i]) {
+			names = append(names, pods.Items[i].Name)
+		}
+	}
+	sort.Strings(names)
+
+	label := fmt.Sprintf("%s-%d", deployapi.BatchHookPodSuffix, batch)
+	env := []kapi.EnvVar{{Name: NewPodsEnvVar, Value: strings.Join(names, ",")}}
+	err = s.hookExecutor.ExecuteWithEnv(hook, deployment, label, env)
+	if err == nil {
+		glog.Infof("Batch %d hook finished", batch)
+		return nil
+	}
+	if hook.FailurePolicy != deployapi.LifecycleHookFailurePolicyPause {
+		return fmt.Errorf("batch %d hook failed: %s", batch, err)
+	}
+	reason := fmt.Sprintf("batch %d hook failed: %s", batch, err)
+	if err := s.pause(deployment, reason); err != nil {
+		return err
+	}
+	glog.Infof("Resumed %s after batch %d", deployutil.LabelForDeployment(deployment), batch)
+	return nil
+}
+
+// waitForResume records reason as the pause reason of deployment and waits
+// until the reason is removed or the deployment is cancelled. The wait is
+// bounded by the active deadline of the deployer pod, which fails the
+// deployment if it is not resumed in time.
+func (s *RollingDeploymentStrategy) waitForResume(deployment *kapi.ReplicationController, reason string) error {
+	glog.Infof("Pausing %s: %s", deployutil.LabelForDeployment(deployment), reason)
+	err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		existing, err := s.client.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+		if err != nil {
+			return err
+		}
+		if existing.Annotations == nil {
+			existing.Annotations = make(map[string]string)
+		}
+		existing.Annotations[deployapi.DeploymentPausedAnnotation] = reason
+		_, err = s.client.ReplicationControllers(existing.Namespace).Update(existing)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("couldn't pause %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+
+	return wait.PollInfinite(s.apiRetryPeriod, func() (bool, error) {
+		existing, err := s.client.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return false, err
+			}
+			glog.Infof("couldn't look up deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		if deployutil.IsDeploymentCancelled(existing) {
+			return false, fmt.Errorf("%s was cancelled while paused", deployutil.LabelForDeployment(existing))
+		}
+		_, paused := existing.Annotations[deployapi.DeploymentPausedAnnotation]
+		return !paused, nil
+	})
+}
+
 // rollingUpdaterWriter is an io.Writer that delegates to glog.
 type rollingUpdaterWriter struct{}
 
@@ -232,14 +405,25 @@ func (w *rollingUpdaterWriter) Write(p []byte) (n int, err error) {
 // hookExecutor knows how to execute a deployment lifecycle hook.
 type hookExecutor interface {
 	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+	ExecuteWithEnv(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string, env []kapi.EnvVar) error
 }
 
 // hookExecutorImpl is a pluggable hookExecutor.
 type hookExecutorImpl struct {
-	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+	executeFunc        func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+	executeWithEnvFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string, env []kapi.EnvVar) error
 }
 
 // Execute executes the provided lifecycle hook
 func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
 	return i.executeFunc(hook, deployment, label)
 }
+
+// ExecuteWithEnv executes the provided lifecycle hook with additional
+// environment, falling back to executeFunc.
+func (i *hookExecutorImpl) ExecuteWithEnv(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string, env []kapi.EnvVar) error {
+	if i.executeWithEnvFunc == nil {
+		return i.executeFunc(hook, deployment, label)
+	}
+	return i.executeWithEnvFunc(hook, deployment, label, env)
+}
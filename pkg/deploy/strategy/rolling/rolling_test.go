@@ -10,9 +10,11 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
 	strat "github.com/openshift/origin/pkg/deploy/strategy"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 
@@ -213,6 +215,177 @@ func TestRolling_deployRollingHooks(t *testing.T) {
 	}
 }
 
+func TestRolling_deployRollingBatchHook(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = deploytest.OkRollingStrategy()
+	latest, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	latest.Spec.Replicas = 3
+
+	deployments := map[string]*kapi.ReplicationController{latest.Name: latest}
+	var podLabels map[string]string
+
+	fake := &ktestclient.Fake{}
+	fake.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		name := action.(ktestclient.GetAction).GetName()
+		return true, deployments[name], nil
+	})
+	fake.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		ready := kapi.PodStatus{Conditions: []kapi.PodCondition{{Type: kapi.PodReady, Status: kapi.ConditionTrue}}}
+		return true, &kapi.PodList{Items: []kapi.Pod{
+			{ObjectMeta: kapi.ObjectMeta{Name: "config-2-b", Labels: podLabels}, Status: ready},
+			{ObjectMeta: kapi.ObjectMeta{Name: "config-2-c", Labels: podLabels}},
+			{ObjectMeta: kapi.ObjectMeta{Name: "config-2-a", Labels: podLabels}, Status: ready},
+			{ObjectMeta: kapi.ObjectMeta{Name: "config-1-a"}, Status: ready},
+		}}, nil
+	})
+
+	cases := []struct {
+		name                 string
+		policy               deployapi.LifecycleHookFailurePolicy
+		hookShouldFail       bool
+		deploymentShouldFail bool
+		expectedHooks        int
+		expectedPauses       int
+	}{
+		{"success", deployapi.LifecycleHookFailurePolicyAbort, false, false, 3, 0},
+		{"abort", deployapi.LifecycleHookFailurePolicyAbort, true, true, 1, 0},
+		{"pause", deployapi.LifecycleHookFailurePolicyPause, true, false, 3, 3},
+	}
+
+	for _, tc := range cases {
+		t.Logf("evaluating case %q", tc.name)
+		labels := []string{}
+		pauses := 0
+		scaler := &scalertest.FakeScaler{}
+		strategy := &RollingDeploymentStrategy{
+			decoder: kapi.Codecs.UniversalDecoder(),
+			client:  fake,
+			scaler:  scaler,
+			rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+				t.Fatalf("unexpected call to rollingUpdate")
+				return nil
+			},
+			hookExecutor: &hookExecutorImpl{
+				executeWithEnvFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string, env []kapi.EnvVar) error {
+					labels = append(labels, label)
+					if e, a := fmt.Sprintf("%v", []kapi.EnvVar{{Name: NewPodsEnvVar, Value: "config-2-a,config-2-b"}}), fmt.Sprintf("%v", env); e != a {
+						t.Errorf("expected env %s, got %s", e, a)
+					}
+					if tc.hookShouldFail {
+						return fmt.Errorf("hook failure")
+					}
+					return nil
+				},
+			},
+			pause: func(deployment *kapi.ReplicationController, reason string) error {
+				pauses++
+				return nil
+			},
+			getUpdateAcceptor: getUpdateAcceptor,
+			apiRetryPeriod:    1 * time.Millisecond,
+			apiRetryTimeout:   10 * time.Millisecond,
+		}
+
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy.RollingParams = rollingParams("", "")
+		config.Spec.Strategy.RollingParams.UpdatePeriodSeconds = mkintp(0)
+		config.Spec.Strategy.RollingParams.MaxSurge = intstr.FromInt(1)
+		config.Spec.Strategy.RollingParams.Batch = &deployapi.LifecycleHook{
+			FailurePolicy: tc.policy,
+			ExecNewPod:    &deployapi.ExecNewPodHook{},
+		}
+		deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+		deployments[deployment.Name] = deployment
+		podLabels = deployment.Spec.Selector
+
+		err := strategy.Deploy(latest, deployment, 3)
+		if err != nil && !tc.deploymentShouldFail {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err == nil && tc.deploymentShouldFail {
+			t.Errorf("expected an error")
+		}
+		if e, a := tc.expectedHooks, len(labels); e != a {
+			t.Errorf("expected %d batch hooks, got %d: %v", e, a, labels)
+		}
+		if len(labels) > 0 && labels[0] != "hook-batch-1" {
+			t.Errorf("expected the first hook to be labelled hook-batch-1, got %s", labels[0])
+		}
+		if e, a := tc.expectedPauses, pauses; e != a {
+			t.Errorf("expected %d pauses, got %d", e, a)
+		}
+		if tc.deploymentShouldFail {
+			continue
+		}
+		expected := []scalertest.ScaleEvent{
+			{Name: deployment.Name, Size: 1}, {Name: latest.Name, Size: 2},
+			{Name: deployment.Name, Size: 2}, {Name: latest.Name, Size: 1},
+			{Name: deployment.Name, Size: 3}, {Name: latest.Name, Size: 0},
+		}
+		if e, a := fmt.Sprintf("%v", expected), fmt.Sprintf("%v", scaler.Events); e != a {
+			t.Errorf("expected scale events %s, got %s", e, a)
+		}
+	}
+}
+
+// TestRolling_deployRollingBatchSize ensures that a percentage of
+// MaxUnavailable is rounded down into the batch size.
+func TestRolling_deployRollingBatchSize(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = deploytest.OkRollingStrategy()
+	latest, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+	latest.Spec.Replicas = 3
+
+	config = deploytest.OkDeploymentConfig(2)
+	config.Spec.Strategy.RollingParams = rollingParams("", "")
+	config.Spec.Strategy.RollingParams.UpdatePeriodSeconds = mkintp(0)
+	config.Spec.Strategy.RollingParams.MaxSurge = intstr.FromInt(0)
+	config.Spec.Strategy.RollingParams.MaxUnavailable = intstr.FromString("50%")
+	config.Spec.Strategy.RollingParams.Batch = &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		ExecNewPod:    &deployapi.ExecNewPodHook{},
+	}
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0]))
+
+	fake := &ktestclient.Fake{}
+	fake.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, deployment, nil
+	})
+	fake.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.PodList{}, nil
+	})
+	scaler := &scalertest.FakeScaler{}
+	strategy := &RollingDeploymentStrategy{
+		decoder: kapi.Codecs.UniversalDecoder(),
+		client:  fake,
+		scaler:  scaler,
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+			t.Fatalf("unexpected call to rollingUpdate")
+			return nil
+		},
+		hookExecutor: &hookExecutorImpl{
+			executeWithEnvFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string, env []kapi.EnvVar) error {
+				return nil
+			},
+		},
+		getUpdateAcceptor: getUpdateAcceptor,
+		apiRetryPeriod:    1 * time.Millisecond,
+		apiRetryTimeout:   10 * time.Millisecond,
+	}
+
+	if err := strategy.Deploy(latest, deployment, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []scalertest.ScaleEvent{
+		{Name: latest.Name, Size: 2}, {Name: deployment.Name, Size: 1},
+		{Name: latest.Name, Size: 1}, {Name: deployment.Name, Size: 2},
+		{Name: latest.Name, Size: 0}, {Name: deployment.Name, Size: 3},
+	}
+	if e, a := fmt.Sprintf("%v", expected), fmt.Sprintf("%v", scaler.Events); e != a {
+		t.Errorf("expected scale events %s, got %s", e, a)
+	}
+}
+
 // TestRolling_deployInitialHooks can go away once the rolling strategy
 // supports initial deployments.
 func TestRolling_deployInitialHooks(t *testing.T) {
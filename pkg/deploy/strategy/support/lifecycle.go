@@ -3,6 +3,9 @@ package support
 import (
 	"fmt"
 	"io"
+	"net"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -42,6 +45,9 @@ type HookExecutor struct {
 	podLogStream func(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error)
 	// decoder is used for encoding/decoding.
 	decoder runtime.Decoder
+	// retryPeriod is how long to wait before retrying a failed HTTP request or
+	// TCP connection.
+	retryPeriod time.Duration
 }
 
 // NewHookExecutor makes a HookExecutor from a client.
@@ -61,6 +67,7 @@ func NewHookExecutor(client kclient.PodsNamespacer, tags client.ImageStreamTagsN
 		},
 		podLogDestination: podLogDestination,
 		decoder:           decoder,
+		retryPeriod:       1 * time.Second,
 	}
 }
 
@@ -73,6 +80,10 @@ func (e *HookExecutor) Execute(hook *deployapi.LifecycleHook, deployment *kapi.R
 		err = e.tagImages(hook, deployment, label)
 	case hook.ExecNewPod != nil:
 		err = e.executeExecNewPod(hook, deployment, label)
+	case hook.HTTPGet != nil:
+		err = e.executeHTTPGet(hook.HTTPGet, label)
+	case hook.TCPSocket != nil:
+		err = e.executeTCPSocket(hook.TCPSocket, label)
 	}
 
 	if err == nil {
@@ -86,11 +97,27 @@ func (e *HookExecutor) Execute(hook *deployapi.LifecycleHook, deployment *kapi.R
 	case deployapi.LifecycleHookFailurePolicyIgnore:
 		glog.Infof("Hook failed, ignoring: %s", err)
 		return nil
+	case deployapi.LifecycleHookFailurePolicyPause:
+		return fmt.Errorf("Hook failed, pausing: %s", err)
 	default:
 		return err
 	}
 }
 
+// ExecuteWithEnv executes hook like Execute, adding env to the environment of
+// the hook pod. The variables of env take precedence over the ones of the
+// hook.
+func (e *HookExecutor) ExecuteWithEnv(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string, env []kapi.EnvVar) error {
+	if hook.ExecNewPod != nil && len(env) > 0 {
+		exec := *hook.ExecNewPod
+		exec.Env = append(append([]kapi.EnvVar{}, exec.Env...), env...)
+		copied := *hook
+		copied.ExecNewPod = &exec
+		hook = &copied
+	}
+	return e.Execute(hook, deployment, label)
+}
+
 func findContainerImage(rc *kapi.ReplicationController, containerName string) (string, bool) {
 	if rc.Spec.Template == nil {
 		return "", false
@@ -137,6 +164,87 @@ func (e *HookExecutor) tagImages(hook *deployapi.LifecycleHook, deployment *kapi
 	return utilerrors.NewAggregate(errs)
 }
 
+// executeHTTPGet makes the request of an HTTPGet hook, retrying it up to the
+// configured number of times until the response has the expected status.
+func (e *HookExecutor) executeHTTPGet(hook *deployapi.HTTPGetHook, label string) error {
+	method := hook.Method
+	if len(method) == 0 {
+		method = "GET"
+	}
+	timeout := deployapi.DefaultHTTPGetHookTimeoutSeconds
+	if hook.TimeoutSeconds != nil {
+		timeout = *hook.TimeoutSeconds
+	}
+	client := &http.Client{Timeout: time.Duration(timeout) * time.Second}
+
+	var err error
+	for attempt := 0; attempt <= hook.Retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(e.retryPeriod)
+		}
+		if err = requestHTTPGet(client, method, hook); err == nil {
+			glog.Infof("%s hook request %s %s succeeded", label, method, hook.URL)
+			return nil
+		}
+		glog.Infof("%s hook request %s %s failed (attempt %d of %d): %v", label, method, hook.URL, attempt+1, hook.Retries+1, err)
+	}
+	return err
+}
+
+// requestHTTPGet makes a single request of hook and checks the status of the
+// response.
+func requestHTTPGet(client *http.Client, method string, hook *deployapi.HTTPGetHook) error {
+	req, err := http.NewRequest(method, hook.URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if hook.ExpectedStatus != 0 {
+		if resp.StatusCode != hook.ExpectedStatus {
+			return fmt.Errorf("expected status %d, got %s", hook.ExpectedStatus, resp.Status)
+		}
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("expected a 2xx status, got %s", resp.Status)
+	}
+	return nil
+}
+
+// executeTCPSocket waits until the address of a TCPSocket hook accepts
+// connections.
+func (e *HookExecutor) executeTCPSocket(hook *deployapi.TCPSocketHook, label string) error {
+	timeout := deployapi.DefaultTCPSocketHookTimeoutSeconds
+	if hook.TimeoutSeconds != nil {
+		timeout = *hook.TimeoutSeconds
+	}
+	address := net.JoinHostPort(hook.Host, strconv.Itoa(hook.Port))
+
+	glog.Infof("%s hook waiting %d seconds for %s to accept connections", label, timeout, address)
+	var lastErr error
+	err := wait.PollImmediate(e.retryPeriod, time.Duration(timeout)*time.Second, func() (bool, error) {
+		conn, err := net.DialTimeout("tcp", address, e.retryPeriod)
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		conn.Close()
+		return true, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("%s didn't accept connections within %d seconds: %v", address, timeout, lastErr)
+		}
+		return err
+	}
+	glog.Infof("%s hook connected to %s", label, address)
+	return nil
+}
+
 // executeExecNewPod executes a ExecNewPod hook by creating a new pod based on
 // the hook parameters and deployment. The pod is then synchronously watched
 // until the pod completes, and if the pod failed, an error is returned.
@@ -300,7 +408,8 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 		ObjectMeta: kapi.ObjectMeta{
 			Name: namer.GetPodName(deployment.Name, label),
 			Annotations: map[string]string{
-				deployapi.DeploymentAnnotation: deployment.Name,
+				deployapi.DeploymentAnnotation:     deployment.Name,
+				deployapi.DeploymentHookAnnotation: label,
 			},
 			Labels: map[string]string{
 				deployapi.DeployerPodForDeploymentLabel: deployment.Name,
@@ -384,26 +493,32 @@ func NewPodWatch(client kclient.PodsNamespacer, namespace, name, resourceVersion
 // from a real client.
 func NewAcceptNewlyObservedReadyPods(kclient kclient.PodsNamespacer, timeout time.Duration, interval time.Duration) *AcceptNewlyObservedReadyPods {
 	return &AcceptNewlyObservedReadyPods{
-		timeout:      timeout,
-		interval:     interval,
-		acceptedPods: sets.NewString(),
-		getDeploymentPodStore: func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
-			selector := labels.Set(deployment.Spec.Selector).AsSelector()
-			store := cache.NewStore(cache.MetaNamespaceKeyFunc)
-			lw := &cache.ListWatch{
-				ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
-					opts := kapi.ListOptions{LabelSelector: selector}
-					return kclient.Pods(deployment.Namespace).List(opts)
-				},
-				WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
-					opts := kapi.ListOptions{LabelSelector: selector, ResourceVersion: options.ResourceVersion}
-					return kclient.Pods(deployment.Namespace).Watch(opts)
-				},
-			}
-			stop := make(chan struct{})
-			cache.NewReflector(lw, &kapi.Pod{}, store, 10*time.Second).RunUntil(stop)
-			return store, stop
-		},
+		timeout:               timeout,
+		interval:              interval,
+		acceptedPods:          sets.NewString(),
+		getDeploymentPodStore: newDeploymentPodStore(kclient),
+	}
+}
+
+// newDeploymentPodStore returns a function which makes a Store of the pods
+// of a deployment fed by a reflector, and the channel which stops it.
+func newDeploymentPodStore(kclient kclient.PodsNamespacer) func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
+	return func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
+		selector := labels.Set(deployment.Spec.Selector).AsSelector()
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		lw := &cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				opts := kapi.ListOptions{LabelSelector: selector}
+				return kclient.Pods(deployment.Namespace).List(opts)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				opts := kapi.ListOptions{LabelSelector: selector, ResourceVersion: options.ResourceVersion}
+				return kclient.Pods(deployment.Namespace).Watch(opts)
+			},
+		}
+		stop := make(chan struct{})
+		cache.NewReflector(lw, &kapi.Pod{}, store, 10*time.Second).RunUntil(stop)
+		return store, stop
 	}
 }
 
@@ -480,3 +595,54 @@ func (c *AcceptNewlyObservedReadyPods) Accept(deployment *kapi.ReplicationContro
 	}
 	return nil
 }
+
+// NewObserveHealthyPods makes a new ObserveHealthyPods from a real client.
+func NewObserveHealthyPods(kclient kclient.PodsNamespacer, interval time.Duration) *ObserveHealthyPods {
+	return &ObserveHealthyPods{
+		interval:              interval,
+		getDeploymentPodStore: newDeploymentPodStore(kclient),
+	}
+}
+
+// ObserveHealthyPods watches the pods of a deployment for a period of time
+// and fails as soon as one of them is unready or one of its containers
+// restarts. Restarts are counted from the first time a pod is observed.
+type ObserveHealthyPods struct {
+	// getDeploymentPodStore should return a Store containing all the pods for
+	// the named deployment, and a channel to stop whatever process is feeding
+	// the store.
+	getDeploymentPodStore func(deployment *kapi.ReplicationController) (cache.Store, chan struct{})
+	// interval is how often to check the pods.
+	interval time.Duration
+}
+
+// Observe returns an error if the pods of deployment do not stay healthy for
+// the given period.
+func (o *ObserveHealthyPods) Observe(deployment *kapi.ReplicationController, period time.Duration) error {
+	podStore, stopStore := o.getDeploymentPodStore(deployment)
+	defer close(stopStore)
+
+	glog.V(0).Infof("Observing the pods of deployment %q for %.f seconds", deployutil.LabelForDeployment(deployment), period.Seconds())
+	restarts := map[string]int{}
+	err := wait.Poll(o.interval, period, func() (bool, error) {
+		for _, obj := range podStore.List() {
+			pod := obj.(*kapi.Pod)
+			if !kapi.IsPodReady(pod) {
+				return false, fmt.Errorf("pod %s is not ready", pod.Name)
+			}
+			count := 0
+			for _, status := range pod.Status.ContainerStatuses {
+				count += status.RestartCount
+			}
+			if observed, ok := restarts[pod.Name]; ok && count > observed {
+				return false, fmt.Errorf("pod %s restarted %d times", pod.Name, count-observed)
+			}
+			restarts[pod.Name] = count
+		}
+		return false, nil
+	})
+	if err != nil && err != wait.ErrWaitTimeout {
+		return fmt.Errorf("pods of deployment %q are unhealthy: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	return nil
+}
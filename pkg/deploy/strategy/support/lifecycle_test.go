@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -147,6 +151,170 @@ func TestHookExecutor_executeExecNewPodFailed(t *testing.T) {
 	t.Logf("got expected error: %s", err)
 }
 
+func TestHookExecutor_executeWithEnv(t *testing.T) {
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyPause,
+		ExecNewPod: &deployapi.ExecNewPodHook{
+			ContainerName: "container1",
+			Env:           []kapi.EnvVar{{Name: "NEW_PODS", Value: "none"}},
+		},
+	}
+
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+
+	var createdPod *kapi.Pod
+	executor := &HookExecutor{
+		podClient: &HookExecutorPodClientImpl{
+			CreatePodFunc: func(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
+				createdPod = pod
+				return createdPod, nil
+			},
+			PodWatchFunc: func(namespace, name, resourceVersion string, stopChannel chan struct{}) func() *kapi.Pod {
+				createdPod.Status.Phase = kapi.PodFailed
+				return func() *kapi.Pod { return createdPod }
+			},
+		},
+		podLogDestination: ioutil.Discard,
+		podLogStream: func(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error) {
+			return nil, fmt.Errorf("can't access logs")
+		},
+		decoder: kapi.Codecs.UniversalDecoder(),
+	}
+
+	err := executor.ExecuteWithEnv(hook, deployment, "hook-batch-1", []kapi.EnvVar{{Name: "NEW_PODS", Value: "pod-1,pod-2"}})
+	if err == nil {
+		t.Fatalf("expected an error, got none")
+	}
+	t.Logf("got expected error: %s", err)
+
+	found := false
+	for _, env := range createdPod.Spec.Containers[0].Env {
+		if env.Name == "NEW_PODS" {
+			found = true
+			if e, a := "pod-1,pod-2", env.Value; e != a {
+				t.Fatalf("expected NEW_PODS=%q, got %q", e, a)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("expected NEW_PODS in the environment of the hook pod")
+	}
+	if e, a := 1, len(hook.ExecNewPod.Env); e != a {
+		t.Fatalf("expected the hook to be left unchanged, got %d env vars", a)
+	}
+}
+
+func TestHookExecutor_executeHTTPGet(t *testing.T) {
+	var requests []string
+	failures := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method)
+		if len(requests) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		policy         deployapi.LifecycleHookFailurePolicy
+		hook           deployapi.HTTPGetHook
+		failures       int
+		expectedErr    bool
+		expectedMethod string
+		expectedCount  int
+	}{
+		{
+			name:           "success",
+			policy:         deployapi.LifecycleHookFailurePolicyAbort,
+			hook:           deployapi.HTTPGetHook{URL: server.URL},
+			expectedMethod: "GET",
+			expectedCount:  1,
+		},
+		{
+			name:           "success after retries",
+			policy:         deployapi.LifecycleHookFailurePolicyAbort,
+			hook:           deployapi.HTTPGetHook{URL: server.URL, Method: "POST", ExpectedStatus: http.StatusAccepted, Retries: 2},
+			failures:       2,
+			expectedMethod: "POST",
+			expectedCount:  3,
+		},
+		{
+			name:           "retries exhausted",
+			policy:         deployapi.LifecycleHookFailurePolicyAbort,
+			hook:           deployapi.HTTPGetHook{URL: server.URL, Retries: 1},
+			failures:       2,
+			expectedErr:    true,
+			expectedMethod: "GET",
+			expectedCount:  2,
+		},
+		{
+			name:           "unexpected status",
+			policy:         deployapi.LifecycleHookFailurePolicyAbort,
+			hook:           deployapi.HTTPGetHook{URL: server.URL, ExpectedStatus: http.StatusOK},
+			expectedErr:    true,
+			expectedMethod: "GET",
+			expectedCount:  1,
+		},
+		{
+			name:           "ignored failure",
+			policy:         deployapi.LifecycleHookFailurePolicyIgnore,
+			hook:           deployapi.HTTPGetHook{URL: server.URL, ExpectedStatus: http.StatusOK},
+			expectedMethod: "GET",
+			expectedCount:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Logf("evaluating test %q", test.name)
+		requests = nil
+		failures = test.failures
+		hook := test.hook
+		executor := &HookExecutor{retryPeriod: 1 * time.Millisecond}
+		err := executor.Execute(&deployapi.LifecycleHook{FailurePolicy: test.policy, HTTPGet: &hook}, nil, "hook")
+		if err != nil && !test.expectedErr {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if err == nil && test.expectedErr {
+			t.Errorf("expected an error")
+		}
+		if e, a := test.expectedCount, len(requests); e != a {
+			t.Errorf("expected %d requests, got %d", e, a)
+		}
+		if len(requests) > 0 && requests[0] != test.expectedMethod {
+			t.Errorf("expected method %s, got %s", test.expectedMethod, requests[0])
+		}
+	}
+}
+
+func TestHookExecutor_executeTCPSocket(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+	timeout := int64(1)
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		TCPSocket:     &deployapi.TCPSocketHook{Host: host, Port: portNumber, TimeoutSeconds: &timeout},
+	}
+	executor := &HookExecutor{retryPeriod: 10 * time.Millisecond}
+
+	if err := executor.Execute(hook, nil, "hook"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	listener.Close()
+	if err := executor.Execute(hook, nil, "hook"); err == nil {
+		t.Fatalf("expected an error once the listener is closed")
+	} else {
+		t.Logf("got expected error: %v", err)
+	}
+}
+
 func TestHookExecutor_makeHookPodInvalidContainerRef(t *testing.T) {
 	hook := &deployapi.LifecycleHook{
 		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
@@ -205,7 +373,8 @@ func TestHookExecutor_makeHookPod(t *testing.T) {
 						deployapi.DeployerPodForDeploymentLabel: deploymentName,
 					},
 					Annotations: map[string]string{
-						deployapi.DeploymentAnnotation: deploymentName,
+						deployapi.DeploymentAnnotation:     deploymentName,
+						deployapi.DeploymentHookAnnotation: "hook",
 					},
 				},
 				Spec: kapi.PodSpec{
@@ -277,7 +446,8 @@ func TestHookExecutor_makeHookPod(t *testing.T) {
 						deployapi.DeployerPodForDeploymentLabel: deploymentName,
 					},
 					Annotations: map[string]string{
-						deployapi.DeploymentAnnotation: deploymentName,
+						deployapi.DeploymentAnnotation:     deploymentName,
+						deployapi.DeploymentHookAnnotation: "hook",
 					},
 				},
 				Spec: kapi.PodSpec{
@@ -333,8 +503,9 @@ func TestHookExecutor_makeHookPod(t *testing.T) {
 						"label1": "value1",
 					},
 					Annotations: map[string]string{
-						deployapi.DeploymentAnnotation: deploymentName,
-						"annotation2":                  "value2",
+						deployapi.DeploymentAnnotation:     deploymentName,
+						deployapi.DeploymentHookAnnotation: "hook",
+						"annotation2":                      "value2",
 					},
 				},
 				Spec: kapi.PodSpec{
@@ -622,3 +793,80 @@ func (a envByNameAsc) Swap(i, j int) {
 func (a envByNameAsc) Less(i, j int) bool {
 	return a[j].Name < a[i].Name
 }
+
+func TestObserveHealthyPods_scenarios(t *testing.T) {
+	scenarios := []struct {
+		name string
+		// the pods which will be in the store; pod name -> ready
+		currentPods map[string]bool
+		// whether the first pod restarts while it is observed
+		restart bool
+		// whether or not the scenario should result in healthy pods
+		healthy bool
+	}{
+		{
+			name:        "all ready",
+			currentPods: map[string]bool{"pod-1": true, "pod-2": true},
+			healthy:     true,
+		},
+		{
+			name:        "one unready",
+			currentPods: map[string]bool{"pod-1": true, "pod-2": false},
+			healthy:     false,
+		},
+		{
+			name:        "ready but restarting",
+			currentPods: map[string]bool{"pod-1": true},
+			restart:     true,
+			healthy:     false,
+		},
+	}
+	for _, s := range scenarios {
+		t.Logf("running scenario: %s", s.name)
+
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		for podName, ready := range s.currentPods {
+			status := kapi.ConditionTrue
+			if !ready {
+				status = kapi.ConditionFalse
+			}
+			store.Add(&kapi.Pod{
+				ObjectMeta: kapi.ObjectMeta{Name: podName},
+				Status: kapi.PodStatus{
+					Conditions:        []kapi.PodCondition{{Type: kapi.PodReady, Status: status}},
+					ContainerStatuses: []kapi.ContainerStatus{{Name: "container1"}},
+				},
+			})
+		}
+
+		observer := &ObserveHealthyPods{
+			interval: 1 * time.Millisecond,
+			getDeploymentPodStore: func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
+				return store, make(chan struct{})
+			},
+		}
+		if s.restart {
+			go func() {
+				time.Sleep(5 * time.Millisecond)
+				obj, _, _ := store.GetByKey("pod-1")
+				pod := *obj.(*kapi.Pod)
+				pod.Status.ContainerStatuses = []kapi.ContainerStatus{{Name: "container1", RestartCount: 1}}
+				store.Update(&pod)
+			}()
+		}
+
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		err := observer.Observe(deployment, 50*time.Millisecond)
+
+		if s.healthy {
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		} else {
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			t.Logf("got expected error: %s", err)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package support
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/common/model"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// DeploymentVerifier runs the verification phase of a deployment. It polls the
+// metric of a DeploymentVerification until the verification times out and
+// fails as soon as the metric breaches one of its thresholds.
+type DeploymentVerifier struct {
+	// client makes the metric requests.
+	client *http.Client
+}
+
+// NewDeploymentVerifier makes a DeploymentVerifier.
+func NewDeploymentVerifier() *DeploymentVerifier {
+	return &DeploymentVerifier{
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Verify runs verification for deployment.
+func (v *DeploymentVerifier) Verify(verification *deployapi.DeploymentVerification, deployment *kapi.ReplicationController) error {
+	interval := deployapi.DefaultVerificationIntervalSeconds
+	if verification.IntervalSeconds != nil {
+		interval = *verification.IntervalSeconds
+	}
+	timeout := deployapi.DefaultVerificationTimeoutSeconds
+	if verification.TimeoutSeconds != nil {
+		timeout = *verification.TimeoutSeconds
+	}
+	return v.verify(verification, deployment, time.Duration(interval)*time.Second, time.Duration(timeout)*time.Second)
+}
+
+func (v *DeploymentVerifier) verify(verification *deployapi.DeploymentVerification, deployment *kapi.ReplicationController, interval, timeout time.Duration) error {
+	var min, max *float64
+	if len(verification.MinValue) > 0 {
+		value, err := strconv.ParseFloat(verification.MinValue, 64)
+		if err != nil {
+			return fmt.Errorf("invalid minimum value %q: %v", verification.MinValue, err)
+		}
+		min = &value
+	}
+	if len(verification.MaxValue) > 0 {
+		value, err := strconv.ParseFloat(verification.MaxValue, 64)
+		if err != nil {
+			return fmt.Errorf("invalid maximum value %q: %v", verification.MaxValue, err)
+		}
+		max = &value
+	}
+
+	glog.Infof("Verifying deployment %s every %.f seconds for %.f seconds", deployutil.LabelForDeployment(deployment), interval.Seconds(), timeout.Seconds())
+	measured := false
+	var lastErr, breach error
+	err := wait.Poll(interval, timeout, func() (bool, error) {
+		value, err := v.measure(verification, deployment)
+		if err != nil {
+			lastErr = err
+			glog.Infof("Couldn't get the verification metric: %v", err)
+			return false, nil
+		}
+		measured = true
+		glog.V(4).Infof("Verification metric value: %v", value)
+		switch {
+		case min != nil && value < *min:
+			breach = fmt.Errorf("verification failed: metric value %v is below the minimum %s", value, verification.MinValue)
+		case max != nil && value > *max:
+			breach = fmt.Errorf("verification failed: metric value %v is above the maximum %s", value, verification.MaxValue)
+		default:
+			return false, nil
+		}
+		return true, nil
+	})
+	switch {
+	case breach != nil:
+		return breach
+	case err != nil && err != wait.ErrWaitTimeout:
+		return err
+	case !measured:
+		return fmt.Errorf("verification failed: couldn't get any value of the metric: %v", lastErr)
+	}
+	glog.Infof("Verified deployment %s", deployutil.LabelForDeployment(deployment))
+	return nil
+}
+
+// measure returns the current value of the metric of verification.
+func (v *DeploymentVerifier) measure(verification *deployapi.DeploymentVerification, deployment *kapi.ReplicationController) (float64, error) {
+	expand := func(s string) string {
+		return deployutil.ExpandVerificationPlaceholders(s, deployment.Name, deployment.Namespace)
+	}
+	switch {
+	case verification.Prometheus != nil:
+		return v.queryPrometheus(expand(verification.Prometheus.URL), expand(verification.Prometheus.Query))
+	case verification.HTTPGet != nil:
+		return v.getMetric(expand(verification.HTTPGet.URL))
+	default:
+		return 0, fmt.Errorf("no metric source")
+	}
+}
+
+// prometheusQueryResponse is the response of the query endpoint of the
+// Prometheus HTTP API.
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType model.ValueType `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// queryPrometheus evaluates query with the Prometheus-compatible HTTP API at
+// baseURL. The query must result in a scalar or in a vector of one sample.
+func (v *DeploymentVerifier) queryPrometheus(baseURL, query string) (float64, error) {
+	resp, err := v.client.Get(strings.TrimSuffix(baseURL, "/") + "/api/v1/query?query=" + url.QueryEscape(query))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	result := &prometheusQueryResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return 0, fmt.Errorf("couldn't decode the query response (%s): %v", resp.Status, err)
+	}
+	if result.Status != "success" {
+		return 0, fmt.Errorf("query failed: %s", result.Error)
+	}
+
+	switch result.Data.ResultType {
+	case model.ValScalar:
+		scalar := &model.Scalar{}
+		if err := json.Unmarshal(result.Data.Result, scalar); err != nil {
+			return 0, err
+		}
+		return float64(scalar.Value), nil
+	case model.ValVector:
+		vector := model.Vector{}
+		if err := json.Unmarshal(result.Data.Result, &vector); err != nil {
+			return 0, err
+		}
+		if len(vector) != 1 {
+			return 0, fmt.Errorf("expected the query to return 1 sample, got %d", len(vector))
+		}
+		return float64(vector[0].Value), nil
+	default:
+		return 0, fmt.Errorf("expected the query to return a scalar or a vector, got a %s", result.Data.ResultType)
+	}
+}
+
+// getMetric requests rawURL, whose response body must be a number.
+func (v *DeploymentVerifier) getMetric(rawURL string) (float64, error) {
+	resp, err := v.client.Get(rawURL)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return 0, fmt.Errorf("expected a 2xx status, got %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(strings.TrimSpace(string(body)), 64)
+}
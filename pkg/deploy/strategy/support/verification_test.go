@@ -0,0 +1,156 @@
+package support
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+func TestDeploymentVerifier_httpGet(t *testing.T) {
+	var values []string
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.URL.Path)
+		if len(values) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		value := values[0]
+		if len(values) > 1 {
+			values = values[1:]
+		}
+		fmt.Fprintln(w, value)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		values      []string
+		min, max    string
+		expectedErr bool
+	}{
+		{
+			name:   "within thresholds",
+			values: []string{"0.5"},
+			min:    "0",
+			max:    "1",
+		},
+		{
+			name:        "above the maximum",
+			values:      []string{"0.5", "1.5"},
+			max:         "1",
+			expectedErr: true,
+		},
+		{
+			name:        "below the minimum",
+			values:      []string{"-1"},
+			min:         "0",
+			expectedErr: true,
+		},
+		{
+			name:        "not a number",
+			values:      []string{"ok"},
+			max:         "1",
+			expectedErr: true,
+		},
+		{
+			name:        "unavailable",
+			max:         "1",
+			expectedErr: true,
+		},
+	}
+
+	deployment := &kapi.ReplicationController{ObjectMeta: kapi.ObjectMeta{Name: "config-1", Namespace: "test"}}
+	for _, test := range tests {
+		values = test.values
+		paths = nil
+		verification := &deployapi.DeploymentVerification{
+			HTTPGet:  &deployapi.HTTPGetMetric{URL: server.URL + "/metrics/${DEPLOYMENT_NAMESPACE}/${DEPLOYMENT_NAME}"},
+			MinValue: test.min,
+			MaxValue: test.max,
+		}
+		err := NewDeploymentVerifier().verify(verification, deployment, 1*time.Millisecond, 50*time.Millisecond)
+		if err != nil && !test.expectedErr {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if err == nil && test.expectedErr {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if len(paths) == 0 || paths[0] != "/metrics/test/config-1" {
+			t.Errorf("%s: expected requests of /metrics/test/config-1, got %v", test.name, paths)
+		}
+	}
+}
+
+func TestDeploymentVerifier_prometheus(t *testing.T) {
+	var response string
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/query" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		queries = append(queries, req.URL.Query().Get("query"))
+		fmt.Fprint(w, response)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		response    string
+		expectedErr bool
+	}{
+		{
+			name:     "scalar",
+			response: `{"status":"success","data":{"resultType":"scalar","result":[1457000000,"0.01"]}}`,
+		},
+		{
+			name:     "vector",
+			response: `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1457000000,"0.01"]}]}}`,
+		},
+		{
+			name:        "breach",
+			response:    `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1457000000,"0.2"]}]}}`,
+			expectedErr: true,
+		},
+		{
+			name:        "several samples",
+			response:    `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"a":"1"},"value":[1457000000,"0"]},{"metric":{"a":"2"},"value":[1457000000,"0"]}]}}`,
+			expectedErr: true,
+		},
+		{
+			name:        "query error",
+			response:    `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			expectedErr: true,
+		},
+	}
+
+	deployment := &kapi.ReplicationController{ObjectMeta: kapi.ObjectMeta{Name: "config-1", Namespace: "test"}}
+	for _, test := range tests {
+		response = test.response
+		queries = nil
+		verification := &deployapi.DeploymentVerification{
+			Prometheus: &deployapi.PrometheusQuery{
+				URL:   server.URL + "/",
+				Query: `error_ratio{deployment="${DEPLOYMENT_NAME}"}`,
+			},
+			MaxValue: "0.1",
+		}
+		err := NewDeploymentVerifier().verify(verification, deployment, 1*time.Millisecond, 50*time.Millisecond)
+		if err != nil && !test.expectedErr {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if err == nil && test.expectedErr {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if len(queries) == 0 || queries[0] != `error_ratio{deployment="config-1"}` {
+			t.Errorf("%s: unexpected queries %v", test.name, queries)
+		}
+	}
+}
@@ -227,6 +227,12 @@ func MakeDeployment(config *deployapi.DeploymentConfig, codec runtime.Codec) (*a
 	return deployment, nil
 }
 
+// ExpandVerificationPlaceholders replaces the ${DEPLOYMENT_NAME} and
+// ${DEPLOYMENT_NAMESPACE} placeholders of a deployment verification URL or query.
+func ExpandVerificationPlaceholders(s, name, namespace string) string {
+	return strings.NewReplacer("${DEPLOYMENT_NAME}", name, "${DEPLOYMENT_NAMESPACE}", namespace).Replace(s)
+}
+
 func DeploymentConfigNameFor(obj runtime.Object) string {
 	return annotationFor(obj, deployapi.DeploymentConfigAnnotation)
 }
@@ -300,6 +306,22 @@ func intAnnotationFor(obj runtime.Object, key string) (int, bool) {
 	return i, true
 }
 
+// CanaryReplicas returns the number of replicas of the new deployment which
+// receive percent of the traffic of desiredReplicas, the traffic being split
+// in proportion to the number of pods of each deployment. The number is
+// rounded up, there is always at least one canary and at least one replica
+// left to the old deployment, unless desiredReplicas is one.
+func CanaryReplicas(desiredReplicas, percent int) int {
+	replicas := (desiredReplicas*percent + 99) / 100
+	if replicas >= desiredReplicas {
+		replicas = desiredReplicas - 1
+	}
+	if replicas < 1 {
+		replicas = 1
+	}
+	return replicas
+}
+
 // ByLatestVersionAsc sorts deployments by LatestVersion ascending.
 type ByLatestVersionAsc []api.ReplicationController
 
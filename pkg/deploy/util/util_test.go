@@ -133,6 +133,25 @@ func TestMakeDeploymentOk(t *testing.T) {
 	}
 }
 
+func TestCanaryReplicas(t *testing.T) {
+	tests := []struct {
+		desired, percent, expected int
+	}{
+		{desired: 10, percent: 10, expected: 1},
+		{desired: 10, percent: 15, expected: 2},
+		{desired: 10, percent: 50, expected: 5},
+		{desired: 10, percent: 99, expected: 9},
+		{desired: 3, percent: 10, expected: 1},
+		{desired: 2, percent: 90, expected: 1},
+		{desired: 1, percent: 50, expected: 1},
+	}
+	for _, test := range tests {
+		if e, a := test.expected, CanaryReplicas(test.desired, test.percent); e != a {
+			t.Errorf("%d%% of %d: expected %d, got %d", test.percent, test.desired, e, a)
+		}
+	}
+}
+
 func TestDeploymentsByLatestVersion_sorting(t *testing.T) {
 	mkdeployment := func(version int) kapi.ReplicationController {
 		deployment, _ := MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
@@ -0,0 +1,192 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+// maxWindowSearchDays bounds the search for the next start of a deployment
+// window. Four years plus a day covers schedules which only match on leap days.
+const maxWindowSearchDays = 4*366 + 1
+
+// WindowSchedule is a parsed cron schedule of a deployment window.
+type WindowSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar record whether the day of month and day of week
+	// fields were '*', in which case the days match on the other field only.
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+// ParseWindowSchedule parses a cron expression with the five fields minute,
+// hour, day of month, month and day of week. Each field is '*', a value, a
+// range 'a-b' or a comma separated list of them, and may be followed by a step
+// '/n'.
+func ParseWindowSchedule(spec string) (*WindowSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("expected %d fields (minute hour day-of-month month day-of-week), got %d", len(cronFields), len(fields))
+	}
+	bits := make([]uint64, len(fields))
+	for i, field := range fields {
+		b, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		bits[i] = b
+	}
+	return &WindowSchedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, part)
+			}
+			rng, step = part[:i], s
+		}
+		low, high := f.min, f.max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			var err1, err2 error
+			low, err1 = strconv.Atoi(bounds[0])
+			high, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil || low > high {
+				return 0, fmt.Errorf("invalid range in %s field %q", f.name, part)
+			}
+		default:
+			v, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value in %s field %q", f.name, part)
+			}
+			low = v
+			if step == 1 {
+				high = v
+			}
+		}
+		if low < f.min || high > f.max {
+			return 0, fmt.Errorf("%s field %q must be within %d-%d", f.name, part, f.min, f.max)
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (s *WindowSchedule) matchesDay(t time.Time) bool {
+	if s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	// Like cron, a day matches either restricted day field if both are set.
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dow
+	case s.dowStar:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// Next returns the first start of the schedule at or after t, or the zero time
+// if the schedule never matches.
+func (s *WindowSchedule) Next(t time.Time) time.Time {
+	if t.Truncate(time.Minute) != t {
+		t = t.Truncate(time.Minute).Add(time.Minute)
+	}
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	for i := 0; i < maxWindowSearchDays; i++ {
+		if s.matchesDay(day) {
+			for h := 0; h < 24; h++ {
+				if s.hour&(1<<uint(h)) == 0 {
+					continue
+				}
+				for m := 0; m < 60; m++ {
+					if s.minute&(1<<uint(m)) == 0 {
+						continue
+					}
+					start := time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, day.Location())
+					if !start.Before(t) {
+						return start
+					}
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+// DeploymentWindowState reports whether any of windows is open at now and, if
+// none is, when the next one opens. Windows are evaluated in UTC and windows
+// with an invalid schedule are ignored. The next opening is the zero time if no
+// window ever opens.
+func DeploymentWindowState(windows []deployapi.DeploymentWindow, now time.Time) (bool, time.Time) {
+	now = now.UTC()
+	var next time.Time
+	for _, window := range windows {
+		schedule, err := ParseWindowSchedule(window.Schedule)
+		if err != nil {
+			continue
+		}
+		duration := time.Duration(window.DurationMinutes) * time.Minute
+		// The window is open if it started within its duration before now.
+		if start := schedule.Next(now.Add(-duration).Add(time.Nanosecond)); !start.IsZero() && !start.After(now) {
+			return true, time.Time{}
+		}
+		if start := schedule.Next(now); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+	}
+	return false, next
+}
+
+// DeploymentDeferred reports whether the latest version of config must wait for
+// one of its deployment windows, and when the next window opens. Configs
+// without windows and versions forced with the DeploymentWindowOverrideAnnotation
+// are never deferred.
+func DeploymentDeferred(config *deployapi.DeploymentConfig, now time.Time) (bool, time.Time) {
+	if len(config.Spec.Windows) == 0 {
+		return false, time.Time{}
+	}
+	if config.Annotations[deployapi.DeploymentWindowOverrideAnnotation] == strconv.Itoa(config.Status.LatestVersion) {
+		return false, time.Time{}
+	}
+	open, next := DeploymentWindowState(config.Spec.Windows, now)
+	return !open, next
+}
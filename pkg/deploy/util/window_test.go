@@ -0,0 +1,131 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+)
+
+func TestParseWindowSchedule(t *testing.T) {
+	valid := []string{
+		"* * * * *",
+		"0 2 * * 6",
+		"*/15 9-17 * * 1-5",
+		"0,30 0 1,15 1-12/3 *",
+		"5/20 * * * 0",
+	}
+	for _, spec := range valid {
+		if _, err := ParseWindowSchedule(spec); err != nil {
+			t.Errorf("%q: unexpected error: %v", spec, err)
+		}
+	}
+	invalid := []string{
+		"",
+		"0 2 * *",
+		"0 2 * * 6 2016",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 7",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+	}
+	for _, spec := range invalid {
+		if _, err := ParseWindowSchedule(spec); err == nil {
+			t.Errorf("%q: expected an error", spec)
+		}
+	}
+}
+
+func TestWindowScheduleNext(t *testing.T) {
+	// A Saturday.
+	now := time.Date(2016, 3, 5, 10, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2016, 3, 5, 10, 1, 0, 0, time.UTC)},
+		{"0 2 * * 6", time.Date(2016, 3, 12, 2, 0, 0, 0, time.UTC)},
+		{"*/15 9-17 * * 1-5", time.Date(2016, 3, 7, 9, 0, 0, 0, time.UTC)},
+		// Either restricted day field matches.
+		{"0 0 1 * 1", time.Date(2016, 3, 7, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, test := range tests {
+		schedule, err := ParseWindowSchedule(test.spec)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", test.spec, err)
+		}
+		if e, a := test.expected, schedule.Next(now); !e.Equal(a) {
+			t.Errorf("%q: expected next start %v, got %v", test.spec, e, a)
+		}
+	}
+}
+
+func TestDeploymentDeferred(t *testing.T) {
+	// A Saturday.
+	now := time.Date(2016, 3, 5, 10, 0, 0, 0, time.UTC)
+	nightly := deployapi.DeploymentWindow{Schedule: "0 2 * * *", DurationMinutes: 60}
+	morning := deployapi.DeploymentWindow{Schedule: "0 9 * * 6", DurationMinutes: 60}
+
+	tests := []struct {
+		name         string
+		windows      []deployapi.DeploymentWindow
+		override     string
+		expectedNext time.Time
+		deferred     bool
+	}{
+		{
+			name: "no windows",
+		},
+		{
+			name:         "closed",
+			windows:      []deployapi.DeploymentWindow{nightly},
+			deferred:     true,
+			expectedNext: time.Date(2016, 3, 6, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			// The window closes exactly at now.
+			name:         "just closed",
+			windows:      []deployapi.DeploymentWindow{morning},
+			deferred:     true,
+			expectedNext: time.Date(2016, 3, 12, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "open",
+			windows: []deployapi.DeploymentWindow{nightly, {Schedule: "0 9 * * 6", DurationMinutes: 61}},
+		},
+		{
+			name:     "forced",
+			windows:  []deployapi.DeploymentWindow{nightly},
+			override: "1",
+		},
+		{
+			name:         "older version forced",
+			windows:      []deployapi.DeploymentWindow{nightly},
+			override:     "0",
+			deferred:     true,
+			expectedNext: time.Date(2016, 3, 6, 2, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, test := range tests {
+		config := deploytest.OkDeploymentConfig(1)
+		config.Spec.Windows = test.windows
+		if len(test.override) > 0 {
+			config.Annotations = map[string]string{deployapi.DeploymentWindowOverrideAnnotation: test.override}
+		}
+		deferred, next := DeploymentDeferred(config, now)
+		if deferred != test.deferred {
+			t.Errorf("%s: expected deferred %t, got %t", test.name, test.deferred, deferred)
+		}
+		if !next.Equal(test.expectedNext) {
+			t.Errorf("%s: expected next window %v, got %v", test.name, test.expectedNext, next)
+		}
+	}
+}
@@ -28,6 +28,7 @@ type Repository interface {
 	CloneBare(dir string, url string) error
 	CloneMirror(dir string, url string) error
 	Fetch(dir string) error
+	FetchRef(dir string, ref string) error
 	Checkout(dir string, ref string) error
 	SubmoduleUpdate(dir string, init, recursive bool) error
 	Archive(dir, ref, format string, w io.Writer) error
@@ -220,6 +221,13 @@ func (r *repository) Fetch(location string) error {
 	return err
 }
 
+// FetchRef fetches the given ref from origin into FETCH_HEAD, for refs such
+// as pull request heads that are not fetched by a clone
+func (r *repository) FetchRef(location string, ref string) error {
+	_, _, err := r.git(nil, location, "fetch", "origin", ref)
+	return err
+}
+
 // Archive creates a archive of the Git repo at directory location at commit ref and with the given Git format,
 // and then writes that to the provided io.Writer
 func (r *repository) Archive(location, ref, format string, w io.Writer) error {
@@ -220,7 +220,7 @@ func removeBuildStrategyPrivileges(t *testing.T, clusterRoleInterface client.Clu
 	}
 
 	for i := range role.Rules {
-		role.Rules[i].Resources.Delete(authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource)
+		role.Rules[i].Resources.Delete(authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, authorizationapi.PipelineBuildResource)
 	}
 	if _, err := clusterRoleInterface.Update(role); err != nil {
 		t.Errorf("unexpected error: %v", err)